@@ -1,54 +1,69 @@
 package api
 
 import (
-	"database/sql"
+	"errors"
 	"net/http"
 
 	db "github.com/Glenn444/banking-app/internal/database"
 	"github.com/Glenn444/banking-app/internal/token"
+	"github.com/Glenn444/banking-app/util"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
-	"github.com/lib/pq"
 	"github.com/shopspring/decimal"
 )
 
 type createAccountRequest struct {
 	Owner    string `json:"owner" binding:"required"`
 	Currency string `json:"currency" binding:"required,currency"`
+	// AccountType is checking or savings, checking when left out
+	AccountType string `json:"account_type" binding:"omitempty,oneof=checking savings"`
 }
 
 func (server *Server) createAccount(ctx *gin.Context) {
 	var req createAccountRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
-		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		ctx.Error(statusError(http.StatusBadRequest, err))
 		return
 	}
 
 	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
 	if authPayload.Username != req.Owner {
-		ctx.JSON(http.StatusForbidden, errorMessage("you can only create your own account"))
+		ctx.Error(statusMessage(http.StatusForbidden, "you can only create your own account"))
 		return
 	}
 
+	if !util.IsEnabledCurrency(req.Currency) {
+		ctx.Error(statusMessage(http.StatusBadRequest, "currency is disabled"))
+		return
+	}
+
+	if req.AccountType == "" {
+		req.AccountType = db.AccountTypeChecking
+	}
+
 	arg := db.CreateAccountParams{
-		Owner:    req.Owner,
-		Currency: req.Currency,
-		Balance:  decimal.Zero,
+		Owner:       req.Owner,
+		Currency:    req.Currency,
+		Balance:     decimal.Zero,
+		AccountType: req.AccountType,
 	}
 
 	acc, err := server.store.CreateAccount(ctx, arg)
 	if err != nil {
-		if pqError, ok := err.(*pq.Error); ok {
-			switch pqError.Code.Name() {
-			case "foreign_key_violation":
-				ctx.JSON(http.StatusForbidden, errorMessage("owner does not exist"))
-				return
-			case "unique_violation":
-				ctx.JSON(http.StatusConflict, errorMessage("account with this currency already exists for owner"))
+		err = db.TranslateError(err)
+		switch {
+		case errors.Is(err, db.ErrForeignKeyViolation):
+			if db.ViolatedConstraint(err) == "fk_account_currency" {
+				ctx.Error(statusMessage(http.StatusBadRequest, "currency is not supported"))
 				return
 			}
+			ctx.Error(statusMessage(http.StatusForbidden, "owner does not exist"))
+			return
+		case errors.Is(err, db.ErrUniqueViolation):
+			ctx.Error(statusMessage(http.StatusConflict, "account with this currency and type already exists for owner"))
+			return
 		}
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		ctx.Error(err)
 		return
 	}
 
@@ -65,28 +80,28 @@ func (server *Server) getAccountById(ctx *gin.Context) {
 	var accountId AccountId
 
 	if err := ctx.ShouldBindUri(&accountId); err != nil {
-		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		ctx.Error(statusError(http.StatusBadRequest, err))
 		return
 	}
 
 	argId, passErr := uuid.Parse(accountId.ID)
 	if passErr != nil {
-		ctx.JSON(http.StatusBadRequest, errorMessage("invalid account id format"))
+		ctx.Error(statusMessage(http.StatusBadRequest, "invalid account id format"))
 		return
 	}
 	acc, err := server.store.GetAccount(ctx, argId)
 
 	if err != nil {
-		if err == sql.ErrNoRows {
-			ctx.JSON(http.StatusNotFound, errorResponse(err))
+		if errors.Is(err, db.ErrNotFound) {
+			ctx.Error(statusError(http.StatusNotFound, err))
 			return
 		}
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		ctx.Error(err)
 		return
 	}
 	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
 	if authPayload.Username != acc.Owner {
-		ctx.JSON(http.StatusForbidden, errorMessage("account not found"))
+		ctx.Error(statusMessage(http.StatusForbidden, "account not found"))
 		return
 	}
 
@@ -105,7 +120,7 @@ func (server *Server) listAllAccounts(ctx *gin.Context) {
     req.PageSize = 5 // default
 
 	if err := ctx.ShouldBindQuery(&req); err != nil {
-		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		ctx.Error(statusError(http.StatusBadRequest, err))
 		return
 	}
 
@@ -120,7 +135,7 @@ func (server *Server) listAllAccounts(ctx *gin.Context) {
 
 	accs, err := server.store.ListAccounts(ctx, arg)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		ctx.Error(err)
 		return
 	}
 	if accs == nil{
@@ -0,0 +1,105 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sort"
+
+	db "github.com/Glenn444/banking-app/internal/database"
+	"github.com/Glenn444/banking-app/util"
+	"github.com/gin-gonic/gin"
+)
+
+// RefreshCurrencies reloads the in-memory currency registry from the database.
+// The instance serving a change reloads right away, the others pick it up
+// the next time their refresher runs.
+func (server *Server) RefreshCurrencies(ctx context.Context) error {
+	rows, err := server.store.ListCurrencies(ctx)
+	if err != nil {
+		return err
+	}
+
+	currencies := make([]util.Currency, 0, len(rows))
+	for _, row := range rows {
+		currencies = append(currencies, toRegistryCurrency(row))
+	}
+	util.SetCurrencies(currencies)
+	return nil
+}
+
+func toRegistryCurrency(currency db.Currency) util.Currency {
+	return util.Currency{
+		Code:        currency.Code,
+		NumericCode: currency.NumericCode,
+		MinorUnits:  currency.MinorUnits,
+		Enabled:     currency.Enabled,
+		Symbol:      currency.Symbol,
+	}
+}
+
+// list the currencies accounts can be opened in
+func (server *Server) listEnabledCurrencies(ctx *gin.Context) {
+	currencies := []util.Currency{}
+	for _, c := range util.Currencies() {
+		if c.Enabled {
+			currencies = append(currencies, c)
+		}
+	}
+	sort.Slice(currencies, func(i, j int) bool {
+		return currencies[i].Code < currencies[j].Code
+	})
+
+	ctx.JSON(http.StatusOK, currencies)
+}
+
+// list every currency in the registry, enabled or not
+func (server *Server) listCurrencies(ctx *gin.Context) {
+	currencies, err := server.store.ListCurrencies(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, currencies)
+}
+
+type currencyCodeUri struct {
+	Code string `uri:"code" binding:"required,len=3,uppercase"`
+}
+
+type updateCurrencyRequest struct {
+	Enabled *bool `json:"enabled" binding:"required"`
+}
+
+// enable or disable a currency without a redeploy
+func (server *Server) updateCurrency(ctx *gin.Context) {
+	var uri currencyCodeUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	var req updateCurrencyRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	currency, err := server.store.SetCurrencyEnabledTx(ctx, uri.Code, *req.Enabled)
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			ctx.Error(statusMessage(http.StatusNotFound, "currency not found"))
+			return
+		}
+		ctx.Error(err)
+		return
+	}
+
+	if err := server.RefreshCurrencies(ctx); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, currency)
+}
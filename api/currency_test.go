@@ -0,0 +1,172 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	db "github.com/Glenn444/banking-app/internal/database"
+	mock_database "github.com/Glenn444/banking-app/internal/database/mock"
+	"github.com/Glenn444/banking-app/internal/token"
+	"github.com/Glenn444/banking-app/util"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUpdateCurrencyApi(t *testing.T) {
+	admin := randomUser()
+	admin.Role = util.AdminRole
+	depositor := randomUser()
+	depositor.Role = util.DepositorRole
+
+	usd := db.Currency{Code: "USD", NumericCode: 840, MinorUnits: 2, Enabled: false, Symbol: "$"}
+
+	testCases := []struct {
+		name          string
+		code          string
+		body          gin.H
+		setupAuth     func(t *testing.T, request *http.Request, tokenMaker token.Maker)
+		buildStubs    func(store *mock_database.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			code: "USD",
+			body: gin.H{"enabled": false},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, admin.Username, time.Minute)
+			},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(admin.Username)).Times(1).Return(admin, nil)
+				store.EXPECT().
+					SetCurrencyEnabledTx(gomock.Any(), gomock.Eq("USD"), gomock.Eq(false)).
+					Times(1).
+					Return(usd, nil)
+				store.EXPECT().ListCurrencies(gomock.Any()).Times(1).Return([]db.Currency{usd}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				// the registry is refreshed straight away
+				require.True(t, util.IsSupportedCurrency("USD"))
+				require.False(t, util.IsEnabledCurrency("USD"))
+			},
+		},
+		{
+			name: "NotAdmin",
+			code: "USD",
+			body: gin.H{"enabled": false},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, depositor.Username, time.Minute)
+			},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(depositor.Username)).Times(1).Return(depositor, nil)
+				store.EXPECT().SetCurrencyEnabledTx(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "NotFound",
+			code: "XYZ",
+			body: gin.H{"enabled": true},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, admin.Username, time.Minute)
+			},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(admin.Username)).Times(1).Return(admin, nil)
+				store.EXPECT().
+					SetCurrencyEnabledTx(gomock.Any(), gomock.Eq("XYZ"), gomock.Eq(true)).
+					Times(1).
+					Return(db.Currency{}, db.ErrNotFound)
+				store.EXPECT().ListCurrencies(gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "MissingEnabled",
+			code: "USD",
+			body: gin.H{},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, admin.Username, time.Minute)
+			},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(admin.Username)).Times(1).Return(admin, nil)
+				store.EXPECT().SetCurrencyEnabledTx(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			restoreCurrencies(t)
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_database.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			body, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPatch, "/admin/currencies/"+tc.code, bytes.NewReader(body))
+			require.NoError(t, err)
+			request.Header.Set("Content-Type", "application/json")
+
+			tc.setupAuth(t, request, server.tokenMaker)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestCreateAccountDisabledCurrency(t *testing.T) {
+	restoreCurrencies(t)
+	util.SetCurrencies([]util.Currency{{Code: "USD", MinorUnits: 2, Enabled: false}})
+
+	user := randomUser()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mock_database.NewMockStore(ctrl)
+	store.EXPECT().CreateAccount(gomock.Any(), gomock.Any()).Times(0)
+
+	server := newTestServer(t, store)
+	recorder := httptest.NewRecorder()
+
+	body, err := json.Marshal(gin.H{"owner": user.Username, "currency": "USD"})
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodPost, "/accounts", bytes.NewReader(body))
+	require.NoError(t, err)
+	request.Header.Set("Content-Type", "application/json")
+
+	addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+	server.router.ServeHTTP(recorder, request)
+
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+// restoreCurrencies puts the currency registry back once the test is done
+func restoreCurrencies(t *testing.T) {
+	currencies := util.Currencies()
+	t.Cleanup(func() {
+		util.SetCurrencies(currencies)
+	})
+}
@@ -0,0 +1,210 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	db "github.com/Glenn444/banking-app/internal/database"
+	"github.com/Glenn444/banking-app/internal/token"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	defaultEscrowTTL = 14 * 24 * time.Hour
+	maxEscrowTTL     = 90 * 24 * time.Hour
+)
+
+type createEscrowRequest struct {
+	FromAccountID uuid.UUID `json:"from_account_id" binding:"required"`
+	// the payee is either ToAccountID or To, a username, alias or verified
+	// email whose account in the currency receives the funds on release
+	ToAccountID uuid.UUID       `json:"to_account_id" binding:"required_without=To,excluded_with=To"`
+	To          string          `json:"to" binding:"max=255"`
+	Amount      decimal.Decimal `json:"amount" binding:"amount=Currency"`
+	Currency    string          `json:"currency" binding:"required,currency"`
+	Description string          `json:"description" binding:"max=255"`
+	// TTLSeconds is how long the parties have to settle before the funds are refunded
+	TTLSeconds int64 `json:"ttl_seconds" binding:"min=0"`
+}
+
+// createEscrow moves funds from the caller's account into escrow for the payee
+func (server *Server) createEscrow(ctx *gin.Context) {
+	var req createEscrowRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	ttl := defaultEscrowTTL
+	if req.TTLSeconds > 0 {
+		ttl = time.Duration(req.TTLSeconds) * time.Second
+	}
+	if ttl > maxEscrowTTL {
+		ctx.Error(statusMessage(http.StatusBadRequest, "escrow can't last longer than 90 days"))
+		return
+	}
+
+	fromAccount, valid := server.validAccount(ctx, req.FromAccountID, req.Currency)
+	if !valid {
+		return
+	}
+
+	if !server.authorizeOutflow(ctx, fromAccount, req.Amount) {
+		return
+	}
+
+	toAccount, valid := server.recipientAccount(ctx, req.ToAccountID, req.To, req.Currency)
+	if !valid {
+		return
+	}
+	if toAccount.Owner == fromAccount.Owner {
+		ctx.Error(statusMessage(http.StatusBadRequest, "can't escrow funds to yourself"))
+		return
+	}
+
+	result, err := server.store.CreateEscrowTx(ctx, db.CreateEscrowTxParams{
+		FromAccountID: fromAccount.ID,
+		ToAccountID:   toAccount.ID,
+		Amount:        req.Amount,
+		Description:   req.Description,
+		ExpiresAt:     time.Now().Add(ttl),
+	})
+	if err != nil {
+		if limitExceeded(ctx, err) {
+			return
+		}
+		server.escrowError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
+
+type listEscrowsRequest struct {
+	PageNum  int32 `form:"page_num" binding:"min=1"`
+	PageSize int32 `form:"page_size" binding:"min=5,max=10"`
+}
+
+// listEscrows shows the escrows the caller pays or is paid by, newest first
+func (server *Server) listEscrows(ctx *gin.Context) {
+	var req listEscrowsRequest
+	req.PageNum = 1
+	req.PageSize = 5
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	escrows, err := server.store.ListEscrows(ctx, db.ListEscrowsParams{
+		Username: authPayload.Username,
+		Limit:    req.PageSize,
+		Offset:   (req.PageNum - 1) * req.PageSize,
+	})
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, escrows)
+}
+
+type escrowUri struct {
+	ID string `uri:"id" binding:"required,uuid"`
+}
+
+type escrowResponse struct {
+	Escrow db.Escrow        `json:"escrow"`
+	Events []db.EscrowEvent `json:"events"`
+}
+
+// getEscrow shows an escrow and its history to its parties
+func (server *Server) getEscrow(ctx *gin.Context) {
+	var uri escrowUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	escrow, err := server.store.GetEscrow(ctx, uuid.MustParse(uri.ID))
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			ctx.Error(statusMessage(http.StatusNotFound, "escrow not found"))
+			return
+		}
+		ctx.Error(err)
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	if escrow.Payer != authPayload.Username && escrow.Payee != authPayload.Username {
+		ctx.Error(statusMessage(http.StatusNotFound, "escrow not found"))
+		return
+	}
+
+	events, err := server.store.ListEscrowEvents(ctx, escrow.ID)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, escrowResponse{Escrow: escrow, Events: events})
+}
+
+type escrowActionRequest struct {
+	Reason string `json:"reason" binding:"max=255"`
+}
+
+// confirmEscrow records the caller is satisfied, once both parties are the
+// funds go to the payee
+func (server *Server) confirmEscrow(ctx *gin.Context) {
+	server.escrowAction(ctx, server.store.ConfirmEscrowTx)
+}
+
+// disputeEscrow calls an escrow off and refunds the payer
+func (server *Server) disputeEscrow(ctx *gin.Context) {
+	server.escrowAction(ctx, server.store.DisputeEscrowTx)
+}
+
+type escrowActionFunc func(ctx context.Context, arg db.EscrowActionTxParams) (db.EscrowTxResult, error)
+
+func (server *Server) escrowAction(ctx *gin.Context, action escrowActionFunc) {
+	var uri escrowUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	// the reason is optional, so is the body
+	var req escrowActionRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	result, err := action(ctx, db.EscrowActionTxParams{
+		EscrowID: uuid.MustParse(uri.ID),
+		Username: authPayload.Username,
+		Reason:   req.Reason,
+	})
+	if err != nil {
+		server.escrowError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
+
+func (server *Server) escrowError(ctx *gin.Context, err error) {
+	if errors.Is(err, db.ErrNotFound) {
+		ctx.Error(statusMessage(http.StatusNotFound, "escrow not found"))
+		return
+	}
+	ctx.Error(err)
+}
@@ -0,0 +1,193 @@
+package api
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	db "github.com/Glenn444/banking-app/internal/database"
+	mock_database "github.com/Glenn444/banking-app/internal/database/mock"
+	"github.com/Glenn444/banking-app/util"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateEscrowApi(t *testing.T) {
+	payer := randomUser()
+	fromAccount := randomAccountWithCurrency("USD")
+	fromAccount.Owner = payer.Username
+	toAccount := randomAccountWithCurrency("USD")
+	ownAccount := randomAccountWithCurrency("USD")
+	ownAccount.Owner = payer.Username
+	amount := decimal.NewFromInt(250)
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mock_database.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: gin.H{"from_account_id": fromAccount.ID, "to_account_id": toAccount.ID, "amount": amount, "currency": "USD", "description": "used bike"},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(toAccount.ID)).Times(1).Return(toAccount, nil)
+				store.EXPECT().
+					CreateEscrowTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ any, arg db.CreateEscrowTxParams) (db.EscrowTxResult, error) {
+						require.Equal(t, fromAccount.ID, arg.FromAccountID)
+						require.Equal(t, toAccount.ID, arg.ToAccountID)
+						require.True(t, amount.Equal(arg.Amount))
+						require.Equal(t, "used bike", arg.Description)
+						require.WithinDuration(t, time.Now().Add(defaultEscrowTTL), arg.ExpiresAt, time.Minute)
+						return db.EscrowTxResult{Escrow: db.Escrow{Status: db.EscrowStatusFunded}}, nil
+					})
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "ToSelf",
+			body: gin.H{"from_account_id": fromAccount.ID, "to_account_id": ownAccount.ID, "amount": amount, "currency": "USD"},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(ownAccount.ID)).Times(1).Return(ownAccount, nil)
+				store.EXPECT().CreateEscrowTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "InsufficientFunds",
+			body: gin.H{"from_account_id": fromAccount.ID, "to_account_id": toAccount.ID, "amount": amount, "currency": "USD"},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(toAccount.ID)).Times(1).Return(toAccount, nil)
+				store.EXPECT().
+					CreateEscrowTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.EscrowTxResult{}, db.ErrInsufficientFunds)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "TTLTooLong",
+			body: gin.H{"from_account_id": fromAccount.ID, "to_account_id": toAccount.ID, "amount": amount, "currency": "USD", "ttl_seconds": 91 * 24 * 3600},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().CreateEscrowTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_database.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodPost, "/escrows", bytes.NewReader(jsonBody(t, tc.body)))
+			require.NoError(t, err)
+			request.Header.Set("Content-Type", "application/json")
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, payer.Username, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestConfirmEscrowApi(t *testing.T) {
+	username := util.RandomOwner()
+	escrowID := uuid.New()
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mock_database.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().
+					ConfirmEscrowTx(gomock.Any(), gomock.Eq(db.EscrowActionTxParams{
+						EscrowID: escrowID,
+						Username: username,
+					})).
+					Times(1).
+					Return(db.EscrowTxResult{Escrow: db.Escrow{ID: escrowID, Status: db.EscrowStatusReleased}}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "NotParty",
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().
+					ConfirmEscrowTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.EscrowTxResult{}, fmt.Errorf("%w: %s", db.ErrNotEscrowParty, username))
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "AlreadySettled",
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().
+					ConfirmEscrowTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.EscrowTxResult{}, fmt.Errorf("%w: refunded escrow can't be confirmed", db.ErrEscrowTransition))
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_database.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/escrows/%s/confirm", escrowID)
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(nil))
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, username, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
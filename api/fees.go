@@ -0,0 +1,293 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+
+	db "github.com/Glenn444/banking-app/internal/database"
+	"github.com/Glenn444/banking-app/internal/token"
+	"github.com/Glenn444/banking-app/util"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type quoteTransferRequest struct {
+	FromAccountID uuid.UUID       `json:"from_account_id" binding:"required"`
+	Amount        decimal.Decimal `json:"amount" binding:"amount=Currency"`
+	Currency      string          `json:"currency" binding:"required,currency"`
+}
+
+// quoteTransfer previews the fee a transfer would be charged without moving any money
+func (server *Server) quoteTransfer(ctx *gin.Context) {
+	var req quoteTransferRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	fromAccount, valid := server.validAccount(ctx, req.FromAccountID, req.Currency)
+	if !valid {
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	if fromAccount.Owner != authPayload.Username {
+		ctx.Error(statusMessage(http.StatusForbidden, "from account doesn't belong to you"))
+		return
+	}
+
+	quote, err := db.QuoteTransferFee(ctx, server.store, fromAccount, req.Amount)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, quote)
+}
+
+type feeScheduleUri struct {
+	Currency string `uri:"currency" binding:"required,currency"`
+}
+
+type feeRuleRequest struct {
+	Tier      string              `json:"tier" binding:"required,oneof=standard premium business"`
+	MinAmount decimal.Decimal     `json:"min_amount"`
+	MaxAmount decimal.NullDecimal `json:"max_amount"`
+	FlatFee   decimal.Decimal     `json:"flat_fee"`
+	// Percentage is a fraction of the amount, 0.015 is 1.5%
+	Percentage decimal.Decimal     `json:"percentage"`
+	MinFee     decimal.Decimal     `json:"min_fee"`
+	MaxFee     decimal.NullDecimal `json:"max_fee"`
+}
+
+// setFeeScheduleRequest replaces every fee rule of a currency, an empty
+// list of rules makes its transfers free
+type setFeeScheduleRequest struct {
+	Rules []feeRuleRequest `json:"rules" binding:"required,dive"`
+}
+
+// validateFeeRules checks every amount fits the currency and that the
+// amount bands of a tier don't overlap, so a transfer matches one rule at most
+func validateFeeRules(rules []feeRuleRequest, currency string) error {
+	for i, rule := range rules {
+		amounts := []struct {
+			name   string
+			amount decimal.NullDecimal
+		}{
+			{"min_amount", decimal.NewNullDecimal(rule.MinAmount)},
+			{"max_amount", rule.MaxAmount},
+			{"flat_fee", decimal.NewNullDecimal(rule.FlatFee)},
+			{"min_fee", decimal.NewNullDecimal(rule.MinFee)},
+			{"max_fee", rule.MaxFee},
+		}
+		for _, a := range amounts {
+			if !a.amount.Valid {
+				continue
+			}
+			if a.amount.Decimal.IsNegative() {
+				return fmt.Errorf("rule %d: %s can't be negative", i, a.name)
+			}
+			if !util.IsValidAmount(a.amount.Decimal, currency) {
+				return fmt.Errorf("rule %d: %s: %w: %v %s", i, a.name, db.ErrInvalidAmountScale, a.amount.Decimal, currency)
+			}
+		}
+
+		if rule.MaxAmount.Valid && !rule.MaxAmount.Decimal.GreaterThan(rule.MinAmount) {
+			return fmt.Errorf("rule %d: max_amount must be greater than min_amount", i)
+		}
+		if rule.MaxFee.Valid && rule.MaxFee.Decimal.LessThan(rule.MinFee) {
+			return fmt.Errorf("rule %d: max_fee can't be less than min_fee", i)
+		}
+		if rule.Percentage.IsNegative() || rule.Percentage.GreaterThan(decimal.NewFromInt(1)) {
+			return fmt.Errorf("rule %d: percentage must be between 0 and 1", i)
+		}
+		if !rule.Percentage.Equal(rule.Percentage.Truncate(6)) {
+			return fmt.Errorf("rule %d: percentage can't have more than 6 decimals", i)
+		}
+	}
+
+	byTier := make(map[string][]feeRuleRequest)
+	for _, rule := range rules {
+		byTier[rule.Tier] = append(byTier[rule.Tier], rule)
+	}
+	for tier, tierRules := range byTier {
+		sort.Slice(tierRules, func(i, j int) bool {
+			return tierRules[i].MinAmount.LessThan(tierRules[j].MinAmount)
+		})
+		for i := 1; i < len(tierRules); i++ {
+			prev := tierRules[i-1]
+			if !prev.MaxAmount.Valid || prev.MaxAmount.Decimal.GreaterThan(tierRules[i].MinAmount) {
+				return fmt.Errorf("%s rules from %v and %v overlap", tier, prev.MinAmount, tierRules[i].MinAmount)
+			}
+		}
+	}
+	return nil
+}
+
+// setFeeSchedule saves a currency's fee rules as its next fee schedule version
+func (server *Server) setFeeSchedule(ctx *gin.Context) {
+	var uri feeScheduleUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	var req setFeeScheduleRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+	if err := validateFeeRules(req.Rules, uri.Currency); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	rules := make([]db.FeeRuleParams, len(req.Rules))
+	for i, rule := range req.Rules {
+		rules[i] = db.FeeRuleParams(rule)
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	result, err := server.store.CreateFeeScheduleTx(ctx, db.CreateFeeScheduleTxParams{
+		Currency:  uri.Currency,
+		CreatedBy: authPayload.Username,
+		Rules:     rules,
+	})
+	if err != nil {
+		if errors.Is(db.TranslateError(err), db.ErrUniqueViolation) {
+			ctx.Error(statusMessage(http.StatusConflict, "the fee schedule was changed at the same time, try again"))
+			return
+		}
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
+
+type getFeeScheduleRequest struct {
+	// Version picks an earlier fee schedule, the current one is returned without it
+	Version int32 `form:"version" binding:"omitempty,min=1"`
+}
+
+// getFeeSchedule shows a currency's current fee schedule or an earlier version of it
+func (server *Server) getFeeSchedule(ctx *gin.Context) {
+	var uri feeScheduleUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	var req getFeeScheduleRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	var schedule db.FeeSchedule
+	var err error
+	if req.Version == 0 {
+		schedule, err = server.store.GetCurrentFeeSchedule(ctx, uri.Currency)
+	} else {
+		schedule, err = server.store.GetFeeScheduleByVersion(ctx, db.GetFeeScheduleByVersionParams{
+			Currency: uri.Currency,
+			Version:  req.Version,
+		})
+	}
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			ctx.Error(statusMessage(http.StatusNotFound, "fee schedule not found"))
+			return
+		}
+		ctx.Error(err)
+		return
+	}
+
+	rules, err := server.store.ListFeeRules(ctx, schedule.ID)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, db.FeeScheduleResult{Schedule: schedule, Rules: rules})
+}
+
+type listFeeSchedulesRequest struct {
+	PageNum  int32 `form:"page_num" binding:"min=1"`
+	PageSize int32 `form:"page_size" binding:"min=5,max=10"`
+}
+
+// listFeeSchedules lists the versions of a currency's fee schedule, newest first
+func (server *Server) listFeeSchedules(ctx *gin.Context) {
+	var uri feeScheduleUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	var req listFeeSchedulesRequest
+	req.PageNum = 1
+	req.PageSize = 5
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	schedules, err := server.store.ListFeeSchedules(ctx, db.ListFeeSchedulesParams{
+		Currency: uri.Currency,
+		Limit:    req.PageSize,
+		Offset:   (req.PageNum - 1) * req.PageSize,
+	})
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, schedules)
+}
+
+type userTierUri struct {
+	Username string `uri:"username" binding:"required,alphanum"`
+}
+
+type userTierResponse struct {
+	Username string `json:"username"`
+	Tier     string `json:"tier"`
+}
+
+type setUserTierRequest struct {
+	Tier string `json:"tier" binding:"required,oneof=standard premium business"`
+}
+
+// setUserTier moves a user to the tier whose fee rules price their transfers
+func (server *Server) setUserTier(ctx *gin.Context) {
+	var uri userTierUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	var req setUserTierRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	user, err := server.store.UpdateUserTier(ctx, db.UpdateUserTierParams{
+		Username: uri.Username,
+		Tier:     req.Tier,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			ctx.Error(statusMessage(http.StatusNotFound, "user not found"))
+			return
+		}
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, userTierResponse{Username: user.Username, Tier: user.Tier})
+}
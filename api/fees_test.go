@@ -0,0 +1,305 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	db "github.com/Glenn444/banking-app/internal/database"
+	mock_database "github.com/Glenn444/banking-app/internal/database/mock"
+	"github.com/Glenn444/banking-app/util"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestQuoteTransferApi(t *testing.T) {
+	user := randomUser()
+	user.Tier = util.PremiumTier
+	account := randomAccountWithCurrency("USD")
+	account.Owner = user.Username
+
+	amount := decimal.NewFromInt(200)
+	rule := db.FeeRule{
+		ID:         uuid.New(),
+		Tier:       util.PremiumTier,
+		FlatFee:    decimal.NewFromFloat(0.5),
+		Percentage: decimal.NewFromFloat(0.01),
+		MaxFee:     decimal.NewNullDecimal(decimal.NewFromInt(2)),
+	}
+
+	testCases := []struct {
+		name          string
+		username      string
+		buildStubs    func(store *mock_database.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:     "OK",
+			username: user.Username,
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+				store.EXPECT().
+					GetApplicableFeeRule(gomock.Any(), gomock.Eq(db.GetApplicableFeeRuleParams{
+						Currency: "USD",
+						Tier:     util.PremiumTier,
+						Amount:   amount,
+					})).
+					Times(1).
+					Return(rule, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				quote := requireBodyFeeQuote(t, recorder.Body)
+				// 0.50 + 1% of 200 is capped at 2.00
+				require.True(t, decimal.NewFromInt(2).Equal(quote.Fee))
+				require.True(t, decimal.NewFromInt(202).Equal(quote.Total))
+				require.NotNil(t, quote.Rule)
+				require.Equal(t, rule.ID, quote.Rule.ID)
+			},
+		},
+		{
+			name:     "NoRule",
+			username: user.Username,
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+				store.EXPECT().GetApplicableFeeRule(gomock.Any(), gomock.Any()).Times(1).Return(db.FeeRule{}, db.ErrNotFound)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				quote := requireBodyFeeQuote(t, recorder.Body)
+				require.True(t, quote.Fee.IsZero())
+				require.True(t, amount.Equal(quote.Total))
+				require.Nil(t, quote.Rule)
+			},
+		},
+		{
+			name:     "NotOwner",
+			username: "other_user",
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetApplicableFeeRule(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_database.NewMockStore(ctrl)
+			store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			body := jsonBody(t, gin.H{
+				"from_account_id": account.ID,
+				"amount":          amount,
+				"currency":        "USD",
+			})
+			request, err := http.NewRequest(http.MethodPost, "/transfers/quote", bytes.NewReader(body))
+			require.NoError(t, err)
+			request.Header.Set("Content-Type", "application/json")
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, tc.username, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestSetFeeScheduleApi(t *testing.T) {
+	admin := randomUser()
+	admin.Role = util.AdminRole
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mock_database.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: gin.H{"rules": []gin.H{
+				{"tier": "standard", "min_amount": "0", "max_amount": "1000", "flat_fee": "1", "percentage": "0.01"},
+				{"tier": "standard", "min_amount": "1000", "percentage": "0.005", "max_fee": "25"},
+				{"tier": "premium", "min_amount": "0"},
+			}},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().
+					CreateFeeScheduleTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ any, arg db.CreateFeeScheduleTxParams) (db.FeeScheduleResult, error) {
+						require.Equal(t, "USD", arg.Currency)
+						require.Equal(t, admin.Username, arg.CreatedBy)
+						require.Len(t, arg.Rules, 3)
+						require.True(t, decimal.NewFromInt(25).Equal(arg.Rules[1].MaxFee.Decimal))
+						require.False(t, arg.Rules[1].MaxAmount.Valid)
+						return db.FeeScheduleResult{Schedule: db.FeeSchedule{Currency: "USD", Version: 2}}, nil
+					})
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "OverlappingBands",
+			body: gin.H{"rules": []gin.H{
+				{"tier": "standard", "min_amount": "0", "max_amount": "1000", "flat_fee": "1"},
+				{"tier": "standard", "min_amount": "500", "flat_fee": "2"},
+			}},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().CreateFeeScheduleTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "MaxFeeBelowMinFee",
+			body: gin.H{"rules": []gin.H{
+				{"tier": "standard", "min_fee": "5", "max_fee": "1"},
+			}},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().CreateFeeScheduleTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidTier",
+			body: gin.H{"rules": []gin.H{
+				{"tier": "gold", "flat_fee": "1"},
+			}},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().CreateFeeScheduleTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_database.NewMockStore(ctrl)
+			store.EXPECT().GetUser(gomock.Any(), gomock.Eq(admin.Username)).Times(1).Return(admin, nil)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodPut, "/admin/fees/USD", bytes.NewReader(jsonBody(t, tc.body)))
+			require.NoError(t, err)
+			request.Header.Set("Content-Type", "application/json")
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, admin.Username, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestGetFeeScheduleApi(t *testing.T) {
+	admin := randomUser()
+	admin.Role = util.AdminRole
+	schedule := db.FeeSchedule{ID: uuid.New(), Currency: "USD", Version: 3}
+
+	testCases := []struct {
+		name          string
+		query         string
+		buildStubs    func(store *mock_database.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "Current",
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetCurrentFeeSchedule(gomock.Any(), gomock.Eq("USD")).Times(1).Return(schedule, nil)
+				store.EXPECT().ListFeeRules(gomock.Any(), gomock.Eq(schedule.ID)).Times(1).Return([]db.FeeRule{}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:  "Version",
+			query: "?version=1",
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetCurrentFeeSchedule(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().
+					GetFeeScheduleByVersion(gomock.Any(), gomock.Eq(db.GetFeeScheduleByVersionParams{Currency: "USD", Version: 1})).
+					Times(1).
+					Return(schedule, nil)
+				store.EXPECT().ListFeeRules(gomock.Any(), gomock.Eq(schedule.ID)).Times(1).Return([]db.FeeRule{}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "NotFound",
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetCurrentFeeSchedule(gomock.Any(), gomock.Eq("USD")).Times(1).Return(db.FeeSchedule{}, db.ErrNotFound)
+				store.EXPECT().ListFeeRules(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_database.NewMockStore(ctrl)
+			store.EXPECT().GetUser(gomock.Any(), gomock.Eq(admin.Username)).Times(1).Return(admin, nil)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/admin/fees/USD%s", tc.query)
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, admin.Username, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func requireBodyFeeQuote(t *testing.T, body io.Reader) db.FeeQuote {
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+
+	var quote db.FeeQuote
+	require.NoError(t, json.Unmarshal(data, &quote))
+	return quote
+}
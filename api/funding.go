@@ -0,0 +1,183 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	db "github.com/Glenn444/banking-app/internal/database"
+	"github.com/Glenn444/banking-app/internal/funding"
+	"github.com/Glenn444/banking-app/internal/token"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type fundingRequest struct {
+	Amount   decimal.Decimal `json:"amount" binding:"amount=Currency"`
+	Currency string          `json:"currency" binding:"required,currency"`
+}
+
+func (server *Server) createDeposit(ctx *gin.Context) {
+	server.createFunding(ctx, db.FundingKindDeposit)
+}
+
+func (server *Server) createWithdrawal(ctx *gin.Context) {
+	server.createFunding(ctx, db.FundingKindWithdrawal)
+}
+
+// createFunding starts a deposit or withdrawal against the account's
+// settlement account and hands it to the funding provider
+func (server *Server) createFunding(ctx *gin.Context, kind string) {
+	var accountId AccountId
+	if err := ctx.ShouldBindUri(&accountId); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	var req fundingRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	account, valid := server.validAccount(ctx, uuid.MustParse(accountId.ID), req.Currency)
+	if !valid {
+		return
+	}
+
+	if kind == db.FundingKindWithdrawal {
+		if !server.authorizeOutflow(ctx, account, req.Amount) {
+			return
+		}
+	} else {
+		authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+		if account.Owner != authPayload.Username {
+			ctx.Error(statusMessage(http.StatusForbidden, "account doesn't belong to you"))
+			return
+		}
+	}
+
+	result, err := server.store.CreateFundingTx(ctx, db.FundingTxParams{
+		AccountID: account.ID,
+		Kind:      kind,
+		Amount:    req.Amount,
+		Currency:  req.Currency,
+		Provider:  server.fundingProvider.Name(),
+	})
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	providerResult, err := server.fundingProvider.Initiate(ctx, funding.Instruction{
+		TransactionID: result.Transaction.ID,
+		AccountID:     account.ID,
+		Kind:          funding.Kind(kind),
+		Amount:        req.Amount,
+		Currency:      req.Currency,
+	})
+	if err != nil {
+		// the provider never accepted the instruction, release anything we reserved
+		if _, failErr := server.store.FailFundingTx(ctx, result.Transaction.ID, err.Error()); failErr != nil {
+			ctx.Error(failErr)
+			return
+		}
+		ctx.Error(statusError(http.StatusBadGateway, err))
+		return
+	}
+
+	result, err = server.applyFundingResult(ctx, result, providerResult)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
+
+type fundingTransactionUri struct {
+	ID        string `uri:"id" binding:"required,uuid"`
+	FundingID string `uri:"funding_id" binding:"required,uuid"`
+}
+
+// getFundingTransaction returns a deposit or withdrawal, asking the
+// provider for its latest status while it is still pending
+func (server *Server) getFundingTransaction(ctx *gin.Context) {
+	var uri fundingTransactionUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	account, err := server.store.GetAccount(ctx, uuid.MustParse(uri.ID))
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			ctx.Error(statusMessage(http.StatusNotFound, "account not found"))
+			return
+		}
+		ctx.Error(err)
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	if account.Owner != authPayload.Username {
+		// someone else's account is as good as missing
+		ctx.Error(statusMessage(http.StatusNotFound, "account not found"))
+		return
+	}
+
+	txn, err := server.store.GetFundingTransaction(ctx, uuid.MustParse(uri.FundingID))
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			ctx.Error(statusMessage(http.StatusNotFound, "funding transaction not found"))
+			return
+		}
+		ctx.Error(err)
+		return
+	}
+	if txn.AccountID != account.ID {
+		ctx.Error(statusMessage(http.StatusNotFound, "funding transaction not found"))
+		return
+	}
+
+	result := db.FundingTxResult{Transaction: txn, Account: account}
+	if txn.Status == db.FundingStatusPending && txn.ProviderReference != "" {
+		providerResult, err := server.fundingProvider.Status(ctx, txn.ProviderReference)
+		if err != nil {
+			ctx.Error(statusError(http.StatusBadGateway, err))
+			return
+		}
+
+		result, err = server.applyFundingResult(ctx, result, providerResult)
+		if err != nil {
+			ctx.Error(err)
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
+
+// applyFundingResult stores the provider reference and moves the
+// transaction to the state reported by the provider
+func (server *Server) applyFundingResult(ctx context.Context, current db.FundingTxResult, providerResult funding.Result) (db.FundingTxResult, error) {
+	var err error
+	if current.Transaction.ProviderReference != providerResult.Reference {
+		current.Transaction, err = server.store.SetFundingProviderReference(ctx, db.SetFundingProviderReferenceParams{
+			ID:                current.Transaction.ID,
+			ProviderReference: providerResult.Reference,
+		})
+		if err != nil {
+			return current, err
+		}
+	}
+
+	switch providerResult.Status {
+	case funding.StatusSettled:
+		return server.store.SettleFundingTx(ctx, current.Transaction.ID)
+	case funding.StatusFailed:
+		return server.store.FailFundingTx(ctx, current.Transaction.ID, providerResult.FailureReason)
+	}
+	return current, nil
+}
@@ -0,0 +1,368 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	db "github.com/Glenn444/banking-app/internal/database"
+	mock_database "github.com/Glenn444/banking-app/internal/database/mock"
+	"github.com/Glenn444/banking-app/internal/funding"
+	"github.com/Glenn444/banking-app/internal/token"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateDepositApi(t *testing.T) {
+	user := randomUser()
+	account := randomAccountWithCurrency("USD")
+	account.Owner = user.Username
+	amount := decimal.NewFromInt(50)
+
+	pending := randomFundingTransaction(account, db.FundingKindDeposit, amount)
+	settled := pending
+	settled.Status = db.FundingStatusSettled
+	settled.ProviderReference = "sim_" + pending.ID.String()
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		decide        func(instruction funding.Instruction) funding.Result
+		setupAuth     func(t *testing.T, request *http.Request, tokenMaker token.Maker)
+		buildStubs    func(store *mock_database.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "Settled",
+			body: gin.H{"amount": amount, "currency": "USD"},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().
+					CreateFundingTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.FundingTxResult{Transaction: pending, Account: account}, nil)
+				store.EXPECT().
+					SetFundingProviderReference(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(settled, nil)
+				store.EXPECT().
+					SettleFundingTx(gomock.Any(), gomock.Eq(pending.ID)).
+					Times(1).
+					Return(db.FundingTxResult{Transaction: settled, Account: account}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got db.FundingTxResult
+				require.NoError(t, json.NewDecoder(recorder.Body).Decode(&got))
+				require.Equal(t, db.FundingStatusSettled, got.Transaction.Status)
+			},
+		},
+		{
+			name: "Pending",
+			body: gin.H{"amount": amount, "currency": "USD"},
+			decide: func(instruction funding.Instruction) funding.Result {
+				return funding.Result{Status: funding.StatusPending}
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().
+					CreateFundingTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.FundingTxResult{Transaction: pending, Account: account}, nil)
+				store.EXPECT().
+					SetFundingProviderReference(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(pending, nil)
+				store.EXPECT().SettleFundingTx(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().FailFundingTx(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "ProviderFailed",
+			body: gin.H{"amount": amount, "currency": "USD"},
+			decide: func(instruction funding.Instruction) funding.Result {
+				return funding.Result{Status: funding.StatusFailed, FailureReason: "card declined"}
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().
+					CreateFundingTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.FundingTxResult{Transaction: pending, Account: account}, nil)
+				store.EXPECT().
+					SetFundingProviderReference(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(pending, nil)
+				store.EXPECT().
+					FailFundingTx(gomock.Any(), gomock.Eq(pending.ID), gomock.Eq("card declined")).
+					Times(1).
+					Return(db.FundingTxResult{Transaction: pending, Account: account}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "NotAccountOwner",
+			body: gin.H{"amount": amount, "currency": "USD"},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, "other_user", time.Minute)
+			},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().CreateFundingTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "CurrencyMismatch",
+			body: gin.H{"amount": amount, "currency": "EUR"},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().CreateFundingTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "NegativeAmount",
+			body: gin.H{"amount": "-10", "currency": "USD"},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateFundingTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "AccountNotFound",
+			body: gin.H{"amount": amount, "currency": "USD"},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(db.Account{}, db.ErrNotFound)
+				store.EXPECT().CreateFundingTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_database.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			simulator := funding.NewSimulator()
+			simulator.Decide = tc.decide
+			server.fundingProvider = simulator
+
+			recorder := httptest.NewRecorder()
+
+			body, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/accounts/%s/deposits", account.ID)
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+			require.NoError(t, err)
+			request.Header.Set("Content-Type", "application/json")
+
+			tc.setupAuth(t, request, server.tokenMaker)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestCreateWithdrawalInsufficientFunds(t *testing.T) {
+	user := randomUser()
+	account := randomAccountWithCurrency("USD")
+	account.Owner = user.Username
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mock_database.NewMockStore(ctrl)
+	store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+	store.EXPECT().
+		CreateFundingTx(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(db.FundingTxResult{}, fmt.Errorf("%w: account %v", db.ErrInsufficientFunds, account.ID))
+
+	server := newTestServer(t, store)
+	recorder := httptest.NewRecorder()
+
+	body, err := json.Marshal(gin.H{"amount": "5000", "currency": "USD"})
+	require.NoError(t, err)
+
+	url := fmt.Sprintf("/accounts/%s/withdrawals", account.ID)
+	request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	require.NoError(t, err)
+	request.Header.Set("Content-Type", "application/json")
+
+	addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+	server.router.ServeHTTP(recorder, request)
+
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestCreateWithdrawalNeedsApproval(t *testing.T) {
+	user := randomUser()
+	organisation := db.Organisation{
+		ID:                        uuid.New(),
+		ApprovalThreshold:         decimal.NewFromInt(500),
+		ApprovalThresholdCurrency: "USD",
+		RequiredApprovals:         2,
+	}
+	account := randomAccountWithCurrency("USD")
+	account.Owner = user.Username
+	account.OrganisationID = uuid.NullUUID{UUID: organisation.ID, Valid: true}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mock_database.NewMockStore(ctrl)
+	store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+	store.EXPECT().GetOrganisation(gomock.Any(), gomock.Eq(organisation.ID)).Times(1).Return(organisation, nil)
+	store.EXPECT().CreateFundingTx(gomock.Any(), gomock.Any()).Times(0)
+
+	server := newTestServer(t, store)
+	recorder := httptest.NewRecorder()
+
+	body, err := json.Marshal(gin.H{"amount": "501", "currency": "USD"})
+	require.NoError(t, err)
+
+	url := fmt.Sprintf("/accounts/%s/withdrawals", account.ID)
+	request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	require.NoError(t, err)
+	request.Header.Set("Content-Type", "application/json")
+
+	addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+	server.router.ServeHTTP(recorder, request)
+
+	require.Equal(t, http.StatusForbidden, recorder.Code)
+	require.Contains(t, recorder.Body.String(), "approval_required")
+}
+
+func TestGetFundingTransactionSettlesPending(t *testing.T) {
+	user := randomUser()
+	account := randomAccountWithCurrency("USD")
+	account.Owner = user.Username
+
+	pending := randomFundingTransaction(account, db.FundingKindDeposit, decimal.NewFromInt(20))
+
+	simulator := funding.NewSimulator()
+	simulator.Decide = func(instruction funding.Instruction) funding.Result {
+		return funding.Result{Status: funding.StatusPending}
+	}
+	providerResult, err := simulator.Initiate(t.Context(), funding.Instruction{TransactionID: pending.ID})
+	require.NoError(t, err)
+	pending.ProviderReference = providerResult.Reference
+	require.NoError(t, simulator.Settle(providerResult.Reference))
+
+	settled := pending
+	settled.Status = db.FundingStatusSettled
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mock_database.NewMockStore(ctrl)
+	store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+	store.EXPECT().GetFundingTransaction(gomock.Any(), gomock.Eq(pending.ID)).Times(1).Return(pending, nil)
+	store.EXPECT().SetFundingProviderReference(gomock.Any(), gomock.Any()).Times(0)
+	store.EXPECT().
+		SettleFundingTx(gomock.Any(), gomock.Eq(pending.ID)).
+		Times(1).
+		Return(db.FundingTxResult{Transaction: settled, Account: account}, nil)
+
+	server := newTestServer(t, store)
+	server.fundingProvider = simulator
+	recorder := httptest.NewRecorder()
+
+	url := fmt.Sprintf("/accounts/%s/funding/%s", account.ID, pending.ID)
+	request, err := http.NewRequest(http.MethodGet, url, nil)
+	require.NoError(t, err)
+
+	addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+	server.router.ServeHTTP(recorder, request)
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	var got db.FundingTxResult
+	require.NoError(t, json.NewDecoder(recorder.Body).Decode(&got))
+	require.Equal(t, db.FundingStatusSettled, got.Transaction.Status)
+}
+
+func TestGetFundingTransactionOtherOwner(t *testing.T) {
+	account := randomAccountWithCurrency("USD")
+	pending := randomFundingTransaction(account, db.FundingKindDeposit, decimal.NewFromInt(20))
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mock_database.NewMockStore(ctrl)
+	store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+	store.EXPECT().GetFundingTransaction(gomock.Any(), gomock.Any()).Times(0)
+
+	server := newTestServer(t, store)
+	recorder := httptest.NewRecorder()
+
+	url := fmt.Sprintf("/accounts/%s/funding/%s", account.ID, pending.ID)
+	request, err := http.NewRequest(http.MethodGet, url, nil)
+	require.NoError(t, err)
+
+	addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, randomUser().Username, time.Minute)
+	server.router.ServeHTTP(recorder, request)
+
+	require.Equal(t, http.StatusNotFound, recorder.Code)
+}
+
+func randomFundingTransaction(account db.Account, kind string, amount decimal.Decimal) db.FundingTransaction {
+	return db.FundingTransaction{
+		ID:                  uuid.New(),
+		AccountID:           account.ID,
+		SettlementAccountID: uuid.New(),
+		Kind:                kind,
+		Amount:              amount,
+		Currency:            account.Currency,
+		Status:              db.FundingStatusPending,
+		Provider:            funding.SimulatorName,
+	}
+}
@@ -0,0 +1,133 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	db "github.com/Glenn444/banking-app/internal/database"
+	"github.com/Glenn444/banking-app/internal/fx"
+	"github.com/Glenn444/banking-app/internal/token"
+	"github.com/Glenn444/banking-app/util"
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+)
+
+// defaultFxQuoteTTL is how long a quote's rate stays locked when FX_QUOTE_TTL isn't set
+const defaultFxQuoteTTL = 30 * time.Second
+
+type createFxQuoteRequest struct {
+	FromCurrency string          `json:"from_currency" binding:"required,currency"`
+	ToCurrency   string          `json:"to_currency" binding:"required,currency,nefield=FromCurrency"`
+	Amount       decimal.Decimal `json:"amount" binding:"amount=FromCurrency"`
+}
+
+// createFxQuote locks a rate for converting amount between two currencies
+func (server *Server) createFxQuote(ctx *gin.Context) {
+	var req createFxQuoteRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	rate, err := server.fxProvider.Rate(ctx, req.FromCurrency, req.ToCurrency)
+	if err != nil {
+		if errors.Is(err, fx.ErrRateNotFound) {
+			ctx.Error(statusError(http.StatusBadRequest, err))
+			return
+		}
+		ctx.Error(statusError(http.StatusBadGateway, err))
+		return
+	}
+
+	toCurrency, _ := util.LookupCurrency(req.ToCurrency)
+	toAmount := rate.Convert(req.Amount).Truncate(toCurrency.MinorUnits)
+	if !toAmount.IsPositive() {
+		ctx.Error(statusMessage(http.StatusBadRequest, "amount is too small to convert"))
+		return
+	}
+
+	ttl := server.config.FXQuoteTTL
+	if ttl <= 0 {
+		ttl = defaultFxQuoteTTL
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	quote, err := server.store.CreateFxQuote(ctx, db.CreateFxQuoteParams{
+		Owner:        authPayload.Username,
+		FromCurrency: req.FromCurrency,
+		ToCurrency:   req.ToCurrency,
+		Rate:         rate.Rate,
+		FromAmount:   req.Amount,
+		ToAmount:     toAmount,
+		ExpiresAt:    time.Now().Add(ttl),
+	})
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, quote)
+}
+
+// createFxTransfer executes a transfer at the rate locked by the request's quote
+func (server *Server) createFxTransfer(ctx *gin.Context, req transferMoneyRequest, details db.TransferDetails) {
+	quote, err := server.store.GetFxQuote(ctx, req.FxQuoteID.UUID)
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			ctx.Error(statusMessage(http.StatusNotFound, "fx quote not found"))
+			return
+		}
+		ctx.Error(err)
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	if quote.Owner != authPayload.Username {
+		ctx.Error(statusMessage(http.StatusNotFound, "fx quote not found"))
+		return
+	}
+
+	if quote.FromCurrency != req.Currency || !quote.FromAmount.Equal(req.Amount) {
+		ctx.Error(statusMessage(http.StatusBadRequest, "amount and currency must match the fx quote"))
+		return
+	}
+
+	fromAccount, valid := server.validAccount(ctx, req.FromAccountID, quote.FromCurrency)
+	if !valid {
+		return
+	}
+	organisation, valid := server.authorizeFromAccount(ctx, fromAccount)
+	if !valid {
+		return
+	}
+	approval, err := server.needsApproval(ctx, organisation, quote.FromAmount, quote.FromCurrency)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	if approval {
+		ctx.Error(statusMessage(http.StatusBadRequest, "fx transfers above the organisation's approval threshold aren't supported"))
+		return
+	}
+
+	toAccount, valid := server.recipientAccount(ctx, req.ToAccountID, req.To, quote.ToCurrency)
+	if !valid {
+		return
+	}
+
+	result, err := server.store.FxTransferTx(ctx, db.FxTransferTxParams{
+		FromAccountID:   req.FromAccountID,
+		ToAccountID:     toAccount.ID,
+		QuoteID:         quote.ID,
+		TransferDetails: details,
+	})
+	if err != nil {
+		if limitExceeded(ctx, err) || server.duplicateReference(ctx, req.FromAccountID, details, err) {
+			return
+		}
+		ctx.Error(err)
+		return
+	}
+	ctx.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,204 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"runtime"
+	"strings"
+	"time"
+
+	db "github.com/Glenn444/banking-app/internal/database"
+	"github.com/Glenn444/banking-app/internal/health"
+	"github.com/Glenn444/banking-app/util"
+	"github.com/gin-gonic/gin"
+)
+
+// readinessTimeout bounds the database checks, so a hanging database fails
+// readiness instead of the orchestrator's probe timing out
+const readinessTimeout = 2 * time.Second
+
+// MonitorWorkers makes readiness depend on the background workers monitor
+// runs, and reports them on the status page
+func (server *Server) MonitorWorkers(monitor *health.Monitor) {
+	server.workers = monitor
+}
+
+type healthResponse struct {
+	Status string `json:"status"`
+}
+
+// healthz tells the orchestrator the process is alive, it doesn't look at
+// the database so a database outage doesn't get every instance restarted
+func (server *Server) healthz(ctx *gin.Context) {
+	ctx.JSON(http.StatusOK, healthResponse{Status: "ok"})
+}
+
+// readiness is the outcome of the checks an instance has to pass to be
+// sent traffic, a nil error means the check passed
+type readiness struct {
+	checks        map[string]error
+	schemaVersion int64
+}
+
+func (r readiness) ready() bool {
+	for _, err := range r.checks {
+		if err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// results returns "ok" for the checks that passed, and what went wrong for
+// the others when detailed, or just "failing"
+func (r readiness) results(detailed bool) map[string]string {
+	results := make(map[string]string, len(r.checks))
+	for name, err := range r.checks {
+		switch {
+		case err == nil:
+			results[name] = "ok"
+		case detailed:
+			results[name] = err.Error()
+		default:
+			results[name] = "failing"
+		}
+	}
+	return results
+}
+
+func (server *Server) checkReadiness(ctx context.Context) readiness {
+	ctx, cancel := context.WithTimeout(ctx, readinessTimeout)
+	defer cancel()
+
+	r := readiness{checks: map[string]error{}}
+
+	r.checks["shutdown"] = nil
+	if server.draining.Load() {
+		r.checks["shutdown"] = errors.New("server is shutting down")
+	}
+
+	r.checks["database"] = server.store.Ping(ctx)
+
+	version, err := server.store.SchemaVersion(ctx)
+	switch {
+	case err != nil:
+		r.checks["migrations"] = err
+	case version < server.schemaVersion:
+		r.checks["migrations"] = fmt.Errorf("schema is at version %d, expected %d", version, server.schemaVersion)
+	default:
+		// a newer schema is fine, it's what the instances still running the
+		// previous release see during a rolling deploy
+		r.checks["migrations"] = nil
+	}
+	r.schemaVersion = version
+
+	if server.workers != nil {
+		r.checks["workers"] = nil
+		var unhealthy []string
+		for _, worker := range server.workers.Workers() {
+			if worker.Stopped || worker.LastError != "" {
+				unhealthy = append(unhealthy, worker.Name)
+			}
+		}
+		if len(unhealthy) > 0 {
+			r.checks["workers"] = fmt.Errorf("unhealthy workers: %s", strings.Join(unhealthy, ", "))
+		}
+	}
+	return r
+}
+
+type readinessResponse struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks"`
+}
+
+// readyz tells the orchestrator whether to send this instance traffic. It
+// fails once shutdown starts, so traffic moves away before the server stops
+// accepting connections. Failures aren't detailed, the endpoint is public.
+func (server *Server) readyz(ctx *gin.Context) {
+	r := server.checkReadiness(ctx)
+	if !r.ready() {
+		for name, err := range r.checks {
+			if err != nil {
+				log.Printf("readiness check %s failed: %v", name, err)
+			}
+		}
+		ctx.JSON(http.StatusServiceUnavailable, readinessResponse{Status: "not_ready", Checks: r.results(false)})
+		return
+	}
+	ctx.JSON(http.StatusOK, readinessResponse{Status: "ready", Checks: r.results(false)})
+}
+
+type databaseStatus struct {
+	SchemaVersion         int64        `json:"schema_version"`
+	ExpectedSchemaVersion int64        `json:"expected_schema_version"`
+	Pool                  db.PoolStats `json:"pool"`
+}
+
+type workerStatus struct {
+	Name     string `json:"name"`
+	Interval string `json:"interval"`
+	// LastRun is null until the worker finishes its first run
+	LastRun   *time.Time `json:"last_run"`
+	LastError string     `json:"last_error,omitempty"`
+	Failures  int        `json:"failures"`
+	Stopped   bool       `json:"stopped"`
+	Healthy   bool       `json:"healthy"`
+}
+
+type statusResponse struct {
+	Version      string            `json:"version"`
+	Commit       string            `json:"commit"`
+	GoVersion    string            `json:"go_version"`
+	StartedAt    time.Time         `json:"started_at"`
+	Uptime       string            `json:"uptime"`
+	Ready        bool              `json:"ready"`
+	ShuttingDown bool              `json:"shutting_down"`
+	Checks       map[string]string `json:"checks"`
+	Database     databaseStatus    `json:"database"`
+	Workers      []workerStatus    `json:"workers"`
+}
+
+// getStatus gives admins the build, uptime, the readiness checks with their
+// errors, the connection pool and the workers
+func (server *Server) getStatus(ctx *gin.Context) {
+	r := server.checkReadiness(ctx)
+
+	workers := []workerStatus{}
+	if server.workers != nil {
+		for _, worker := range server.workers.Workers() {
+			status := workerStatus{
+				Name:      worker.Name,
+				Interval:  worker.Interval.String(),
+				LastError: worker.LastError,
+				Failures:  worker.Failures,
+				Stopped:   worker.Stopped,
+				Healthy:   worker.Healthy,
+			}
+			if !worker.LastRun.IsZero() {
+				status.LastRun = &worker.LastRun
+			}
+			workers = append(workers, status)
+		}
+	}
+
+	ctx.JSON(http.StatusOK, statusResponse{
+		Version:      util.Version,
+		Commit:       util.BuildCommit(),
+		GoVersion:    runtime.Version(),
+		StartedAt:    server.startedAt,
+		Uptime:       time.Since(server.startedAt).Round(time.Second).String(),
+		Ready:        r.ready(),
+		ShuttingDown: server.draining.Load(),
+		Checks:       r.results(true),
+		Database: databaseStatus{
+			SchemaVersion:         r.schemaVersion,
+			ExpectedSchemaVersion: server.schemaVersion,
+			Pool:                  server.store.PoolStats(),
+		},
+		Workers: workers,
+	})
+}
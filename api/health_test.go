@@ -0,0 +1,244 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	db "github.com/Glenn444/banking-app/internal/database"
+	mock_database "github.com/Glenn444/banking-app/internal/database/mock"
+	"github.com/Glenn444/banking-app/internal/health"
+	"github.com/Glenn444/banking-app/internal/scheduler"
+	"github.com/Glenn444/banking-app/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// stoppedMonitor returns a monitor whose only worker has stopped
+func stoppedMonitor() *health.Monitor {
+	monitor := health.NewMonitor(scheduler.RealClock())
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	monitor.Run(ctx, "transfer worker", time.Minute, func(context.Context) error { return nil })
+	return monitor
+}
+
+// failingMonitor returns a monitor whose only worker is still running but
+// failed its last run
+func failingMonitor(t *testing.T) *health.Monitor {
+	monitor := health.NewMonitor(scheduler.RealClock())
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	ran := make(chan struct{})
+	go monitor.Run(ctx, "transfer worker", time.Hour, func(context.Context) error {
+		defer close(ran)
+		return errors.New("connection refused")
+	})
+	<-ran
+	require.Eventually(t, func() bool {
+		return monitor.Workers()[0].LastError != ""
+	}, time.Second, time.Millisecond)
+	return monitor
+}
+
+func TestHealthz(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// liveness doesn't touch the database
+	server := newTestServer(t, mock_database.NewMockStore(ctrl))
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodGet, "/healthz", nil)
+	require.NoError(t, err)
+	server.router.ServeHTTP(recorder, request)
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.JSONEq(t, `{"status":"ok"}`, recorder.Body.String())
+}
+
+func TestReadyz(t *testing.T) {
+	testCases := []struct {
+		name          string
+		schemaVersion int64
+		pingErr       error
+		schemaErr     error
+		setup         func(server *Server)
+		wantStatus    int
+		wantChecks    map[string]string
+	}{
+		{
+			name:          "Ready",
+			schemaVersion: 42,
+			setup: func(server *Server) {
+				server.MonitorWorkers(health.NewMonitor(scheduler.RealClock()))
+			},
+			wantStatus: http.StatusOK,
+			wantChecks: map[string]string{"shutdown": "ok", "database": "ok", "migrations": "ok", "workers": "ok"},
+		},
+		{
+			name:          "SchemaAhead",
+			schemaVersion: 43,
+			wantStatus:    http.StatusOK,
+			wantChecks:    map[string]string{"shutdown": "ok", "database": "ok", "migrations": "ok"},
+		},
+		{
+			name:          "SchemaBehind",
+			schemaVersion: 41,
+			wantStatus:    http.StatusServiceUnavailable,
+			wantChecks:    map[string]string{"shutdown": "ok", "database": "ok", "migrations": "failing"},
+		},
+		{
+			name:       "DatabaseDown",
+			pingErr:    errors.New("dial tcp 10.0.0.5:5432: connection refused"),
+			schemaErr:  errors.New("dial tcp 10.0.0.5:5432: connection refused"),
+			wantStatus: http.StatusServiceUnavailable,
+			wantChecks: map[string]string{"shutdown": "ok", "database": "failing", "migrations": "failing"},
+		},
+		{
+			name:          "WorkerStopped",
+			schemaVersion: 42,
+			setup: func(server *Server) {
+				server.MonitorWorkers(stoppedMonitor())
+			},
+			wantStatus: http.StatusServiceUnavailable,
+			wantChecks: map[string]string{"shutdown": "ok", "database": "ok", "migrations": "ok", "workers": "failing"},
+		},
+		{
+			name:          "WorkerFailed",
+			schemaVersion: 42,
+			setup: func(server *Server) {
+				server.MonitorWorkers(failingMonitor(t))
+			},
+			wantStatus: http.StatusServiceUnavailable,
+			wantChecks: map[string]string{"shutdown": "ok", "database": "ok", "migrations": "ok", "workers": "failing"},
+		},
+		{
+			name:          "ShuttingDown",
+			schemaVersion: 42,
+			setup: func(server *Server) {
+				server.draining.Store(true)
+			},
+			wantStatus: http.StatusServiceUnavailable,
+			wantChecks: map[string]string{"shutdown": "failing", "database": "ok", "migrations": "ok"},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_database.NewMockStore(ctrl)
+			store.EXPECT().Ping(gomock.Any()).Times(1).Return(tc.pingErr)
+			store.EXPECT().SchemaVersion(gomock.Any()).Times(1).Return(tc.schemaVersion, tc.schemaErr)
+
+			server := newTestServer(t, store)
+			server.schemaVersion = 42
+			if tc.setup != nil {
+				tc.setup(server)
+			}
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, "/readyz", nil)
+			require.NoError(t, err)
+			server.router.ServeHTTP(recorder, request)
+
+			require.Equal(t, tc.wantStatus, recorder.Code)
+			require.NotContains(t, recorder.Body.String(), "10.0.0.5")
+
+			var rsp readinessResponse
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &rsp))
+			require.Equal(t, tc.wantChecks, rsp.Checks)
+		})
+	}
+}
+
+func TestStatusApi(t *testing.T) {
+	admin := randomUser()
+	admin.Role = util.AdminRole
+	depositor := randomUser()
+	depositor.Role = util.DepositorRole
+
+	testCases := []struct {
+		name          string
+		username      string
+		buildStubs    func(store *mock_database.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:     "OK",
+			username: admin.Username,
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(admin.Username)).Times(1).Return(admin, nil)
+				store.EXPECT().Ping(gomock.Any()).Times(1).Return(nil)
+				store.EXPECT().SchemaVersion(gomock.Any()).Times(1).Return(int64(41), nil)
+				store.EXPECT().PoolStats().Times(1).Return(db.PoolStats{MaxConns: 10, TotalConns: 3, IdleConns: 2, AcquiredConns: 1})
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var rsp statusResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &rsp))
+				require.Equal(t, util.Version, rsp.Version)
+				require.NotEmpty(t, rsp.GoVersion)
+				require.NotEmpty(t, rsp.Uptime)
+				require.False(t, rsp.Ready)
+				require.Equal(t, "schema is at version 41, expected 42", rsp.Checks["migrations"])
+				require.Equal(t, "unhealthy workers: transfer worker", rsp.Checks["workers"])
+				require.Equal(t, int64(41), rsp.Database.SchemaVersion)
+				require.Equal(t, int64(42), rsp.Database.ExpectedSchemaVersion)
+				require.Equal(t, int32(3), rsp.Database.Pool.TotalConns)
+
+				require.Len(t, rsp.Workers, 1)
+				require.Equal(t, "transfer worker", rsp.Workers[0].Name)
+				require.Equal(t, "1m0s", rsp.Workers[0].Interval)
+				require.NotNil(t, rsp.Workers[0].LastRun)
+				require.True(t, rsp.Workers[0].Stopped)
+				require.False(t, rsp.Workers[0].Healthy)
+			},
+		},
+		{
+			name:     "NotAdmin",
+			username: depositor.Username,
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(depositor.Username)).Times(1).Return(depositor, nil)
+				store.EXPECT().Ping(gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_database.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			server.schemaVersion = 42
+			server.MonitorWorkers(stoppedMonitor())
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, "/admin/status", nil)
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, tc.username, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
@@ -0,0 +1,242 @@
+package api
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	db "github.com/Glenn444/banking-app/internal/database"
+	"github.com/Glenn444/banking-app/internal/token"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	defaultHoldTTL = 7 * 24 * time.Hour
+	maxHoldTTL     = 30 * 24 * time.Hour
+)
+
+type createHoldRequest struct {
+	ToAccountID uuid.UUID       `json:"to_account_id" binding:"required"`
+	Amount      decimal.Decimal `json:"amount" binding:"amount=Currency"`
+	Currency    string          `json:"currency" binding:"required,currency"`
+	Description string          `json:"description" binding:"max=255"`
+	// TTLSeconds is how long the hold lasts before it expires on its own
+	TTLSeconds int64 `json:"ttl_seconds" binding:"min=0"`
+}
+
+// createHold reserves funds on the caller's account for the recipient to capture later
+func (server *Server) createHold(ctx *gin.Context) {
+	var accountId AccountId
+	if err := ctx.ShouldBindUri(&accountId); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	var req createHoldRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	ttl := defaultHoldTTL
+	if req.TTLSeconds > 0 {
+		ttl = time.Duration(req.TTLSeconds) * time.Second
+	}
+	if ttl > maxHoldTTL {
+		ctx.Error(statusMessage(http.StatusBadRequest, "hold can't last longer than 30 days"))
+		return
+	}
+
+	account, valid := server.validAccount(ctx, uuid.MustParse(accountId.ID), req.Currency)
+	if !valid {
+		return
+	}
+
+	if !server.authorizeOutflow(ctx, account, req.Amount) {
+		return
+	}
+
+	if req.ToAccountID == account.ID {
+		ctx.Error(statusMessage(http.StatusBadRequest, "cannot place a hold for the same account"))
+		return
+	}
+	_, valid = server.validAccount(ctx, req.ToAccountID, req.Currency)
+	if !valid {
+		return
+	}
+
+	result, err := server.store.CreateHoldTx(ctx, db.CreateHoldTxParams{
+		AccountID:   account.ID,
+		ToAccountID: req.ToAccountID,
+		Amount:      req.Amount,
+		Description: req.Description,
+		ExpiresAt:   time.Now().Add(ttl),
+	})
+	if err != nil {
+		if limitExceeded(ctx, err) {
+			return
+		}
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
+
+type listHoldsRequest struct {
+	PageNum  int32 `form:"page_num" binding:"min=1"`
+	PageSize int32 `form:"page_size" binding:"min=5,max=10"`
+}
+
+func (server *Server) listHolds(ctx *gin.Context) {
+	var accountId AccountId
+	if err := ctx.ShouldBindUri(&accountId); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	var req listHoldsRequest
+	req.PageNum = 1
+	req.PageSize = 5
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	account, err := server.store.GetAccount(ctx, uuid.MustParse(accountId.ID))
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			ctx.Error(statusError(http.StatusNotFound, err))
+			return
+		}
+		ctx.Error(err)
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	if account.Owner != authPayload.Username {
+		ctx.Error(statusMessage(http.StatusForbidden, "account doesn't belong to you"))
+		return
+	}
+
+	holds, err := server.store.ListHolds(ctx, db.ListHoldsParams{
+		AccountID: account.ID,
+		Limit:     req.PageSize,
+		Offset:    (req.PageNum - 1) * req.PageSize,
+	})
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, holds)
+}
+
+func (server *Server) getHold(ctx *gin.Context) {
+	hold, _, ok := server.holdParty(ctx)
+	if !ok {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, hold)
+}
+
+type captureHoldRequest struct {
+	// Amount captures part of the hold, leave it out to capture all of it
+	Amount decimal.Decimal `json:"amount"`
+}
+
+// captureHold lets the hold's recipient collect the reserved funds
+func (server *Server) captureHold(ctx *gin.Context) {
+	var req captureHoldRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	hold, recipient, ok := server.holdParty(ctx)
+	if !ok {
+		return
+	}
+	if !recipient {
+		ctx.Error(statusMessage(http.StatusForbidden, "only the recipient can capture a hold"))
+		return
+	}
+
+	result, err := server.store.CaptureHoldTx(ctx, db.CaptureHoldTxParams{
+		HoldID: hold.ID,
+		Amount: req.Amount,
+	})
+	if err != nil {
+		server.holdError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
+
+// releaseHold cancels a hold, either party may release it
+func (server *Server) releaseHold(ctx *gin.Context) {
+	hold, _, ok := server.holdParty(ctx)
+	if !ok {
+		return
+	}
+
+	result, err := server.store.ReleaseHoldTx(ctx, hold.ID)
+	if err != nil {
+		server.holdError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
+
+func (server *Server) holdError(ctx *gin.Context, err error) {
+	ctx.Error(err)
+}
+
+type holdUri struct {
+	ID string `uri:"id" binding:"required,uuid"`
+}
+
+// holdParty loads the hold in the uri and checks the caller owns the held
+// account or the recipient account, recipient tells which. It writes the
+// error response when the caller is neither.
+func (server *Server) holdParty(ctx *gin.Context) (hold db.Hold, recipient bool, ok bool) {
+	var uri holdUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	hold, err := server.store.GetHold(ctx, uuid.MustParse(uri.ID))
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			ctx.Error(statusMessage(http.StatusNotFound, "hold not found"))
+			return
+		}
+		ctx.Error(err)
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	owners := make([]string, 2)
+	for i, id := range []uuid.UUID{hold.AccountID, hold.ToAccountID} {
+		account, err := server.store.GetAccount(ctx, id)
+		if err != nil {
+			ctx.Error(err)
+			return db.Hold{}, false, false
+		}
+		owners[i] = account.Owner
+	}
+
+	recipient = owners[1] == authPayload.Username
+	if !recipient && owners[0] != authPayload.Username {
+		ctx.Error(statusMessage(http.StatusNotFound, "hold not found"))
+		return db.Hold{}, false, false
+	}
+	return hold, recipient, true
+}
@@ -0,0 +1,254 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	db "github.com/Glenn444/banking-app/internal/database"
+	mock_database "github.com/Glenn444/banking-app/internal/database/mock"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateHoldApi(t *testing.T) {
+	user := randomUser()
+	account := randomAccountWithCurrency("USD")
+	account.Owner = user.Username
+	merchant := randomAccountWithCurrency("USD")
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mock_database.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: gin.H{"to_account_id": merchant.ID, "amount": "45.10", "currency": "USD", "ttl_seconds": 3600},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(merchant.ID)).Times(1).Return(merchant, nil)
+				store.EXPECT().
+					CreateHoldTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ any, arg db.CreateHoldTxParams) (db.HoldTxResult, error) {
+						require.Equal(t, account.ID, arg.AccountID)
+						require.Equal(t, merchant.ID, arg.ToAccountID)
+						require.True(t, decimal.RequireFromString("45.10").Equal(arg.Amount))
+						require.WithinDuration(t, time.Now().Add(time.Hour), arg.ExpiresAt, time.Minute)
+						return db.HoldTxResult{}, nil
+					})
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "InsufficientFunds",
+			body: gin.H{"to_account_id": merchant.ID, "amount": "4500", "currency": "USD"},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(merchant.ID)).Times(1).Return(merchant, nil)
+				store.EXPECT().
+					CreateHoldTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.HoldTxResult{}, fmt.Errorf("%w: account", db.ErrInsufficientFunds))
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "TTLTooLong",
+			body: gin.H{"to_account_id": merchant.ID, "amount": "10", "currency": "USD", "ttl_seconds": 31 * 24 * 3600},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().CreateHoldTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "SameAccount",
+			body: gin.H{"to_account_id": account.ID, "amount": "10", "currency": "USD"},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().CreateHoldTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_database.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			body, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/accounts/%s/holds", account.ID)
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+			require.NoError(t, err)
+			request.Header.Set("Content-Type", "application/json")
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestCaptureAndReleaseHoldApi(t *testing.T) {
+	payer := randomAccountWithCurrency("USD")
+	merchant := randomAccountWithCurrency("USD")
+	hold := db.Hold{
+		ID:          uuid.New(),
+		AccountID:   payer.ID,
+		ToAccountID: merchant.ID,
+		Amount:      decimal.NewFromInt(50),
+		Currency:    "USD",
+		Status:      db.HoldStatusActive,
+	}
+
+	stubParties := func(store *mock_database.MockStore) {
+		store.EXPECT().GetHold(gomock.Any(), gomock.Eq(hold.ID)).Times(1).Return(hold, nil)
+		store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(payer.ID)).Times(1).Return(payer, nil)
+		store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(merchant.ID)).Times(1).Return(merchant, nil)
+	}
+
+	testCases := []struct {
+		name          string
+		path          string
+		body          string
+		username      string
+		buildStubs    func(store *mock_database.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:     "PartialCapture",
+			path:     "capture",
+			body:     `{"amount": "30"}`,
+			username: merchant.Owner,
+			buildStubs: func(store *mock_database.MockStore) {
+				stubParties(store)
+				store.EXPECT().
+					CaptureHoldTx(gomock.Any(), gomock.Eq(db.CaptureHoldTxParams{
+						HoldID: hold.ID,
+						Amount: decimal.NewFromInt(30),
+					})).
+					Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:     "FullCaptureWithoutBody",
+			path:     "capture",
+			username: merchant.Owner,
+			buildStubs: func(store *mock_database.MockStore) {
+				stubParties(store)
+				store.EXPECT().
+					CaptureHoldTx(gomock.Any(), gomock.Eq(db.CaptureHoldTxParams{HoldID: hold.ID})).
+					Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:     "PayerCannotCapture",
+			path:     "capture",
+			username: payer.Owner,
+			buildStubs: func(store *mock_database.MockStore) {
+				stubParties(store)
+				store.EXPECT().CaptureHoldTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:     "CaptureExpired",
+			path:     "capture",
+			username: merchant.Owner,
+			buildStubs: func(store *mock_database.MockStore) {
+				stubParties(store)
+				store.EXPECT().
+					CaptureHoldTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.CaptureHoldTxResult{}, fmt.Errorf("%w: hold", db.ErrHoldExpired))
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name:     "PayerReleases",
+			path:     "release",
+			username: payer.Owner,
+			buildStubs: func(store *mock_database.MockStore) {
+				stubParties(store)
+				store.EXPECT().ReleaseHoldTx(gomock.Any(), gomock.Eq(hold.ID)).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:     "StrangerCannotRelease",
+			path:     "release",
+			username: "stranger",
+			buildStubs: func(store *mock_database.MockStore) {
+				stubParties(store)
+				store.EXPECT().ReleaseHoldTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_database.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/holds/%s/%s", hold.ID, tc.path)
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader([]byte(tc.body)))
+			require.NoError(t, err)
+			request.Header.Set("Content-Type", "application/json")
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, tc.username, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
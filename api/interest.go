@@ -0,0 +1,217 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	db "github.com/Glenn444/banking-app/internal/database"
+	"github.com/Glenn444/banking-app/internal/interest"
+	"github.com/Glenn444/banking-app/internal/token"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// maxInterestRate caps an interest product's annual rate at 100%
+var maxInterestRate = decimal.NewFromInt(1)
+
+type createInterestProductRequest struct {
+	Name     string `json:"name" binding:"required,max=100"`
+	Currency string `json:"currency" binding:"required,currency"`
+	// AnnualRate is the yearly rate, 0.035 is 3.5%
+	AnnualRate      decimal.Decimal `json:"annual_rate"`
+	DayCount        string          `json:"day_count" binding:"required,oneof=actual_365 actual_360 actual_actual"`
+	PayoutFrequency string          `json:"payout_frequency" binding:"required,oneof=monthly quarterly annually"`
+}
+
+func (server *Server) createInterestProduct(ctx *gin.Context) {
+	var req createInterestProductRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	if req.AnnualRate.IsNegative() || req.AnnualRate.GreaterThan(maxInterestRate) {
+		ctx.Error(statusMessage(http.StatusBadRequest, "annual_rate must be between 0 and 1"))
+		return
+	}
+	if !req.AnnualRate.Equal(req.AnnualRate.Truncate(6)) {
+		ctx.Error(statusMessage(http.StatusBadRequest, "annual_rate can't have more than 6 decimals"))
+		return
+	}
+
+	product, err := server.store.CreateInterestProduct(ctx, db.CreateInterestProductParams{
+		Name:            req.Name,
+		Currency:        req.Currency,
+		AnnualRate:      req.AnnualRate,
+		DayCount:        req.DayCount,
+		PayoutFrequency: req.PayoutFrequency,
+	})
+	if err != nil {
+		if errors.Is(db.TranslateError(err), db.ErrUniqueViolation) {
+			ctx.Error(statusMessage(http.StatusConflict, "an interest product with this name already exists"))
+			return
+		}
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, product)
+}
+
+func (server *Server) listInterestProducts(ctx *gin.Context) {
+	products, err := server.store.ListInterestProducts(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, products)
+}
+
+type setInterestProductRequest struct {
+	// InterestProductID is the product to put the account on, null takes it off
+	InterestProductID uuid.NullUUID `json:"interest_product_id"`
+}
+
+// setInterestProduct puts a savings account on an interest product or takes it off
+func (server *Server) setInterestProduct(ctx *gin.Context) {
+	var accountId AccountId
+	if err := ctx.ShouldBindUri(&accountId); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	var req setInterestProductRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	account, err := server.store.GetAccount(ctx, uuid.MustParse(accountId.ID))
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			ctx.Error(statusError(http.StatusNotFound, err))
+			return
+		}
+		ctx.Error(err)
+		return
+	}
+
+	if req.InterestProductID.Valid {
+		if account.AccountType != db.AccountTypeSavings {
+			ctx.Error(statusMessage(http.StatusBadRequest, "only savings accounts earn interest"))
+			return
+		}
+
+		product, err := server.store.GetInterestProduct(ctx, req.InterestProductID.UUID)
+		if err != nil {
+			if errors.Is(err, db.ErrNotFound) {
+				ctx.Error(statusMessage(http.StatusNotFound, "interest product not found"))
+				return
+			}
+			ctx.Error(err)
+			return
+		}
+		if product.Currency != account.Currency {
+			ctx.Error(statusMessage(http.StatusBadRequest, "interest product currency doesn't match the account"))
+			return
+		}
+	}
+
+	account, err = server.store.SetAccountInterestProduct(ctx, db.SetAccountInterestProductParams{
+		ID:                account.ID,
+		InterestProductID: req.InterestProductID,
+	})
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, account)
+}
+
+type backfillInterestRequest struct {
+	From string `json:"from" binding:"required,datetime=2006-01-02"`
+	To   string `json:"to" binding:"required,datetime=2006-01-02"`
+}
+
+// backfillInterest runs interest accrual for a range of past business
+// dates, dates that already ran only pick up what they missed
+func (server *Server) backfillInterest(ctx *gin.Context) {
+	var req backfillInterestRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	from, _ := time.Parse(time.DateOnly, req.From)
+	to, _ := time.Parse(time.DateOnly, req.To)
+	if !to.Before(db.BusinessDate(time.Now())) {
+		ctx.Error(statusMessage(http.StatusBadRequest, "only business dates that have ended can be run"))
+		return
+	}
+
+	runs, err := interest.Backfill(ctx, server.store, from, to)
+	if err != nil {
+		if errors.Is(err, interest.ErrBackfillRange) {
+			ctx.Error(statusMessage(http.StatusBadRequest, "from must not be after to and the range can't exceed 366 days"))
+			return
+		}
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, runs)
+}
+
+type listInterestPayoutsRequest struct {
+	PageNum  int32 `form:"page_num" binding:"min=1"`
+	PageSize int32 `form:"page_size" binding:"min=5,max=10"`
+}
+
+// listInterestPayouts shows the owner the interest paid into the account, newest first
+func (server *Server) listInterestPayouts(ctx *gin.Context) {
+	var accountId AccountId
+	if err := ctx.ShouldBindUri(&accountId); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	var req listInterestPayoutsRequest
+	req.PageNum = 1
+	req.PageSize = 5
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	account, err := server.store.GetAccount(ctx, uuid.MustParse(accountId.ID))
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			ctx.Error(statusError(http.StatusNotFound, err))
+			return
+		}
+		ctx.Error(err)
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	if account.Owner != authPayload.Username {
+		ctx.Error(statusMessage(http.StatusForbidden, "account doesn't belong to you"))
+		return
+	}
+
+	payouts, err := server.store.ListInterestPayouts(ctx, db.ListInterestPayoutsParams{
+		AccountID: account.ID,
+		Limit:     req.PageSize,
+		Offset:    (req.PageNum - 1) * req.PageSize,
+	})
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, payouts)
+}
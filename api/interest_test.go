@@ -0,0 +1,213 @@
+package api
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	db "github.com/Glenn444/banking-app/internal/database"
+	mock_database "github.com/Glenn444/banking-app/internal/database/mock"
+	"github.com/Glenn444/banking-app/util"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSetInterestProductApi(t *testing.T) {
+	admin := randomUser()
+	admin.Role = util.AdminRole
+
+	savings := randomAccountWithCurrency("USD")
+	savings.AccountType = db.AccountTypeSavings
+	checking := randomAccountWithCurrency("USD")
+	checking.AccountType = db.AccountTypeChecking
+
+	usdProduct := db.InterestProduct{ID: uuid.New(), Currency: "USD"}
+	eurProduct := db.InterestProduct{ID: uuid.New(), Currency: "EUR"}
+
+	testCases := []struct {
+		name          string
+		account       db.Account
+		body          gin.H
+		buildStubs    func(store *mock_database.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:    "OK",
+			account: savings,
+			body:    gin.H{"interest_product_id": usdProduct.ID},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetInterestProduct(gomock.Any(), gomock.Eq(usdProduct.ID)).Times(1).Return(usdProduct, nil)
+				store.EXPECT().
+					SetAccountInterestProduct(gomock.Any(), gomock.Eq(db.SetAccountInterestProductParams{
+						ID:                savings.ID,
+						InterestProductID: uuid.NullUUID{UUID: usdProduct.ID, Valid: true},
+					})).
+					Times(1).
+					Return(savings, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:    "RemoveProduct",
+			account: checking,
+			body:    gin.H{"interest_product_id": nil},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetInterestProduct(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().
+					SetAccountInterestProduct(gomock.Any(), gomock.Eq(db.SetAccountInterestProductParams{ID: checking.ID})).
+					Times(1).
+					Return(checking, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:    "NotSavings",
+			account: checking,
+			body:    gin.H{"interest_product_id": usdProduct.ID},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().SetAccountInterestProduct(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:    "CurrencyMismatch",
+			account: savings,
+			body:    gin.H{"interest_product_id": eurProduct.ID},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetInterestProduct(gomock.Any(), gomock.Eq(eurProduct.ID)).Times(1).Return(eurProduct, nil)
+				store.EXPECT().SetAccountInterestProduct(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_database.NewMockStore(ctrl)
+			store.EXPECT().GetUser(gomock.Any(), gomock.Eq(admin.Username)).Times(1).Return(admin, nil)
+			store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(tc.account.ID)).Times(1).Return(tc.account, nil)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/admin/accounts/%s/interest-product", tc.account.ID)
+			request, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(jsonBody(t, tc.body)))
+			require.NoError(t, err)
+			request.Header.Set("Content-Type", "application/json")
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, admin.Username, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestBackfillInterestApi(t *testing.T) {
+	admin := randomUser()
+	admin.Role = util.AdminRole
+
+	yesterday := db.BusinessDate(time.Now()).AddDate(0, 0, -1)
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mock_database.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: gin.H{
+				"from": yesterday.AddDate(0, 0, -1).Format(time.DateOnly),
+				"to":   yesterday.Format(time.DateOnly),
+			},
+			buildStubs: func(store *mock_database.MockStore) {
+				gomock.InOrder(
+					store.EXPECT().AccrueInterestTx(gomock.Any(), gomock.Eq(yesterday.AddDate(0, 0, -1))).Times(1),
+					store.EXPECT().AccrueInterestTx(gomock.Any(), gomock.Eq(yesterday)).Times(1),
+				)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "NotEnded",
+			body: gin.H{
+				"from": yesterday.Format(time.DateOnly),
+				"to":   yesterday.AddDate(0, 0, 1).Format(time.DateOnly),
+			},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().AccrueInterestTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Reversed",
+			body: gin.H{
+				"from": yesterday.Format(time.DateOnly),
+				"to":   yesterday.AddDate(0, 0, -3).Format(time.DateOnly),
+			},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().AccrueInterestTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidDate",
+			body: gin.H{"from": "yesterday", "to": yesterday.Format(time.DateOnly)},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().AccrueInterestTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_database.NewMockStore(ctrl)
+			store.EXPECT().GetUser(gomock.Any(), gomock.Eq(admin.Username)).Times(1).Return(admin, nil)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodPost, "/admin/interest/backfill", bytes.NewReader(jsonBody(t, tc.body)))
+			require.NoError(t, err)
+			request.Header.Set("Content-Type", "application/json")
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, admin.Username, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
@@ -0,0 +1,250 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	db "github.com/Glenn444/banking-app/internal/database"
+	"github.com/Glenn444/banking-app/internal/token"
+	"github.com/Glenn444/banking-app/util"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+)
+
+// limitExceeded answers with the limit that rejected a transfer and reports
+// whether err was one
+func limitExceeded(ctx *gin.Context, err error) bool {
+	var limitErr *db.LimitExceededError
+	if !errors.As(err, &limitErr) {
+		return false
+	}
+	ctx.Error(&apiError{
+		status:     http.StatusBadRequest,
+		detail:     limitErr.Error(),
+		extensions: gin.H{"limit": limitErr},
+		err:        err,
+	})
+	return true
+}
+
+// accountLimitsResponse holds the limits that apply to an account's outgoing
+// transfers, a scope without limits is null
+type accountLimitsResponse struct {
+	Account *db.TransferLimit `json:"account"`
+	User    *db.TransferLimit `json:"user"`
+}
+
+// getAccountLimits shows the owner which limits apply to the account
+func (server *Server) getAccountLimits(ctx *gin.Context) {
+	var accountId AccountId
+	if err := ctx.ShouldBindUri(&accountId); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	account, err := server.store.GetAccount(ctx, uuid.MustParse(accountId.ID))
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			ctx.Error(statusError(http.StatusNotFound, err))
+			return
+		}
+		ctx.Error(err)
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	if account.Owner != authPayload.Username {
+		ctx.Error(statusMessage(http.StatusForbidden, "account doesn't belong to you"))
+		return
+	}
+
+	var rsp accountLimitsResponse
+	accountLimit, err := server.store.GetAccountTransferLimit(ctx, uuid.NullUUID{UUID: account.ID, Valid: true})
+	switch {
+	case err == nil:
+		rsp.Account = &accountLimit
+	case !errors.Is(err, db.ErrNotFound):
+		ctx.Error(err)
+		return
+	}
+
+	userLimit, err := server.store.GetUserTransferLimit(ctx, db.GetUserTransferLimitParams{
+		Owner:    pgtype.Text{String: account.Owner, Valid: true},
+		Currency: account.Currency,
+	})
+	switch {
+	case err == nil:
+		rsp.User = &userLimit
+	case !errors.Is(err, db.ErrNotFound):
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, rsp)
+}
+
+// transferLimitRequest sets every limit of a scope at once, a limit left out
+// or null is removed
+type transferLimitRequest struct {
+	MaxSingleAmount decimal.NullDecimal `json:"max_single_amount"`
+	DailyAmount     decimal.NullDecimal `json:"daily_amount"`
+	MonthlyAmount   decimal.NullDecimal `json:"monthly_amount"`
+	HourlyCount     *int32              `json:"hourly_count" binding:"omitempty,min=1"`
+}
+
+// validate checks the request sets at least one limit and every amount is a
+// positive amount of currency
+func (req transferLimitRequest) validate(currency string) error {
+	if !req.MaxSingleAmount.Valid && !req.DailyAmount.Valid && !req.MonthlyAmount.Valid && req.HourlyCount == nil {
+		return errors.New("at least one limit must be set")
+	}
+
+	amounts := []struct {
+		name   string
+		amount decimal.NullDecimal
+	}{
+		{db.LimitMaxSingleAmount, req.MaxSingleAmount},
+		{db.LimitDailyAmount, req.DailyAmount},
+		{db.LimitMonthlyAmount, req.MonthlyAmount},
+	}
+	for _, a := range amounts {
+		if !a.amount.Valid {
+			continue
+		}
+		if !a.amount.Decimal.IsPositive() {
+			return fmt.Errorf("%s must be positive", a.name)
+		}
+		if !util.IsValidAmount(a.amount.Decimal, currency) {
+			return fmt.Errorf("%s: %w: %v %s", a.name, db.ErrInvalidAmountScale, a.amount.Decimal, currency)
+		}
+	}
+	return nil
+}
+
+func (req transferLimitRequest) hourlyCount() pgtype.Int4 {
+	if req.HourlyCount == nil {
+		return pgtype.Int4{}
+	}
+	return pgtype.Int4{Int32: *req.HourlyCount, Valid: true}
+}
+
+// setAccountLimits replaces the limits of one account
+func (server *Server) setAccountLimits(ctx *gin.Context) {
+	var accountId AccountId
+	if err := ctx.ShouldBindUri(&accountId); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	var req transferLimitRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	account, err := server.store.GetAccount(ctx, uuid.MustParse(accountId.ID))
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			ctx.Error(statusError(http.StatusNotFound, err))
+			return
+		}
+		ctx.Error(err)
+		return
+	}
+
+	if err := req.validate(account.Currency); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	limit, err := server.store.UpsertAccountTransferLimit(ctx, db.UpsertAccountTransferLimitParams{
+		AccountID:       uuid.NullUUID{UUID: account.ID, Valid: true},
+		Currency:        account.Currency,
+		MaxSingleAmount: req.MaxSingleAmount,
+		DailyAmount:     req.DailyAmount,
+		MonthlyAmount:   req.MonthlyAmount,
+		HourlyCount:     req.hourlyCount(),
+	})
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, limit)
+}
+
+type userLimitUri struct {
+	Username string `uri:"username" binding:"required,alphanum"`
+	Currency string `uri:"currency" binding:"required,currency"`
+}
+
+// setUserLimits replaces the limits across all of a user's accounts in one currency
+func (server *Server) setUserLimits(ctx *gin.Context) {
+	var uri userLimitUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	var req transferLimitRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+	if err := req.validate(uri.Currency); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	if _, err := server.store.GetUser(ctx, uri.Username); err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			ctx.Error(statusMessage(http.StatusNotFound, "user not found"))
+			return
+		}
+		ctx.Error(err)
+		return
+	}
+
+	limit, err := server.store.UpsertUserTransferLimit(ctx, db.UpsertUserTransferLimitParams{
+		Owner:           pgtype.Text{String: uri.Username, Valid: true},
+		Currency:        uri.Currency,
+		MaxSingleAmount: req.MaxSingleAmount,
+		DailyAmount:     req.DailyAmount,
+		MonthlyAmount:   req.MonthlyAmount,
+		HourlyCount:     req.hourlyCount(),
+	})
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, limit)
+}
+
+type transferLimitUri struct {
+	ID string `uri:"limit_id" binding:"required,uuid"`
+}
+
+// deleteTransferLimit removes an account or user limit
+func (server *Server) deleteTransferLimit(ctx *gin.Context) {
+	var uri transferLimitUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	deleted, err := server.store.DeleteTransferLimit(ctx, uuid.MustParse(uri.ID))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	if deleted == 0 {
+		ctx.Error(statusMessage(http.StatusNotFound, "limit not found"))
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/Glenn444/banking-app/internal/database"
+	"github.com/Glenn444/banking-app/internal/funding"
 	"github.com/Glenn444/banking-app/util"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/require"
@@ -15,6 +16,8 @@ func newTestServer(t *testing.T,store database.Store) *Server{
 	config := util.Config{
 		TokenSymmetricKey: util.RandomString(32),
 		AcessTokenDuration: time.Minute,
+		Environment: util.EnvironmentTest,
+		FundingProvider: funding.SimulatorName,
 	}
 
 	server,err := NewServer(config,store)
@@ -23,9 +26,20 @@ func newTestServer(t *testing.T,store database.Store) *Server{
 	return server
 }
 
+// testCurrencies seeds the registry, the server loads it from the database
+// at startup and the tests don't have one
+var testCurrencies = []util.Currency{
+	{Code: "USD", NumericCode: 840, MinorUnits: 2, Enabled: true, Symbol: "$"},
+	{Code: "EUR", NumericCode: 978, MinorUnits: 2, Enabled: true, Symbol: "€"},
+	{Code: "CAD", NumericCode: 124, MinorUnits: 2, Enabled: true, Symbol: "CA$"},
+	{Code: "JPY", NumericCode: 392, MinorUnits: 0, Enabled: true, Symbol: "¥"},
+	{Code: "BHD", NumericCode: 48, MinorUnits: 3, Enabled: true, Symbol: "BD"},
+}
+
 func TestMain(m *testing.M)  {
 
 	gin.SetMode(gin.TestMode)
+	util.SetCurrencies(testCurrencies)
 	
 	os.Exit(m.Run())
 }
\ No newline at end of file
@@ -1,10 +1,13 @@
 package api
 
 import (
+	"errors"
 	"net/http"
 	"strings"
 
+	db "github.com/Glenn444/banking-app/internal/database"
 	"github.com/Glenn444/banking-app/internal/token"
+	"github.com/Glenn444/banking-app/util"
 	"github.com/gin-gonic/gin"
 )
 
@@ -22,20 +25,23 @@ func authMiddleware(tokenMaker token.Maker) gin.HandlerFunc {
 		var h authHeader
 
 		if err := ctx.ShouldBindHeader(&h); err != nil {
-			ctx.AbortWithStatusJSON(http.StatusUnauthorized, errorResponse(err))
+			ctx.Error(statusError(http.StatusUnauthorized, err))
+			ctx.Abort()
 			return
 		}
 		authParts := strings.Split(h.Authorization, " ")
 
 		if len(authParts) != 2 || authParts[0] != "Bearer" {
-			ctx.AbortWithStatusJSON(http.StatusUnauthorized, errorMessage("invalid or missing authorization header"))
+			ctx.Error(statusMessage(http.StatusUnauthorized, "invalid or missing authorization header"))
+			ctx.Abort()
 			return
 		}
 		authorizationBearerToken := authParts[1]
 		//verify that the token is valid and it's not a refreshToken
 		payload, err := tokenMaker.VerifyToken(authorizationBearerToken, token.AccessToken)
 		if err != nil {
-			ctx.AbortWithStatusJSON(http.StatusUnauthorized, errorResponse(err))
+			ctx.Error(statusError(http.StatusUnauthorized, err))
+			ctx.Abort()
 			return
 		}
 
@@ -44,3 +50,31 @@ func authMiddleware(tokenMaker token.Maker) gin.HandlerFunc {
 		ctx.Next()
 	}
 }
+
+// adminMiddleware only lets users with the admin role through,
+// it must run after authMiddleware
+func adminMiddleware(store db.Store) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+		user, err := store.GetUser(ctx, authPayload.Username)
+		if err != nil {
+			if errors.Is(err, db.ErrNotFound) {
+				ctx.Error(statusMessage(http.StatusForbidden, "admin access required"))
+				ctx.Abort()
+				return
+			}
+			ctx.Error(err)
+			ctx.Abort()
+			return
+		}
+
+		if user.Role != util.AdminRole {
+			ctx.Error(statusMessage(http.StatusForbidden, "admin access required"))
+			ctx.Abort()
+			return
+		}
+
+		ctx.Next()
+	}
+}
@@ -0,0 +1,71 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	db "github.com/Glenn444/banking-app/internal/database"
+	"github.com/Glenn444/banking-app/internal/token"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type listNotificationsRequest struct {
+	UnreadOnly bool  `form:"unread_only"`
+	PageNum    int32 `form:"page_num" binding:"min=1"`
+	PageSize   int32 `form:"page_size" binding:"min=5,max=10"`
+}
+
+// listNotifications shows the caller's notifications, newest first
+func (server *Server) listNotifications(ctx *gin.Context) {
+	var req listNotificationsRequest
+	req.PageNum = 1
+	req.PageSize = 5
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	notifications, err := server.store.ListNotifications(ctx, db.ListNotificationsParams{
+		Username:   authPayload.Username,
+		UnreadOnly: req.UnreadOnly,
+		Limit:      req.PageSize,
+		Offset:     (req.PageNum - 1) * req.PageSize,
+	})
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, notifications)
+}
+
+type notificationUri struct {
+	ID string `uri:"id" binding:"required,uuid"`
+}
+
+// readNotification marks one of the caller's notifications as read
+func (server *Server) readNotification(ctx *gin.Context) {
+	var uri notificationUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	notification, err := server.store.MarkNotificationRead(ctx, db.MarkNotificationReadParams{
+		ID:       uuid.MustParse(uri.ID),
+		Username: authPayload.Username,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			ctx.Error(statusMessage(http.StatusNotFound, "notification not found"))
+			return
+		}
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, notification)
+}
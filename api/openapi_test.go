@@ -0,0 +1,300 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Glenn444/banking-app/client"
+	mock_database "github.com/Glenn444/banking-app/internal/database/mock"
+	"github.com/Glenn444/banking-app/internal/token"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// openAPIDocument is the part of the spec the tests check
+type openAPIDocument struct {
+	OpenAPI    string                                 `json:"openapi"`
+	Paths      map[string]map[string]openAPIOperation `json:"paths"`
+	Components struct {
+		Parameters map[string]openAPIParameter `json:"parameters"`
+	} `json:"components"`
+}
+
+type openAPIOperation struct {
+	OperationID string             `json:"operationId"`
+	Parameters  []openAPIParameter `json:"parameters"`
+}
+
+type openAPIParameter struct {
+	Ref  string `json:"$ref"`
+	Name string `json:"name"`
+	In   string `json:"in"`
+}
+
+var openAPIPathParam = regexp.MustCompile(`\{([a-z_]+)\}`)
+
+func loadOpenAPIDocument(t *testing.T) openAPIDocument {
+	var doc openAPIDocument
+	require.NoError(t, json.Unmarshal(openAPISpec, &doc))
+	require.Equal(t, "3.1.0", doc.OpenAPI)
+	return doc
+}
+
+func TestOpenAPISpecCoversRoutes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	server := newTestServer(t, mock_database.NewMockStore(ctrl))
+	doc := loadOpenAPIDocument(t)
+
+	routes := map[string]bool{}
+	for _, route := range server.router.Routes() {
+		path := ginPathParam.ReplaceAllString(route.Path, "{$1}")
+		method := strings.ToLower(route.Method)
+		routes[method+" "+path] = true
+
+		_, ok := doc.Paths[path][method]
+		require.Truef(t, ok, "%s %s is missing from api/openapi.json", route.Method, route.Path)
+	}
+
+	operationIDs := map[string]bool{}
+	for path, operations := range doc.Paths {
+		for method, operation := range operations {
+			require.Truef(t, routes[method+" "+path], "api/openapi.json documents %s %s which isn't a route", method, path)
+
+			require.NotEmptyf(t, operation.OperationID, "%s %s has no operationId", method, path)
+			require.Falsef(t, operationIDs[operation.OperationID], "operationId %s is used twice", operation.OperationID)
+			operationIDs[operation.OperationID] = true
+
+			declared := map[string]bool{}
+			for _, param := range operation.Parameters {
+				if param.Ref != "" {
+					param = doc.Components.Parameters[strings.TrimPrefix(param.Ref, "#/components/parameters/")]
+				}
+				if param.In == "path" {
+					declared[param.Name] = true
+				}
+			}
+			for _, match := range openAPIPathParam.FindAllStringSubmatch(path, -1) {
+				require.Truef(t, declared[match[1]], "%s %s doesn't declare its path parameter %s", method, path, match[1])
+			}
+			require.Lenf(t, declared, len(openAPIPathParam.FindAllString(path, -1)), "%s %s declares path parameters it doesn't have", method, path)
+		}
+	}
+}
+
+func TestGetOpenAPI(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	server := newTestServer(t, mock_database.NewMockStore(ctrl))
+
+	testCases := []struct {
+		name        string
+		url         string
+		status      int
+		contentType string
+	}{
+		{
+			name:        "Spec",
+			url:         "/openapi.json",
+			status:      http.StatusOK,
+			contentType: "application/json",
+		},
+		{
+			name:        "SwaggerUI",
+			url:         "/docs",
+			status:      http.StatusOK,
+			contentType: "text/html; charset=utf-8",
+		},
+		{
+			name:        "Stylesheet",
+			url:         "/docs/swagger-ui.css",
+			status:      http.StatusOK,
+			contentType: "text/css; charset=utf-8",
+		},
+		{
+			name:        "Script",
+			url:         "/docs/swagger-ui-bundle.js",
+			status:      http.StatusOK,
+			contentType: "text/javascript; charset=utf-8",
+		},
+		{
+			name:        "UnknownAsset",
+			url:         "/docs/index.html",
+			status:      http.StatusNotFound,
+			contentType: problemContentType,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			request, err := http.NewRequest(http.MethodGet, tc.url, nil)
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, tc.status, recorder.Code)
+			require.Equal(t, tc.contentType, recorder.Header().Get("Content-Type"))
+		})
+	}
+}
+
+func TestValidateRequest(t *testing.T) {
+	user := randomUser()
+
+	testCases := []struct {
+		name          string
+		method        string
+		url           string
+		body          gin.H
+		setupAuth     func(t *testing.T, request *http.Request, tokenMaker token.Maker)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:   "MissingRequiredField",
+			method: http.MethodPost,
+			url:    "/transfers",
+			body:   gin.H{"from_account_id": uuid.New(), "to_account_id": uuid.New(), "amount": "10"},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				requireInvalidRequest(t, recorder, "request body has an error")
+			},
+		},
+		{
+			name:   "WrongType",
+			method: http.MethodPost,
+			url:    "/transfers",
+			body:   gin.H{"from_account_id": uuid.New(), "to_account_id": uuid.New(), "amount": true, "currency": "USD"},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				requireInvalidRequest(t, recorder, "request body has an error")
+			},
+		},
+		{
+			name:   "QueryOutOfRange",
+			method: http.MethodGet,
+			url:    "/accounts?page_num=1&page_size=50",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				requireInvalidRequest(t, recorder, `parameter "page_size" in query has an error`)
+			},
+		},
+		{
+			name:   "PublicRoute",
+			method: http.MethodPost,
+			url:    "/users/login",
+			body:   gin.H{"username": 42, "password": "secret"},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				requireInvalidRequest(t, recorder, "request body has an error")
+			},
+		},
+		{
+			name:   "NoAuthorization",
+			method: http.MethodPost,
+			url:    "/transfers",
+			body:   gin.H{"amount": true},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// the request never reaches a handler
+			server := newTestServer(t, mock_database.NewMockStore(ctrl))
+			recorder := httptest.NewRecorder()
+
+			var body io.Reader
+			if tc.body != nil {
+				body = bytes.NewReader(jsonBody(t, tc.body))
+			}
+			request, err := http.NewRequest(tc.method, tc.url, body)
+			require.NoError(t, err)
+			request.Header.Set("Content-Type", "application/json")
+
+			tc.setupAuth(t, request, server.tokenMaker)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func requireInvalidRequest(t *testing.T, recorder *httptest.ResponseRecorder, detail string) {
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	var rsp problem
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &rsp))
+	require.Equal(t, "invalid_request", rsp.Code)
+	require.Contains(t, rsp.Detail, detail)
+}
+
+func TestGeneratedClient(t *testing.T) {
+	user := randomUser()
+	account := randomAccount(user.Username)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mock_database.NewMockStore(ctrl)
+	store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+
+	server := newTestServer(t, store)
+	httpServer := httptest.NewServer(server.router)
+	defer httpServer.Close()
+
+	accessToken, err := server.tokenMaker.CreateToken(user.Username, token.AccessToken, time.Minute)
+	require.NoError(t, err)
+
+	c, err := client.NewClientWithResponses(httpServer.URL, client.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("Authorization", authorizationTypeBearer+" "+accessToken)
+		return nil
+	}))
+	require.NoError(t, err)
+
+	rsp, err := c.GetAccountByIdWithResponse(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rsp.StatusCode())
+	require.NotNil(t, rsp.JSON200)
+	require.Equal(t, account.ID, rsp.JSON200.Id)
+	require.Equal(t, account.Owner, rsp.JSON200.Owner)
+	require.Equal(t, account.Balance.String(), rsp.JSON200.Balance)
+	require.Nil(t, rsp.JSON200.OrganisationId)
+
+	rsp, err = c.GetAccountByIdWithResponse(context.Background(), account.ID, func(ctx context.Context, req *http.Request) error {
+		req.Header.Del("Authorization")
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusUnauthorized, rsp.StatusCode())
+	require.NotNil(t, rsp.ApplicationproblemJSONDefault)
+	require.Equal(t, "unauthorized", rsp.ApplicationproblemJSONDefault.Code)
+}
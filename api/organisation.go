@@ -0,0 +1,379 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	db "github.com/Glenn444/banking-app/internal/database"
+	"github.com/Glenn444/banking-app/internal/fx"
+	"github.com/Glenn444/banking-app/internal/token"
+	"github.com/Glenn444/banking-app/util"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+const defaultApprovalTTLSeconds = 24 * 3600
+
+type organisationPolicyRequest struct {
+	// transfers above ApprovalThreshold, in ApprovalThresholdCurrency, wait
+	// for RequiredApprovals distinct approvers, for at most ApprovalTTLSeconds
+	ApprovalThreshold         decimal.Decimal `json:"approval_threshold"`
+	ApprovalThresholdCurrency string          `json:"approval_threshold_currency" binding:"required,currency"`
+	RequiredApprovals         int32           `json:"required_approvals" binding:"required,min=1,max=10"`
+	ApprovalTTLSeconds        int32           `json:"approval_ttl_seconds" binding:"min=0,max=2592000"`
+}
+
+type createOrganisationRequest struct {
+	Name string `json:"name" binding:"required,max=100"`
+	organisationPolicyRequest
+}
+
+// createOrganisation lets admins onboard a corporate customer
+func (server *Server) createOrganisation(ctx *gin.Context) {
+	var req createOrganisationRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+	if req.ApprovalThreshold.IsNegative() {
+		ctx.Error(statusMessage(http.StatusBadRequest, "approval threshold can't be negative"))
+		return
+	}
+	if req.ApprovalTTLSeconds == 0 {
+		req.ApprovalTTLSeconds = defaultApprovalTTLSeconds
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	organisation, err := server.store.CreateOrganisation(ctx, db.CreateOrganisationParams{
+		Name:                      req.Name,
+		ApprovalThreshold:         req.ApprovalThreshold,
+		ApprovalThresholdCurrency: req.ApprovalThresholdCurrency,
+		RequiredApprovals:         req.RequiredApprovals,
+		ApprovalTtlSeconds:        req.ApprovalTTLSeconds,
+		CreatedBy:                 authPayload.Username,
+	})
+	if err != nil {
+		if errors.Is(db.TranslateError(err), db.ErrUniqueViolation) {
+			ctx.Error(statusMessage(http.StatusConflict, "organisation name is taken"))
+			return
+		}
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, organisation)
+}
+
+type organisationUri struct {
+	ID string `uri:"id" binding:"required,uuid"`
+}
+
+// setOrganisationPolicy changes when an organisation's transfers need
+// approval, transfers already waiting keep the policy they were made with
+func (server *Server) setOrganisationPolicy(ctx *gin.Context) {
+	var uri organisationUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	var req organisationPolicyRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+	if req.ApprovalThreshold.IsNegative() {
+		ctx.Error(statusMessage(http.StatusBadRequest, "approval threshold can't be negative"))
+		return
+	}
+	if req.ApprovalTTLSeconds == 0 {
+		req.ApprovalTTLSeconds = defaultApprovalTTLSeconds
+	}
+
+	organisation, err := server.store.UpdateOrganisationPolicy(ctx, db.UpdateOrganisationPolicyParams{
+		ID:                        uuid.MustParse(uri.ID),
+		ApprovalThreshold:         req.ApprovalThreshold,
+		ApprovalThresholdCurrency: req.ApprovalThresholdCurrency,
+		RequiredApprovals:         req.RequiredApprovals,
+		ApprovalTtlSeconds:        req.ApprovalTTLSeconds,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			ctx.Error(statusMessage(http.StatusNotFound, "organisation not found"))
+			return
+		}
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, organisation)
+}
+
+type organisationMemberUri struct {
+	ID       string `uri:"id" binding:"required,uuid"`
+	Username string `uri:"username" binding:"required,alphanum"`
+}
+
+type organisationMemberRequest struct {
+	Role string `json:"role" form:"role" binding:"required,oneof=initiator approver"`
+}
+
+// addOrganisationMember gives a user a role in an organisation
+func (server *Server) addOrganisationMember(ctx *gin.Context) {
+	var uri organisationMemberUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	var req organisationMemberRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	user, err := server.store.GetUser(ctx, uri.Username)
+	if err != nil && !errors.Is(err, db.ErrNotFound) {
+		ctx.Error(err)
+		return
+	}
+	if errors.Is(err, db.ErrNotFound) || user.Role == util.SystemRole {
+		ctx.Error(statusMessage(http.StatusNotFound, "user not found"))
+		return
+	}
+
+	member, err := server.store.AddOrganisationMember(ctx, db.AddOrganisationMemberParams{
+		OrganisationID: uuid.MustParse(uri.ID),
+		Username:       user.Username,
+		Role:           req.Role,
+	})
+	if err != nil {
+		if errors.Is(db.TranslateError(err), db.ErrForeignKeyViolation) {
+			ctx.Error(statusMessage(http.StatusNotFound, "organisation not found"))
+			return
+		}
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, member)
+}
+
+// removeOrganisationMember takes a role in an organisation away from a user,
+// the approvals they already gave stand
+func (server *Server) removeOrganisationMember(ctx *gin.Context) {
+	var uri organisationMemberUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	var req organisationMemberRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	removed, err := server.store.RemoveOrganisationMember(ctx, db.RemoveOrganisationMemberParams{
+		OrganisationID: uuid.MustParse(uri.ID),
+		Username:       uri.Username,
+		Role:           req.Role,
+	})
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	if removed == 0 {
+		ctx.Error(statusMessage(http.StatusNotFound, "member not found"))
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+type organisationAccountUri struct {
+	ID        string `uri:"id" binding:"required,uuid"`
+	AccountID string `uri:"account_id" binding:"required,uuid"`
+}
+
+// linkOrganisationAccount puts an account under an organisation, so its
+// initiators can move money out of it within the approval policy
+func (server *Server) linkOrganisationAccount(ctx *gin.Context) {
+	var uri organisationAccountUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	organisation, err := server.store.GetOrganisation(ctx, uuid.MustParse(uri.ID))
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			ctx.Error(statusMessage(http.StatusNotFound, "organisation not found"))
+			return
+		}
+		ctx.Error(err)
+		return
+	}
+
+	account, err := server.store.SetAccountOrganisation(ctx, db.SetAccountOrganisationParams{
+		ID:             uuid.MustParse(uri.AccountID),
+		OrganisationID: uuid.NullUUID{UUID: organisation.ID, Valid: true},
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			ctx.Error(statusMessage(http.StatusNotFound, "account not found"))
+			return
+		}
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, account)
+}
+
+// listOrganisations shows the organisations the caller is a member of
+func (server *Server) listOrganisations(ctx *gin.Context) {
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	organisations, err := server.store.ListUserOrganisations(ctx, authPayload.Username)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, organisations)
+}
+
+// memberOrganisation loads the organisation in the uri and checks the caller
+// is one of its members. It writes the error response when they aren't.
+func (server *Server) memberOrganisation(ctx *gin.Context) (db.Organisation, bool) {
+	var uri organisationUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return db.Organisation{}, false
+	}
+
+	organisation, err := server.store.GetOrganisation(ctx, uuid.MustParse(uri.ID))
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			ctx.Error(statusMessage(http.StatusNotFound, "organisation not found"))
+			return db.Organisation{}, false
+		}
+		ctx.Error(err)
+		return db.Organisation{}, false
+	}
+
+	if !server.isOrganisationMember(ctx, organisation.ID) {
+		return db.Organisation{}, false
+	}
+	return organisation, true
+}
+
+// isOrganisationMember checks the caller is a member of the organisation,
+// writing the error response when they aren't
+func (server *Server) isOrganisationMember(ctx *gin.Context, organisationID uuid.UUID) bool {
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	isMember, err := server.store.IsOrganisationMember(ctx, db.IsOrganisationMemberParams{
+		OrganisationID: organisationID,
+		Username:       authPayload.Username,
+	})
+	if err != nil {
+		ctx.Error(err)
+		return false
+	}
+	if !isMember {
+		ctx.Error(statusMessage(http.StatusNotFound, "organisation not found"))
+		return false
+	}
+	return true
+}
+
+// listOrganisationMembers shows an organisation's members and their roles to its members
+func (server *Server) listOrganisationMembers(ctx *gin.Context) {
+	organisation, ok := server.memberOrganisation(ctx)
+	if !ok {
+		return
+	}
+
+	members, err := server.store.ListOrganisationMembers(ctx, organisation.ID)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, members)
+}
+
+// authorizeFromAccount checks the caller can move money out of the account,
+// as its owner or as an initiator of the organisation it is linked to. It
+// returns the organisation of a linked account, and writes the error
+// response when the caller can't.
+func (server *Server) authorizeFromAccount(ctx *gin.Context, account db.Account) (*db.Organisation, bool) {
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	if !account.OrganisationID.Valid {
+		if account.Owner != authPayload.Username {
+			ctx.Error(statusMessage(http.StatusForbidden, "from account doesn't belong to you"))
+			return nil, false
+		}
+		return nil, true
+	}
+
+	organisation, err := server.store.GetOrganisation(ctx, account.OrganisationID.UUID)
+	if err != nil {
+		ctx.Error(err)
+		return nil, false
+	}
+	if account.Owner == authPayload.Username {
+		return &organisation, true
+	}
+
+	isInitiator, err := server.store.HasOrganisationRole(ctx, db.HasOrganisationRoleParams{
+		OrganisationID: organisation.ID,
+		Username:       authPayload.Username,
+		Role:           db.OrganisationRoleInitiator,
+	})
+	if err != nil {
+		ctx.Error(err)
+		return nil, false
+	}
+	if !isInitiator {
+		ctx.Error(statusMessage(http.StatusForbidden, "from account doesn't belong to you"))
+		return nil, false
+	}
+	return &organisation, true
+}
+
+// authorizeOutflow checks the caller can move amount out of the account
+// some way other than a transfer: a withdrawal, batch, escrow, scheduled
+// transfer, hold or paid payment request. Only transfers can wait for an
+// organisation's approvers, so an amount over its threshold is refused
+// rather than let through unapproved. It writes the error response when
+// the caller can't.
+func (server *Server) authorizeOutflow(ctx *gin.Context, account db.Account, amount decimal.Decimal) bool {
+	organisation, valid := server.authorizeFromAccount(ctx, account)
+	if !valid {
+		return false
+	}
+	approval, err := server.needsApproval(ctx, organisation, amount, account.Currency)
+	if err != nil {
+		ctx.Error(err)
+		return false
+	}
+	if approval {
+		ctx.Error(fmt.Errorf("%w: %v out of account %v", db.ErrApprovalRequired, amount, account.ID))
+		return false
+	}
+	return true
+}
+
+// needsApproval reports whether a transfer of amount in currency out of an
+// account of the organisation waits for its approvers, an amount in another
+// currency than the threshold's is converted at the current rate first
+func (server *Server) needsApproval(ctx context.Context, organisation *db.Organisation, amount decimal.Decimal, currency string) (bool, error) {
+	if organisation == nil {
+		return false, nil
+	}
+	return fx.Exceeds(ctx, server.fxProvider, amount, currency, organisation.ApprovalThreshold, organisation.ApprovalThresholdCurrency)
+}
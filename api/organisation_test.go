@@ -0,0 +1,219 @@
+package api
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	db "github.com/Glenn444/banking-app/internal/database"
+	mock_database "github.com/Glenn444/banking-app/internal/database/mock"
+	"github.com/Glenn444/banking-app/util"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateOrganisationApi(t *testing.T) {
+	admin := randomUser()
+	admin.Role = util.AdminRole
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mock_database.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: gin.H{"name": "Acme Ltd", "approval_threshold": "10000", "approval_threshold_currency": "EUR", "required_approvals": 2},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().
+					CreateOrganisation(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ any, arg db.CreateOrganisationParams) (db.Organisation, error) {
+						require.Equal(t, "Acme Ltd", arg.Name)
+						require.True(t, decimal.NewFromInt(10000).Equal(arg.ApprovalThreshold))
+						require.Equal(t, "EUR", arg.ApprovalThresholdCurrency)
+						require.Equal(t, int32(2), arg.RequiredApprovals)
+						require.Equal(t, int32(defaultApprovalTTLSeconds), arg.ApprovalTtlSeconds)
+						require.Equal(t, admin.Username, arg.CreatedBy)
+						return db.Organisation{ID: uuid.New(), Name: arg.Name}, nil
+					})
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "NegativeThreshold",
+			body: gin.H{"name": "Acme Ltd", "approval_threshold": "-1", "approval_threshold_currency": "USD", "required_approvals": 1},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().CreateOrganisation(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "NoThresholdCurrency",
+			body: gin.H{"name": "Acme Ltd", "approval_threshold": "100", "required_approvals": 1},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().CreateOrganisation(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "NoApprovers",
+			body: gin.H{"name": "Acme Ltd", "approval_threshold": "100", "approval_threshold_currency": "USD", "required_approvals": 0},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().CreateOrganisation(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "NameTaken",
+			body: gin.H{"name": "Acme Ltd", "approval_threshold": "100", "approval_threshold_currency": "USD", "required_approvals": 1},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().
+					CreateOrganisation(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Organisation{}, &pgconn.PgError{Code: "23505"})
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_database.NewMockStore(ctrl)
+			store.EXPECT().GetUser(gomock.Any(), gomock.Eq(admin.Username)).Times(1).Return(admin, nil)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodPost, "/admin/organisations", bytes.NewReader(jsonBody(t, tc.body)))
+			require.NoError(t, err)
+			request.Header.Set("Content-Type", "application/json")
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, admin.Username, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestAddOrganisationMemberApi(t *testing.T) {
+	admin := randomUser()
+	admin.Role = util.AdminRole
+	member := randomUser()
+	organisationID := uuid.New()
+
+	testCases := []struct {
+		name          string
+		username      string
+		body          gin.H
+		buildStubs    func(store *mock_database.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:     "OK",
+			username: member.Username,
+			body:     gin.H{"role": "approver"},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(member.Username)).Times(1).Return(member, nil)
+				store.EXPECT().
+					AddOrganisationMember(gomock.Any(), gomock.Eq(db.AddOrganisationMemberParams{
+						OrganisationID: organisationID,
+						Username:       member.Username,
+						Role:           db.OrganisationRoleApprover,
+					})).
+					Times(1).
+					Return(db.OrganisationMember{}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:     "InvalidRole",
+			username: member.Username,
+			body:     gin.H{"role": "owner"},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().AddOrganisationMember(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:     "UserNotFound",
+			username: member.Username,
+			body:     gin.H{"role": "initiator"},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(member.Username)).Times(1).Return(db.User{}, db.ErrNotFound)
+				store.EXPECT().AddOrganisationMember(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:     "SystemUser",
+			username: "fees",
+			body:     gin.H{"role": "initiator"},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq("fees")).
+					Times(1).
+					Return(db.User{Username: "fees", Role: util.SystemRole}, nil)
+				store.EXPECT().AddOrganisationMember(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_database.NewMockStore(ctrl)
+			store.EXPECT().GetUser(gomock.Any(), gomock.Eq(admin.Username)).Times(1).Return(admin, nil)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/admin/organisations/%s/members/%s", organisationID, tc.username)
+			request, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(jsonBody(t, tc.body)))
+			require.NoError(t, err)
+			request.Header.Set("Content-Type", "application/json")
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, admin.Username, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	db "github.com/Glenn444/banking-app/internal/database"
+	"github.com/Glenn444/banking-app/internal/token"
+	"github.com/Glenn444/banking-app/util"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// maxOverdraftInterestRate caps the yearly rate at 100%
+var maxOverdraftInterestRate = decimal.NewFromInt(1)
+
+type setOverdraftRequest struct {
+	Limit decimal.Decimal `json:"limit"`
+	// InterestRate is the yearly rate charged on a negative balance, 0.18 is 18%
+	InterestRate decimal.Decimal `json:"interest_rate"`
+}
+
+// setOverdraft approves or changes an account's overdraft, it is the only
+// way the overdraft limit and its interest rate change
+func (server *Server) setOverdraft(ctx *gin.Context) {
+	var accountId AccountId
+	if err := ctx.ShouldBindUri(&accountId); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	var req setOverdraftRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	if req.Limit.IsNegative() {
+		ctx.Error(statusMessage(http.StatusBadRequest, "limit can't be negative"))
+		return
+	}
+	if req.InterestRate.IsNegative() || req.InterestRate.GreaterThan(maxOverdraftInterestRate) {
+		ctx.Error(statusMessage(http.StatusBadRequest, "interest_rate must be between 0 and 1"))
+		return
+	}
+	if !req.InterestRate.Equal(req.InterestRate.Truncate(6)) {
+		ctx.Error(statusMessage(http.StatusBadRequest, "interest_rate can't have more than 6 decimals"))
+		return
+	}
+
+	account, err := server.store.GetAccount(ctx, uuid.MustParse(accountId.ID))
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			ctx.Error(statusError(http.StatusNotFound, err))
+			return
+		}
+		ctx.Error(err)
+		return
+	}
+
+	if !util.IsValidAmount(req.Limit, account.Currency) {
+		err := fmt.Errorf("%w: %v %s", db.ErrInvalidAmountScale, req.Limit, account.Currency)
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	account, err = server.store.SetAccountOverdraft(ctx, db.SetAccountOverdraftParams{
+		ID:                    account.ID,
+		OverdraftLimit:        req.Limit,
+		OverdraftInterestRate: req.InterestRate,
+	})
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, account)
+}
+
+type listOverdraftChargesRequest struct {
+	PageNum  int32 `form:"page_num" binding:"min=1"`
+	PageSize int32 `form:"page_size" binding:"min=5,max=10"`
+}
+
+// listOverdraftCharges shows the owner the interest charged on the account, newest first
+func (server *Server) listOverdraftCharges(ctx *gin.Context) {
+	var accountId AccountId
+	if err := ctx.ShouldBindUri(&accountId); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	var req listOverdraftChargesRequest
+	req.PageNum = 1
+	req.PageSize = 5
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	account, err := server.store.GetAccount(ctx, uuid.MustParse(accountId.ID))
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			ctx.Error(statusError(http.StatusNotFound, err))
+			return
+		}
+		ctx.Error(err)
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	if account.Owner != authPayload.Username {
+		ctx.Error(statusMessage(http.StatusForbidden, "account doesn't belong to you"))
+		return
+	}
+
+	charges, err := server.store.ListOverdraftInterestCharges(ctx, db.ListOverdraftInterestChargesParams{
+		AccountID: account.ID,
+		Limit:     req.PageSize,
+		Offset:    (req.PageNum - 1) * req.PageSize,
+	})
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, charges)
+}
@@ -0,0 +1,233 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	db "github.com/Glenn444/banking-app/internal/database"
+	"github.com/Glenn444/banking-app/internal/token"
+	"github.com/Glenn444/banking-app/util"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	defaultPaymentRequestTTL = 7 * 24 * time.Hour
+	maxPaymentRequestTTL     = 30 * 24 * time.Hour
+)
+
+type createPaymentRequestRequest struct {
+	Payer    string          `json:"payer" binding:"required,alphanum"`
+	Amount   decimal.Decimal `json:"amount" binding:"amount=Currency"`
+	Currency string          `json:"currency" binding:"required,currency"`
+	Memo     string          `json:"memo" binding:"max=140"`
+	// TTLSeconds is how long the payer has to answer before the request expires
+	TTLSeconds int64 `json:"ttl_seconds" binding:"min=0"`
+}
+
+// createPaymentRequest asks another user for money, paid into the caller's
+// account in the currency once they accept
+func (server *Server) createPaymentRequest(ctx *gin.Context) {
+	var req createPaymentRequestRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	ttl := defaultPaymentRequestTTL
+	if req.TTLSeconds > 0 {
+		ttl = time.Duration(req.TTLSeconds) * time.Second
+	}
+	if ttl > maxPaymentRequestTTL {
+		ctx.Error(statusMessage(http.StatusBadRequest, "payment request can't last longer than 30 days"))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	if req.Payer == authPayload.Username {
+		ctx.Error(statusMessage(http.StatusBadRequest, "can't request money from yourself"))
+		return
+	}
+
+	payer, err := server.store.GetUser(ctx, req.Payer)
+	if err != nil && !errors.Is(err, db.ErrNotFound) {
+		ctx.Error(err)
+		return
+	}
+	if errors.Is(err, db.ErrNotFound) || payer.Role == util.SystemRole {
+		ctx.Error(statusMessage(http.StatusNotFound, "payer not found"))
+		return
+	}
+
+	// the request is paid into the requester's account, so they need one
+	_, err = server.store.ResolveRecipientAccount(ctx, db.ResolveRecipientAccountParams{
+		Currency: req.Currency,
+		Handle:   authPayload.Username,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			ctx.Error(statusMessage(http.StatusBadRequest, "you have no account in this currency"))
+			return
+		}
+		ctx.Error(err)
+		return
+	}
+
+	request, err := server.store.CreatePaymentRequestTx(ctx, db.CreatePaymentRequestParams{
+		Requester: authPayload.Username,
+		Payer:     payer.Username,
+		Amount:    req.Amount,
+		Currency:  req.Currency,
+		Memo:      req.Memo,
+		ExpiresAt: time.Now().Add(ttl),
+	})
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, request)
+}
+
+type listPaymentRequestsRequest struct {
+	// Direction is incoming for the requests the caller can pay, outgoing
+	// for the ones they made
+	Direction string `form:"direction" binding:"oneof=incoming outgoing"`
+	PageNum   int32  `form:"page_num" binding:"min=1"`
+	PageSize  int32  `form:"page_size" binding:"min=5,max=10"`
+}
+
+// listPaymentRequests lists the pending requests the caller can still answer
+// or every request they made
+func (server *Server) listPaymentRequests(ctx *gin.Context) {
+	var req listPaymentRequestsRequest
+	req.Direction = "incoming"
+	req.PageNum = 1
+	req.PageSize = 5
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	var requests []db.PaymentRequest
+	var err error
+	if req.Direction == "incoming" {
+		requests, err = server.store.ListIncomingPaymentRequests(ctx, db.ListIncomingPaymentRequestsParams{
+			Payer:  authPayload.Username,
+			Now:    time.Now(),
+			Limit:  req.PageSize,
+			Offset: (req.PageNum - 1) * req.PageSize,
+		})
+	} else {
+		requests, err = server.store.ListOutgoingPaymentRequests(ctx, db.ListOutgoingPaymentRequestsParams{
+			Requester: authPayload.Username,
+			Limit:     req.PageSize,
+			Offset:    (req.PageNum - 1) * req.PageSize,
+		})
+	}
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, requests)
+}
+
+type paymentRequestUri struct {
+	ID string `uri:"id" binding:"required,uuid"`
+}
+
+// incomingPaymentRequest loads the request in the uri and checks the caller
+// is its payer. It writes the error response when they aren't.
+func (server *Server) incomingPaymentRequest(ctx *gin.Context) (db.PaymentRequest, bool) {
+	var uri paymentRequestUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return db.PaymentRequest{}, false
+	}
+
+	request, err := server.store.GetPaymentRequest(ctx, uuid.MustParse(uri.ID))
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			ctx.Error(statusMessage(http.StatusNotFound, "payment request not found"))
+			return db.PaymentRequest{}, false
+		}
+		ctx.Error(err)
+		return db.PaymentRequest{}, false
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	if request.Payer != authPayload.Username {
+		ctx.Error(statusMessage(http.StatusNotFound, "payment request not found"))
+		return db.PaymentRequest{}, false
+	}
+	return request, true
+}
+
+type acceptPaymentRequestRequest struct {
+	FromAccountID uuid.UUID `json:"from_account_id" binding:"required"`
+}
+
+// acceptPaymentRequest pays a request from one of the caller's accounts
+func (server *Server) acceptPaymentRequest(ctx *gin.Context) {
+	var req acceptPaymentRequestRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	request, ok := server.incomingPaymentRequest(ctx)
+	if !ok {
+		return
+	}
+
+	fromAccount, valid := server.validAccount(ctx, req.FromAccountID, request.Currency)
+	if !valid {
+		return
+	}
+	if !server.authorizeOutflow(ctx, fromAccount, request.Amount) {
+		return
+	}
+
+	result, err := server.store.AcceptPaymentRequestTx(ctx, db.AcceptPaymentRequestTxParams{
+		RequestID:     request.ID,
+		FromAccountID: req.FromAccountID,
+	})
+	if err != nil {
+		if limitExceeded(ctx, err) {
+			return
+		}
+		server.paymentRequestError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
+
+// declinePaymentRequest turns down a request made to the caller
+func (server *Server) declinePaymentRequest(ctx *gin.Context) {
+	request, ok := server.incomingPaymentRequest(ctx)
+	if !ok {
+		return
+	}
+
+	declined, err := server.store.DeclinePaymentRequestTx(ctx, request.ID)
+	if err != nil {
+		server.paymentRequestError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, declined)
+}
+
+func (server *Server) paymentRequestError(ctx *gin.Context, err error) {
+	if errors.Is(err, db.ErrNotFound) {
+		ctx.Error(statusMessage(http.StatusNotFound, "account not found"))
+		return
+	}
+	ctx.Error(err)
+}
@@ -0,0 +1,239 @@
+package api
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	db "github.com/Glenn444/banking-app/internal/database"
+	mock_database "github.com/Glenn444/banking-app/internal/database/mock"
+	"github.com/Glenn444/banking-app/util"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreatePaymentRequestApi(t *testing.T) {
+	requester := randomUser()
+	payer := randomUser()
+	account := randomAccountWithCurrency("USD")
+	account.Owner = requester.Username
+	amount := decimal.NewFromInt(40)
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mock_database.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: gin.H{"payer": payer.Username, "amount": amount, "currency": "USD", "memo": "dinner"},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(payer.Username)).Times(1).Return(payer, nil)
+				store.EXPECT().
+					ResolveRecipientAccount(gomock.Any(), gomock.Eq(db.ResolveRecipientAccountParams{
+						Currency: "USD",
+						Handle:   requester.Username,
+					})).
+					Times(1).
+					Return(db.ResolveRecipientAccountRow{Account: account}, nil)
+				store.EXPECT().
+					CreatePaymentRequestTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ any, arg db.CreatePaymentRequestParams) (db.PaymentRequest, error) {
+						require.Equal(t, requester.Username, arg.Requester)
+						require.Equal(t, payer.Username, arg.Payer)
+						require.True(t, amount.Equal(arg.Amount))
+						require.Equal(t, "dinner", arg.Memo)
+						require.WithinDuration(t, time.Now().Add(defaultPaymentRequestTTL), arg.ExpiresAt, time.Minute)
+						return db.PaymentRequest{ID: uuid.New()}, nil
+					})
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "Self",
+			body: gin.H{"payer": requester.Username, "amount": amount, "currency": "USD"},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().CreatePaymentRequestTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "SystemPayer",
+			body: gin.H{"payer": "fees", "amount": amount, "currency": "USD"},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq("fees")).
+					Times(1).
+					Return(db.User{Username: "fees", Role: util.SystemRole}, nil)
+				store.EXPECT().CreatePaymentRequestTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "NoRequesterAccount",
+			body: gin.H{"payer": payer.Username, "amount": amount, "currency": "USD"},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(payer.Username)).Times(1).Return(payer, nil)
+				store.EXPECT().
+					ResolveRecipientAccount(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ResolveRecipientAccountRow{}, db.ErrNotFound)
+				store.EXPECT().CreatePaymentRequestTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "TTLTooLong",
+			body: gin.H{"payer": payer.Username, "amount": amount, "currency": "USD", "ttl_seconds": 31 * 24 * 3600},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().CreatePaymentRequestTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_database.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodPost, "/payment-requests", bytes.NewReader(jsonBody(t, tc.body)))
+			require.NoError(t, err)
+			request.Header.Set("Content-Type", "application/json")
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, requester.Username, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestAcceptPaymentRequestApi(t *testing.T) {
+	payer := randomUser()
+	fromAccount := randomAccountWithCurrency("USD")
+	fromAccount.Owner = payer.Username
+	paymentRequest := db.PaymentRequest{
+		ID:        uuid.New(),
+		Requester: util.RandomOwner(),
+		Payer:     payer.Username,
+		Amount:    decimal.NewFromInt(40),
+		Currency:  "USD",
+		Status:    db.PaymentRequestStatusPending,
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+
+	testCases := []struct {
+		name          string
+		username      string
+		buildStubs    func(store *mock_database.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:     "OK",
+			username: payer.Username,
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+				store.EXPECT().
+					AcceptPaymentRequestTx(gomock.Any(), gomock.Eq(db.AcceptPaymentRequestTxParams{
+						RequestID:     paymentRequest.ID,
+						FromAccountID: fromAccount.ID,
+					})).
+					Times(1).
+					Return(db.AcceptPaymentRequestTxResult{Request: paymentRequest}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:     "NotPayer",
+			username: paymentRequest.Requester,
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().AcceptPaymentRequestTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:     "AlreadyAnswered",
+			username: payer.Username,
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+				store.EXPECT().
+					AcceptPaymentRequestTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.AcceptPaymentRequestTxResult{}, fmt.Errorf("%w: declined", db.ErrPaymentRequestNotPending))
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name:     "InsufficientFunds",
+			username: payer.Username,
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+				store.EXPECT().
+					AcceptPaymentRequestTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.AcceptPaymentRequestTxResult{}, db.ErrInsufficientFunds)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_database.NewMockStore(ctrl)
+			store.EXPECT().GetPaymentRequest(gomock.Any(), gomock.Eq(paymentRequest.ID)).Times(1).Return(paymentRequest, nil)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/payment-requests/%s/accept", paymentRequest.ID)
+			body := jsonBody(t, gin.H{"from_account_id": fromAccount.ID})
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+			require.NoError(t, err)
+			request.Header.Set("Content-Type", "application/json")
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, tc.username, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
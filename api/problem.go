@@ -0,0 +1,213 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	db "github.com/Glenn444/banking-app/internal/database"
+	"github.com/gin-gonic/gin"
+)
+
+// problemContentType is the media type of RFC 7807 problem details
+const problemContentType = "application/problem+json"
+
+// problem is the RFC 7807 body of every error response. Code is a stable
+// machine readable name for the error, clients match it rather than the
+// detail, which is meant for people and can change.
+type problem struct {
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Status   int    `json:"status"`
+	Detail   string `json:"detail,omitempty"`
+	Instance string `json:"instance,omitempty"`
+	Code     string `json:"code"`
+}
+
+// problemKind is how an error of the store's taxonomy is answered
+type problemKind struct {
+	err    error
+	status int
+	code   string
+}
+
+// problemKinds are matched in order with errors.Is, so a kind has to come
+// before any more general kind it also matches
+var problemKinds = []problemKind{
+	{db.ErrAccountNotFound, http.StatusNotFound, "account_not_found"},
+	{db.ErrNotFound, http.StatusNotFound, "not_found"},
+	{db.ErrUniqueViolation, http.StatusConflict, "already_exists"},
+	{db.ErrForeignKeyViolation, http.StatusBadRequest, "invalid_reference"},
+	{db.ErrCheckViolation, http.StatusBadRequest, "constraint_violation"},
+	{db.ErrConcurrentUpdate, http.StatusConflict, "concurrent_update"},
+
+	{db.ErrInsufficientFunds, http.StatusBadRequest, "insufficient_funds"},
+	{db.ErrInvalidAmountScale, http.StatusBadRequest, "invalid_amount_scale"},
+	{db.ErrLimitExceeded, http.StatusBadRequest, "limit_exceeded"},
+	{db.ErrTransferNotQueued, http.StatusConflict, "transfer_not_queued"},
+	{db.ErrTransferNotReversible, http.StatusConflict, "transfer_not_reversible"},
+
+	{db.ErrApprovalNotPending, http.StatusConflict, "approval_not_pending"},
+	{db.ErrApprovalExpired, http.StatusConflict, "approval_expired"},
+	{db.ErrAlreadyApproved, http.StatusConflict, "already_approved"},
+	{db.ErrNotApprover, http.StatusForbidden, "not_approver"},
+	{db.ErrApproverIsInitiator, http.StatusForbidden, "approver_is_initiator"},
+	{db.ErrNotApprovalInitiator, http.StatusForbidden, "not_approval_initiator"},
+	{db.ErrApprovalCurrency, http.StatusBadRequest, "currency_mismatch"},
+	{db.ErrApprovalRequired, http.StatusForbidden, "approval_required"},
+
+	{db.ErrBatchFinished, http.StatusConflict, "batch_finished"},
+	{db.ErrBatchItemFailed, http.StatusBadRequest, "batch_item_failed"},
+
+	{db.ErrEscrowTransition, http.StatusConflict, "escrow_transition"},
+	{db.ErrEscrowExpired, http.StatusConflict, "escrow_expired"},
+	{db.ErrNotEscrowParty, http.StatusNotFound, "escrow_not_found"},
+	{db.ErrEscrowAccounts, http.StatusBadRequest, "invalid_escrow_accounts"},
+
+	{db.ErrFundingNotPending, http.StatusConflict, "funding_not_pending"},
+
+	{db.ErrFxQuoteUsed, http.StatusConflict, "fx_quote_used"},
+	{db.ErrFxQuoteExpired, http.StatusBadRequest, "fx_quote_expired"},
+	{db.ErrFxQuoteMismatch, http.StatusBadRequest, "fx_quote_mismatch"},
+
+	{db.ErrHoldNotActive, http.StatusConflict, "hold_not_active"},
+	{db.ErrHoldExpired, http.StatusConflict, "hold_expired"},
+	{db.ErrCaptureExceedsHold, http.StatusBadRequest, "capture_exceeds_hold"},
+	{db.ErrHoldCurrencyInvalid, http.StatusBadRequest, "currency_mismatch"},
+
+	{db.ErrPaymentRequestNotPending, http.StatusConflict, "payment_request_not_pending"},
+	{db.ErrPaymentRequestExpired, http.StatusConflict, "payment_request_expired"},
+	{db.ErrPaymentRequestAccount, http.StatusBadRequest, "invalid_payment_request_account"},
+}
+
+// statusCodes are the codes of the errors the taxonomy doesn't know, by the
+// status their handler answers with
+var statusCodes = map[int]string{
+	http.StatusBadRequest:   "invalid_request",
+	http.StatusUnauthorized: "unauthorized",
+	http.StatusForbidden:    "forbidden",
+	http.StatusNotFound:     "not_found",
+	http.StatusConflict:     "conflict",
+	http.StatusBadGateway:   "upstream_error",
+}
+
+// internalDetail is the detail of every server error, what went wrong is
+// logged and never told to the client
+const internalDetail = "the request couldn't be completed, try again later"
+
+// apiError is an error a handler answers with. A zero status or code is
+// taken from the taxonomy, and a detail from the error when it is safe to
+// show. Extensions are added to the problem as extra members.
+type apiError struct {
+	status     int
+	code       string
+	detail     string
+	extensions gin.H
+	err        error
+}
+
+func (e *apiError) Error() string {
+	if e.err == nil {
+		return e.detail
+	}
+	return e.err.Error()
+}
+
+func (e *apiError) Unwrap() error {
+	return e.err
+}
+
+// statusError answers with err under the status the handler chose
+func statusError(status int, err error) error {
+	return &apiError{status: status, err: err}
+}
+
+// statusMessage answers with a message written for the client
+func statusMessage(status int, message string) error {
+	return &apiError{status: status, detail: message}
+}
+
+// errorHandler writes the last error a handler or middleware added to the
+// context with ctx.Error as a problem, unless a response was written since.
+// The error itself is logged by gin's logger.
+func errorHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		ctx.Next()
+
+		last := ctx.Errors.Last()
+		if last == nil || ctx.Writer.Written() {
+			return
+		}
+		p, extensions := newProblem(ctx.Request.URL.Path, last.Err)
+
+		body := gin.H{}
+		for name, value := range extensions {
+			body[name] = value
+		}
+		body["type"] = p.Type
+		body["title"] = p.Title
+		body["status"] = p.Status
+		if p.Detail != "" {
+			body["detail"] = p.Detail
+		}
+		body["instance"] = p.Instance
+		body["code"] = p.Code
+
+		ctx.Header("Content-Type", problemContentType)
+		ctx.JSON(p.Status, body)
+	}
+}
+
+// newProblem describes err to the client, nothing but the taxonomy's
+// messages and the handlers' own messages reach the detail of a client error
+func newProblem(instance string, err error) (problem, gin.H) {
+	var apiErr *apiError
+	if !errors.As(err, &apiErr) {
+		apiErr = &apiError{err: err}
+	}
+
+	var kind *problemKind
+	if apiErr.err != nil {
+		translated := db.TranslateError(apiErr.err)
+		for i := range problemKinds {
+			if errors.Is(translated, problemKinds[i].err) {
+				kind = &problemKinds[i]
+				break
+			}
+		}
+	}
+
+	p := problem{
+		Type:     "about:blank",
+		Status:   apiErr.status,
+		Detail:   apiErr.detail,
+		Instance: instance,
+		Code:     apiErr.code,
+	}
+	if p.Status == 0 {
+		p.Status = http.StatusInternalServerError
+		if kind != nil {
+			p.Status = kind.status
+		}
+	}
+	if p.Code == "" {
+		switch code, ok := statusCodes[p.Status]; {
+		case kind != nil:
+			p.Code = kind.code
+		case ok:
+			p.Code = code
+		default:
+			p.Code = "internal_error"
+		}
+	}
+	switch {
+	case p.Status >= http.StatusInternalServerError:
+		p.Detail = internalDetail
+	case p.Detail != "":
+	case kind != nil:
+		p.Detail = kind.err.Error()
+	case apiErr.err != nil:
+		p.Detail = apiErr.err.Error()
+	}
+	p.Title = http.StatusText(p.Status)
+	return p, apiErr.extensions
+}
@@ -0,0 +1,190 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	db "github.com/Glenn444/banking-app/internal/database"
+	mock_database "github.com/Glenn444/banking-app/internal/database/mock"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestErrorHandler(t *testing.T) {
+	accountID := uuid.New()
+
+	testCases := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantCode   string
+		wantDetail string
+	}{
+		{
+			name:       "InternalError",
+			err:        fmt.Errorf("account %v: %w", accountID, errors.New("pq: connection refused")),
+			wantStatus: http.StatusInternalServerError,
+			wantCode:   "internal_error",
+			wantDetail: internalDetail,
+		},
+		{
+			name:       "InsufficientFunds",
+			err:        fmt.Errorf("%w: account %v can spend 10", db.ErrInsufficientFunds, accountID),
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "insufficient_funds",
+			wantDetail: db.ErrInsufficientFunds.Error(),
+		},
+		{
+			name:       "BalanceTrigger",
+			err:        &pgconn.PgError{Code: "P0001", Message: fmt.Sprintf("account %v would be overdrawn", accountID)},
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "insufficient_funds",
+			wantDetail: db.ErrInsufficientFunds.Error(),
+		},
+		{
+			name:       "UniqueViolation",
+			err:        &pgconn.PgError{Code: "23505", ConstraintName: "users_email_key"},
+			wantStatus: http.StatusConflict,
+			wantCode:   "already_exists",
+			wantDetail: db.ErrUniqueViolation.Error(),
+		},
+		{
+			name:       "RetriesExhausted",
+			err:        &pgconn.PgError{Code: "40P01"},
+			wantStatus: http.StatusConflict,
+			wantCode:   "concurrent_update",
+			wantDetail: db.ErrConcurrentUpdate.Error(),
+		},
+		{
+			name:       "NoRows",
+			err:        db.ErrNotFound,
+			wantStatus: http.StatusNotFound,
+			wantCode:   "not_found",
+			wantDetail: db.ErrNotFound.Error(),
+		},
+		{
+			name:       "HandlerStatus",
+			err:        statusError(http.StatusConflict, fmt.Errorf("%w: escrow %v", db.ErrEscrowTransition, accountID)),
+			wantStatus: http.StatusConflict,
+			wantCode:   "escrow_transition",
+			wantDetail: db.ErrEscrowTransition.Error(),
+		},
+		{
+			name:       "HandlerMessage",
+			err:        statusMessage(http.StatusForbidden, "account doesn't belong to the authenticated user"),
+			wantStatus: http.StatusForbidden,
+			wantCode:   "forbidden",
+			wantDetail: "account doesn't belong to the authenticated user",
+		},
+		{
+			name:       "ValidationError",
+			err:        statusError(http.StatusBadRequest, errors.New("amount must be positive")),
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "invalid_request",
+			wantDetail: "amount must be positive",
+		},
+		{
+			name:       "UpstreamError",
+			err:        statusError(http.StatusBadGateway, errors.New("provider at 10.0.0.1 timed out")),
+			wantStatus: http.StatusBadGateway,
+			wantCode:   "upstream_error",
+			wantDetail: internalDetail,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			router := gin.New()
+			router.Use(errorHandler())
+			router.GET("/fail", func(ctx *gin.Context) {
+				ctx.Error(tc.err)
+			})
+
+			recorder := httptest.NewRecorder()
+			request, err := http.NewRequest(http.MethodGet, "/fail", nil)
+			require.NoError(t, err)
+			router.ServeHTTP(recorder, request)
+
+			require.Equal(t, tc.wantStatus, recorder.Code)
+			require.Equal(t, problemContentType, recorder.Header().Get("Content-Type"))
+			require.NotContains(t, recorder.Body.String(), accountID.String())
+
+			var rsp problem
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &rsp))
+			require.Equal(t, problem{
+				Type:     "about:blank",
+				Title:    http.StatusText(tc.wantStatus),
+				Status:   tc.wantStatus,
+				Detail:   tc.wantDetail,
+				Instance: "/fail",
+				Code:     tc.wantCode,
+			}, rsp)
+		})
+	}
+}
+
+func TestErrorHandlerAfterResponse(t *testing.T) {
+	router := gin.New()
+	router.Use(errorHandler())
+	router.GET("/written", func(ctx *gin.Context) {
+		ctx.JSON(http.StatusOK, gin.H{"ok": true})
+		ctx.Error(errors.New("failed after answering"))
+	})
+
+	recorder := httptest.NewRecorder()
+	request, err := http.NewRequest(http.MethodGet, "/written", nil)
+	require.NoError(t, err)
+	router.ServeHTTP(recorder, request)
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.JSONEq(t, `{"ok":true}`, recorder.Body.String())
+}
+
+func TestTransferInsufficientFundsProblem(t *testing.T) {
+	user := randomUser()
+	fromAccount := randomAccountWithCurrency("USD")
+	fromAccount.Owner = user.Username
+	toAccount := randomAccountWithCurrency("USD")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mock_database.NewMockStore(ctrl)
+	store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+	store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(toAccount.ID)).Times(1).Return(toAccount, nil)
+	store.EXPECT().
+		TransferTx(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(db.TransferTxResult{}, fmt.Errorf("%w: account %v can spend 0", db.ErrInsufficientFunds, fromAccount.ID))
+
+	server := newTestServer(t, store)
+	recorder := httptest.NewRecorder()
+
+	body := gin.H{"from_account_id": fromAccount.ID, "to_account_id": toAccount.ID, "amount": 10, "currency": "USD"}
+	request, err := http.NewRequest(http.MethodPost, "/transfers", bytes.NewReader(jsonBody(t, body)))
+	require.NoError(t, err)
+	request.Header.Set("Content-Type", "application/json")
+
+	addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+	server.router.ServeHTTP(recorder, request)
+
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+	require.Equal(t, problemContentType, recorder.Header().Get("Content-Type"))
+	require.NotContains(t, recorder.Body.String(), fromAccount.ID.String())
+
+	var rsp problem
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &rsp))
+	require.Equal(t, "insufficient_funds", rsp.Code)
+	require.Equal(t, "/transfers", rsp.Instance)
+}
@@ -0,0 +1,188 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	db "github.com/Glenn444/banking-app/internal/database"
+	"github.com/Glenn444/banking-app/internal/token"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// maskName keeps the first letter of every word of a name, so a sender can
+// recognise the recipient without the name being given away
+func maskName(name string) string {
+	words := strings.Fields(name)
+	for i, word := range words {
+		first, size := utf8.DecodeRuneInString(word)
+		words[i] = string(first) + strings.Repeat("*", utf8.RuneCountInString(word[size:]))
+	}
+	return strings.Join(words, " ")
+}
+
+// recipientAccount finds the account a transfer lands in, either the one
+// named by to_account_id or the account in currency of the user named by to
+func (server *Server) recipientAccount(ctx *gin.Context, toAccountID uuid.UUID, to string, currency string) (db.Account, bool) {
+	if to == "" {
+		return server.validAccount(ctx, toAccountID, currency)
+	}
+
+	recipient, err := server.store.ResolveRecipientAccount(ctx, db.ResolveRecipientAccountParams{
+		Currency: currency,
+		Handle:   to,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			ctx.Error(statusMessage(http.StatusNotFound, "no recipient with an account in this currency"))
+			return db.Account{}, false
+		}
+		ctx.Error(err)
+		return db.Account{}, false
+	}
+	return recipient.Account, true
+}
+
+type lookupRecipientRequest struct {
+	// Handle is the recipient's username, alias or verified email
+	Handle   string `form:"handle" binding:"required,max=255"`
+	Currency string `form:"currency" binding:"required,currency"`
+}
+
+type lookupRecipientResponse struct {
+	Handle   string `json:"handle"`
+	Name     string `json:"name"`
+	Currency string `json:"currency"`
+}
+
+// lookupRecipient shows the masked name of who a handle would pay, for the
+// sender to confirm before sending
+func (server *Server) lookupRecipient(ctx *gin.Context) {
+	var req lookupRecipientRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	recipient, err := server.store.ResolveRecipientAccount(ctx, db.ResolveRecipientAccountParams{
+		Currency: req.Currency,
+		Handle:   req.Handle,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			ctx.Error(statusMessage(http.StatusNotFound, "no recipient with an account in this currency"))
+			return
+		}
+		ctx.Error(err)
+		return
+	}
+
+	name := recipient.FullName
+	if strings.TrimSpace(name) == "" {
+		name = recipient.Account.Owner
+	}
+
+	ctx.JSON(http.StatusOK, lookupRecipientResponse{
+		Handle:   req.Handle,
+		Name:     maskName(name),
+		Currency: recipient.Account.Currency,
+	})
+}
+
+type setAliasRequest struct {
+	Alias string `json:"alias" binding:"required,alphanum,min=3,max=30"`
+}
+
+type aliasResponse struct {
+	Username string `json:"username"`
+	Alias    string `json:"alias"`
+}
+
+// setAlias gives the logged in user a handle others can pay them with
+func (server *Server) setAlias(ctx *gin.Context) {
+	var req setAliasRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	// an alias that's someone else's username would be resolved to them
+	_, err := server.store.GetUser(ctx, req.Alias)
+	switch {
+	case err == nil && req.Alias != authPayload.Username:
+		ctx.Error(statusMessage(http.StatusConflict, "alias is already taken"))
+		return
+	case err != nil && !errors.Is(err, db.ErrNotFound):
+		ctx.Error(err)
+		return
+	}
+
+	user, err := server.store.SetUserAlias(ctx, db.SetUserAliasParams{
+		Username: authPayload.Username,
+		Alias:    pgtype.Text{String: req.Alias, Valid: true},
+	})
+	if err != nil {
+		if errors.Is(db.TranslateError(err), db.ErrUniqueViolation) {
+			ctx.Error(statusMessage(http.StatusConflict, "alias is already taken"))
+			return
+		}
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, aliasResponse{Username: user.Username, Alias: user.Alias.String})
+}
+
+// deleteAlias removes the logged in user's alias
+func (server *Server) deleteAlias(ctx *gin.Context) {
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	_, err := server.store.SetUserAlias(ctx, db.SetUserAliasParams{Username: authPayload.Username})
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+type verifyEmailResponse struct {
+	Username        string    `json:"username"`
+	Email           string    `json:"email"`
+	EmailVerifiedAt time.Time `json:"email_verified_at"`
+}
+
+type verifyEmailUri struct {
+	Username string `uri:"username" binding:"required,alphanum"`
+}
+
+// verifyUserEmail marks a user's email as verified, so it can be used to pay them
+func (server *Server) verifyUserEmail(ctx *gin.Context) {
+	var uri verifyEmailUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	user, err := server.store.MarkUserEmailVerified(ctx, uri.Username)
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			ctx.Error(statusMessage(http.StatusNotFound, "user not found"))
+			return
+		}
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, verifyEmailResponse{
+		Username:        user.Username,
+		Email:           user.Email,
+		EmailVerifiedAt: user.EmailVerifiedAt.Time,
+	})
+}
@@ -0,0 +1,231 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	db "github.com/Glenn444/banking-app/internal/database"
+	mock_database "github.com/Glenn444/banking-app/internal/database/mock"
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestMaskName(t *testing.T) {
+	require.Equal(t, "J*** S****", maskName("John Smith"))
+	require.Equal(t, "Z** Ø****", maskName("  Zoë   Østby "))
+	require.Equal(t, "a", maskName("a"))
+	require.Equal(t, "", maskName(""))
+}
+
+func TestLookupRecipientApi(t *testing.T) {
+	user := randomUser()
+	recipient := randomAccountWithCurrency("USD")
+
+	testCases := []struct {
+		name          string
+		query         string
+		buildStubs    func(store *mock_database.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:  "OK",
+			query: "handle=jane_doe@example.com&currency=USD",
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().
+					ResolveRecipientAccount(gomock.Any(), gomock.Eq(db.ResolveRecipientAccountParams{
+						Currency: "USD",
+						Handle:   "jane_doe@example.com",
+					})).
+					Times(1).
+					Return(db.ResolveRecipientAccountRow{Account: recipient, FullName: "Jane Doe"}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var rsp lookupRecipientResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &rsp))
+				require.Equal(t, "J*** D**", rsp.Name)
+				require.Equal(t, "USD", rsp.Currency)
+				require.NotContains(t, recorder.Body.String(), recipient.ID.String())
+			},
+		},
+		{
+			name:  "NotFound",
+			query: "handle=nobody&currency=USD",
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().
+					ResolveRecipientAccount(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ResolveRecipientAccountRow{}, db.ErrNotFound)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:  "MissingCurrency",
+			query: "handle=nobody",
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().ResolveRecipientAccount(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_database.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/recipients?%s", tc.query), nil)
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestCreateTransferToHandleApi(t *testing.T) {
+	user := randomUser()
+	fromAccount := randomAccountWithCurrency("USD")
+	fromAccount.Owner = user.Username
+	toAccount := randomAccountWithCurrency("USD")
+	amount := decimal.NewFromInt(25)
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mock_database.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: gin.H{
+				"from_account_id": fromAccount.ID,
+				"to":              "janedoe",
+				"amount":          amount,
+				"currency":        "USD",
+			},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+				store.EXPECT().
+					ResolveRecipientAccount(gomock.Any(), gomock.Eq(db.ResolveRecipientAccountParams{
+						Currency: "USD",
+						Handle:   "janedoe",
+					})).
+					Times(1).
+					Return(db.ResolveRecipientAccountRow{Account: toAccount, FullName: "Jane Doe"}, nil)
+				store.EXPECT().
+					TransferTx(gomock.Any(), EqTransferParams(db.TransferTxParams{
+						FromAccountID: fromAccount.ID,
+						ToAccountID:   toAccount.ID,
+						Amount:        amount,
+					})).
+					Times(1).
+					Return(db.TransferTxResult{}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "OwnAccount",
+			body: gin.H{
+				"from_account_id": fromAccount.ID,
+				"to":              user.Username,
+				"amount":          amount,
+				"currency":        "USD",
+			},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+				store.EXPECT().
+					ResolveRecipientAccount(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ResolveRecipientAccountRow{Account: fromAccount}, nil)
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "RecipientNotFound",
+			body: gin.H{
+				"from_account_id": fromAccount.ID,
+				"to":              "nobody",
+				"amount":          amount,
+				"currency":        "USD",
+			},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+				store.EXPECT().
+					ResolveRecipientAccount(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ResolveRecipientAccountRow{}, db.ErrNotFound)
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "BothRecipients",
+			body: gin.H{
+				"from_account_id": fromAccount.ID,
+				"to_account_id":   toAccount.ID,
+				"to":              "janedoe",
+				"amount":          amount,
+				"currency":        "USD",
+			},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_database.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodPost, "/transfers", bytes.NewReader(jsonBody(t, tc.body)))
+			require.NoError(t, err)
+			request.Header.Set("Content-Type", "application/json")
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
@@ -0,0 +1,265 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	db "github.com/Glenn444/banking-app/internal/database"
+	"github.com/Glenn444/banking-app/internal/scheduler"
+	"github.com/Glenn444/banking-app/internal/token"
+	"github.com/Glenn444/banking-app/util"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+)
+
+type createScheduledTransferRequest struct {
+	FromAccountID uuid.UUID       `json:"from_account_id" binding:"required"`
+	ToAccountID   uuid.UUID       `json:"to_account_id" binding:"required"`
+	Amount        decimal.Decimal `json:"amount" binding:"amount=Currency"`
+	Currency      string          `json:"currency" binding:"required,currency"`
+	Frequency     string          `json:"frequency" binding:"required,oneof=once weekly monthly monthly_last_business_day"`
+	StartAt       time.Time       `json:"start_at" binding:"required"`
+	EndAt         *time.Time      `json:"end_at"`
+}
+
+func (server *Server) createScheduledTransfer(ctx *gin.Context) {
+	var req createScheduledTransferRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	if req.FromAccountID == req.ToAccountID {
+		ctx.Error(statusMessage(http.StatusBadRequest, "cannot schedule a transfer to the same account"))
+		return
+	}
+	if !req.StartAt.After(time.Now()) {
+		ctx.Error(statusMessage(http.StatusBadRequest, "start_at must be in the future"))
+		return
+	}
+	endAt := pgtype.Timestamptz{}
+	if req.EndAt != nil {
+		if !req.EndAt.After(req.StartAt) {
+			ctx.Error(statusMessage(http.StatusBadRequest, "end_at must be after start_at"))
+			return
+		}
+		endAt = pgtype.Timestamptz{Time: *req.EndAt, Valid: true}
+	}
+
+	fromAccount, valid := server.validAccount(ctx, req.FromAccountID, req.Currency)
+	if !valid {
+		return
+	}
+
+	if !server.authorizeOutflow(ctx, fromAccount, req.Amount) {
+		return
+	}
+
+	_, valid = server.validAccount(ctx, req.ToAccountID, req.Currency)
+	if !valid {
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	scheduled, err := server.store.CreateScheduledTransfer(ctx, db.CreateScheduledTransferParams{
+		Owner:         authPayload.Username,
+		FromAccountID: req.FromAccountID,
+		ToAccountID:   req.ToAccountID,
+		Amount:        req.Amount,
+		Currency:      req.Currency,
+		Frequency:     req.Frequency,
+		StartAt:       req.StartAt,
+		EndAt:         endAt,
+		NextRunAt:     scheduler.FirstOccurrence(req.Frequency, req.StartAt),
+	})
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, scheduled)
+}
+
+type listScheduledTransfersRequest struct {
+	PageNum  int32 `form:"page_num" binding:"min=1"`
+	PageSize int32 `form:"page_size" binding:"min=5,max=10"`
+}
+
+func (server *Server) listScheduledTransfers(ctx *gin.Context) {
+	var req listScheduledTransfersRequest
+	req.PageNum = 1
+	req.PageSize = 5
+
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	scheduled, err := server.store.ListScheduledTransfers(ctx, db.ListScheduledTransfersParams{
+		Owner:  authPayload.Username,
+		Limit:  req.PageSize,
+		Offset: (req.PageNum - 1) * req.PageSize,
+	})
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, scheduled)
+}
+
+// recentRuns is how many run records are returned with a scheduled transfer
+const recentRuns = 10
+
+type scheduledTransferResponse struct {
+	db.ScheduledTransfer
+	Runs []db.ScheduledTransferRun `json:"runs"`
+}
+
+func (server *Server) getScheduledTransfer(ctx *gin.Context) {
+	scheduled, ok := server.ownedScheduledTransfer(ctx)
+	if !ok {
+		return
+	}
+
+	runs, err := server.store.ListScheduledTransferRuns(ctx, db.ListScheduledTransferRunsParams{
+		ScheduledTransferID: scheduled.ID,
+		Limit:               recentRuns,
+	})
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, scheduledTransferResponse{ScheduledTransfer: scheduled, Runs: runs})
+}
+
+type updateScheduledTransferRequest struct {
+	Amount *decimal.Decimal `json:"amount"`
+	Status string           `json:"status" binding:"omitempty,oneof=active paused"`
+	EndAt  *time.Time       `json:"end_at"`
+}
+
+// updateScheduledTransfer changes the amount or end date, or pauses and resumes a schedule
+func (server *Server) updateScheduledTransfer(ctx *gin.Context) {
+	var req updateScheduledTransferRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	scheduled, ok := server.ownedScheduledTransfer(ctx)
+	if !ok {
+		return
+	}
+
+	if !isEditableSchedule(scheduled) {
+		ctx.Error(statusMessage(http.StatusConflict, "scheduled transfer is "+scheduled.Status))
+		return
+	}
+
+	arg := db.UpdateScheduledTransferParams{
+		ID:     scheduled.ID,
+		Amount: scheduled.Amount,
+		Status: scheduled.Status,
+		EndAt:  scheduled.EndAt,
+	}
+	if req.Amount != nil {
+		if !req.Amount.IsPositive() || !util.IsValidAmount(*req.Amount, scheduled.Currency) {
+			ctx.Error(statusMessage(http.StatusBadRequest, "invalid amount for "+scheduled.Currency))
+			return
+		}
+		fromAccount, valid := server.validAccount(ctx, scheduled.FromAccountID, scheduled.Currency)
+		if !valid {
+			return
+		}
+		if !server.authorizeOutflow(ctx, fromAccount, *req.Amount) {
+			return
+		}
+		arg.Amount = *req.Amount
+	}
+	if req.Status != "" {
+		arg.Status = req.Status
+	}
+	if req.EndAt != nil {
+		if !req.EndAt.After(scheduled.NextRunAt) {
+			ctx.Error(statusMessage(http.StatusBadRequest, "end_at must be after the next run"))
+			return
+		}
+		arg.EndAt = pgtype.Timestamptz{Time: *req.EndAt, Valid: true}
+	}
+
+	scheduled, err := server.store.UpdateScheduledTransfer(ctx, arg)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, scheduled)
+}
+
+// cancelScheduledTransfer stops a schedule, its run history is kept
+func (server *Server) cancelScheduledTransfer(ctx *gin.Context) {
+	scheduled, ok := server.ownedScheduledTransfer(ctx)
+	if !ok {
+		return
+	}
+
+	if !isEditableSchedule(scheduled) {
+		ctx.Error(statusMessage(http.StatusConflict, "scheduled transfer is "+scheduled.Status))
+		return
+	}
+
+	scheduled, err := server.store.UpdateScheduledTransfer(ctx, db.UpdateScheduledTransferParams{
+		ID:     scheduled.ID,
+		Amount: scheduled.Amount,
+		Status: db.ScheduleStatusCancelled,
+		EndAt:  scheduled.EndAt,
+	})
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, scheduled)
+}
+
+type scheduledTransferUri struct {
+	ID string `uri:"id" binding:"required,uuid"`
+}
+
+// ownedScheduledTransfer loads the scheduled transfer in the uri and checks
+// it belongs to the caller, it writes the error response when it doesn't
+func (server *Server) ownedScheduledTransfer(ctx *gin.Context) (db.ScheduledTransfer, bool) {
+	var uri scheduledTransferUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return db.ScheduledTransfer{}, false
+	}
+
+	scheduled, err := server.store.GetScheduledTransfer(ctx, uuid.MustParse(uri.ID))
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			ctx.Error(statusMessage(http.StatusNotFound, "scheduled transfer not found"))
+			return scheduled, false
+		}
+		ctx.Error(err)
+		return scheduled, false
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	if scheduled.Owner != authPayload.Username {
+		ctx.Error(statusMessage(http.StatusNotFound, "scheduled transfer not found"))
+		return db.ScheduledTransfer{}, false
+	}
+	return scheduled, true
+}
+
+func isEditableSchedule(scheduled db.ScheduledTransfer) bool {
+	return scheduled.Status == db.ScheduleStatusActive || scheduled.Status == db.ScheduleStatusPaused
+}
@@ -0,0 +1,304 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	db "github.com/Glenn444/banking-app/internal/database"
+	mock_database "github.com/Glenn444/banking-app/internal/database/mock"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateScheduledTransferApi(t *testing.T) {
+	user := randomUser()
+	fromAccount := randomAccountWithCurrency("USD")
+	fromAccount.Owner = user.Username
+	toAccount := randomAccountWithCurrency("USD")
+	startAt := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second)
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mock_database.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: gin.H{
+				"from_account_id": fromAccount.ID,
+				"to_account_id":   toAccount.ID,
+				"amount":          "25.50",
+				"currency":        "USD",
+				"frequency":       db.FrequencyMonthly,
+				"start_at":        startAt,
+			},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(toAccount.ID)).Times(1).Return(toAccount, nil)
+				store.EXPECT().
+					CreateScheduledTransfer(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ any, arg db.CreateScheduledTransferParams) (db.ScheduledTransfer, error) {
+						require.Equal(t, user.Username, arg.Owner)
+						require.True(t, decimal.RequireFromString("25.50").Equal(arg.Amount))
+						require.True(t, startAt.Equal(arg.NextRunAt))
+						require.False(t, arg.EndAt.Valid)
+						return db.ScheduledTransfer{ID: uuid.New()}, nil
+					})
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "StartInPast",
+			body: gin.H{
+				"from_account_id": fromAccount.ID,
+				"to_account_id":   toAccount.ID,
+				"amount":          "25",
+				"currency":        "USD",
+				"frequency":       db.FrequencyWeekly,
+				"start_at":        time.Now().Add(-time.Hour),
+			},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().CreateScheduledTransfer(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "EndBeforeStart",
+			body: gin.H{
+				"from_account_id": fromAccount.ID,
+				"to_account_id":   toAccount.ID,
+				"amount":          "25",
+				"currency":        "USD",
+				"frequency":       db.FrequencyWeekly,
+				"start_at":        startAt,
+				"end_at":          startAt.Add(-time.Minute),
+			},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().CreateScheduledTransfer(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidFrequency",
+			body: gin.H{
+				"from_account_id": fromAccount.ID,
+				"to_account_id":   toAccount.ID,
+				"amount":          "25",
+				"currency":        "USD",
+				"frequency":       "daily",
+				"start_at":        startAt,
+			},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().CreateScheduledTransfer(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "FromAccountNotOwned",
+			body: gin.H{
+				"from_account_id": toAccount.ID,
+				"to_account_id":   fromAccount.ID,
+				"amount":          "25",
+				"currency":        "USD",
+				"frequency":       db.FrequencyWeekly,
+				"start_at":        startAt,
+			},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(toAccount.ID)).Times(1).Return(toAccount, nil)
+				store.EXPECT().CreateScheduledTransfer(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_database.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			body, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/scheduled-transfers", bytes.NewReader(body))
+			require.NoError(t, err)
+			request.Header.Set("Content-Type", "application/json")
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestUpdateScheduledTransferApi(t *testing.T) {
+	user := randomUser()
+	fromAccount := randomAccountWithCurrency("USD")
+	fromAccount.Owner = user.Username
+	scheduled := db.ScheduledTransfer{
+		ID:            uuid.New(),
+		Owner:         user.Username,
+		FromAccountID: fromAccount.ID,
+		Amount:        decimal.NewFromInt(25),
+		Currency:      "USD",
+		Frequency:     db.FrequencyWeekly,
+		NextRunAt:     time.Now().Add(time.Hour),
+		Status:        db.ScheduleStatusActive,
+	}
+
+	testCases := []struct {
+		name          string
+		method        string
+		body          gin.H
+		username      string
+		buildStubs    func(store *mock_database.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:     "Pause",
+			method:   http.MethodPatch,
+			body:     gin.H{"status": db.ScheduleStatusPaused, "amount": "30"},
+			username: user.Username,
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetScheduledTransfer(gomock.Any(), gomock.Eq(scheduled.ID)).Times(1).Return(scheduled, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+				store.EXPECT().
+					UpdateScheduledTransfer(gomock.Any(), gomock.Eq(db.UpdateScheduledTransferParams{
+						ID:     scheduled.ID,
+						Amount: decimal.NewFromInt(30),
+						Status: db.ScheduleStatusPaused,
+					})).
+					Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:     "CannotComplete",
+			method:   http.MethodPatch,
+			body:     gin.H{"status": db.ScheduleStatusCompleted},
+			username: user.Username,
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().UpdateScheduledTransfer(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:     "ExcessPrecision",
+			method:   http.MethodPatch,
+			body:     gin.H{"amount": "30.001"},
+			username: user.Username,
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetScheduledTransfer(gomock.Any(), gomock.Eq(scheduled.ID)).Times(1).Return(scheduled, nil)
+				store.EXPECT().UpdateScheduledTransfer(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:     "Cancel",
+			method:   http.MethodDelete,
+			username: user.Username,
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetScheduledTransfer(gomock.Any(), gomock.Eq(scheduled.ID)).Times(1).Return(scheduled, nil)
+				store.EXPECT().
+					UpdateScheduledTransfer(gomock.Any(), gomock.Eq(db.UpdateScheduledTransferParams{
+						ID:     scheduled.ID,
+						Amount: scheduled.Amount,
+						Status: db.ScheduleStatusCancelled,
+					})).
+					Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:     "CancelCompleted",
+			method:   http.MethodDelete,
+			username: user.Username,
+			buildStubs: func(store *mock_database.MockStore) {
+				completed := scheduled
+				completed.Status = db.ScheduleStatusCompleted
+				store.EXPECT().GetScheduledTransfer(gomock.Any(), gomock.Eq(scheduled.ID)).Times(1).Return(completed, nil)
+				store.EXPECT().UpdateScheduledTransfer(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name:     "OtherUser",
+			method:   http.MethodDelete,
+			username: "other_user",
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetScheduledTransfer(gomock.Any(), gomock.Eq(scheduled.ID)).Times(1).Return(scheduled, nil)
+				store.EXPECT().UpdateScheduledTransfer(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_database.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			var body []byte
+			if tc.body != nil {
+				var err error
+				body, err = json.Marshal(tc.body)
+				require.NoError(t, err)
+			}
+
+			url := "/scheduled-transfers/" + scheduled.ID.String()
+			request, err := http.NewRequest(tc.method, url, bytes.NewReader(body))
+			require.NoError(t, err)
+			request.Header.Set("Content-Type", "application/json")
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, tc.username, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
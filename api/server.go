@@ -1,11 +1,21 @@
 package api
 
 import (
+	"context"
+	"errors"
+	"expvar"
 	"fmt"
+	"net"
 	"net/http"
+	"sync/atomic"
+	"time"
 
 	db "github.com/Glenn444/banking-app/internal/database"
+	"github.com/Glenn444/banking-app/internal/funding"
+	"github.com/Glenn444/banking-app/internal/fx"
+	"github.com/Glenn444/banking-app/internal/health"
 	"github.com/Glenn444/banking-app/internal/token"
+	migrations "github.com/Glenn444/banking-app/sql"
 	"github.com/Glenn444/banking-app/util"
 	"github.com/gin-gonic/gin"
 	"github.com/gin-gonic/gin/binding"
@@ -14,10 +24,19 @@ import (
 
 // Server serves HTTP requests for our banking service
 type Server struct {
-	config     util.Config
-	tokenMaker token.Maker
-	store      db.Store
-	router     *gin.Engine
+	config          util.Config
+	tokenMaker      token.Maker
+	store           db.Store
+	fundingProvider funding.Provider
+	fxProvider      fx.Provider
+	router          *gin.Engine
+	httpServer      *http.Server
+	workers         *health.Monitor
+	// schemaVersion is the migration the database has to have reached for readiness
+	schemaVersion int64
+	startedAt     time.Time
+	// draining is set once shutdown starts, failing readiness
+	draining atomic.Bool
 }
 
 func NewServer(config util.Config, store db.Store) (*Server, error) {
@@ -25,57 +44,216 @@ func NewServer(config util.Config, store db.Store) (*Server, error) {
 	if err != nil {
 		return nil, fmt.Errorf("cannot create token maker %w\n", err)
 	}
+	fundingProvider, err := funding.NewProvider(config.FundingProvider, config.Environment)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create funding provider %w", err)
+	}
+	fxProvider, err := fx.NewProvider(config.FXRatesFile)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create fx rate provider %w", err)
+	}
+	tlsConfig, err := newTLSConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("cannot configure tls %w", err)
+	}
+	spec, err := loadOpenAPISpec()
+	if err != nil {
+		return nil, fmt.Errorf("cannot load openapi spec %w", err)
+	}
+	schemaVersion, err := migrations.LatestVersion()
+	if err != nil {
+		return nil, fmt.Errorf("cannot read migrations %w", err)
+	}
 	server := &Server{
-		tokenMaker: jwtTokenMaker,
-		store:      store,
-		config:     config,
+		tokenMaker:      jwtTokenMaker,
+		store:           store,
+		fundingProvider: fundingProvider,
+		fxProvider:      fxProvider,
+		config:          config,
+		schemaVersion:   schemaVersion,
+		startedAt:       time.Now(),
 	}
 
 	// Force log's color
 	gin.ForceConsoleColor()
 	router := gin.Default()
+	router.Use(errorHandler())
 
 	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
 		v.RegisterValidation("currency", validCurrency)
+		v.RegisterValidation("amount", validAmount)
 	}
 
 	//add middleware to refresh token
 	router.Use()
+
+	// requests are checked against the spec once they are authenticated, so
+	// only callers allowed on a route learn what it expects
+	validate := validateRequest(spec)
+	publicRoutes := router.Group("/").Use(validate)
+
 	//add routes to router
-	router.GET("/", server.welcome)
+	publicRoutes.GET("/", server.welcome)
+	publicRoutes.GET("/healthz", server.healthz)
+	publicRoutes.GET("/readyz", server.readyz)
+	publicRoutes.GET("/openapi.json", server.getOpenAPI)
+	publicRoutes.GET("/docs", server.getDocs)
+	publicRoutes.GET("/docs/:asset", server.getDocsAsset)
 
-	authRoutes := router.Group("/").Use(authMiddleware(server.tokenMaker))
+	authRoutes := router.Group("/").Use(authMiddleware(server.tokenMaker), validate)
 	authRoutes.POST("/accounts", server.createAccount)
 	authRoutes.GET("/accounts/:id", server.getAccountById)
 	authRoutes.GET("/accounts", server.listAllAccounts)
 
+	authRoutes.POST("/accounts/:id/deposits", server.createDeposit)
+	authRoutes.POST("/accounts/:id/withdrawals", server.createWithdrawal)
+	authRoutes.GET("/accounts/:id/funding/:funding_id", server.getFundingTransaction)
+	authRoutes.GET("/accounts/:id/limits", server.getAccountLimits)
+	authRoutes.GET("/accounts/:id/overdraft-charges", server.listOverdraftCharges)
+	authRoutes.GET("/accounts/:id/interest-payouts", server.listInterestPayouts)
+	authRoutes.GET("/accounts/:id/transfers", server.listAccountTransfers)
+
+	authRoutes.POST("/accounts/:id/holds", server.createHold)
+	authRoutes.GET("/accounts/:id/holds", server.listHolds)
+	authRoutes.GET("/holds/:id", server.getHold)
+	authRoutes.POST("/holds/:id/capture", server.captureHold)
+	authRoutes.POST("/holds/:id/release", server.releaseHold)
+
 	authRoutes.POST("/transfers", server.createTransfer)
+	authRoutes.POST("/transfers/quote", server.quoteTransfer)
+	authRoutes.GET("/transfers/:id", server.getTransfer)
+	authRoutes.GET("/recipients", server.lookupRecipient)
+	authRoutes.POST("/fx/quotes", server.createFxQuote)
+
+	authRoutes.POST("/scheduled-transfers", server.createScheduledTransfer)
+	authRoutes.GET("/scheduled-transfers", server.listScheduledTransfers)
+	authRoutes.GET("/scheduled-transfers/:id", server.getScheduledTransfer)
+	authRoutes.PATCH("/scheduled-transfers/:id", server.updateScheduledTransfer)
+	authRoutes.DELETE("/scheduled-transfers/:id", server.cancelScheduledTransfer)
+
+	authRoutes.POST("/transfer-batches", server.createTransferBatch)
+	authRoutes.GET("/transfer-batches", server.listTransferBatches)
+	authRoutes.GET("/transfer-batches/:id", server.getTransferBatch)
+	authRoutes.GET("/transfer-batches/:id/items", server.listTransferBatchItems)
+
+	authRoutes.POST("/payment-requests", server.createPaymentRequest)
+	authRoutes.GET("/payment-requests", server.listPaymentRequests)
+	authRoutes.POST("/payment-requests/:id/accept", server.acceptPaymentRequest)
+	authRoutes.POST("/payment-requests/:id/decline", server.declinePaymentRequest)
+
+	authRoutes.POST("/escrows", server.createEscrow)
+	authRoutes.GET("/escrows", server.listEscrows)
+	authRoutes.GET("/escrows/:id", server.getEscrow)
+	authRoutes.POST("/escrows/:id/confirm", server.confirmEscrow)
+	authRoutes.POST("/escrows/:id/dispute", server.disputeEscrow)
+
+	authRoutes.GET("/organisations", server.listOrganisations)
+	authRoutes.GET("/organisations/:id/members", server.listOrganisationMembers)
+	authRoutes.GET("/organisations/:id/approvals", server.listTransferApprovals)
+	authRoutes.GET("/approvals/:id", server.getTransferApproval)
+	authRoutes.POST("/approvals/:id/approve", server.approveTransfer)
+	authRoutes.POST("/approvals/:id/reject", server.rejectTransfer)
+	authRoutes.POST("/approvals/:id/cancel", server.cancelTransferApproval)
+
+	authRoutes.GET("/notifications", server.listNotifications)
+	authRoutes.POST("/notifications/:id/read", server.readNotification)
 
 	authRoutes.GET("/user", server.getUser)
+	authRoutes.GET("/user/wallet", server.getWallet)
+	authRoutes.PUT("/user/alias", server.setAlias)
+	authRoutes.DELETE("/user/alias", server.deleteAlias)
 	authRoutes.GET("/users", server.getAllUsers)
 
 
-	router.POST("/user", server.createUser)
+	publicRoutes.POST("/user", server.createUser)
 	
-	router.POST("/users/login", server.loginUser)
+	publicRoutes.POST("/users/login", server.loginUser)
 
-	router.POST("/token/refresh",server.refreshToken)
+	publicRoutes.POST("/token/refresh",server.refreshToken)
+
+	publicRoutes.GET("/currencies", server.listEnabledCurrencies)
+
+	adminRoutes := router.Group("/admin").Use(authMiddleware(server.tokenMaker), adminMiddleware(server.store), validate)
+	adminRoutes.GET("/metrics", gin.WrapH(expvar.Handler()))
+	adminRoutes.GET("/status", server.getStatus)
+	adminRoutes.GET("/currencies", server.listCurrencies)
+	adminRoutes.PATCH("/currencies/:code", server.updateCurrency)
+	adminRoutes.PUT("/accounts/:id/limits", server.setAccountLimits)
+	adminRoutes.PUT("/accounts/:id/overdraft", server.setOverdraft)
+	adminRoutes.PUT("/accounts/:id/interest-product", server.setInterestProduct)
+	adminRoutes.POST("/interest-products", server.createInterestProduct)
+	adminRoutes.GET("/interest-products", server.listInterestProducts)
+	adminRoutes.POST("/interest/backfill", server.backfillInterest)
+	adminRoutes.PUT("/users/:username/limits/:currency", server.setUserLimits)
+	adminRoutes.DELETE("/limits/:limit_id", server.deleteTransferLimit)
+	adminRoutes.PUT("/users/:username/tier", server.setUserTier)
+	adminRoutes.POST("/users/:username/verify-email", server.verifyUserEmail)
+	adminRoutes.POST("/transfers/:id/reverse", server.reverseTransfer)
+	adminRoutes.PUT("/fees/:currency", server.setFeeSchedule)
+	adminRoutes.GET("/fees/:currency", server.getFeeSchedule)
+	adminRoutes.GET("/fees/:currency/versions", server.listFeeSchedules)
+	adminRoutes.POST("/organisations", server.createOrganisation)
+	adminRoutes.PUT("/organisations/:id/policy", server.setOrganisationPolicy)
+	adminRoutes.PUT("/organisations/:id/members/:username", server.addOrganisationMember)
+	adminRoutes.DELETE("/organisations/:id/members/:username", server.removeOrganisationMember)
+	adminRoutes.PUT("/organisations/:id/accounts/:account_id", server.linkOrganisationAccount)
 
 	server.router = router
+	server.httpServer = &http.Server{
+		Handler:           router,
+		ReadTimeout:       config.HTTPReadTimeout,
+		ReadHeaderTimeout: config.HTTPReadHeaderTimeout,
+		WriteTimeout:      config.HTTPWriteTimeout,
+		IdleTimeout:       config.HTTPIdleTimeout,
+		MaxHeaderBytes:    config.HTTPMaxHeaderBytes,
+		TLSConfig:         tlsConfig,
+	}
 	return server, nil
 }
 
-// start runs the HTTP server on a specific address
+// Start runs the HTTP server on a specific address until Shutdown is called
 func (server *Server) Start(address string) error {
-	return server.router.Run(address)
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return err
+	}
+	return server.serve(listener)
 }
 
-func errorResponse(err error) gin.H {
-	return gin.H{"error": err.Error()}
+// serve answers the requests coming in on listener, over TLS when it is configured
+func (server *Server) serve(listener net.Listener) error {
+	var err error
+	if server.httpServer.TLSConfig != nil {
+		err = server.httpServer.ServeTLS(listener, "", "")
+	} else {
+		err = server.httpServer.Serve(listener)
+	}
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	return err
 }
 
-func errorMessage(message string) gin.H {
-	return gin.H{"error": message}
+// Shutdown fails readiness and, after config.ShutdownDrainDelay has given
+// the orchestrator time to notice and move traffic away, stops accepting
+// requests and waits for the ones in flight, such as transfers, to finish.
+// When ctx is done first the connections still open are closed, cancelling
+// their requests.
+func (server *Server) Shutdown(ctx context.Context) error {
+	server.draining.Store(true)
+
+	delay := time.NewTimer(server.config.ShutdownDrainDelay)
+	defer delay.Stop()
+	select {
+	case <-delay.C:
+	case <-ctx.Done():
+	}
+
+	err := server.httpServer.Shutdown(ctx)
+	if err != nil {
+		server.httpServer.Close()
+	}
+	return err
 }
 
 func (server *Server) welcome(ctx *gin.Context) {
@@ -0,0 +1,159 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	db "github.com/Glenn444/banking-app/internal/database"
+	mock_database "github.com/Glenn444/banking-app/internal/database/mock"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestShutdownDrainsTransfer(t *testing.T) {
+	user := randomUser()
+	fromAccount := randomAccountWithCurrency("USD")
+	fromAccount.Owner = user.Username
+	toAccount := randomAccountWithCurrency("USD")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	store := mock_database.NewMockStore(ctrl)
+	store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+	store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(toAccount.ID)).Times(1).Return(toAccount, nil)
+	store.EXPECT().
+		TransferTx(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(ctx context.Context, arg db.TransferTxParams) (db.TransferTxResult, error) {
+			close(started)
+			<-release
+			return db.TransferTxResult{}, ctx.Err()
+		})
+
+	server := newTestServer(t, store)
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- server.serve(listener)
+	}()
+
+	body := gin.H{"from_account_id": fromAccount.ID, "to_account_id": toAccount.ID, "amount": 10, "currency": "USD"}
+	request, err := http.NewRequest(http.MethodPost, "http://"+listener.Addr().String()+"/transfers", bytes.NewReader(jsonBody(t, body)))
+	require.NoError(t, err)
+	request.Header.Set("Content-Type", "application/json")
+	addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+
+	responseCode := make(chan int, 1)
+	go func() {
+		response, err := http.DefaultClient.Do(request)
+		if err != nil {
+			responseCode <- 0
+			return
+		}
+		response.Body.Close()
+		responseCode <- response.StatusCode
+	}()
+	<-started
+
+	shutdownErr := make(chan error, 1)
+	go func() {
+		shutdownErr <- server.Shutdown(context.Background())
+	}()
+
+	// the transfer in flight holds the shutdown up
+	select {
+	case err := <-shutdownErr:
+		t.Fatalf("shutdown returned %v before the transfer finished", err)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	close(release)
+	require.Equal(t, http.StatusOK, <-responseCode)
+	require.NoError(t, <-shutdownErr)
+	require.NoError(t, <-serveErr)
+}
+
+func TestShutdownTimeout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	server := newTestServer(t, mock_database.NewMockStore(ctrl))
+
+	started := make(chan struct{})
+	cancelled := make(chan struct{})
+	server.router.GET("/slow", func(ctx *gin.Context) {
+		close(started)
+		<-ctx.Request.Context().Done()
+		close(cancelled)
+	})
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go server.serve(listener)
+
+	go http.Get("http://" + listener.Addr().String() + "/slow")
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	// a request still running when the timeout is up is cancelled
+	require.ErrorIs(t, server.Shutdown(ctx), context.DeadlineExceeded)
+	select {
+	case <-cancelled:
+	case <-time.After(time.Second):
+		t.Fatal("the request in flight wasn't cancelled")
+	}
+}
+
+func TestShutdownFailsReadiness(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mock_database.NewMockStore(ctrl)
+	store.EXPECT().Ping(gomock.Any()).AnyTimes().Return(nil)
+	store.EXPECT().SchemaVersion(gomock.Any()).AnyTimes().Return(int64(42), nil)
+
+	server := newTestServer(t, store)
+	server.schemaVersion = 42
+	server.config.ShutdownDrainDelay = 500 * time.Millisecond
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- server.serve(listener)
+	}()
+
+	readyz := func() int {
+		response, err := http.Get("http://" + listener.Addr().String() + "/readyz")
+		require.NoError(t, err)
+		response.Body.Close()
+		return response.StatusCode
+	}
+	require.Equal(t, http.StatusOK, readyz())
+
+	shutdownErr := make(chan error, 1)
+	go func() {
+		shutdownErr <- server.Shutdown(context.Background())
+	}()
+
+	// during the drain delay the server still answers, but isn't ready
+	require.Eventually(t, func() bool {
+		return readyz() == http.StatusServiceUnavailable
+	}, 250*time.Millisecond, 5*time.Millisecond)
+
+	require.NoError(t, <-shutdownErr)
+	require.NoError(t, <-serveErr)
+}
@@ -0,0 +1,51 @@
+package api
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/Glenn444/banking-app/util"
+)
+
+// newTLSConfig is the TLS setup of the servers, nil when they serve plain
+// text. With a client CA every client has to present a certificate it signed.
+func newTLSConfig(config util.Config) (*tls.Config, error) {
+	if config.TLSCertFile == "" && config.TLSKeyFile == "" {
+		if config.TLSClientCAFile != "" {
+			return nil, errors.New("client CA is set without a server certificate")
+		}
+		return nil, nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(config.TLSCertFile, config.TLSKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load certificate %w", err)
+	}
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if config.TLSClientCAFile != "" {
+		pem, err := os.ReadFile(config.TLSClientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read client CA %w", err)
+		}
+		clientCAs := x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(pem) {
+			return nil, errors.New("client CA has no certificates")
+		}
+		tlsConfig.ClientCAs = clientCAs
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return tlsConfig, nil
+}
+
+// TLSConfig is the TLS setup the HTTP server serves with, nil for plain
+// HTTP. The gRPC server and the gateway serve with it too.
+func (server *Server) TLSConfig() *tls.Config {
+	return server.httpServer.TLSConfig
+}
@@ -0,0 +1,214 @@
+package api
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	mock_database "github.com/Glenn444/banking-app/internal/database/mock"
+	"github.com/Glenn444/banking-app/internal/funding"
+	"github.com/Glenn444/banking-app/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// testCert is a certificate signed by parent, or self-signed when parent is nil
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	der  []byte
+}
+
+func newTestCert(t *testing.T, name string, isCA bool, parent *testCert) testCert {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  isCA,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+	}
+
+	signer, signerKey := template, key
+	if parent != nil {
+		signer, signerKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, signer, &key.PublicKey, signerKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return testCert{cert: cert, key: key, der: der}
+}
+
+// writePEM saves the certificate and its key in dir, returning their paths
+func (c testCert) writePEM(t *testing.T, dir string, name string) (string, string) {
+	certFile := filepath.Join(dir, name+".crt")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.der}), 0o600))
+
+	keyDER, err := x509.MarshalECPrivateKey(c.key)
+	require.NoError(t, err)
+	keyFile := filepath.Join(dir, name+".key")
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+
+	return certFile, keyFile
+}
+
+func (c testCert) tlsCertificate() tls.Certificate {
+	return tls.Certificate{Certificate: [][]byte{c.der}, PrivateKey: c.key}
+}
+
+func TestNewTLSConfig(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCert(t, "ca", true, nil)
+	caFile, _ := ca.writePEM(t, dir, "ca")
+	certFile, keyFile := newTestCert(t, "server", false, &ca).writePEM(t, dir, "server")
+
+	testCases := []struct {
+		name    string
+		config  util.Config
+		check   func(t *testing.T, tlsConfig *tls.Config)
+		wantErr bool
+	}{
+		{
+			name:   "PlainHTTP",
+			config: util.Config{},
+			check: func(t *testing.T, tlsConfig *tls.Config) {
+				require.Nil(t, tlsConfig)
+			},
+		},
+		{
+			name:   "TLS",
+			config: util.Config{TLSCertFile: certFile, TLSKeyFile: keyFile},
+			check: func(t *testing.T, tlsConfig *tls.Config) {
+				require.Len(t, tlsConfig.Certificates, 1)
+				require.Equal(t, tls.NoClientCert, tlsConfig.ClientAuth)
+			},
+		},
+		{
+			name:   "MutualTLS",
+			config: util.Config{TLSCertFile: certFile, TLSKeyFile: keyFile, TLSClientCAFile: caFile},
+			check: func(t *testing.T, tlsConfig *tls.Config) {
+				require.Equal(t, tls.RequireAndVerifyClientCert, tlsConfig.ClientAuth)
+				require.NotNil(t, tlsConfig.ClientCAs)
+			},
+		},
+		{
+			name:    "MissingKey",
+			config:  util.Config{TLSCertFile: certFile, TLSKeyFile: filepath.Join(dir, "missing.key")},
+			wantErr: true,
+		},
+		{
+			name:    "ClientCAWithoutCertificate",
+			config:  util.Config{TLSClientCAFile: caFile},
+			wantErr: true,
+		},
+		{
+			name:    "ClientCANotPEM",
+			config:  util.Config{TLSCertFile: certFile, TLSKeyFile: keyFile, TLSClientCAFile: keyFile},
+			wantErr: true,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			tlsConfig, err := newTLSConfig(tc.config)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			tc.check(t, tlsConfig)
+		})
+	}
+}
+
+func TestServeMutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCert(t, "ca", true, nil)
+	caFile, _ := ca.writePEM(t, dir, "ca")
+	certFile, keyFile := newTestCert(t, "server", false, &ca).writePEM(t, dir, "server")
+	client := newTestCert(t, "client", false, &ca)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	config := util.Config{
+		TokenSymmetricKey: util.RandomString(32),
+		Environment:       util.EnvironmentTest,
+		FundingProvider:   funding.SimulatorName,
+		TLSCertFile:       certFile,
+		TLSKeyFile:        keyFile,
+		TLSClientCAFile:   caFile,
+	}
+	server, err := NewServer(config, mock_database.NewMockStore(ctrl))
+	require.NoError(t, err)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go server.serve(listener)
+	defer server.Shutdown(t.Context())
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+	url := "https://" + listener.Addr().String() + "/currencies"
+
+	testCases := []struct {
+		name         string
+		certificates []tls.Certificate
+		wantErr      bool
+	}{
+		{
+			name:         "ClientCertificate",
+			certificates: []tls.Certificate{client.tlsCertificate()},
+		},
+		{
+			name:    "NoClientCertificate",
+			wantErr: true,
+		},
+		{
+			name:         "UntrustedClientCertificate",
+			certificates: []tls.Certificate{newTestCert(t, "stranger", false, nil).tlsCertificate()},
+			wantErr:      true,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			httpClient := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{
+				RootCAs:      roots,
+				Certificates: tc.certificates,
+			}}}
+
+			response, err := httpClient.Get(url)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			defer response.Body.Close()
+			require.Equal(t, http.StatusOK, response.StatusCode)
+		})
+	}
+}
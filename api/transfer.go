@@ -1,7 +1,9 @@
 package api
 
 import (
-	"database/sql"
+	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -9,20 +11,112 @@ import (
 	"github.com/Glenn444/banking-app/internal/token"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/shopspring/decimal"
 )
 
 type transferMoneyRequest struct {
-	FromAccountID uuid.UUID       `json:"from_account_id" binding:"required"`
-	ToAccountID   uuid.UUID       `json:"to_account_id" binding:"required"`
-	Amount        decimal.Decimal `json:"amount" bindings:"required,gt=0"`
-	Currency      string          `json:"currency" binding:"required,currency"`
+	FromAccountID uuid.UUID `json:"from_account_id" binding:"required"`
+	// the recipient is either ToAccountID or To, a username, alias or
+	// verified email whose account in the currency receives the money
+	ToAccountID uuid.UUID       `json:"to_account_id" binding:"required_without=To,excluded_with=To"`
+	To          string          `json:"to" binding:"max=255"`
+	Amount      decimal.Decimal `json:"amount" binding:"amount=Currency"`
+	Currency    string          `json:"currency" binding:"required,currency"`
+	// FxQuoteID turns the transfer into a cross-currency one at the quoted rate
+	FxQuoteID uuid.NullUUID `json:"fx_quote_id"`
+	// Description, ExternalReference and Metadata are optional and kept on
+	// the transfer, a reference can only be used once per from account
+	Description       string          `json:"description" binding:"max=255"`
+	ExternalReference string          `json:"external_reference" binding:"omitempty,max=64,printascii"`
+	Metadata          json.RawMessage `json:"metadata"`
+	// Mode async queues the transfer and answers 202 right away, its status
+	// is then polled at /transfers/:id or followed through notifications
+	Mode string `json:"mode" binding:"omitempty,oneof=sync async"`
+}
+
+const transferModeAsync = "async"
+
+const (
+	maxMetadataBytes = 4096
+	maxMetadataKeys  = 50
+)
+
+// transferDetails checks the optional details of the request, writing the
+// error response when they are invalid
+func transferDetails(ctx *gin.Context, req transferMoneyRequest) (db.TransferDetails, bool) {
+	details := db.TransferDetails{
+		Description: req.Description,
+		ExternalReference: pgtype.Text{
+			String: req.ExternalReference,
+			Valid:  req.ExternalReference != "",
+		},
+	}
+
+	metadata := bytes.TrimSpace(req.Metadata)
+	if len(metadata) == 0 || bytes.Equal(metadata, []byte("null")) {
+		return details, true
+	}
+	if len(metadata) > maxMetadataBytes {
+		ctx.Error(statusMessage(http.StatusBadRequest, fmt.Sprintf("metadata can't be larger than %d bytes", maxMetadataBytes)))
+		return details, false
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(metadata, &fields); err != nil {
+		ctx.Error(statusMessage(http.StatusBadRequest, "metadata must be a JSON object"))
+		return details, false
+	}
+	if len(fields) > maxMetadataKeys {
+		ctx.Error(statusMessage(http.StatusBadRequest, fmt.Sprintf("metadata can't have more than %d keys", maxMetadataKeys)))
+		return details, false
+	}
+
+	details.Metadata = json.RawMessage(metadata)
+	return details, true
+}
+
+// duplicateReference answers with the transfer already made with the
+// reference when err is a unique violation on it
+func (server *Server) duplicateReference(ctx *gin.Context, fromAccountID uuid.UUID, details db.TransferDetails, err error) bool {
+	if !errors.Is(db.TranslateError(err), db.ErrUniqueViolation) || !details.ExternalReference.Valid {
+		return false
+	}
+
+	transfer, err := server.store.GetTransferByExternalReference(ctx, db.GetTransferByExternalReferenceParams{
+		FromAccountID:     fromAccountID,
+		ExternalReference: details.ExternalReference,
+	})
+	if err != nil {
+		ctx.Error(err)
+		return true
+	}
+	ctx.Error(&apiError{
+		status:     http.StatusConflict,
+		code:       "duplicate_reference",
+		detail:     "external reference already used",
+		extensions: gin.H{"transfer": transfer},
+	})
+	return true
 }
 
 func (server *Server) createTransfer(ctx *gin.Context) {
 	var req transferMoneyRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
-		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	details, valid := transferDetails(ctx, req)
+	if !valid {
+		return
+	}
+
+	if req.FxQuoteID.Valid {
+		if req.Mode == transferModeAsync {
+			ctx.Error(statusMessage(http.StatusBadRequest, "cross-currency transfers can't be submitted asynchronously"))
+			return
+		}
+		server.createFxTransfer(ctx, req, details)
 		return
 	}
 
@@ -31,28 +125,50 @@ func (server *Server) createTransfer(ctx *gin.Context) {
 		return
 	}
 
-	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
-	//check if the from_account is valid
-	if fromAccount.Owner != authPayload.Username {
-		ctx.JSON(http.StatusForbidden,errorMessage("from account doesn't belong to you"))
+	//check the caller can move money out of the from_account
+	organisation,valid := server.authorizeFromAccount(ctx,fromAccount)
+	if !valid{
 		return
 	}
 
 	//check if the to_account is valid
-	_,valid = server.validAccount(ctx,req.ToAccountID,req.Currency)
+	toAccount,valid := server.recipientAccount(ctx,req.ToAccountID,req.To,req.Currency)
 	if !valid{
 		return
 	}
+	if toAccount.ID == fromAccount.ID {
+		ctx.Error(statusMessage(http.StatusBadRequest, "can't transfer to the same account"))
+		return
+	}
 
 	arg := db.TransferTxParams{
-		FromAccountID: req.FromAccountID,
-		ToAccountID:   req.ToAccountID,
-		Amount:        req.Amount,
+		FromAccountID:   req.FromAccountID,
+		ToAccountID:     toAccount.ID,
+		Amount:          req.Amount,
+		TransferDetails: details,
+	}
+
+	approval, err := server.needsApproval(ctx, organisation, req.Amount, fromAccount.Currency)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	if approval {
+		server.createTransferApproval(ctx, *organisation, arg)
+		return
+	}
+
+	if req.Mode == transferModeAsync {
+		server.submitTransfer(ctx, arg)
+		return
 	}
 
 	result, err := server.store.TransferTx(ctx,arg)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		if limitExceeded(ctx, err) || server.duplicateReference(ctx, req.FromAccountID, details, err) {
+			return
+		}
+		ctx.Error(err)
 		return
 	}
 	ctx.JSON(http.StatusOK, result)
@@ -62,18 +178,87 @@ func (server *Server) createTransfer(ctx *gin.Context) {
 func (server *Server) validAccount(ctx *gin.Context, accounID uuid.UUID, currency string) (db.Account,bool) {
 	account, err := server.store.GetAccount(ctx, accounID)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			ctx.JSON(http.StatusNotFound, errorResponse(err))
+		if errors.Is(err, db.ErrNotFound) {
+			ctx.Error(statusError(http.StatusNotFound, err))
 			return account,false
 		}
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		ctx.Error(err)
 		return account,false
 	}
 
 	if account.Currency != currency {
 		err := fmt.Errorf("account [%v] currency mismatch: %s vs %s", account.ID, account.Currency, currency)
-		ctx.JSON(http.StatusBadRequest,errorResponse(err))
+		ctx.Error(statusError(http.StatusBadRequest, err))
 		return account,false
 	}
 	return account,true
 }
+
+type listAccountTransfersRequest struct {
+	// Query matches the description or external reference
+	Query             string `form:"q" binding:"max=255"`
+	ExternalReference string `form:"reference" binding:"max=64"`
+	// Metadata is a JSON object the transfers' metadata must contain
+	Metadata string `form:"metadata" binding:"max=4096"`
+	PageNum  int32  `form:"page_num" binding:"min=1"`
+	PageSize int32  `form:"page_size" binding:"min=5,max=10"`
+}
+
+// listAccountTransfers shows the owner the transfers in and out of the
+// account, newest first
+func (server *Server) listAccountTransfers(ctx *gin.Context) {
+	var accountId AccountId
+	if err := ctx.ShouldBindUri(&accountId); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	var req listAccountTransfersRequest
+	req.PageNum = 1
+	req.PageSize = 5
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	metadata := json.RawMessage("{}")
+	if req.Metadata != "" {
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal([]byte(req.Metadata), &fields); err != nil || fields == nil {
+			ctx.Error(statusMessage(http.StatusBadRequest, "metadata must be a JSON object"))
+			return
+		}
+		metadata = json.RawMessage(req.Metadata)
+	}
+
+	account, err := server.store.GetAccount(ctx, uuid.MustParse(accountId.ID))
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			ctx.Error(statusError(http.StatusNotFound, err))
+			return
+		}
+		ctx.Error(err)
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	if account.Owner != authPayload.Username {
+		ctx.Error(statusMessage(http.StatusForbidden, "account doesn't belong to you"))
+		return
+	}
+
+	transfers, err := server.store.SearchAccountTransfers(ctx, db.SearchAccountTransfersParams{
+		AccountID:         account.ID,
+		Search:            pgtype.Text{String: req.Query, Valid: req.Query != ""},
+		ExternalReference: pgtype.Text{String: req.ExternalReference, Valid: req.ExternalReference != ""},
+		Metadata:          metadata,
+		Limit:             req.PageSize,
+		Offset:            (req.PageNum - 1) * req.PageSize,
+	})
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, transfers)
+}
@@ -0,0 +1,170 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+
+	db "github.com/Glenn444/banking-app/internal/database"
+	"github.com/Glenn444/banking-app/internal/token"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// createTransferApproval records a transfer out of an organisation account
+// that waits for the organisation's approvers, answering 202 as it isn't
+// made yet
+func (server *Server) createTransferApproval(ctx *gin.Context, organisation db.Organisation, arg db.TransferTxParams) {
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	result, err := server.store.CreateTransferApprovalTx(ctx, db.CreateTransferApprovalTxParams{
+		OrganisationID:   organisation.ID,
+		InitiatedBy:      authPayload.Username,
+		TransferTxParams: arg,
+	})
+	if err != nil {
+		if limitExceeded(ctx, err) {
+			return
+		}
+		server.transferApprovalError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusAccepted, result)
+}
+
+type listTransferApprovalsRequest struct {
+	Status   string `form:"status" binding:"omitempty,oneof=pending_approval executed rejected cancelled expired"`
+	PageNum  int32  `form:"page_num" binding:"min=1"`
+	PageSize int32  `form:"page_size" binding:"min=5,max=10"`
+}
+
+// listTransferApprovals shows an organisation's transfer approvals to its members, newest first
+func (server *Server) listTransferApprovals(ctx *gin.Context) {
+	var req listTransferApprovalsRequest
+	req.PageNum = 1
+	req.PageSize = 5
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	organisation, ok := server.memberOrganisation(ctx)
+	if !ok {
+		return
+	}
+
+	approvals, err := server.store.ListTransferApprovals(ctx, db.ListTransferApprovalsParams{
+		OrganisationID: organisation.ID,
+		Status:         pgtype.Text{String: req.Status, Valid: req.Status != ""},
+		Limit:          req.PageSize,
+		Offset:         (req.PageNum - 1) * req.PageSize,
+	})
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, approvals)
+}
+
+type transferApprovalUri struct {
+	ID string `uri:"id" binding:"required,uuid"`
+}
+
+type transferApprovalResponse struct {
+	Approval db.TransferApproval   `json:"approval"`
+	AuditLog []db.ApprovalAuditLog `json:"audit_log"`
+}
+
+// getTransferApproval shows a transfer approval and every decision taken on
+// it to the organisation's members
+func (server *Server) getTransferApproval(ctx *gin.Context) {
+	var uri transferApprovalUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	approval, err := server.store.GetTransferApproval(ctx, uuid.MustParse(uri.ID))
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			ctx.Error(statusMessage(http.StatusNotFound, "transfer approval not found"))
+			return
+		}
+		ctx.Error(err)
+		return
+	}
+	if !server.isOrganisationMember(ctx, approval.OrganisationID) {
+		return
+	}
+
+	auditLog, err := server.store.ListApprovalAuditLog(ctx, approval.ID)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, transferApprovalResponse{Approval: approval, AuditLog: auditLog})
+}
+
+type decideTransferApprovalRequest struct {
+	Reason string `json:"reason" binding:"max=255"`
+}
+
+// approveTransfer signs a transfer off, the last approval it needs makes it
+func (server *Server) approveTransfer(ctx *gin.Context) {
+	server.decideTransferApproval(ctx, server.store.ApproveTransferTx)
+}
+
+// rejectTransfer turns a transfer down and gives its reserved funds back
+func (server *Server) rejectTransfer(ctx *gin.Context) {
+	server.decideTransferApproval(ctx, server.store.RejectTransferTx)
+}
+
+// cancelTransferApproval lets the initiator withdraw a transfer still waiting for approval
+func (server *Server) cancelTransferApproval(ctx *gin.Context) {
+	server.decideTransferApproval(ctx, server.store.CancelTransferApprovalTx)
+}
+
+type decideTransferApprovalFunc func(ctx context.Context, arg db.DecideTransferApprovalTxParams) (db.TransferApprovalTxResult, error)
+
+func (server *Server) decideTransferApproval(ctx *gin.Context, decide decideTransferApprovalFunc) {
+	var uri transferApprovalUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	// the reason is optional, so is the body
+	var req decideTransferApprovalRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	result, err := decide(ctx, db.DecideTransferApprovalTxParams{
+		ApprovalID: uuid.MustParse(uri.ID),
+		Username:   authPayload.Username,
+		Reason:     req.Reason,
+	})
+	if err != nil {
+		if limitExceeded(ctx, err) {
+			return
+		}
+		server.transferApprovalError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
+
+func (server *Server) transferApprovalError(ctx *gin.Context, err error) {
+	if errors.Is(err, db.ErrNotFound) {
+		ctx.Error(statusMessage(http.StatusNotFound, "transfer approval not found"))
+		return
+	}
+	ctx.Error(err)
+}
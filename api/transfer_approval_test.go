@@ -0,0 +1,284 @@
+package api
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	db "github.com/Glenn444/banking-app/internal/database"
+	mock_database "github.com/Glenn444/banking-app/internal/database/mock"
+	"github.com/Glenn444/banking-app/util"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateOrganisationTransferApi(t *testing.T) {
+	initiator := randomUser()
+	organisation := db.Organisation{
+		ID:                        uuid.New(),
+		Name:                      "Acme Ltd",
+		ApprovalThreshold:         decimal.NewFromInt(500),
+		ApprovalThresholdCurrency: "USD",
+		RequiredApprovals:         2,
+	}
+	fromAccount := randomAccountWithCurrency("USD")
+	fromAccount.OrganisationID = uuid.NullUUID{UUID: organisation.ID, Valid: true}
+	toAccount := randomAccountWithCurrency("USD")
+	eurFromAccount := randomAccountWithCurrency("EUR")
+	eurFromAccount.OrganisationID = uuid.NullUUID{UUID: organisation.ID, Valid: true}
+	eurToAccount := randomAccountWithCurrency("EUR")
+
+	expectOrganisationAccount := func(store *mock_database.MockStore, fromAccount db.Account, isInitiator bool) {
+		store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+		store.EXPECT().GetOrganisation(gomock.Any(), gomock.Eq(organisation.ID)).Times(1).Return(organisation, nil)
+		store.EXPECT().
+			HasOrganisationRole(gomock.Any(), gomock.Eq(db.HasOrganisationRoleParams{
+				OrganisationID: organisation.ID,
+				Username:       initiator.Username,
+				Role:           db.OrganisationRoleInitiator,
+			})).
+			Times(1).
+			Return(isInitiator, nil)
+	}
+
+	testCases := []struct {
+		name          string
+		fromAccount   db.Account
+		toAccount     db.Account
+		amount        decimal.Decimal
+		buildStubs    func(store *mock_database.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:        "AboveThreshold",
+			fromAccount: fromAccount,
+			toAccount:   toAccount,
+			amount:      decimal.NewFromInt(900),
+			buildStubs: func(store *mock_database.MockStore) {
+				expectOrganisationAccount(store, fromAccount, true)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(toAccount.ID)).Times(1).Return(toAccount, nil)
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().
+					CreateTransferApprovalTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ any, arg db.CreateTransferApprovalTxParams) (db.TransferApprovalTxResult, error) {
+						require.Equal(t, organisation.ID, arg.OrganisationID)
+						require.Equal(t, initiator.Username, arg.InitiatedBy)
+						require.Equal(t, toAccount.ID, arg.ToAccountID)
+						require.True(t, decimal.NewFromInt(900).Equal(arg.Amount))
+						return db.TransferApprovalTxResult{
+							Approval: db.TransferApproval{Status: db.ApprovalStatusPending},
+						}, nil
+					})
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusAccepted, recorder.Code)
+			},
+		},
+		{
+			name:        "AtThreshold",
+			fromAccount: fromAccount,
+			toAccount:   toAccount,
+			amount:      decimal.NewFromInt(500),
+			buildStubs: func(store *mock_database.MockStore) {
+				expectOrganisationAccount(store, fromAccount, true)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(toAccount.ID)).Times(1).Return(toAccount, nil)
+				store.EXPECT().CreateTransferApprovalTx(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(1).Return(db.TransferTxResult{}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:        "NotInitiator",
+			fromAccount: fromAccount,
+			toAccount:   toAccount,
+			amount:      decimal.NewFromInt(100),
+			buildStubs: func(store *mock_database.MockStore) {
+				expectOrganisationAccount(store, fromAccount, false)
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateTransferApprovalTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			// 480 EUR is over 500 USD at the default rates, though 480 is under 500
+			name:        "OtherCurrencyAboveThreshold",
+			fromAccount: eurFromAccount,
+			toAccount:   eurToAccount,
+			amount:      decimal.NewFromInt(480),
+			buildStubs: func(store *mock_database.MockStore) {
+				expectOrganisationAccount(store, eurFromAccount, true)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(eurToAccount.ID)).Times(1).Return(eurToAccount, nil)
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().
+					CreateTransferApprovalTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.TransferApprovalTxResult{
+						Approval: db.TransferApproval{Status: db.ApprovalStatusPending},
+					}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusAccepted, recorder.Code)
+			},
+		},
+		{
+			// 450 EUR is under 500 USD at the default rates
+			name:        "OtherCurrencyBelowThreshold",
+			fromAccount: eurFromAccount,
+			toAccount:   eurToAccount,
+			amount:      decimal.NewFromInt(450),
+			buildStubs: func(store *mock_database.MockStore) {
+				expectOrganisationAccount(store, eurFromAccount, true)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(eurToAccount.ID)).Times(1).Return(eurToAccount, nil)
+				store.EXPECT().CreateTransferApprovalTx(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(1).Return(db.TransferTxResult{}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_database.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			body := jsonBody(t, gin.H{
+				"from_account_id": tc.fromAccount.ID,
+				"to_account_id":   tc.toAccount.ID,
+				"amount":          tc.amount,
+				"currency":        tc.fromAccount.Currency,
+			})
+			request, err := http.NewRequest(http.MethodPost, "/transfers", bytes.NewReader(body))
+			require.NoError(t, err)
+			request.Header.Set("Content-Type", "application/json")
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, initiator.Username, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestApproveTransferApi(t *testing.T) {
+	approver := util.RandomOwner()
+	approvalID := uuid.New()
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mock_database.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().
+					ApproveTransferTx(gomock.Any(), gomock.Eq(db.DecideTransferApprovalTxParams{
+						ApprovalID: approvalID,
+						Username:   approver,
+						Reason:     "invoice checked",
+					})).
+					Times(1).
+					Return(db.TransferApprovalTxResult{
+						Approval: db.TransferApproval{ID: approvalID, Status: db.ApprovalStatusExecuted},
+						Transfer: &db.TransferTxResult{},
+					}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "NotApprover",
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().
+					ApproveTransferTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.TransferApprovalTxResult{}, fmt.Errorf("%w: %s", db.ErrNotApprover, approver))
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "Initiator",
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().
+					ApproveTransferTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.TransferApprovalTxResult{}, db.ErrApproverIsInitiator)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "AlreadyApproved",
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().
+					ApproveTransferTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.TransferApprovalTxResult{}, fmt.Errorf("%w: %s", db.ErrAlreadyApproved, approver))
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name: "Expired",
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().
+					ApproveTransferTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.TransferApprovalTxResult{}, db.ErrApprovalExpired)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_database.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/approvals/%s/approve", approvalID)
+			body := jsonBody(t, gin.H{"reason": "invoice checked"})
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+			require.NoError(t, err)
+			request.Header.Set("Content-Type", "application/json")
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, approver, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
@@ -0,0 +1,357 @@
+package api
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	db "github.com/Glenn444/banking-app/internal/database"
+	"github.com/Glenn444/banking-app/internal/token"
+	"github.com/Glenn444/banking-app/util"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// maxBatchItems caps the number of transfers in one batch, all-or-nothing
+// batches are capped lower by db.MaxAllOrNothingBatchItems
+const maxBatchItems = 10000
+
+type transferBatchItemRequest struct {
+	ToAccountID uuid.UUID       `json:"to_account_id"`
+	Amount      decimal.Decimal `json:"amount"`
+}
+
+type createTransferBatchRequest struct {
+	FromAccountID uuid.UUID                  `json:"from_account_id" binding:"required"`
+	Currency      string                     `json:"currency" binding:"required,currency"`
+	Mode          string                     `json:"mode" binding:"required,oneof=all_or_nothing best_effort"`
+	Items         []transferBatchItemRequest `json:"items" binding:"required,min=1"`
+}
+
+// createTransferBatchCSVQuery carries the batch header when the items are sent as CSV
+type createTransferBatchCSVQuery struct {
+	FromAccountID string `form:"from_account_id" binding:"required,uuid"`
+	Currency      string `form:"currency" binding:"required,currency"`
+	Mode          string `form:"mode" binding:"required,oneof=all_or_nothing best_effort"`
+}
+
+type batchItemError struct {
+	Line  int    `json:"line"`
+	Error string `json:"error"`
+}
+
+// invalidBatchItems answers with every invalid line of a batch
+func invalidBatchItems(itemErrors []batchItemError) error {
+	return &apiError{
+		status:     http.StatusBadRequest,
+		code:       "invalid_batch_items",
+		detail:     "batch has invalid items",
+		extensions: gin.H{"items": itemErrors},
+	}
+}
+
+// createTransferBatch accepts a batch of transfers from one account as JSON,
+// or as a text/csv body with to_account_id and amount columns and the batch
+// header in the query string. Every item is validated before the batch is
+// stored, the transfers run in the background and the batch is polled for
+// progress.
+func (server *Server) createTransferBatch(ctx *gin.Context) {
+	var req createTransferBatchRequest
+	if ctx.ContentType() == "text/csv" {
+		var query createTransferBatchCSVQuery
+		if err := ctx.ShouldBindQuery(&query); err != nil {
+			ctx.Error(statusError(http.StatusBadRequest, err))
+			return
+		}
+
+		items, itemErrors, err := parseBatchCSV(ctx.Request.Body)
+		if err != nil {
+			ctx.Error(statusError(http.StatusBadRequest, err))
+			return
+		}
+		if len(itemErrors) > 0 {
+			ctx.Error(invalidBatchItems(itemErrors))
+			return
+		}
+
+		req = createTransferBatchRequest{
+			FromAccountID: uuid.MustParse(query.FromAccountID),
+			Currency:      query.Currency,
+			Mode:          query.Mode,
+			Items:         items,
+		}
+	} else if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	if len(req.Items) == 0 {
+		ctx.Error(statusMessage(http.StatusBadRequest, "batch has no items"))
+		return
+	}
+	if len(req.Items) > maxBatchItems {
+		ctx.Error(statusMessage(http.StatusBadRequest, fmt.Sprintf("batch has more than %d items", maxBatchItems)))
+		return
+	}
+	if req.Mode == db.BatchModeAllOrNothing && len(req.Items) > db.MaxAllOrNothingBatchItems {
+		ctx.Error(statusMessage(http.StatusBadRequest, fmt.Sprintf("an all_or_nothing batch has at most %d items, send larger batches as best_effort", db.MaxAllOrNothingBatchItems)))
+		return
+	}
+
+	fromAccount, valid := server.validAccount(ctx, req.FromAccountID, req.Currency)
+	if !valid {
+		return
+	}
+
+	// the batch moves its total out of the account, approval thresholds
+	// apply to it as a whole so it can't be split under them
+	total := decimal.Zero
+	for _, item := range req.Items {
+		total = total.Add(item.Amount)
+	}
+	if !server.authorizeOutflow(ctx, fromAccount, total) {
+		return
+	}
+
+	itemErrors, err := server.validateBatchItems(ctx, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	if len(itemErrors) > 0 {
+		ctx.Error(invalidBatchItems(itemErrors))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	arg := db.CreateTransferBatchTxParams{
+		Owner:         authPayload.Username,
+		FromAccountID: req.FromAccountID,
+		Currency:      req.Currency,
+		Mode:          req.Mode,
+	}
+	for _, item := range req.Items {
+		arg.Items = append(arg.Items, db.TransferBatchItemParams(item))
+	}
+
+	// an all-or-nothing batch that can't be covered now is bound to fail
+	if req.Mode == db.BatchModeAllOrNothing && fromAccount.SpendableBalance().LessThan(total) {
+		err := fmt.Errorf("%w: account %v can spend %v, batch total %v",
+			db.ErrInsufficientFunds, fromAccount.ID, fromAccount.SpendableBalance(), total)
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	batch, err := server.store.CreateTransferBatchTx(ctx, arg)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusAccepted, batch)
+}
+
+// validateBatchItems checks every item's amount and recipient account,
+// it returns one error per invalid line, lines are numbered from 1
+func (server *Server) validateBatchItems(ctx *gin.Context, req createTransferBatchRequest) ([]batchItemError, error) {
+	ids := make([]uuid.UUID, 0, len(req.Items))
+	for _, item := range req.Items {
+		ids = append(ids, item.ToAccountID)
+	}
+	accounts, err := server.store.ListAccountsByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[uuid.UUID]db.Account, len(accounts))
+	for _, account := range accounts {
+		byID[account.ID] = account
+	}
+
+	itemErrors := []batchItemError{}
+	for i, item := range req.Items {
+		line := i + 1
+		account, found := byID[item.ToAccountID]
+
+		switch {
+		case !item.Amount.IsPositive():
+			itemErrors = append(itemErrors, batchItemError{line, "amount must be positive"})
+		case !util.IsValidAmount(item.Amount, req.Currency):
+			itemErrors = append(itemErrors, batchItemError{line, fmt.Sprintf("%v: %v %s", db.ErrInvalidAmountScale, item.Amount, req.Currency)})
+		case item.ToAccountID == req.FromAccountID:
+			itemErrors = append(itemErrors, batchItemError{line, "cannot transfer to the source account"})
+		case !found:
+			itemErrors = append(itemErrors, batchItemError{line, fmt.Sprintf("account %v not found", item.ToAccountID)})
+		case account.Currency != req.Currency:
+			itemErrors = append(itemErrors, batchItemError{line, fmt.Sprintf("account [%v] currency mismatch: %s vs %s", account.ID, account.Currency, req.Currency)})
+		}
+	}
+	return itemErrors, nil
+}
+
+// parseBatchCSV reads items from a CSV with a header row naming the
+// to_account_id and amount columns, other columns are ignored
+func parseBatchCSV(body io.Reader) ([]transferBatchItemRequest, []batchItemError, error) {
+	reader := csv.NewReader(body)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil, errors.New("csv is empty")
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	toColumn, amountColumn := -1, -1
+	for i, name := range header {
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "to_account_id":
+			toColumn = i
+		case "amount":
+			amountColumn = i
+		}
+	}
+	if toColumn < 0 || amountColumn < 0 {
+		return nil, nil, errors.New("csv header must have to_account_id and amount columns")
+	}
+
+	items := []transferBatchItemRequest{}
+	itemErrors := []batchItemError{}
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		if line > maxBatchItems {
+			return nil, nil, fmt.Errorf("batch has more than %d items", maxBatchItems)
+		}
+
+		if len(record) <= toColumn || len(record) <= amountColumn {
+			itemErrors = append(itemErrors, batchItemError{line, "missing columns"})
+			continue
+		}
+		toAccountID, err := uuid.Parse(strings.TrimSpace(record[toColumn]))
+		if err != nil {
+			itemErrors = append(itemErrors, batchItemError{line, "invalid to_account_id"})
+			continue
+		}
+		amount, err := decimal.NewFromString(strings.TrimSpace(record[amountColumn]))
+		if err != nil {
+			itemErrors = append(itemErrors, batchItemError{line, "invalid amount"})
+			continue
+		}
+		items = append(items, transferBatchItemRequest{ToAccountID: toAccountID, Amount: amount})
+	}
+	return items, itemErrors, nil
+}
+
+type listTransferBatchesRequest struct {
+	PageNum  int32 `form:"page_num" binding:"min=1"`
+	PageSize int32 `form:"page_size" binding:"min=5,max=10"`
+}
+
+func (server *Server) listTransferBatches(ctx *gin.Context) {
+	var req listTransferBatchesRequest
+	req.PageNum = 1
+	req.PageSize = 5
+
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	batches, err := server.store.ListTransferBatches(ctx, db.ListTransferBatchesParams{
+		Owner:  authPayload.Username,
+		Limit:  req.PageSize,
+		Offset: (req.PageNum - 1) * req.PageSize,
+	})
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, batches)
+}
+
+func (server *Server) getTransferBatch(ctx *gin.Context) {
+	batch, ok := server.ownedTransferBatch(ctx)
+	if !ok {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, batch)
+}
+
+type listTransferBatchItemsRequest struct {
+	PageNum  int32 `form:"page_num" binding:"min=1"`
+	PageSize int32 `form:"page_size" binding:"min=10,max=500"`
+}
+
+// listTransferBatchItems returns the outcome of each item, in line order
+func (server *Server) listTransferBatchItems(ctx *gin.Context) {
+	var req listTransferBatchItemsRequest
+	req.PageNum = 1
+	req.PageSize = 100
+
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	batch, ok := server.ownedTransferBatch(ctx)
+	if !ok {
+		return
+	}
+
+	items, err := server.store.ListTransferBatchItems(ctx, db.ListTransferBatchItemsParams{
+		BatchID: batch.ID,
+		Limit:   req.PageSize,
+		Offset:  (req.PageNum - 1) * req.PageSize,
+	})
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, items)
+}
+
+type transferBatchUri struct {
+	ID string `uri:"id" binding:"required,uuid"`
+}
+
+// ownedTransferBatch loads the batch in the uri and checks it belongs to
+// the caller, it writes the error response when it doesn't
+func (server *Server) ownedTransferBatch(ctx *gin.Context) (db.TransferBatch, bool) {
+	var uri transferBatchUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return db.TransferBatch{}, false
+	}
+
+	batch, err := server.store.GetTransferBatch(ctx, uuid.MustParse(uri.ID))
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			ctx.Error(statusMessage(http.StatusNotFound, "transfer batch not found"))
+			return batch, false
+		}
+		ctx.Error(err)
+		return batch, false
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	if batch.Owner != authPayload.Username {
+		ctx.Error(statusMessage(http.StatusNotFound, "transfer batch not found"))
+		return db.TransferBatch{}, false
+	}
+	return batch, true
+}
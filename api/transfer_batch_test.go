@@ -0,0 +1,386 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	db "github.com/Glenn444/banking-app/internal/database"
+	mock_database "github.com/Glenn444/banking-app/internal/database/mock"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateTransferBatchApi(t *testing.T) {
+	user := randomUser()
+	fromAccount := randomAccountWithCurrency("USD")
+	fromAccount.Owner = user.Username
+	toAccount1 := randomAccountWithCurrency("USD")
+	toAccount2 := randomAccountWithCurrency("USD")
+	eurAccount := randomAccountWithCurrency("EUR")
+
+	batchURL := func(mode string) string {
+		return fmt.Sprintf("/transfer-batches?from_account_id=%s&currency=USD&mode=%s", fromAccount.ID, mode)
+	}
+
+	testCases := []struct {
+		name          string
+		url           string
+		contentType   string
+		body          func(t *testing.T) []byte
+		buildStubs    func(store *mock_database.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:        "JSON",
+			url:         "/transfer-batches",
+			contentType: "application/json",
+			body: func(t *testing.T) []byte {
+				return jsonBody(t, gin.H{
+					"from_account_id": fromAccount.ID,
+					"currency":        "USD",
+					"mode":            db.BatchModeBestEffort,
+					"items": []gin.H{
+						{"to_account_id": toAccount1.ID, "amount": "10.50"},
+						{"to_account_id": toAccount2.ID, "amount": "20"},
+					},
+				})
+			},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+				store.EXPECT().
+					ListAccountsByIDs(gomock.Any(), gomock.Eq([]uuid.UUID{toAccount1.ID, toAccount2.ID})).
+					Times(1).
+					Return([]db.Account{toAccount1, toAccount2}, nil)
+				store.EXPECT().
+					CreateTransferBatchTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ any, arg db.CreateTransferBatchTxParams) (db.TransferBatch, error) {
+						require.Equal(t, user.Username, arg.Owner)
+						require.Equal(t, db.BatchModeBestEffort, arg.Mode)
+						require.Len(t, arg.Items, 2)
+						require.Equal(t, toAccount1.ID, arg.Items[0].ToAccountID)
+						require.True(t, decimal.RequireFromString("10.50").Equal(arg.Items[0].Amount))
+						return db.TransferBatch{ID: uuid.New(), Status: db.BatchStatusPending}, nil
+					})
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusAccepted, recorder.Code)
+			},
+		},
+		{
+			name:        "CSV",
+			url:         batchURL(db.BatchModeAllOrNothing),
+			contentType: "text/csv",
+			body: func(t *testing.T) []byte {
+				return []byte(fmt.Sprintf("reference,amount,to_account_id\nMarch,10.50,%s\nMarch,20,%s\n", toAccount1.ID, toAccount2.ID))
+			},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+				store.EXPECT().
+					ListAccountsByIDs(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.Account{toAccount1, toAccount2}, nil)
+				store.EXPECT().
+					CreateTransferBatchTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ any, arg db.CreateTransferBatchTxParams) (db.TransferBatch, error) {
+						require.Equal(t, fromAccount.ID, arg.FromAccountID)
+						require.Equal(t, db.BatchModeAllOrNothing, arg.Mode)
+						require.Len(t, arg.Items, 2)
+						require.Equal(t, toAccount2.ID, arg.Items[1].ToAccountID)
+						return db.TransferBatch{ID: uuid.New()}, nil
+					})
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusAccepted, recorder.Code)
+			},
+		},
+		{
+			name:        "CSVInvalidLines",
+			url:         batchURL(db.BatchModeBestEffort),
+			contentType: "text/csv",
+			body: func(t *testing.T) []byte {
+				return []byte(fmt.Sprintf("to_account_id,amount\nnot-a-uuid,10\n%s,ten\n%s,5\n", toAccount1.ID, toAccount2.ID))
+			},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().CreateTransferBatchTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				lines := batchErrorLines(t, recorder)
+				require.Equal(t, []int{1, 2}, lines)
+			},
+		},
+		{
+			name:        "CSVMissingHeader",
+			url:         batchURL(db.BatchModeBestEffort),
+			contentType: "text/csv",
+			body: func(t *testing.T) []byte {
+				return []byte(fmt.Sprintf("%s,10\n", toAccount1.ID))
+			},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().CreateTransferBatchTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:        "InvalidItems",
+			url:         "/transfer-batches",
+			contentType: "application/json",
+			body: func(t *testing.T) []byte {
+				return jsonBody(t, gin.H{
+					"from_account_id": fromAccount.ID,
+					"currency":        "USD",
+					"mode":            db.BatchModeBestEffort,
+					"items": []gin.H{
+						{"to_account_id": toAccount1.ID, "amount": "10"},
+						{"to_account_id": toAccount1.ID, "amount": "0.001"},
+						{"to_account_id": eurAccount.ID, "amount": "10"},
+						{"to_account_id": uuid.New(), "amount": "10"},
+						{"to_account_id": fromAccount.ID, "amount": "10"},
+						{"to_account_id": toAccount1.ID, "amount": "-1"},
+					},
+				})
+			},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+				store.EXPECT().
+					ListAccountsByIDs(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.Account{toAccount1, eurAccount, fromAccount}, nil)
+				store.EXPECT().CreateTransferBatchTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				require.Equal(t, []int{2, 3, 4, 5, 6}, batchErrorLines(t, recorder))
+			},
+		},
+		{
+			name:        "AllOrNothingExceedsBalance",
+			url:         "/transfer-batches",
+			contentType: "application/json",
+			body: func(t *testing.T) []byte {
+				return jsonBody(t, gin.H{
+					"from_account_id": fromAccount.ID,
+					"currency":        "USD",
+					"mode":            db.BatchModeAllOrNothing,
+					"items": []gin.H{
+						{"to_account_id": toAccount1.ID, "amount": "600"},
+						{"to_account_id": toAccount2.ID, "amount": "600"},
+					},
+				})
+			},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+				store.EXPECT().
+					ListAccountsByIDs(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.Account{toAccount1, toAccount2}, nil)
+				store.EXPECT().CreateTransferBatchTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:        "AllOrNothingTooManyItems",
+			url:         "/transfer-batches",
+			contentType: "application/json",
+			body: func(t *testing.T) []byte {
+				items := make([]gin.H, db.MaxAllOrNothingBatchItems+1)
+				for i := range items {
+					items[i] = gin.H{"to_account_id": toAccount1.ID, "amount": "1"}
+				}
+				return jsonBody(t, gin.H{
+					"from_account_id": fromAccount.ID,
+					"currency":        "USD",
+					"mode":            db.BatchModeAllOrNothing,
+					"items":           items,
+				})
+			},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateTransferBatchTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:        "FromAccountNotOwned",
+			url:         "/transfer-batches",
+			contentType: "application/json",
+			body: func(t *testing.T) []byte {
+				return jsonBody(t, gin.H{
+					"from_account_id": toAccount1.ID,
+					"currency":        "USD",
+					"mode":            db.BatchModeBestEffort,
+					"items":           []gin.H{{"to_account_id": toAccount2.ID, "amount": "10"}},
+				})
+			},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(toAccount1.ID)).Times(1).Return(toAccount1, nil)
+				store.EXPECT().CreateTransferBatchTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:        "InvalidMode",
+			url:         "/transfer-batches",
+			contentType: "application/json",
+			body: func(t *testing.T) []byte {
+				return jsonBody(t, gin.H{
+					"from_account_id": fromAccount.ID,
+					"currency":        "USD",
+					"mode":            "some",
+					"items":           []gin.H{{"to_account_id": toAccount1.ID, "amount": "10"}},
+				})
+			},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_database.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodPost, tc.url, bytes.NewReader(tc.body(t)))
+			require.NoError(t, err)
+			request.Header.Set("Content-Type", tc.contentType)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestGetTransferBatchApi(t *testing.T) {
+	user := randomUser()
+	batch := db.TransferBatch{
+		ID:     uuid.New(),
+		Owner:  user.Username,
+		Status: db.BatchStatusProcessing,
+	}
+
+	testCases := []struct {
+		name          string
+		url           string
+		username      string
+		buildStubs    func(store *mock_database.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:     "OK",
+			url:      "/transfer-batches/" + batch.ID.String(),
+			username: user.Username,
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetTransferBatch(gomock.Any(), gomock.Eq(batch.ID)).Times(1).Return(batch, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				var got db.TransferBatch
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &got))
+				require.Equal(t, batch.Status, got.Status)
+			},
+		},
+		{
+			name:     "Items",
+			url:      "/transfer-batches/" + batch.ID.String() + "/items?page_size=50",
+			username: user.Username,
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetTransferBatch(gomock.Any(), gomock.Eq(batch.ID)).Times(1).Return(batch, nil)
+				store.EXPECT().
+					ListTransferBatchItems(gomock.Any(), gomock.Eq(db.ListTransferBatchItemsParams{
+						BatchID: batch.ID,
+						Limit:   50,
+						Offset:  0,
+					})).
+					Times(1).
+					Return([]db.TransferBatchItem{{Line: 1, Status: db.BatchItemStatusSucceeded}}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:     "OtherUser",
+			url:      "/transfer-batches/" + batch.ID.String(),
+			username: "other_user",
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetTransferBatch(gomock.Any(), gomock.Eq(batch.ID)).Times(1).Return(batch, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_database.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, tc.url, nil)
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, tc.username, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func jsonBody(t *testing.T, body gin.H) []byte {
+	data, err := json.Marshal(body)
+	require.NoError(t, err)
+	return data
+}
+
+func batchErrorLines(t *testing.T, recorder *httptest.ResponseRecorder) []int {
+	var resp struct {
+		Items []batchItemError `json:"items"`
+	}
+	require.NoError(t, json.NewDecoder(strings.NewReader(recorder.Body.String())).Decode(&resp))
+
+	lines := []int{}
+	for _, item := range resp.Items {
+		lines = append(lines, item.Line)
+	}
+	return lines
+}
@@ -0,0 +1,111 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	db "github.com/Glenn444/banking-app/internal/database"
+	"github.com/Glenn444/banking-app/internal/token"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// submitTransfer queues a transfer for the transfer worker and answers 202
+// with it pending
+func (server *Server) submitTransfer(ctx *gin.Context, arg db.TransferTxParams) {
+	transfer, err := server.store.SubmitTransferTx(ctx, arg)
+	if err != nil {
+		if server.duplicateReference(ctx, arg.FromAccountID, arg.TransferDetails, err) {
+			return
+		}
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusAccepted, transfer)
+}
+
+type transferUri struct {
+	ID string `uri:"id" binding:"required,uuid"`
+}
+
+// getTransfer shows a transfer and its status to the owners of either
+// account and to the members of the sending account's organisation
+func (server *Server) getTransfer(ctx *gin.Context) {
+	var uri transferUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	transfer, err := server.store.GetTransfer(ctx, uuid.MustParse(uri.ID))
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			ctx.Error(statusMessage(http.StatusNotFound, "transfer not found"))
+			return
+		}
+		ctx.Error(err)
+		return
+	}
+
+	visible, err := server.canSeeTransfer(ctx, transfer)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	if !visible {
+		ctx.Error(statusMessage(http.StatusNotFound, "transfer not found"))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, transfer)
+}
+
+func (server *Server) canSeeTransfer(ctx *gin.Context, transfer db.Transfer) (bool, error) {
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	fromAccount, err := server.store.GetAccount(ctx, transfer.FromAccountID)
+	if err != nil {
+		return false, err
+	}
+	if fromAccount.Owner == authPayload.Username {
+		return true, nil
+	}
+
+	toAccount, err := server.store.GetAccount(ctx, transfer.ToAccountID)
+	if err != nil {
+		return false, err
+	}
+	if toAccount.Owner == authPayload.Username {
+		return true, nil
+	}
+
+	if !fromAccount.OrganisationID.Valid {
+		return false, nil
+	}
+	return server.store.IsOrganisationMember(ctx, db.IsOrganisationMemberParams{
+		OrganisationID: fromAccount.OrganisationID.UUID,
+		Username:       authPayload.Username,
+	})
+}
+
+// reverseTransfer lets an admin move a completed transfer's money back
+func (server *Server) reverseTransfer(ctx *gin.Context) {
+	var uri transferUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+
+	result, err := server.store.ReverseTransferTx(ctx, uuid.MustParse(uri.ID))
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			ctx.Error(statusMessage(http.StatusNotFound, "transfer not found"))
+			return
+		}
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
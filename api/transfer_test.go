@@ -17,6 +17,8 @@ import (
 	"github.com/Glenn444/banking-app/util"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -142,7 +144,7 @@ func TestCreateTransferApi(t *testing.T) {
 				store.EXPECT().
 					GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).
 					Times(1).
-					Return(db.Account{}, sql.ErrNoRows)
+					Return(db.Account{}, db.ErrNotFound)
 
 				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(toAccount.ID)).Times(0)
 				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(0)
@@ -171,7 +173,7 @@ func TestCreateTransferApi(t *testing.T) {
 				store.EXPECT().
 					GetAccount(gomock.Any(), gomock.Eq(toAccount.ID)).
 					Times(1).
-					Return(db.Account{}, sql.ErrNoRows)
+					Return(db.Account{}, db.ErrNotFound)
 
 				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(0)
 			},
@@ -249,6 +251,87 @@ func TestCreateTransferApi(t *testing.T) {
 				require.Equal(t, http.StatusBadRequest, recorder.Code)
 			},
 		},
+		{
+			name: "ExcessPrecision",
+			body: gin.H{
+				"from_account_id": fromAccount.ID,
+				"to_account_id":   toAccount.ID,
+				"amount":          "10.005",
+				"currency":        "USD",
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "NonPositiveAmount",
+			body: gin.H{
+				"from_account_id": fromAccount.ID,
+				"to_account_id":   toAccount.ID,
+				"amount":          "0",
+				"currency":        "USD",
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "LimitExceeded",
+			body: gin.H{
+				"from_account_id": fromAccount.ID,
+				"to_account_id":   toAccount.ID,
+				"amount":          amount,
+				"currency":        "USD",
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(toAccount.ID)).Times(1).Return(toAccount, nil)
+
+				resetsAt := time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC)
+				store.EXPECT().
+					TransferTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.TransferTxResult{}, &db.LimitExceededError{
+						Scope:    db.LimitScopeAccount,
+						Limit:    db.LimitDailyAmount,
+						Max:      decimal.NewFromInt(150),
+						Used:     decimal.NewFromInt(100),
+						Currency: "USD",
+						ResetsAt: &resetsAt,
+					})
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+
+				var rsp struct {
+					Code  string                `json:"code"`
+					Limit db.LimitExceededError `json:"limit"`
+				}
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &rsp))
+				require.Equal(t, "limit_exceeded", rsp.Code)
+				require.Equal(t, db.LimitDailyAmount, rsp.Limit.Limit)
+				require.Equal(t, db.LimitScopeAccount, rsp.Limit.Scope)
+				require.NotNil(t, rsp.Limit.ResetsAt)
+				require.Equal(t, "2026-10-20T00:00:00Z", rsp.Limit.ResetsAt.Format(time.RFC3339))
+			},
+		},
 		{
 			name: "TransferTxInternalError",
 			body: gin.H{
@@ -315,10 +398,11 @@ func TestCreateTransferApi(t *testing.T) {
 // helper - to build a random account with a given currency
 func randomAccountWithCurrency(currency string) database.Account {
 	return database.Account{
-		ID:       uuid.New(),
-		Owner:    util.RandomOwner(),
-		Balance:  decimal.NewFromFloat(1000),
-		Currency: currency,
+		ID:               uuid.New(),
+		Owner:            util.RandomOwner(),
+		Balance:          decimal.NewFromFloat(1000),
+		AvailableBalance: decimal.NewFromFloat(1000),
+		Currency:         currency,
 	}
 }
 
@@ -335,7 +419,10 @@ func (e eqTransferParamsMatcher) Matches(x interface{}) bool {
 
     return arg.FromAccountID == e.arg.FromAccountID &&
         arg.ToAccountID == e.arg.ToAccountID &&
-        arg.Amount.Equal(e.arg.Amount) // ← use decimal's own Equal method
+        arg.Amount.Equal(e.arg.Amount) && // ← use decimal's own Equal method
+        arg.Description == e.arg.Description &&
+        arg.ExternalReference == e.arg.ExternalReference &&
+        bytes.Equal(arg.Metadata, e.arg.Metadata)
 }
 
 func (e eqTransferParamsMatcher) String() string {
@@ -344,4 +431,218 @@ func (e eqTransferParamsMatcher) String() string {
 
 func EqTransferParams(arg db.TransferTxParams) gomock.Matcher {
     return eqTransferParamsMatcher{arg}
-}
\ No newline at end of file
+}
+func TestCreateTransferDetailsApi(t *testing.T) {
+	user := randomUser()
+	fromAccount := randomAccountWithCurrency("USD")
+	fromAccount.Owner = user.Username
+	toAccount := randomAccountWithCurrency("USD")
+	amount := decimal.NewFromInt(25)
+	reference := pgtype.Text{String: "inv-2026-001", Valid: true}
+
+	expectAccounts := func(store *mock_database.MockStore) {
+		store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+		store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(toAccount.ID)).Times(1).Return(toAccount, nil)
+	}
+	body := func(extra gin.H) gin.H {
+		body := gin.H{
+			"from_account_id": fromAccount.ID,
+			"to_account_id":   toAccount.ID,
+			"amount":          amount,
+			"currency":        "USD",
+		}
+		for k, v := range extra {
+			body[k] = v
+		}
+		return body
+	}
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mock_database.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: body(gin.H{
+				"description":        "rent",
+				"external_reference": reference.String,
+				"metadata":           gin.H{"invoice": 1},
+			}),
+			buildStubs: func(store *mock_database.MockStore) {
+				expectAccounts(store)
+				store.EXPECT().
+					TransferTx(gomock.Any(), EqTransferParams(db.TransferTxParams{
+						FromAccountID: fromAccount.ID,
+						ToAccountID:   toAccount.ID,
+						Amount:        amount,
+						TransferDetails: db.TransferDetails{
+							Description:       "rent",
+							ExternalReference: reference,
+							Metadata:          json.RawMessage(`{"invoice":1}`),
+						},
+					})).
+					Times(1).
+					Return(db.TransferTxResult{}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "DuplicateReference",
+			body: body(gin.H{"external_reference": reference.String}),
+			buildStubs: func(store *mock_database.MockStore) {
+				expectAccounts(store)
+				store.EXPECT().
+					TransferTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.TransferTxResult{}, &pgconn.PgError{Code: "23505"})
+				store.EXPECT().
+					GetTransferByExternalReference(gomock.Any(), gomock.Eq(db.GetTransferByExternalReferenceParams{
+						FromAccountID:     fromAccount.ID,
+						ExternalReference: reference,
+					})).
+					Times(1).
+					Return(db.Transfer{ID: uuid.New(), ExternalReference: reference}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name: "MetadataNotObject",
+			body: body(gin.H{"metadata": []int{1, 2}}),
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "MetadataTooLarge",
+			body: body(gin.H{"metadata": gin.H{"note": util.RandomString(maxMetadataBytes)}}),
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "DescriptionTooLong",
+			body: body(gin.H{"description": util.RandomString(256)}),
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_database.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodPost, "/transfers", bytes.NewReader(jsonBody(t, tc.body)))
+			require.NoError(t, err)
+			request.Header.Set("Content-Type", "application/json")
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestListAccountTransfersApi(t *testing.T) {
+	user := randomUser()
+	account := randomAccountWithCurrency("USD")
+	account.Owner = user.Username
+
+	testCases := []struct {
+		name          string
+		query         string
+		username      string
+		buildStubs    func(store *mock_database.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:     "OK",
+			query:    `?q=rent&metadata={"invoice":1}`,
+			username: user.Username,
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().
+					SearchAccountTransfers(gomock.Any(), gomock.Eq(db.SearchAccountTransfersParams{
+						AccountID: account.ID,
+						Search:    pgtype.Text{String: "rent", Valid: true},
+						Metadata:  json.RawMessage(`{"invoice":1}`),
+						Limit:     5,
+					})).
+					Times(1).
+					Return([]db.Transfer{}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:     "NotOwner",
+			username: "other_user",
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().SearchAccountTransfers(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:     "BadMetadata",
+			query:    `?metadata=[1]`,
+			username: user.Username,
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().SearchAccountTransfers(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_database.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/accounts/%s/transfers%s", account.ID, tc.query)
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, tc.username, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
@@ -1,7 +1,7 @@
 package api
 
 import (
-	"database/sql"
+	"errors"
 	"net/http"
 	"time"
 
@@ -10,7 +10,6 @@ import (
 	"github.com/Glenn444/banking-app/internal/token"
 	"github.com/Glenn444/banking-app/util"
 	"github.com/gin-gonic/gin"
-	"github.com/lib/pq"
 )
 
 type CreateUserRequest struct {
@@ -31,13 +30,13 @@ type CreateUserResponse struct {
 func (server *Server) createUser(ctx *gin.Context) {
 	var req CreateUserRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
-		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		ctx.Error(statusError(http.StatusBadRequest, err))
 		return
 	}
 
 	hashedPassword, err := util.HashPassword(req.Password)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		ctx.Error(err)
 		return
 	}
 
@@ -50,14 +49,11 @@ func (server *Server) createUser(ctx *gin.Context) {
 
 	user, err := server.store.CreateUsers(ctx, arg)
 	if err != nil {
-		if pqError, ok := err.(*pq.Error); ok {
-			switch pqError.Code.Name() {
-			case "unique_violation":
-				ctx.JSON(http.StatusConflict, errorResponse(err))
-				return
-			}
+		if errors.Is(db.TranslateError(err), db.ErrUniqueViolation) {
+			ctx.Error(statusMessage(http.StatusConflict, "username or email is already taken"))
+			return
 		}
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		ctx.Error(err)
 		return
 	}
 
@@ -87,18 +83,18 @@ type GetUserResponse struct {
 func (server *Server) getUser(ctx *gin.Context) {
 	var param SearchUserParams
 	if err := ctx.ShouldBindQuery(&param); err != nil {
-		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		ctx.Error(statusError(http.StatusBadRequest, err))
 		return
 	}
 
 	user, err := server.store.GetUser(ctx, param.Username)
 	if err != nil {
-		if err == sql.ErrNoRows{
-			ctx.JSON(http.StatusNotFound,errorMessage("user does not exist"))
+		if errors.Is(err, db.ErrNotFound){
+			ctx.Error(statusMessage(http.StatusNotFound, "user does not exist"))
 			return
 		}
 		
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		ctx.Error(err)
 		return
 	}
 
@@ -133,7 +129,7 @@ func (server *Server) loginUser(ctx *gin.Context) {
 	var req loginUserRequest
 	err := ctx.ShouldBindJSON(&req)
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		ctx.Error(statusError(http.StatusBadRequest, err))
 		return
 	}
 
@@ -141,25 +137,25 @@ func (server *Server) loginUser(ctx *gin.Context) {
 	if err != nil {
 		// possible errors,
 		//1. user not found
-		if err == sql.ErrNoRows {
-			ctx.JSON(http.StatusNotFound, errorMessage("user does not exist, sign up"))
+		if errors.Is(err, db.ErrNotFound) {
+			ctx.Error(statusMessage(http.StatusNotFound, "user does not exist, sign up"))
 			return
 		}
 		//2. something happened to the database
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		ctx.Error(err)
 	}
 
 	//check user password against saved db password
 	err = util.CheckPassword(user.HashedPassword, req.Password)
 	if err != nil {
-		ctx.JSON(http.StatusUnauthorized, errorMessage("Invalid username or password"))
+		ctx.Error(statusMessage(http.StatusUnauthorized, "Invalid username or password"))
 		return
 	}
 
 	//create the access token
 	access_token, err := server.tokenMaker.CreateToken(req.Username, token.AccessToken, server.config.AcessTokenDuration)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		ctx.Error(err)
 		return
 	}
 
@@ -168,7 +164,7 @@ func (server *Server) loginUser(ctx *gin.Context) {
 
 	refresh_token, err := server.tokenMaker.CreateToken(req.Username, token.RefreshToken, week)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		ctx.Error(err)
 		return
 	}
 
@@ -178,7 +174,7 @@ func (server *Server) loginUser(ctx *gin.Context) {
 	})
 
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		ctx.Error(err)
 		return
 	}
 
@@ -209,7 +205,7 @@ func (server *Server) getAllUsers(ctx *gin.Context) {
 
 	users, err := server.store.GetAllUsers(ctx)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		ctx.Error(err)
 		return
 	}
 
@@ -241,21 +237,21 @@ func (server *Server) refreshToken(ctx *gin.Context) {
 
 	err := ctx.ShouldBindJSON(&req)
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		ctx.Error(statusError(http.StatusBadRequest, err))
 		return
 	}
 
 	//verify the refresh token and get the payload
 	payload, err := server.tokenMaker.VerifyToken(req.RefreshToken, token.RefreshToken)
 	if err != nil {
-		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		ctx.Error(statusError(http.StatusUnauthorized, err))
 		return
 	}
 
 	//refreshtoken is valid issue new access token
 	accessToken, err := server.tokenMaker.CreateToken(payload.Subject, token.AccessToken, server.config.AcessTokenDuration)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		ctx.Error(err)
 		return
 	}
 
@@ -15,7 +15,7 @@ import (
 	mock_database "github.com/Glenn444/banking-app/internal/database/mock"
 	"github.com/Glenn444/banking-app/internal/token"
 	"github.com/Glenn444/banking-app/util"
-	"github.com/lib/pq"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
@@ -66,7 +66,7 @@ func TestCreateUser(t *testing.T) {
 					EXPECT().
 					CreateUsers(gomock.Any(), gomock.Any()).
 					Times(1).
-					Return(database.User{}, &pq.Error{Code: "23505"}) //postgres unique violation
+					Return(database.User{}, &pgconn.PgError{Code: "23505"}) //postgres unique violation
 			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusConflict, recorder.Code)
@@ -140,7 +140,7 @@ func TestGetUser(t *testing.T) {
 					EXPECT().
 					GetUser(gomock.Any(), gomock.Eq(user.Username)).
 					Times(1).
-					Return(db.User{}, sql.ErrNoRows)
+					Return(db.User{}, db.ErrNotFound)
 			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusNotFound, recorder.Code)
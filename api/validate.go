@@ -3,6 +3,7 @@ package api
 import (
 	"github.com/Glenn444/banking-app/util"
 	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
 )
 
 var validCurrency validator.Func = func(fieldLevel validator.FieldLevel) bool{
@@ -11,4 +12,23 @@ var validCurrency validator.Func = func(fieldLevel validator.FieldLevel) bool{
 		return util.IsSupportedCurrency(currency)
 	}
 	return false
-}
\ No newline at end of file
+}
+
+// validAmount checks that a decimal amount is positive and has no more
+// decimals than the currency named by the tag param allows, e.g. amount=Currency
+var validAmount validator.Func = func(fieldLevel validator.FieldLevel) bool {
+	amount, ok := fieldLevel.Field().Interface().(decimal.Decimal)
+	if !ok || !amount.IsPositive() {
+		return false
+	}
+
+	currencyField := fieldLevel.Parent().FieldByName(fieldLevel.Param())
+	if !currencyField.IsValid() {
+		return false
+	}
+	currency, ok := currencyField.Interface().(string)
+	if !ok {
+		return false
+	}
+	return util.IsValidAmount(amount, currency)
+}
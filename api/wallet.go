@@ -0,0 +1,101 @@
+package api
+
+import (
+	"net/http"
+	"time"
+
+	db "github.com/Glenn444/banking-app/internal/database"
+	"github.com/Glenn444/banking-app/internal/token"
+	"github.com/Glenn444/banking-app/util"
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+)
+
+const defaultReportingCurrency = "USD"
+
+type getWalletRequest struct {
+	ReportingCurrency string `form:"reporting_currency" binding:"omitempty,currency"`
+}
+
+type walletCurrencyTotal struct {
+	Currency  string          `json:"currency"`
+	Balance   decimal.Decimal `json:"balance"`
+	Rate      decimal.Decimal `json:"rate"`
+	Converted decimal.Decimal `json:"converted"`
+}
+
+type walletResponse struct {
+	Owner             string                `json:"owner"`
+	Accounts          []db.Account          `json:"accounts"`
+	Totals            []walletCurrencyTotal `json:"totals"`
+	ReportingCurrency string                `json:"reporting_currency"`
+	Total             decimal.Decimal       `json:"total"`
+	// RatesAsOf is the oldest timestamp among the rates used for the total
+	RatesAsOf *time.Time `json:"rates_as_of"`
+}
+
+// getWallet returns all the user's accounts with per-currency totals and
+// a grand total converted into the reporting currency
+func (server *Server) getWallet(ctx *gin.Context) {
+	var req getWalletRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.Error(statusError(http.StatusBadRequest, err))
+		return
+	}
+	if req.ReportingCurrency == "" {
+		req.ReportingCurrency = defaultReportingCurrency
+	}
+	reporting, _ := util.LookupCurrency(req.ReportingCurrency)
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	accounts, err := server.store.ListAllAccountsByOwner(ctx, authPayload.Username)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	// accounts are ordered by currency, so totals come out ordered too
+	var totals []walletCurrencyTotal
+	for _, account := range accounts {
+		if n := len(totals); n > 0 && totals[n-1].Currency == account.Currency {
+			totals[n-1].Balance = totals[n-1].Balance.Add(account.Balance)
+			continue
+		}
+		totals = append(totals, walletCurrencyTotal{
+			Currency: account.Currency,
+			Balance:  account.Balance,
+		})
+	}
+
+	resp := walletResponse{
+		Owner:             authPayload.Username,
+		Accounts:          accounts,
+		Totals:            []walletCurrencyTotal{},
+		ReportingCurrency: reporting.Code,
+		Total:             decimal.Zero,
+	}
+
+	total := decimal.Zero
+	for _, t := range totals {
+		rate, err := server.fxProvider.Rate(ctx, t.Currency, reporting.Code)
+		if err != nil {
+			ctx.Error(statusError(http.StatusBadGateway, err))
+			return
+		}
+
+		converted := rate.Convert(t.Balance)
+		total = total.Add(converted)
+
+		t.Rate = rate.Rate
+		t.Converted = converted.Round(reporting.MinorUnits)
+		resp.Totals = append(resp.Totals, t)
+
+		if resp.RatesAsOf == nil || rate.AsOf.Before(*resp.RatesAsOf) {
+			asOf := rate.AsOf
+			resp.RatesAsOf = &asOf
+		}
+	}
+	resp.Total = total.Round(reporting.MinorUnits)
+
+	ctx.JSON(http.StatusOK, resp)
+}
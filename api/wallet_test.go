@@ -0,0 +1,128 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	db "github.com/Glenn444/banking-app/internal/database"
+	mock_database "github.com/Glenn444/banking-app/internal/database/mock"
+	"github.com/Glenn444/banking-app/internal/fx"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetWalletApi(t *testing.T) {
+	user := randomUser()
+
+	eurAccount := randomAccountWithCurrency("EUR")
+	eurAccount.Owner = user.Username
+	eurAccount.Balance = decimal.NewFromInt(100)
+	usdAccount := randomAccountWithCurrency("USD")
+	usdAccount.Owner = user.Username
+	usdAccount.Balance = decimal.RequireFromString("25.50")
+
+	asOf := time.Date(2026, time.May, 4, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name          string
+		query         string
+		buildStubs    func(store *mock_database.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:  "OK",
+			query: "?reporting_currency=EUR",
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().
+					ListAllAccountsByOwner(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return([]db.Account{eurAccount, usdAccount}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got walletResponse
+				require.NoError(t, json.NewDecoder(recorder.Body).Decode(&got))
+				require.Len(t, got.Accounts, 2)
+				require.Len(t, got.Totals, 2)
+				require.Equal(t, "EUR", got.ReportingCurrency)
+				// 100 EUR + 25.50 USD * 0.8
+				require.True(t, decimal.RequireFromString("120.40").Equal(got.Total), got.Total.String())
+				require.NotNil(t, got.RatesAsOf)
+				require.True(t, asOf.Equal(*got.RatesAsOf))
+			},
+		},
+		{
+			name:  "DefaultReportingCurrency",
+			query: "",
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().
+					ListAllAccountsByOwner(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return([]db.Account{}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got walletResponse
+				require.NoError(t, json.NewDecoder(recorder.Body).Decode(&got))
+				require.Equal(t, defaultReportingCurrency, got.ReportingCurrency)
+				require.True(t, got.Total.IsZero())
+				require.Nil(t, got.RatesAsOf)
+			},
+		},
+		{
+			name:  "UnsupportedReportingCurrency",
+			query: "?reporting_currency=XXX",
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().ListAllAccountsByOwner(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "InternalError",
+			query: "",
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().
+					ListAllAccountsByOwner(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_database.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			server.fxProvider = fx.NewStaticProvider("USD", map[string]decimal.Decimal{
+				"EUR": decimal.RequireFromString("0.8"),
+			}, asOf)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, "/user/wallet"+tc.query, nil)
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
@@ -0,0 +1,13791 @@
+// Package client provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.1 DO NOT EDIT.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+const (
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
+// Defines values for AccountAccountType.
+const (
+	AccountAccountTypeChecking AccountAccountType = "checking"
+	AccountAccountTypeSavings  AccountAccountType = "savings"
+)
+
+// Defines values for CreateAccountRequestAccountType.
+const (
+	CreateAccountRequestAccountTypeChecking CreateAccountRequestAccountType = "checking"
+	CreateAccountRequestAccountTypeSavings  CreateAccountRequestAccountType = "savings"
+)
+
+// Defines values for CreateInterestProductRequestDayCount.
+const (
+	CreateInterestProductRequestDayCountActual360    CreateInterestProductRequestDayCount = "actual_360"
+	CreateInterestProductRequestDayCountActual365    CreateInterestProductRequestDayCount = "actual_365"
+	CreateInterestProductRequestDayCountActualActual CreateInterestProductRequestDayCount = "actual_actual"
+)
+
+// Defines values for CreateInterestProductRequestPayoutFrequency.
+const (
+	CreateInterestProductRequestPayoutFrequencyAnnually  CreateInterestProductRequestPayoutFrequency = "annually"
+	CreateInterestProductRequestPayoutFrequencyMonthly   CreateInterestProductRequestPayoutFrequency = "monthly"
+	CreateInterestProductRequestPayoutFrequencyQuarterly CreateInterestProductRequestPayoutFrequency = "quarterly"
+)
+
+// Defines values for CreateScheduledTransferRequestFrequency.
+const (
+	CreateScheduledTransferRequestFrequencyMonthly                CreateScheduledTransferRequestFrequency = "monthly"
+	CreateScheduledTransferRequestFrequencyMonthlyLastBusinessDay CreateScheduledTransferRequestFrequency = "monthly_last_business_day"
+	CreateScheduledTransferRequestFrequencyOnce                   CreateScheduledTransferRequestFrequency = "once"
+	CreateScheduledTransferRequestFrequencyWeekly                 CreateScheduledTransferRequestFrequency = "weekly"
+)
+
+// Defines values for CreateTransferRequestMode.
+const (
+	Async CreateTransferRequestMode = "async"
+	Sync  CreateTransferRequestMode = "sync"
+)
+
+// Defines values for EscrowStatus.
+const (
+	EscrowStatusFunded   EscrowStatus = "funded"
+	EscrowStatusRefunded EscrowStatus = "refunded"
+	EscrowStatusReleased EscrowStatus = "released"
+)
+
+// Defines values for FundingTransactionKind.
+const (
+	Deposit    FundingTransactionKind = "deposit"
+	Withdrawal FundingTransactionKind = "withdrawal"
+)
+
+// Defines values for FundingTransactionStatus.
+const (
+	FundingTransactionStatusFailed  FundingTransactionStatus = "failed"
+	FundingTransactionStatusPending FundingTransactionStatus = "pending"
+	FundingTransactionStatusSettled FundingTransactionStatus = "settled"
+)
+
+// Defines values for HealthStatus.
+const (
+	Ok HealthStatus = "ok"
+)
+
+// Defines values for HoldStatus.
+const (
+	HoldStatusActive   HoldStatus = "active"
+	HoldStatusCaptured HoldStatus = "captured"
+	HoldStatusExpired  HoldStatus = "expired"
+	HoldStatusReleased HoldStatus = "released"
+)
+
+// Defines values for InterestProductDayCount.
+const (
+	InterestProductDayCountActual360    InterestProductDayCount = "actual_360"
+	InterestProductDayCountActual365    InterestProductDayCount = "actual_365"
+	InterestProductDayCountActualActual InterestProductDayCount = "actual_actual"
+)
+
+// Defines values for InterestProductPayoutFrequency.
+const (
+	InterestProductPayoutFrequencyAnnually  InterestProductPayoutFrequency = "annually"
+	InterestProductPayoutFrequencyMonthly   InterestProductPayoutFrequency = "monthly"
+	InterestProductPayoutFrequencyQuarterly InterestProductPayoutFrequency = "quarterly"
+)
+
+// Defines values for OrganisationRole.
+const (
+	Approver  OrganisationRole = "approver"
+	Initiator OrganisationRole = "initiator"
+)
+
+// Defines values for PaymentRequestStatus.
+const (
+	PaymentRequestStatusDeclined PaymentRequestStatus = "declined"
+	PaymentRequestStatusPaid     PaymentRequestStatus = "paid"
+	PaymentRequestStatusPending  PaymentRequestStatus = "pending"
+)
+
+// Defines values for ReadinessStatus.
+const (
+	NotReady ReadinessStatus = "not_ready"
+	Ready    ReadinessStatus = "ready"
+)
+
+// Defines values for ScheduledTransferFrequency.
+const (
+	ScheduledTransferFrequencyMonthly                ScheduledTransferFrequency = "monthly"
+	ScheduledTransferFrequencyMonthlyLastBusinessDay ScheduledTransferFrequency = "monthly_last_business_day"
+	ScheduledTransferFrequencyOnce                   ScheduledTransferFrequency = "once"
+	ScheduledTransferFrequencyWeekly                 ScheduledTransferFrequency = "weekly"
+)
+
+// Defines values for ScheduledTransferStatus.
+const (
+	ScheduledTransferStatusActive    ScheduledTransferStatus = "active"
+	ScheduledTransferStatusCancelled ScheduledTransferStatus = "cancelled"
+	ScheduledTransferStatusCompleted ScheduledTransferStatus = "completed"
+	ScheduledTransferStatusFailed    ScheduledTransferStatus = "failed"
+	ScheduledTransferStatusPaused    ScheduledTransferStatus = "paused"
+)
+
+// Defines values for ScheduledTransferRunStatus.
+const (
+	ScheduledTransferRunStatusFailed    ScheduledTransferRunStatus = "failed"
+	ScheduledTransferRunStatusRunning   ScheduledTransferRunStatus = "running"
+	ScheduledTransferRunStatusSucceeded ScheduledTransferRunStatus = "succeeded"
+)
+
+// Defines values for ScheduledTransferWithRunsFrequency.
+const (
+	Monthly                ScheduledTransferWithRunsFrequency = "monthly"
+	MonthlyLastBusinessDay ScheduledTransferWithRunsFrequency = "monthly_last_business_day"
+	Once                   ScheduledTransferWithRunsFrequency = "once"
+	Weekly                 ScheduledTransferWithRunsFrequency = "weekly"
+)
+
+// Defines values for ScheduledTransferWithRunsStatus.
+const (
+	ScheduledTransferWithRunsStatusActive    ScheduledTransferWithRunsStatus = "active"
+	ScheduledTransferWithRunsStatusCancelled ScheduledTransferWithRunsStatus = "cancelled"
+	ScheduledTransferWithRunsStatusCompleted ScheduledTransferWithRunsStatus = "completed"
+	ScheduledTransferWithRunsStatusFailed    ScheduledTransferWithRunsStatus = "failed"
+	ScheduledTransferWithRunsStatusPaused    ScheduledTransferWithRunsStatus = "paused"
+)
+
+// Defines values for Tier.
+const (
+	Business Tier = "business"
+	Premium  Tier = "premium"
+	Standard Tier = "standard"
+)
+
+// Defines values for TransferStatus.
+const (
+	TransferStatusCompleted  TransferStatus = "completed"
+	TransferStatusFailed     TransferStatus = "failed"
+	TransferStatusPending    TransferStatus = "pending"
+	TransferStatusProcessing TransferStatus = "processing"
+	TransferStatusReversed   TransferStatus = "reversed"
+)
+
+// Defines values for TransferApprovalStatus.
+const (
+	Cancelled       TransferApprovalStatus = "cancelled"
+	Executed        TransferApprovalStatus = "executed"
+	Expired         TransferApprovalStatus = "expired"
+	PendingApproval TransferApprovalStatus = "pending_approval"
+	Rejected        TransferApprovalStatus = "rejected"
+)
+
+// Defines values for TransferBatchStatus.
+const (
+	TransferBatchStatusCompleted          TransferBatchStatus = "completed"
+	TransferBatchStatusFailed             TransferBatchStatus = "failed"
+	TransferBatchStatusPartiallyCompleted TransferBatchStatus = "partially_completed"
+	TransferBatchStatusPending            TransferBatchStatus = "pending"
+	TransferBatchStatusProcessing         TransferBatchStatus = "processing"
+)
+
+// Defines values for TransferBatchItemStatus.
+const (
+	TransferBatchItemStatusFailed    TransferBatchItemStatus = "failed"
+	TransferBatchItemStatusPending   TransferBatchItemStatus = "pending"
+	TransferBatchItemStatusSucceeded TransferBatchItemStatus = "succeeded"
+)
+
+// Defines values for TransferBatchMode.
+const (
+	AllOrNothing TransferBatchMode = "all_or_nothing"
+	BestEffort   TransferBatchMode = "best_effort"
+)
+
+// Defines values for UpdateScheduledTransferRequestStatus.
+const (
+	Active UpdateScheduledTransferRequestStatus = "active"
+	Paused UpdateScheduledTransferRequestStatus = "paused"
+)
+
+// Defines values for ListPaymentRequestsParamsDirection.
+const (
+	Incoming ListPaymentRequestsParamsDirection = "incoming"
+	Outgoing ListPaymentRequestsParamsDirection = "outgoing"
+)
+
+// AcceptPaymentRequestRequest defines model for AcceptPaymentRequestRequest.
+type AcceptPaymentRequestRequest struct {
+	FromAccountId openapi_types.UUID `json:"from_account_id"`
+}
+
+// AcceptPaymentRequestTxResult defines model for AcceptPaymentRequestTxResult.
+type AcceptPaymentRequestTxResult struct {
+	// Fee Decimal amount, requests also accept a JSON number
+	Fee         Decimal        `json:"fee"`
+	FeeEntry    *Entry         `json:"fee_entry,omitempty"`
+	FromAccount Account        `json:"from_account"`
+	FromEntry   Entry          `json:"from_entry"`
+	Request     PaymentRequest `json:"request"`
+	ToAccount   Account        `json:"to_account"`
+	ToEntry     Entry          `json:"to_entry"`
+	Transfer    Transfer       `json:"transfer"`
+}
+
+// AcceptedTransfer A transfer waiting for the approvers of the from account's organisation, or queued
+type AcceptedTransfer struct {
+	union json.RawMessage
+}
+
+// Account defines model for Account.
+type Account struct {
+	AccountType AccountAccountType `json:"account_type"`
+
+	// AvailableBalance Decimal amount, requests also accept a JSON number
+	AvailableBalance Decimal `json:"available_balance"`
+
+	// Balance Decimal amount, requests also accept a JSON number
+	Balance   Decimal   `json:"balance"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// Currency ISO 4217 code of a supported currency
+	Currency CurrencyCode       `json:"currency"`
+	Id       openapi_types.UUID `json:"id"`
+
+	// InterestProductId null when the account earns no interest
+	InterestProductId *openapi_types.UUID `json:"interest_product_id,omitempty"`
+
+	// OrganisationId null for a personal account
+	OrganisationId *openapi_types.UUID `json:"organisation_id,omitempty"`
+
+	// OverdraftInterestRate Decimal amount, requests also accept a JSON number
+	OverdraftInterestRate Decimal `json:"overdraft_interest_rate"`
+
+	// OverdraftLimit Decimal amount, requests also accept a JSON number
+	OverdraftLimit Decimal   `json:"overdraft_limit"`
+	Owner          string    `json:"owner"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// AccountAccountType defines model for Account.AccountType.
+type AccountAccountType string
+
+// AccountLimits defines model for AccountLimits.
+type AccountLimits struct {
+	Account *TransferLimit `json:"account,omitempty"`
+	User    *TransferLimit `json:"user,omitempty"`
+}
+
+// Alias defines model for Alias.
+type Alias struct {
+	Alias    string `json:"alias"`
+	Username string `json:"username"`
+}
+
+// ApprovalAuditLog defines model for ApprovalAuditLog.
+type ApprovalAuditLog struct {
+	Actor          string             `json:"actor"`
+	ApprovalId     openapi_types.UUID `json:"approval_id"`
+	CreatedAt      time.Time          `json:"created_at"`
+	Decision       string             `json:"decision"`
+	Id             int64              `json:"id"`
+	OrganisationId openapi_types.UUID `json:"organisation_id"`
+	Reason         string             `json:"reason"`
+}
+
+// BackfillInterestRequest defines model for BackfillInterestRequest.
+type BackfillInterestRequest struct {
+	From openapi_types.Date `json:"from"`
+	To   openapi_types.Date `json:"to"`
+}
+
+// CaptureHoldRequest defines model for CaptureHoldRequest.
+type CaptureHoldRequest struct {
+	// Amount Decimal amount, requests also accept a JSON number
+	Amount *Decimal `json:"amount,omitempty"`
+}
+
+// CaptureHoldTxResult defines model for CaptureHoldTxResult.
+type CaptureHoldTxResult struct {
+	// Fee Decimal amount, requests also accept a JSON number
+	Fee         Decimal  `json:"fee"`
+	FeeEntry    *Entry   `json:"fee_entry,omitempty"`
+	FromAccount Account  `json:"from_account"`
+	FromEntry   Entry    `json:"from_entry"`
+	Hold        Hold     `json:"hold"`
+	ToAccount   Account  `json:"to_account"`
+	ToEntry     Entry    `json:"to_entry"`
+	Transfer    Transfer `json:"transfer"`
+}
+
+// CreateAccountRequest defines model for CreateAccountRequest.
+type CreateAccountRequest struct {
+	// AccountType checking when left out
+	AccountType *CreateAccountRequestAccountType `json:"account_type,omitempty"`
+
+	// Currency ISO 4217 code of a supported currency
+	Currency CurrencyCode `json:"currency"`
+
+	// Owner Must be the authenticated user
+	Owner string `json:"owner"`
+}
+
+// CreateAccountRequestAccountType checking when left out
+type CreateAccountRequestAccountType string
+
+// CreateEscrowRequest defines model for CreateEscrowRequest.
+type CreateEscrowRequest struct {
+	// Amount Decimal amount, requests also accept a JSON number
+	Amount Decimal `json:"amount"`
+
+	// Currency ISO 4217 code of a supported currency
+	Currency      CurrencyCode       `json:"currency"`
+	Description   *string            `json:"description,omitempty"`
+	FromAccountId openapi_types.UUID `json:"from_account_id"`
+
+	// To Username, alias or verified email of the payee
+	To *string `json:"to,omitempty"`
+
+	// ToAccountId Required unless to is given
+	ToAccountId *openapi_types.UUID `json:"to_account_id,omitempty"`
+	TtlSeconds  *int64              `json:"ttl_seconds,omitempty"`
+}
+
+// CreateFxQuoteRequest defines model for CreateFxQuoteRequest.
+type CreateFxQuoteRequest struct {
+	// Amount Decimal amount, requests also accept a JSON number
+	Amount Decimal `json:"amount"`
+
+	// FromCurrency ISO 4217 code of a supported currency
+	FromCurrency CurrencyCode `json:"from_currency"`
+
+	// ToCurrency ISO 4217 code of a supported currency
+	ToCurrency CurrencyCode `json:"to_currency"`
+}
+
+// CreateHoldRequest defines model for CreateHoldRequest.
+type CreateHoldRequest struct {
+	// Amount Decimal amount, requests also accept a JSON number
+	Amount Decimal `json:"amount"`
+
+	// Currency ISO 4217 code of a supported currency
+	Currency    CurrencyCode       `json:"currency"`
+	Description *string            `json:"description,omitempty"`
+	ToAccountId openapi_types.UUID `json:"to_account_id"`
+
+	// TtlSeconds How long the hold lasts, the default when 0
+	TtlSeconds *int64 `json:"ttl_seconds,omitempty"`
+}
+
+// CreateInterestProductRequest defines model for CreateInterestProductRequest.
+type CreateInterestProductRequest struct {
+	// AnnualRate Decimal amount, requests also accept a JSON number
+	AnnualRate Decimal `json:"annual_rate"`
+
+	// Currency ISO 4217 code of a supported currency
+	Currency        CurrencyCode                                `json:"currency"`
+	DayCount        CreateInterestProductRequestDayCount        `json:"day_count"`
+	Name            string                                      `json:"name"`
+	PayoutFrequency CreateInterestProductRequestPayoutFrequency `json:"payout_frequency"`
+}
+
+// CreateInterestProductRequestDayCount defines model for CreateInterestProductRequest.DayCount.
+type CreateInterestProductRequestDayCount string
+
+// CreateInterestProductRequestPayoutFrequency defines model for CreateInterestProductRequest.PayoutFrequency.
+type CreateInterestProductRequestPayoutFrequency string
+
+// CreateOrganisationRequest defines model for CreateOrganisationRequest.
+type CreateOrganisationRequest struct {
+	// ApprovalThreshold Decimal amount, requests also accept a JSON number
+	ApprovalThreshold *Decimal `json:"approval_threshold,omitempty"`
+
+	// ApprovalThresholdCurrency ISO 4217 code of a supported currency
+	ApprovalThresholdCurrency CurrencyCode `json:"approval_threshold_currency"`
+	ApprovalTtlSeconds        *int32       `json:"approval_ttl_seconds,omitempty"`
+	Name                      string       `json:"name"`
+	RequiredApprovals         int32        `json:"required_approvals"`
+}
+
+// CreatePaymentRequestRequest defines model for CreatePaymentRequestRequest.
+type CreatePaymentRequestRequest struct {
+	// Amount Decimal amount, requests also accept a JSON number
+	Amount Decimal `json:"amount"`
+
+	// Currency ISO 4217 code of a supported currency
+	Currency   CurrencyCode `json:"currency"`
+	Memo       *string      `json:"memo,omitempty"`
+	Payer      string       `json:"payer"`
+	TtlSeconds *int64       `json:"ttl_seconds,omitempty"`
+}
+
+// CreateScheduledTransferRequest defines model for CreateScheduledTransferRequest.
+type CreateScheduledTransferRequest struct {
+	// Amount Decimal amount, requests also accept a JSON number
+	Amount Decimal `json:"amount"`
+
+	// Currency ISO 4217 code of a supported currency
+	Currency      CurrencyCode                            `json:"currency"`
+	EndAt         *time.Time                              `json:"end_at"`
+	Frequency     CreateScheduledTransferRequestFrequency `json:"frequency"`
+	FromAccountId openapi_types.UUID                      `json:"from_account_id"`
+	StartAt       time.Time                               `json:"start_at"`
+	ToAccountId   openapi_types.UUID                      `json:"to_account_id"`
+}
+
+// CreateScheduledTransferRequestFrequency defines model for CreateScheduledTransferRequest.Frequency.
+type CreateScheduledTransferRequestFrequency string
+
+// CreateTransferBatchRequest defines model for CreateTransferBatchRequest.
+type CreateTransferBatchRequest struct {
+	// Currency ISO 4217 code of a supported currency
+	Currency      CurrencyCode               `json:"currency"`
+	FromAccountId openapi_types.UUID         `json:"from_account_id"`
+	Items         []TransferBatchItemRequest `json:"items"`
+
+	// Mode all_or_nothing batches run in one transaction and take at most 500 items, best_effort batches take up to 10000
+	Mode TransferBatchMode `json:"mode"`
+}
+
+// CreateTransferRequest defines model for CreateTransferRequest.
+type CreateTransferRequest struct {
+	// Amount Decimal amount, requests also accept a JSON number
+	Amount Decimal `json:"amount"`
+
+	// Currency ISO 4217 code of a supported currency
+	Currency    CurrencyCode `json:"currency"`
+	Description *string      `json:"description,omitempty"`
+
+	// ExternalReference Can only be used once per from account
+	ExternalReference *string            `json:"external_reference,omitempty"`
+	FromAccountId     openapi_types.UUID `json:"from_account_id"`
+
+	// FxQuoteId Makes the transfer cross-currency at the quoted rate
+	FxQuoteId *openapi_types.UUID `json:"fx_quote_id"`
+
+	// Metadata At most 50 keys and 4096 bytes
+	Metadata *map[string]interface{} `json:"metadata"`
+
+	// Mode async queues the transfer and answers 202 right away
+	Mode *CreateTransferRequestMode `json:"mode,omitempty"`
+
+	// To Username, alias or verified email whose account in the currency receives the money
+	To *string `json:"to,omitempty"`
+
+	// ToAccountId Required unless to is given
+	ToAccountId *openapi_types.UUID `json:"to_account_id,omitempty"`
+}
+
+// CreateTransferRequestMode async queues the transfer and answers 202 right away
+type CreateTransferRequestMode string
+
+// CreateUserRequest defines model for CreateUserRequest.
+type CreateUserRequest struct {
+	Email    openapi_types.Email `json:"email"`
+	FullName *string             `json:"full_name,omitempty"`
+	Password string              `json:"password"`
+	Username string              `json:"username"`
+}
+
+// Currency defines model for Currency.
+type Currency struct {
+	// Code ISO 4217 code of a supported currency
+	Code    CurrencyCode `json:"code"`
+	Enabled bool         `json:"enabled"`
+
+	// MinorUnits Number of decimals, 2 for USD and 0 for JPY
+	MinorUnits  int32  `json:"minor_units"`
+	NumericCode int32  `json:"numeric_code"`
+	Symbol      string `json:"symbol"`
+}
+
+// CurrencyCode ISO 4217 code of a supported currency
+type CurrencyCode = string
+
+// CurrencyRecord defines model for CurrencyRecord.
+type CurrencyRecord struct {
+	// Code ISO 4217 code of a supported currency
+	Code        CurrencyCode `json:"code"`
+	CreatedAt   time.Time    `json:"created_at"`
+	Enabled     bool         `json:"enabled"`
+	MinorUnits  int32        `json:"minor_units"`
+	NumericCode int32        `json:"numeric_code"`
+	Symbol      string       `json:"symbol"`
+	UpdatedAt   time.Time    `json:"updated_at"`
+}
+
+// Decimal Decimal amount, requests also accept a JSON number
+type Decimal = string
+
+// DecisionRequest defines model for DecisionRequest.
+type DecisionRequest struct {
+	Reason *string `json:"reason,omitempty"`
+}
+
+// EmailVerification defines model for EmailVerification.
+type EmailVerification struct {
+	Email           string    `json:"email"`
+	EmailVerifiedAt time.Time `json:"email_verified_at"`
+	Username        string    `json:"username"`
+}
+
+// Entry defines model for Entry.
+type Entry struct {
+	AccountId openapi_types.UUID `json:"account_id"`
+
+	// Amount Decimal amount, requests also accept a JSON number
+	Amount    Decimal            `json:"amount"`
+	CreatedAt *time.Time         `json:"created_at"`
+	Id        openapi_types.UUID `json:"id"`
+	UpdatedAt *time.Time         `json:"updated_at"`
+}
+
+// Escrow defines model for Escrow.
+type Escrow struct {
+	// Amount Decimal amount, requests also accept a JSON number
+	Amount    Decimal   `json:"amount"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// Currency ISO 4217 code of a supported currency
+	Currency          CurrencyCode       `json:"currency"`
+	Description       string             `json:"description"`
+	EscrowAccountId   openapi_types.UUID `json:"escrow_account_id"`
+	ExpiresAt         time.Time          `json:"expires_at"`
+	FromAccountId     openapi_types.UUID `json:"from_account_id"`
+	FundingTransferId openapi_types.UUID `json:"funding_transfer_id"`
+	Id                openapi_types.UUID `json:"id"`
+	Payee             string             `json:"payee"`
+	PayeeConfirmedAt  *time.Time         `json:"payee_confirmed_at"`
+	Payer             string             `json:"payer"`
+	PayerConfirmedAt  *time.Time         `json:"payer_confirmed_at"`
+
+	// SettlementTransferId null until the escrow is released or refunded
+	SettlementTransferId *openapi_types.UUID `json:"settlement_transfer_id,omitempty"`
+	Status               EscrowStatus        `json:"status"`
+	ToAccountId          openapi_types.UUID  `json:"to_account_id"`
+	UpdatedAt            time.Time           `json:"updated_at"`
+}
+
+// EscrowStatus defines model for Escrow.Status.
+type EscrowStatus string
+
+// EscrowActionRequest defines model for EscrowActionRequest.
+type EscrowActionRequest struct {
+	Reason *string `json:"reason,omitempty"`
+}
+
+// EscrowEvent defines model for EscrowEvent.
+type EscrowEvent struct {
+	Actor      string             `json:"actor"`
+	CreatedAt  time.Time          `json:"created_at"`
+	EscrowId   openapi_types.UUID `json:"escrow_id"`
+	Event      string             `json:"event"`
+	FromStatus string             `json:"from_status"`
+	Id         int64              `json:"id"`
+	Reason     string             `json:"reason"`
+	ToStatus   string             `json:"to_status"`
+}
+
+// EscrowTxResult defines model for EscrowTxResult.
+type EscrowTxResult struct {
+	Escrow   Escrow    `json:"escrow"`
+	Transfer *Transfer `json:"transfer,omitempty"`
+}
+
+// EscrowWithEvents defines model for EscrowWithEvents.
+type EscrowWithEvents struct {
+	Escrow Escrow        `json:"escrow"`
+	Events []EscrowEvent `json:"events"`
+}
+
+// FeeQuote defines model for FeeQuote.
+type FeeQuote struct {
+	// Amount Decimal amount, requests also accept a JSON number
+	Amount Decimal `json:"amount"`
+
+	// Currency ISO 4217 code of a supported currency
+	Currency CurrencyCode `json:"currency"`
+
+	// Fee Decimal amount, requests also accept a JSON number
+	Fee  Decimal  `json:"fee"`
+	Rule *FeeRule `json:"rule,omitempty"`
+
+	// Total Decimal amount, requests also accept a JSON number
+	Total Decimal `json:"total"`
+}
+
+// FeeRule defines model for FeeRule.
+type FeeRule struct {
+	// FlatFee Decimal amount, requests also accept a JSON number
+	FlatFee Decimal            `json:"flat_fee"`
+	Id      openapi_types.UUID `json:"id"`
+
+	// MaxAmount Decimal amount or null, requests also accept a JSON number
+	MaxAmount *NullDecimal `json:"max_amount"`
+
+	// MaxFee Decimal amount or null, requests also accept a JSON number
+	MaxFee *NullDecimal `json:"max_fee"`
+
+	// MinAmount Decimal amount, requests also accept a JSON number
+	MinAmount Decimal `json:"min_amount"`
+
+	// MinFee Decimal amount, requests also accept a JSON number
+	MinFee Decimal `json:"min_fee"`
+
+	// Percentage Decimal amount, requests also accept a JSON number
+	Percentage Decimal            `json:"percentage"`
+	ScheduleId openapi_types.UUID `json:"schedule_id"`
+	Tier       Tier               `json:"tier"`
+}
+
+// FeeRuleRequest defines model for FeeRuleRequest.
+type FeeRuleRequest struct {
+	// FlatFee Decimal amount, requests also accept a JSON number
+	FlatFee *Decimal `json:"flat_fee,omitempty"`
+
+	// MaxAmount Decimal amount or null, requests also accept a JSON number
+	MaxAmount *NullDecimal `json:"max_amount"`
+
+	// MaxFee Decimal amount or null, requests also accept a JSON number
+	MaxFee *NullDecimal `json:"max_fee"`
+
+	// MinAmount Decimal amount, requests also accept a JSON number
+	MinAmount *Decimal `json:"min_amount,omitempty"`
+
+	// MinFee Decimal amount, requests also accept a JSON number
+	MinFee *Decimal `json:"min_fee,omitempty"`
+
+	// Percentage Decimal amount, requests also accept a JSON number
+	Percentage *Decimal `json:"percentage,omitempty"`
+	Tier       Tier     `json:"tier"`
+}
+
+// FeeSchedule defines model for FeeSchedule.
+type FeeSchedule struct {
+	CreatedAt time.Time `json:"created_at"`
+	CreatedBy string    `json:"created_by"`
+
+	// Currency ISO 4217 code of a supported currency
+	Currency CurrencyCode       `json:"currency"`
+	Id       openapi_types.UUID `json:"id"`
+	Version  int32              `json:"version"`
+}
+
+// FeeScheduleResult defines model for FeeScheduleResult.
+type FeeScheduleResult struct {
+	Rules    []FeeRule   `json:"rules"`
+	Schedule FeeSchedule `json:"schedule"`
+}
+
+// FundingRequest defines model for FundingRequest.
+type FundingRequest struct {
+	// Amount Decimal amount, requests also accept a JSON number
+	Amount Decimal `json:"amount"`
+
+	// Currency ISO 4217 code of a supported currency
+	Currency CurrencyCode `json:"currency"`
+}
+
+// FundingTransaction defines model for FundingTransaction.
+type FundingTransaction struct {
+	AccountId openapi_types.UUID `json:"account_id"`
+
+	// Amount Decimal amount, requests also accept a JSON number
+	Amount    Decimal   `json:"amount"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// Currency ISO 4217 code of a supported currency
+	Currency            CurrencyCode             `json:"currency"`
+	FailureReason       string                   `json:"failure_reason"`
+	Id                  openapi_types.UUID       `json:"id"`
+	Kind                FundingTransactionKind   `json:"kind"`
+	Provider            string                   `json:"provider"`
+	ProviderReference   string                   `json:"provider_reference"`
+	SettledAt           *time.Time               `json:"settled_at"`
+	SettlementAccountId openapi_types.UUID       `json:"settlement_account_id"`
+	Status              FundingTransactionStatus `json:"status"`
+	UpdatedAt           time.Time                `json:"updated_at"`
+}
+
+// FundingTransactionKind defines model for FundingTransaction.Kind.
+type FundingTransactionKind string
+
+// FundingTransactionStatus defines model for FundingTransaction.Status.
+type FundingTransactionStatus string
+
+// FundingTxResult defines model for FundingTxResult.
+type FundingTxResult struct {
+	Account     Account            `json:"account"`
+	Transaction FundingTransaction `json:"transaction"`
+}
+
+// FxQuote defines model for FxQuote.
+type FxQuote struct {
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+
+	// FromAmount Decimal amount, requests also accept a JSON number
+	FromAmount Decimal `json:"from_amount"`
+
+	// FromCurrency ISO 4217 code of a supported currency
+	FromCurrency CurrencyCode       `json:"from_currency"`
+	Id           openapi_types.UUID `json:"id"`
+	Owner        string             `json:"owner"`
+
+	// Rate Decimal amount, requests also accept a JSON number
+	Rate Decimal `json:"rate"`
+
+	// ToAmount Decimal amount, requests also accept a JSON number
+	ToAmount Decimal `json:"to_amount"`
+
+	// ToCurrency ISO 4217 code of a supported currency
+	ToCurrency CurrencyCode `json:"to_currency"`
+	UsedAt     *time.Time   `json:"used_at"`
+}
+
+// FxTransferTxResult defines model for FxTransferTxResult.
+type FxTransferTxResult struct {
+	// Fee Decimal amount, requests also accept a JSON number
+	Fee         Decimal  `json:"fee"`
+	FeeEntry    *Entry   `json:"fee_entry,omitempty"`
+	FromAccount Account  `json:"from_account"`
+	FromEntry   Entry    `json:"from_entry"`
+	Quote       FxQuote  `json:"quote"`
+	ToAccount   Account  `json:"to_account"`
+	ToEntry     Entry    `json:"to_entry"`
+	Transfer    Transfer `json:"transfer"`
+}
+
+// Health defines model for Health.
+type Health struct {
+	Status HealthStatus `json:"status"`
+}
+
+// HealthStatus defines model for Health.Status.
+type HealthStatus string
+
+// Hold defines model for Hold.
+type Hold struct {
+	AccountId openapi_types.UUID `json:"account_id"`
+
+	// Amount Decimal amount, requests also accept a JSON number
+	Amount Decimal `json:"amount"`
+
+	// CapturedAmount Decimal amount, requests also accept a JSON number
+	CapturedAmount Decimal   `json:"captured_amount"`
+	CreatedAt      time.Time `json:"created_at"`
+
+	// Currency ISO 4217 code of a supported currency
+	Currency    CurrencyCode       `json:"currency"`
+	Description string             `json:"description"`
+	ExpiresAt   time.Time          `json:"expires_at"`
+	Id          openapi_types.UUID `json:"id"`
+	Status      HoldStatus         `json:"status"`
+	ToAccountId openapi_types.UUID `json:"to_account_id"`
+
+	// TransferId The capture's transfer, null until captured
+	TransferId *openapi_types.UUID `json:"transfer_id,omitempty"`
+	UpdatedAt  time.Time           `json:"updated_at"`
+}
+
+// HoldStatus defines model for Hold.Status.
+type HoldStatus string
+
+// HoldTxResult defines model for HoldTxResult.
+type HoldTxResult struct {
+	Account Account `json:"account"`
+	Hold    Hold    `json:"hold"`
+}
+
+// InterestPayout defines model for InterestPayout.
+type InterestPayout struct {
+	AccountId openapi_types.UUID `json:"account_id"`
+
+	// AccruedAmount Decimal amount, requests also accept a JSON number
+	AccruedAmount Decimal `json:"accrued_amount"`
+
+	// Amount Decimal amount, requests also accept a JSON number
+	Amount    Decimal            `json:"amount"`
+	CreatedAt time.Time          `json:"created_at"`
+	Id        openapi_types.UUID `json:"id"`
+	PeriodEnd time.Time          `json:"period_end"`
+
+	// Residue Decimal amount, requests also accept a JSON number
+	Residue Decimal `json:"residue"`
+}
+
+// InterestProduct defines model for InterestProduct.
+type InterestProduct struct {
+	// AnnualRate Decimal amount, requests also accept a JSON number
+	AnnualRate Decimal   `json:"annual_rate"`
+	CreatedAt  time.Time `json:"created_at"`
+
+	// Currency ISO 4217 code of a supported currency
+	Currency        CurrencyCode                   `json:"currency"`
+	DayCount        InterestProductDayCount        `json:"day_count"`
+	Id              openapi_types.UUID             `json:"id"`
+	Name            string                         `json:"name"`
+	PayoutFrequency InterestProductPayoutFrequency `json:"payout_frequency"`
+	UpdatedAt       time.Time                      `json:"updated_at"`
+}
+
+// InterestProductDayCount defines model for InterestProduct.DayCount.
+type InterestProductDayCount string
+
+// InterestProductPayoutFrequency defines model for InterestProduct.PayoutFrequency.
+type InterestProductPayoutFrequency string
+
+// InterestRun defines model for InterestRun.
+type InterestRun struct {
+	Accrued      int32     `json:"accrued"`
+	BusinessDate time.Time `json:"business_date"`
+	CompletedAt  time.Time `json:"completed_at"`
+	PaidOut      int32     `json:"paid_out"`
+}
+
+// Login defines model for Login.
+type Login struct {
+	AccessToken       string              `json:"access_token"`
+	CreatedAt         time.Time           `json:"created_at"`
+	Email             openapi_types.Email `json:"email"`
+	FullName          string              `json:"full_name"`
+	PasswordChangedAt time.Time           `json:"password_changed_at"`
+	RefreshToken      string              `json:"refresh_token"`
+	Username          string              `json:"username"`
+}
+
+// LoginUserRequest defines model for LoginUserRequest.
+type LoginUserRequest struct {
+	Password string `json:"password"`
+	Username string `json:"username"`
+}
+
+// Notification defines model for Notification.
+type Notification struct {
+	CreatedAt time.Time              `json:"created_at"`
+	Id        openapi_types.UUID     `json:"id"`
+	Kind      string                 `json:"kind"`
+	Payload   map[string]interface{} `json:"payload"`
+	ReadAt    *time.Time             `json:"read_at"`
+	Username  string                 `json:"username"`
+}
+
+// NullDecimal Decimal amount or null, requests also accept a JSON number
+type NullDecimal = string
+
+// Organisation defines model for Organisation.
+type Organisation struct {
+	// ApprovalThreshold Decimal amount, requests also accept a JSON number
+	ApprovalThreshold Decimal `json:"approval_threshold"`
+
+	// ApprovalThresholdCurrency ISO 4217 code of a supported currency
+	ApprovalThresholdCurrency CurrencyCode       `json:"approval_threshold_currency"`
+	ApprovalTtlSeconds        int32              `json:"approval_ttl_seconds"`
+	CreatedAt                 time.Time          `json:"created_at"`
+	CreatedBy                 string             `json:"created_by"`
+	Id                        openapi_types.UUID `json:"id"`
+	Name                      string             `json:"name"`
+	RequiredApprovals         int32              `json:"required_approvals"`
+	UpdatedAt                 time.Time          `json:"updated_at"`
+}
+
+// OrganisationMember defines model for OrganisationMember.
+type OrganisationMember struct {
+	CreatedAt      time.Time          `json:"created_at"`
+	OrganisationId openapi_types.UUID `json:"organisation_id"`
+	Role           OrganisationRole   `json:"role"`
+	Username       string             `json:"username"`
+}
+
+// OrganisationMemberRequest defines model for OrganisationMemberRequest.
+type OrganisationMemberRequest struct {
+	Role OrganisationRole `json:"role"`
+}
+
+// OrganisationPolicyRequest defines model for OrganisationPolicyRequest.
+type OrganisationPolicyRequest struct {
+	// ApprovalThreshold Decimal amount, requests also accept a JSON number
+	ApprovalThreshold *Decimal `json:"approval_threshold,omitempty"`
+
+	// ApprovalThresholdCurrency ISO 4217 code of a supported currency
+	ApprovalThresholdCurrency CurrencyCode `json:"approval_threshold_currency"`
+	ApprovalTtlSeconds        *int32       `json:"approval_ttl_seconds,omitempty"`
+	RequiredApprovals         int32        `json:"required_approvals"`
+}
+
+// OrganisationRole defines model for OrganisationRole.
+type OrganisationRole string
+
+// OverdraftInterestCharge defines model for OverdraftInterestCharge.
+type OverdraftInterestCharge struct {
+	AccountId openapi_types.UUID `json:"account_id"`
+
+	// Amount Decimal amount, requests also accept a JSON number
+	Amount Decimal `json:"amount"`
+
+	// Balance Decimal amount, requests also accept a JSON number
+	Balance      Decimal            `json:"balance"`
+	BusinessDate time.Time          `json:"business_date"`
+	CreatedAt    time.Time          `json:"created_at"`
+	Id           openapi_types.UUID `json:"id"`
+
+	// InterestRate Decimal amount, requests also accept a JSON number
+	InterestRate Decimal `json:"interest_rate"`
+}
+
+// PaymentRequest defines model for PaymentRequest.
+type PaymentRequest struct {
+	// Amount Decimal amount, requests also accept a JSON number
+	Amount    Decimal   `json:"amount"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// Currency ISO 4217 code of a supported currency
+	Currency  CurrencyCode         `json:"currency"`
+	ExpiresAt time.Time            `json:"expires_at"`
+	Id        openapi_types.UUID   `json:"id"`
+	Memo      string               `json:"memo"`
+	Payer     string               `json:"payer"`
+	Requester string               `json:"requester"`
+	Status    PaymentRequestStatus `json:"status"`
+
+	// TransferId null until the request is accepted
+	TransferId *openapi_types.UUID `json:"transfer_id,omitempty"`
+	UpdatedAt  time.Time           `json:"updated_at"`
+}
+
+// PaymentRequestStatus defines model for PaymentRequest.Status.
+type PaymentRequestStatus string
+
+// PoolStats defines model for PoolStats.
+type PoolStats struct {
+	AcquireCount int64 `json:"acquire_count"`
+
+	// AcquireDurationNs Total time spent acquiring connections, in nanoseconds
+	AcquireDurationNs    int64 `json:"acquire_duration_ns"`
+	AcquiredConns        int32 `json:"acquired_conns"`
+	CanceledAcquireCount int64 `json:"canceled_acquire_count"`
+	ConstructingConns    int32 `json:"constructing_conns"`
+
+	// EmptyAcquireCount Acquires that had to wait for a connection
+	EmptyAcquireCount int64 `json:"empty_acquire_count"`
+	IdleConns         int32 `json:"idle_conns"`
+	MaxConns          int32 `json:"max_conns"`
+	TotalConns        int32 `json:"total_conns"`
+}
+
+// Problem RFC 7807 problem details. Some errors add members: limit_exceeded has limit, duplicate_reference has transfer and invalid_batch_items has items.
+type Problem struct {
+	// Code Stable machine readable name of the error, match it rather than the detail
+	Code                 string                 `json:"code"`
+	Detail               *string                `json:"detail,omitempty"`
+	Instance             *string                `json:"instance,omitempty"`
+	Status               int                    `json:"status"`
+	Title                string                 `json:"title"`
+	Type                 string                 `json:"type"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// QuoteTransferRequest defines model for QuoteTransferRequest.
+type QuoteTransferRequest struct {
+	// Amount Decimal amount, requests also accept a JSON number
+	Amount Decimal `json:"amount"`
+
+	// Currency ISO 4217 code of a supported currency
+	Currency      CurrencyCode       `json:"currency"`
+	FromAccountId openapi_types.UUID `json:"from_account_id"`
+}
+
+// Readiness defines model for Readiness.
+type Readiness struct {
+	// Checks Result of each check by name: shutdown, database, migrations and, when the workers are monitored, workers. ok or failing.
+	Checks map[string]string `json:"checks"`
+	Status ReadinessStatus   `json:"status"`
+}
+
+// ReadinessStatus defines model for Readiness.Status.
+type ReadinessStatus string
+
+// Recipient defines model for Recipient.
+type Recipient struct {
+	// Currency ISO 4217 code of a supported currency
+	Currency CurrencyCode `json:"currency"`
+	Handle   string       `json:"handle"`
+	Name     string       `json:"name"`
+}
+
+// RefreshTokenRequest defines model for RefreshTokenRequest.
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+// RefreshedToken defines model for RefreshedToken.
+type RefreshedToken struct {
+	AccessToken string `json:"access_token"`
+}
+
+// ReverseTransferTxResult defines model for ReverseTransferTxResult.
+type ReverseTransferTxResult struct {
+	Reversal Transfer `json:"reversal"`
+	Transfer Transfer `json:"transfer"`
+}
+
+// ScheduledTransfer defines model for ScheduledTransfer.
+type ScheduledTransfer struct {
+	// Amount Decimal amount, requests also accept a JSON number
+	Amount    Decimal   `json:"amount"`
+	Attempts  int32     `json:"attempts"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// Currency ISO 4217 code of a supported currency
+	Currency      CurrencyCode               `json:"currency"`
+	EndAt         *time.Time                 `json:"end_at"`
+	Frequency     ScheduledTransferFrequency `json:"frequency"`
+	FromAccountId openapi_types.UUID         `json:"from_account_id"`
+	Id            openapi_types.UUID         `json:"id"`
+	LastError     string                     `json:"last_error"`
+	NextAttemptAt time.Time                  `json:"next_attempt_at"`
+	NextRunAt     time.Time                  `json:"next_run_at"`
+	Owner         string                     `json:"owner"`
+	StartAt       time.Time                  `json:"start_at"`
+	Status        ScheduledTransferStatus    `json:"status"`
+	ToAccountId   openapi_types.UUID         `json:"to_account_id"`
+	UpdatedAt     time.Time                  `json:"updated_at"`
+}
+
+// ScheduledTransferFrequency defines model for ScheduledTransfer.Frequency.
+type ScheduledTransferFrequency string
+
+// ScheduledTransferStatus defines model for ScheduledTransfer.Status.
+type ScheduledTransferStatus string
+
+// ScheduledTransferRun defines model for ScheduledTransferRun.
+type ScheduledTransferRun struct {
+	Attempt             int32                      `json:"attempt"`
+	CreatedAt           time.Time                  `json:"created_at"`
+	Error               string                     `json:"error"`
+	FinishedAt          *time.Time                 `json:"finished_at"`
+	Id                  openapi_types.UUID         `json:"id"`
+	ScheduledFor        time.Time                  `json:"scheduled_for"`
+	ScheduledTransferId openapi_types.UUID         `json:"scheduled_transfer_id"`
+	Status              ScheduledTransferRunStatus `json:"status"`
+
+	// TransferId null when the run made no transfer
+	TransferId *openapi_types.UUID `json:"transfer_id,omitempty"`
+}
+
+// ScheduledTransferRunStatus defines model for ScheduledTransferRun.Status.
+type ScheduledTransferRunStatus string
+
+// ScheduledTransferWithRuns defines model for ScheduledTransferWithRuns.
+type ScheduledTransferWithRuns struct {
+	// Amount Decimal amount, requests also accept a JSON number
+	Amount    Decimal   `json:"amount"`
+	Attempts  int32     `json:"attempts"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// Currency ISO 4217 code of a supported currency
+	Currency      CurrencyCode                       `json:"currency"`
+	EndAt         *time.Time                         `json:"end_at"`
+	Frequency     ScheduledTransferWithRunsFrequency `json:"frequency"`
+	FromAccountId openapi_types.UUID                 `json:"from_account_id"`
+	Id            openapi_types.UUID                 `json:"id"`
+	LastError     string                             `json:"last_error"`
+	NextAttemptAt time.Time                          `json:"next_attempt_at"`
+	NextRunAt     time.Time                          `json:"next_run_at"`
+	Owner         string                             `json:"owner"`
+	Runs          []ScheduledTransferRun             `json:"runs"`
+	StartAt       time.Time                          `json:"start_at"`
+	Status        ScheduledTransferWithRunsStatus    `json:"status"`
+	ToAccountId   openapi_types.UUID                 `json:"to_account_id"`
+	UpdatedAt     time.Time                          `json:"updated_at"`
+}
+
+// ScheduledTransferWithRunsFrequency defines model for ScheduledTransferWithRuns.Frequency.
+type ScheduledTransferWithRunsFrequency string
+
+// ScheduledTransferWithRunsStatus defines model for ScheduledTransferWithRuns.Status.
+type ScheduledTransferWithRunsStatus string
+
+// SetAliasRequest defines model for SetAliasRequest.
+type SetAliasRequest struct {
+	Alias string `json:"alias"`
+}
+
+// SetFeeScheduleRequest defines model for SetFeeScheduleRequest.
+type SetFeeScheduleRequest struct {
+	Rules []FeeRuleRequest `json:"rules"`
+}
+
+// SetInterestProductRequest defines model for SetInterestProductRequest.
+type SetInterestProductRequest struct {
+	// InterestProductId Stops the account earning interest when null
+	InterestProductId *openapi_types.UUID `json:"interest_product_id"`
+}
+
+// SetOverdraftRequest defines model for SetOverdraftRequest.
+type SetOverdraftRequest struct {
+	// InterestRate Decimal amount, requests also accept a JSON number
+	InterestRate Decimal `json:"interest_rate"`
+
+	// Limit Decimal amount, requests also accept a JSON number
+	Limit Decimal `json:"limit"`
+}
+
+// SetUserTierRequest defines model for SetUserTierRequest.
+type SetUserTierRequest struct {
+	Tier Tier `json:"tier"`
+}
+
+// Status defines model for Status.
+type Status struct {
+	// Checks Result of each check by name: shutdown, database, migrations and, when the workers are monitored, workers. ok or the error.
+	Checks   map[string]string `json:"checks"`
+	Commit   string            `json:"commit"`
+	Database struct {
+		ExpectedSchemaVersion int64     `json:"expected_schema_version"`
+		Pool                  PoolStats `json:"pool"`
+		SchemaVersion         int64     `json:"schema_version"`
+	} `json:"database"`
+	GoVersion    string         `json:"go_version"`
+	Ready        bool           `json:"ready"`
+	ShuttingDown bool           `json:"shutting_down"`
+	StartedAt    time.Time      `json:"started_at"`
+	Uptime       string         `json:"uptime"`
+	Version      string         `json:"version"`
+	Workers      []WorkerStatus `json:"workers"`
+}
+
+// Tier defines model for Tier.
+type Tier string
+
+// Transfer defines model for Transfer.
+type Transfer struct {
+	// Amount Decimal amount, requests also accept a JSON number
+	Amount      Decimal    `json:"amount"`
+	CompletedAt *time.Time `json:"completed_at"`
+
+	// ConvertedAmount Decimal amount, requests also accept a JSON number
+	ConvertedAmount   *Decimal   `json:"converted_amount,omitempty"`
+	CreatedAt         time.Time  `json:"created_at"`
+	Description       string     `json:"description"`
+	ExternalReference *string    `json:"external_reference"`
+	FailedAt          *time.Time `json:"failed_at"`
+	FailureReason     string     `json:"failure_reason"`
+
+	// Fee Decimal amount, requests also accept a JSON number
+	Fee Decimal `json:"fee"`
+
+	// FeeRuleId null when no fee was charged
+	FeeRuleId     *openapi_types.UUID `json:"fee_rule_id,omitempty"`
+	FromAccountId openapi_types.UUID  `json:"from_account_id"`
+
+	// FxQuoteId null unless the transfer was cross-currency
+	FxQuoteId *openapi_types.UUID `json:"fx_quote_id,omitempty"`
+	Id        openapi_types.UUID  `json:"id"`
+
+	// Metadata null when the transfer has none
+	Metadata     *map[string]interface{} `json:"metadata,omitempty"`
+	ProcessingAt *time.Time              `json:"processing_at"`
+
+	// ReversalTransferId null unless the transfer was reversed
+	ReversalTransferId *openapi_types.UUID `json:"reversal_transfer_id,omitempty"`
+	ReversedAt         *time.Time          `json:"reversed_at"`
+	Status             TransferStatus      `json:"status"`
+	ToAccountId        openapi_types.UUID  `json:"to_account_id"`
+	UpdatedAt          time.Time           `json:"updated_at"`
+}
+
+// TransferStatus defines model for Transfer.Status.
+type TransferStatus string
+
+// TransferApproval defines model for TransferApproval.
+type TransferApproval struct {
+	// Amount Decimal amount, requests also accept a JSON number
+	Amount    Decimal   `json:"amount"`
+	Approvals int32     `json:"approvals"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// Currency ISO 4217 code of a supported currency
+	Currency          CurrencyCode       `json:"currency"`
+	Description       string             `json:"description"`
+	ExpiresAt         time.Time          `json:"expires_at"`
+	ExternalReference *string            `json:"external_reference"`
+	FromAccountId     openapi_types.UUID `json:"from_account_id"`
+	Id                openapi_types.UUID `json:"id"`
+	InitiatedBy       string             `json:"initiated_by"`
+
+	// Metadata null when the transfer has none
+	Metadata          *map[string]interface{} `json:"metadata,omitempty"`
+	OrganisationId    openapi_types.UUID      `json:"organisation_id"`
+	RequiredApprovals int32                   `json:"required_approvals"`
+	Status            TransferApprovalStatus  `json:"status"`
+	ToAccountId       openapi_types.UUID      `json:"to_account_id"`
+
+	// TransferId null until the transfer is executed
+	TransferId *openapi_types.UUID `json:"transfer_id,omitempty"`
+	UpdatedAt  time.Time           `json:"updated_at"`
+}
+
+// TransferApprovalStatus defines model for TransferApprovalStatus.
+type TransferApprovalStatus string
+
+// TransferApprovalTxResult defines model for TransferApprovalTxResult.
+type TransferApprovalTxResult struct {
+	Account  Account           `json:"account"`
+	Approval TransferApproval  `json:"approval"`
+	Transfer *TransferTxResult `json:"transfer,omitempty"`
+}
+
+// TransferApprovalWithAuditLog defines model for TransferApprovalWithAuditLog.
+type TransferApprovalWithAuditLog struct {
+	Approval TransferApproval   `json:"approval"`
+	AuditLog []ApprovalAuditLog `json:"audit_log"`
+}
+
+// TransferBatch defines model for TransferBatch.
+type TransferBatch struct {
+	CompletedAt *time.Time `json:"completed_at"`
+	CreatedAt   time.Time  `json:"created_at"`
+
+	// Currency ISO 4217 code of a supported currency
+	Currency      CurrencyCode       `json:"currency"`
+	Error         string             `json:"error"`
+	FailedItems   int32              `json:"failed_items"`
+	FromAccountId openapi_types.UUID `json:"from_account_id"`
+	Id            openapi_types.UUID `json:"id"`
+
+	// Mode all_or_nothing batches run in one transaction and take at most 500 items, best_effort batches take up to 10000
+	Mode           TransferBatchMode   `json:"mode"`
+	Owner          string              `json:"owner"`
+	Status         TransferBatchStatus `json:"status"`
+	SucceededItems int32               `json:"succeeded_items"`
+
+	// TotalAmount Decimal amount, requests also accept a JSON number
+	TotalAmount Decimal   `json:"total_amount"`
+	TotalItems  int32     `json:"total_items"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// TransferBatchStatus defines model for TransferBatch.Status.
+type TransferBatchStatus string
+
+// TransferBatchItem defines model for TransferBatchItem.
+type TransferBatchItem struct {
+	// Amount Decimal amount, requests also accept a JSON number
+	Amount      Decimal                 `json:"amount"`
+	BatchId     openapi_types.UUID      `json:"batch_id"`
+	Error       string                  `json:"error"`
+	Id          openapi_types.UUID      `json:"id"`
+	Line        int32                   `json:"line"`
+	ProcessedAt *time.Time              `json:"processed_at"`
+	Status      TransferBatchItemStatus `json:"status"`
+	ToAccountId openapi_types.UUID      `json:"to_account_id"`
+
+	// TransferId null until the item is transferred
+	TransferId *openapi_types.UUID `json:"transfer_id,omitempty"`
+}
+
+// TransferBatchItemStatus defines model for TransferBatchItem.Status.
+type TransferBatchItemStatus string
+
+// TransferBatchItemRequest defines model for TransferBatchItemRequest.
+type TransferBatchItemRequest struct {
+	// Amount Decimal amount, requests also accept a JSON number
+	Amount      Decimal            `json:"amount"`
+	ToAccountId openapi_types.UUID `json:"to_account_id"`
+}
+
+// TransferBatchMode all_or_nothing batches run in one transaction and take at most 500 items, best_effort batches take up to 10000
+type TransferBatchMode string
+
+// TransferLimit defines model for TransferLimit.
+type TransferLimit struct {
+	// AccountId null for a user's limit
+	AccountId *openapi_types.UUID `json:"account_id,omitempty"`
+	CreatedAt time.Time           `json:"created_at"`
+
+	// Currency ISO 4217 code of a supported currency
+	Currency CurrencyCode `json:"currency"`
+
+	// DailyAmount Decimal amount, requests also accept a JSON number
+	DailyAmount *Decimal           `json:"daily_amount,omitempty"`
+	HourlyCount *int32             `json:"hourly_count"`
+	Id          openapi_types.UUID `json:"id"`
+
+	// MaxSingleAmount Decimal amount, requests also accept a JSON number
+	MaxSingleAmount *Decimal `json:"max_single_amount,omitempty"`
+
+	// MonthlyAmount Decimal amount, requests also accept a JSON number
+	MonthlyAmount *Decimal  `json:"monthly_amount,omitempty"`
+	Owner         *string   `json:"owner"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// TransferLimitRequest A limit left out or null isn't enforced
+type TransferLimitRequest struct {
+	// DailyAmount Decimal amount or null, requests also accept a JSON number
+	DailyAmount *NullDecimal `json:"daily_amount"`
+	HourlyCount *int32       `json:"hourly_count"`
+
+	// MaxSingleAmount Decimal amount or null, requests also accept a JSON number
+	MaxSingleAmount *NullDecimal `json:"max_single_amount"`
+
+	// MonthlyAmount Decimal amount or null, requests also accept a JSON number
+	MonthlyAmount *NullDecimal `json:"monthly_amount"`
+}
+
+// TransferResult A transfer made, a cross-currency one also has its quote
+type TransferResult struct {
+	union json.RawMessage
+}
+
+// TransferTxResult defines model for TransferTxResult.
+type TransferTxResult struct {
+	// Fee Decimal amount, requests also accept a JSON number
+	Fee         Decimal  `json:"fee"`
+	FeeEntry    *Entry   `json:"fee_entry,omitempty"`
+	FromAccount Account  `json:"from_account"`
+	FromEntry   Entry    `json:"from_entry"`
+	ToAccount   Account  `json:"to_account"`
+	ToEntry     Entry    `json:"to_entry"`
+	Transfer    Transfer `json:"transfer"`
+}
+
+// UpdateCurrencyRequest defines model for UpdateCurrencyRequest.
+type UpdateCurrencyRequest struct {
+	Enabled bool `json:"enabled"`
+}
+
+// UpdateScheduledTransferRequest Only the fields given are changed
+type UpdateScheduledTransferRequest struct {
+	// Amount Decimal amount or null, requests also accept a JSON number
+	Amount *NullDecimal                          `json:"amount"`
+	EndAt  *time.Time                            `json:"end_at"`
+	Status *UpdateScheduledTransferRequestStatus `json:"status,omitempty"`
+}
+
+// UpdateScheduledTransferRequestStatus defines model for UpdateScheduledTransferRequest.Status.
+type UpdateScheduledTransferRequestStatus string
+
+// User defines model for User.
+type User struct {
+	CreatedAt         time.Time           `json:"created_at"`
+	Email             openapi_types.Email `json:"email"`
+	FullName          string              `json:"full_name"`
+	PasswordChangedAt time.Time           `json:"password_changed_at"`
+	Username          string              `json:"username"`
+}
+
+// UserTier defines model for UserTier.
+type UserTier struct {
+	Tier     Tier   `json:"tier"`
+	Username string `json:"username"`
+}
+
+// Wallet defines model for Wallet.
+type Wallet struct {
+	Accounts []Account `json:"accounts"`
+	Owner    string    `json:"owner"`
+
+	// RatesAsOf When the rates used were published, null when no conversion was needed
+	RatesAsOf *time.Time `json:"rates_as_of,omitempty"`
+
+	// ReportingCurrency ISO 4217 code of a supported currency
+	ReportingCurrency CurrencyCode `json:"reporting_currency"`
+
+	// Total Decimal amount, requests also accept a JSON number
+	Total  Decimal               `json:"total"`
+	Totals []WalletCurrencyTotal `json:"totals"`
+}
+
+// WalletCurrencyTotal defines model for WalletCurrencyTotal.
+type WalletCurrencyTotal struct {
+	// Balance Decimal amount, requests also accept a JSON number
+	Balance Decimal `json:"balance"`
+
+	// Converted Decimal amount, requests also accept a JSON number
+	Converted Decimal `json:"converted"`
+
+	// Currency ISO 4217 code of a supported currency
+	Currency CurrencyCode `json:"currency"`
+
+	// Rate Decimal amount, requests also accept a JSON number
+	Rate Decimal `json:"rate"`
+}
+
+// WorkerStatus defines model for WorkerStatus.
+type WorkerStatus struct {
+	// Failures Runs failed since the last one that succeeded
+	Failures int    `json:"failures"`
+	Healthy  bool   `json:"healthy"`
+	Interval string `json:"interval"`
+
+	// LastError Error of the last run, left out when it succeeded
+	LastError *string `json:"last_error,omitempty"`
+
+	// LastRun When the last run finished, null until the first one does
+	LastRun *time.Time `json:"last_run,omitempty"`
+	Name    string     `json:"name"`
+	Stopped bool       `json:"stopped"`
+}
+
+// ID defines model for ID.
+type ID = openapi_types.UUID
+
+// PageNum defines model for PageNum.
+type PageNum = int32
+
+// PageSize defines model for PageSize.
+type PageSize = int32
+
+// ListAllAccountsParams defines parameters for ListAllAccounts.
+type ListAllAccountsParams struct {
+	PageNum  *PageNum  `form:"page_num,omitempty" json:"page_num,omitempty"`
+	PageSize *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+}
+
+// ListHoldsParams defines parameters for ListHolds.
+type ListHoldsParams struct {
+	PageNum  *PageNum  `form:"page_num,omitempty" json:"page_num,omitempty"`
+	PageSize *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+}
+
+// ListInterestPayoutsParams defines parameters for ListInterestPayouts.
+type ListInterestPayoutsParams struct {
+	PageNum  *PageNum  `form:"page_num,omitempty" json:"page_num,omitempty"`
+	PageSize *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+}
+
+// ListOverdraftChargesParams defines parameters for ListOverdraftCharges.
+type ListOverdraftChargesParams struct {
+	PageNum  *PageNum  `form:"page_num,omitempty" json:"page_num,omitempty"`
+	PageSize *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+}
+
+// ListAccountTransfersParams defines parameters for ListAccountTransfers.
+type ListAccountTransfersParams struct {
+	// Q Matches the description or external reference
+	Q *string `form:"q,omitempty" json:"q,omitempty"`
+
+	// Reference External reference
+	Reference *string `form:"reference,omitempty" json:"reference,omitempty"`
+
+	// Metadata JSON object the transfers' metadata must contain
+	Metadata *string   `form:"metadata,omitempty" json:"metadata,omitempty"`
+	PageNum  *PageNum  `form:"page_num,omitempty" json:"page_num,omitempty"`
+	PageSize *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+}
+
+// GetFeeScheduleParams defines parameters for GetFeeSchedule.
+type GetFeeScheduleParams struct {
+	// Version The current version when left out
+	Version *int32 `form:"version,omitempty" json:"version,omitempty"`
+}
+
+// ListFeeSchedulesParams defines parameters for ListFeeSchedules.
+type ListFeeSchedulesParams struct {
+	PageNum  *PageNum  `form:"page_num,omitempty" json:"page_num,omitempty"`
+	PageSize *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+}
+
+// ListEscrowsParams defines parameters for ListEscrows.
+type ListEscrowsParams struct {
+	PageNum  *PageNum  `form:"page_num,omitempty" json:"page_num,omitempty"`
+	PageSize *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+}
+
+// ListNotificationsParams defines parameters for ListNotifications.
+type ListNotificationsParams struct {
+	UnreadOnly *bool     `form:"unread_only,omitempty" json:"unread_only,omitempty"`
+	PageNum    *PageNum  `form:"page_num,omitempty" json:"page_num,omitempty"`
+	PageSize   *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+}
+
+// ListTransferApprovalsParams defines parameters for ListTransferApprovals.
+type ListTransferApprovalsParams struct {
+	Status   *TransferApprovalStatus `form:"status,omitempty" json:"status,omitempty"`
+	PageNum  *PageNum                `form:"page_num,omitempty" json:"page_num,omitempty"`
+	PageSize *PageSize               `form:"page_size,omitempty" json:"page_size,omitempty"`
+}
+
+// ListPaymentRequestsParams defines parameters for ListPaymentRequests.
+type ListPaymentRequestsParams struct {
+	Direction *ListPaymentRequestsParamsDirection `form:"direction,omitempty" json:"direction,omitempty"`
+	PageNum   *PageNum                            `form:"page_num,omitempty" json:"page_num,omitempty"`
+	PageSize  *PageSize                           `form:"page_size,omitempty" json:"page_size,omitempty"`
+}
+
+// ListPaymentRequestsParamsDirection defines parameters for ListPaymentRequests.
+type ListPaymentRequestsParamsDirection string
+
+// LookupRecipientParams defines parameters for LookupRecipient.
+type LookupRecipientParams struct {
+	// Handle Username, alias or verified email
+	Handle   string       `form:"handle" json:"handle"`
+	Currency CurrencyCode `form:"currency" json:"currency"`
+}
+
+// ListScheduledTransfersParams defines parameters for ListScheduledTransfers.
+type ListScheduledTransfersParams struct {
+	PageNum  *PageNum  `form:"page_num,omitempty" json:"page_num,omitempty"`
+	PageSize *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+}
+
+// ListTransferBatchesParams defines parameters for ListTransferBatches.
+type ListTransferBatchesParams struct {
+	PageNum  *PageNum  `form:"page_num,omitempty" json:"page_num,omitempty"`
+	PageSize *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+}
+
+// CreateTransferBatchParams defines parameters for CreateTransferBatch.
+type CreateTransferBatchParams struct {
+	// FromAccountId Required with a CSV body
+	FromAccountId *openapi_types.UUID `form:"from_account_id,omitempty" json:"from_account_id,omitempty"`
+
+	// Currency Required with a CSV body
+	Currency *CurrencyCode `form:"currency,omitempty" json:"currency,omitempty"`
+
+	// Mode Required with a CSV body
+	Mode *TransferBatchMode `form:"mode,omitempty" json:"mode,omitempty"`
+}
+
+// ListTransferBatchItemsParams defines parameters for ListTransferBatchItems.
+type ListTransferBatchItemsParams struct {
+	PageNum  *PageNum `form:"page_num,omitempty" json:"page_num,omitempty"`
+	PageSize *int32   `form:"page_size,omitempty" json:"page_size,omitempty"`
+}
+
+// GetUserParams defines parameters for GetUser.
+type GetUserParams struct {
+	Username string `form:"username" json:"username"`
+}
+
+// GetWalletParams defines parameters for GetWallet.
+type GetWalletParams struct {
+	// ReportingCurrency Currency the total is converted to, USD when left out
+	ReportingCurrency *CurrencyCode `form:"reporting_currency,omitempty" json:"reporting_currency,omitempty"`
+}
+
+// CreateAccountJSONRequestBody defines body for CreateAccount for application/json ContentType.
+type CreateAccountJSONRequestBody = CreateAccountRequest
+
+// CreateDepositJSONRequestBody defines body for CreateDeposit for application/json ContentType.
+type CreateDepositJSONRequestBody = FundingRequest
+
+// CreateHoldJSONRequestBody defines body for CreateHold for application/json ContentType.
+type CreateHoldJSONRequestBody = CreateHoldRequest
+
+// CreateWithdrawalJSONRequestBody defines body for CreateWithdrawal for application/json ContentType.
+type CreateWithdrawalJSONRequestBody = FundingRequest
+
+// SetInterestProductJSONRequestBody defines body for SetInterestProduct for application/json ContentType.
+type SetInterestProductJSONRequestBody = SetInterestProductRequest
+
+// SetAccountLimitsJSONRequestBody defines body for SetAccountLimits for application/json ContentType.
+type SetAccountLimitsJSONRequestBody = TransferLimitRequest
+
+// SetOverdraftJSONRequestBody defines body for SetOverdraft for application/json ContentType.
+type SetOverdraftJSONRequestBody = SetOverdraftRequest
+
+// UpdateCurrencyJSONRequestBody defines body for UpdateCurrency for application/json ContentType.
+type UpdateCurrencyJSONRequestBody = UpdateCurrencyRequest
+
+// SetFeeScheduleJSONRequestBody defines body for SetFeeSchedule for application/json ContentType.
+type SetFeeScheduleJSONRequestBody = SetFeeScheduleRequest
+
+// CreateInterestProductJSONRequestBody defines body for CreateInterestProduct for application/json ContentType.
+type CreateInterestProductJSONRequestBody = CreateInterestProductRequest
+
+// BackfillInterestJSONRequestBody defines body for BackfillInterest for application/json ContentType.
+type BackfillInterestJSONRequestBody = BackfillInterestRequest
+
+// CreateOrganisationJSONRequestBody defines body for CreateOrganisation for application/json ContentType.
+type CreateOrganisationJSONRequestBody = CreateOrganisationRequest
+
+// AddOrganisationMemberJSONRequestBody defines body for AddOrganisationMember for application/json ContentType.
+type AddOrganisationMemberJSONRequestBody = OrganisationMemberRequest
+
+// SetOrganisationPolicyJSONRequestBody defines body for SetOrganisationPolicy for application/json ContentType.
+type SetOrganisationPolicyJSONRequestBody = OrganisationPolicyRequest
+
+// SetUserLimitsJSONRequestBody defines body for SetUserLimits for application/json ContentType.
+type SetUserLimitsJSONRequestBody = TransferLimitRequest
+
+// SetUserTierJSONRequestBody defines body for SetUserTier for application/json ContentType.
+type SetUserTierJSONRequestBody = SetUserTierRequest
+
+// ApproveTransferJSONRequestBody defines body for ApproveTransfer for application/json ContentType.
+type ApproveTransferJSONRequestBody = DecisionRequest
+
+// CancelTransferApprovalJSONRequestBody defines body for CancelTransferApproval for application/json ContentType.
+type CancelTransferApprovalJSONRequestBody = DecisionRequest
+
+// RejectTransferJSONRequestBody defines body for RejectTransfer for application/json ContentType.
+type RejectTransferJSONRequestBody = DecisionRequest
+
+// CreateEscrowJSONRequestBody defines body for CreateEscrow for application/json ContentType.
+type CreateEscrowJSONRequestBody = CreateEscrowRequest
+
+// ConfirmEscrowJSONRequestBody defines body for ConfirmEscrow for application/json ContentType.
+type ConfirmEscrowJSONRequestBody = EscrowActionRequest
+
+// DisputeEscrowJSONRequestBody defines body for DisputeEscrow for application/json ContentType.
+type DisputeEscrowJSONRequestBody = EscrowActionRequest
+
+// CreateFxQuoteJSONRequestBody defines body for CreateFxQuote for application/json ContentType.
+type CreateFxQuoteJSONRequestBody = CreateFxQuoteRequest
+
+// CaptureHoldJSONRequestBody defines body for CaptureHold for application/json ContentType.
+type CaptureHoldJSONRequestBody = CaptureHoldRequest
+
+// CreatePaymentRequestJSONRequestBody defines body for CreatePaymentRequest for application/json ContentType.
+type CreatePaymentRequestJSONRequestBody = CreatePaymentRequestRequest
+
+// AcceptPaymentRequestJSONRequestBody defines body for AcceptPaymentRequest for application/json ContentType.
+type AcceptPaymentRequestJSONRequestBody = AcceptPaymentRequestRequest
+
+// CreateScheduledTransferJSONRequestBody defines body for CreateScheduledTransfer for application/json ContentType.
+type CreateScheduledTransferJSONRequestBody = CreateScheduledTransferRequest
+
+// UpdateScheduledTransferJSONRequestBody defines body for UpdateScheduledTransfer for application/json ContentType.
+type UpdateScheduledTransferJSONRequestBody = UpdateScheduledTransferRequest
+
+// RefreshTokenJSONRequestBody defines body for RefreshToken for application/json ContentType.
+type RefreshTokenJSONRequestBody = RefreshTokenRequest
+
+// CreateTransferBatchJSONRequestBody defines body for CreateTransferBatch for application/json ContentType.
+type CreateTransferBatchJSONRequestBody = CreateTransferBatchRequest
+
+// CreateTransferJSONRequestBody defines body for CreateTransfer for application/json ContentType.
+type CreateTransferJSONRequestBody = CreateTransferRequest
+
+// QuoteTransferJSONRequestBody defines body for QuoteTransfer for application/json ContentType.
+type QuoteTransferJSONRequestBody = QuoteTransferRequest
+
+// CreateUserJSONRequestBody defines body for CreateUser for application/json ContentType.
+type CreateUserJSONRequestBody = CreateUserRequest
+
+// SetAliasJSONRequestBody defines body for SetAlias for application/json ContentType.
+type SetAliasJSONRequestBody = SetAliasRequest
+
+// LoginUserJSONRequestBody defines body for LoginUser for application/json ContentType.
+type LoginUserJSONRequestBody = LoginUserRequest
+
+// Getter for additional properties for Problem. Returns the specified
+// element and whether it was found
+func (a Problem) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for Problem
+func (a *Problem) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for Problem to handle AdditionalProperties
+func (a *Problem) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["code"]; found {
+		err = json.Unmarshal(raw, &a.Code)
+		if err != nil {
+			return fmt.Errorf("error reading 'code': %w", err)
+		}
+		delete(object, "code")
+	}
+
+	if raw, found := object["detail"]; found {
+		err = json.Unmarshal(raw, &a.Detail)
+		if err != nil {
+			return fmt.Errorf("error reading 'detail': %w", err)
+		}
+		delete(object, "detail")
+	}
+
+	if raw, found := object["instance"]; found {
+		err = json.Unmarshal(raw, &a.Instance)
+		if err != nil {
+			return fmt.Errorf("error reading 'instance': %w", err)
+		}
+		delete(object, "instance")
+	}
+
+	if raw, found := object["status"]; found {
+		err = json.Unmarshal(raw, &a.Status)
+		if err != nil {
+			return fmt.Errorf("error reading 'status': %w", err)
+		}
+		delete(object, "status")
+	}
+
+	if raw, found := object["title"]; found {
+		err = json.Unmarshal(raw, &a.Title)
+		if err != nil {
+			return fmt.Errorf("error reading 'title': %w", err)
+		}
+		delete(object, "title")
+	}
+
+	if raw, found := object["type"]; found {
+		err = json.Unmarshal(raw, &a.Type)
+		if err != nil {
+			return fmt.Errorf("error reading 'type': %w", err)
+		}
+		delete(object, "type")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for Problem to handle AdditionalProperties
+func (a Problem) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	object["code"], err = json.Marshal(a.Code)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'code': %w", err)
+	}
+
+	if a.Detail != nil {
+		object["detail"], err = json.Marshal(a.Detail)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'detail': %w", err)
+		}
+	}
+
+	if a.Instance != nil {
+		object["instance"], err = json.Marshal(a.Instance)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'instance': %w", err)
+		}
+	}
+
+	object["status"], err = json.Marshal(a.Status)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'status': %w", err)
+	}
+
+	object["title"], err = json.Marshal(a.Title)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'title': %w", err)
+	}
+
+	object["type"], err = json.Marshal(a.Type)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'type': %w", err)
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// AsTransferApprovalTxResult returns the union data inside the AcceptedTransfer as a TransferApprovalTxResult
+func (t AcceptedTransfer) AsTransferApprovalTxResult() (TransferApprovalTxResult, error) {
+	var body TransferApprovalTxResult
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromTransferApprovalTxResult overwrites any union data inside the AcceptedTransfer as the provided TransferApprovalTxResult
+func (t *AcceptedTransfer) FromTransferApprovalTxResult(v TransferApprovalTxResult) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeTransferApprovalTxResult performs a merge with any union data inside the AcceptedTransfer, using the provided TransferApprovalTxResult
+func (t *AcceptedTransfer) MergeTransferApprovalTxResult(v TransferApprovalTxResult) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsTransfer returns the union data inside the AcceptedTransfer as a Transfer
+func (t AcceptedTransfer) AsTransfer() (Transfer, error) {
+	var body Transfer
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromTransfer overwrites any union data inside the AcceptedTransfer as the provided Transfer
+func (t *AcceptedTransfer) FromTransfer(v Transfer) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeTransfer performs a merge with any union data inside the AcceptedTransfer, using the provided Transfer
+func (t *AcceptedTransfer) MergeTransfer(v Transfer) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t AcceptedTransfer) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *AcceptedTransfer) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsTransferTxResult returns the union data inside the TransferResult as a TransferTxResult
+func (t TransferResult) AsTransferTxResult() (TransferTxResult, error) {
+	var body TransferTxResult
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromTransferTxResult overwrites any union data inside the TransferResult as the provided TransferTxResult
+func (t *TransferResult) FromTransferTxResult(v TransferTxResult) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeTransferTxResult performs a merge with any union data inside the TransferResult, using the provided TransferTxResult
+func (t *TransferResult) MergeTransferTxResult(v TransferTxResult) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsFxTransferTxResult returns the union data inside the TransferResult as a FxTransferTxResult
+func (t TransferResult) AsFxTransferTxResult() (FxTransferTxResult, error) {
+	var body FxTransferTxResult
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromFxTransferTxResult overwrites any union data inside the TransferResult as the provided FxTransferTxResult
+func (t *TransferResult) FromFxTransferTxResult(v FxTransferTxResult) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeFxTransferTxResult performs a merge with any union data inside the TransferResult, using the provided FxTransferTxResult
+func (t *TransferResult) MergeFxTransferTxResult(v FxTransferTxResult) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t TransferResult) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *TransferResult) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// Welcome request
+	Welcome(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListAllAccounts request
+	ListAllAccounts(ctx context.Context, params *ListAllAccountsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateAccountWithBody request with any body
+	CreateAccountWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateAccount(ctx context.Context, body CreateAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAccountById request
+	GetAccountById(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateDepositWithBody request with any body
+	CreateDepositWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateDeposit(ctx context.Context, id ID, body CreateDepositJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetFundingTransaction request
+	GetFundingTransaction(ctx context.Context, id ID, fundingId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListHolds request
+	ListHolds(ctx context.Context, id ID, params *ListHoldsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateHoldWithBody request with any body
+	CreateHoldWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateHold(ctx context.Context, id ID, body CreateHoldJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListInterestPayouts request
+	ListInterestPayouts(ctx context.Context, id ID, params *ListInterestPayoutsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAccountLimits request
+	GetAccountLimits(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListOverdraftCharges request
+	ListOverdraftCharges(ctx context.Context, id ID, params *ListOverdraftChargesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListAccountTransfers request
+	ListAccountTransfers(ctx context.Context, id ID, params *ListAccountTransfersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateWithdrawalWithBody request with any body
+	CreateWithdrawalWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateWithdrawal(ctx context.Context, id ID, body CreateWithdrawalJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetInterestProductWithBody request with any body
+	SetInterestProductWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetInterestProduct(ctx context.Context, id ID, body SetInterestProductJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetAccountLimitsWithBody request with any body
+	SetAccountLimitsWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetAccountLimits(ctx context.Context, id ID, body SetAccountLimitsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetOverdraftWithBody request with any body
+	SetOverdraftWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetOverdraft(ctx context.Context, id ID, body SetOverdraftJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListCurrencies request
+	ListCurrencies(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateCurrencyWithBody request with any body
+	UpdateCurrencyWithBody(ctx context.Context, code string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateCurrency(ctx context.Context, code string, body UpdateCurrencyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetFeeSchedule request
+	GetFeeSchedule(ctx context.Context, currency CurrencyCode, params *GetFeeScheduleParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetFeeScheduleWithBody request with any body
+	SetFeeScheduleWithBody(ctx context.Context, currency CurrencyCode, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetFeeSchedule(ctx context.Context, currency CurrencyCode, body SetFeeScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListFeeSchedules request
+	ListFeeSchedules(ctx context.Context, currency CurrencyCode, params *ListFeeSchedulesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListInterestProducts request
+	ListInterestProducts(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateInterestProductWithBody request with any body
+	CreateInterestProductWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateInterestProduct(ctx context.Context, body CreateInterestProductJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// BackfillInterestWithBody request with any body
+	BackfillInterestWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	BackfillInterest(ctx context.Context, body BackfillInterestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteTransferLimit request
+	DeleteTransferLimit(ctx context.Context, limitId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetMetrics request
+	GetMetrics(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateOrganisationWithBody request with any body
+	CreateOrganisationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateOrganisation(ctx context.Context, body CreateOrganisationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LinkOrganisationAccount request
+	LinkOrganisationAccount(ctx context.Context, id ID, accountId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RemoveOrganisationMember request
+	RemoveOrganisationMember(ctx context.Context, id ID, username string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddOrganisationMemberWithBody request with any body
+	AddOrganisationMemberWithBody(ctx context.Context, id ID, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AddOrganisationMember(ctx context.Context, id ID, username string, body AddOrganisationMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetOrganisationPolicyWithBody request with any body
+	SetOrganisationPolicyWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetOrganisationPolicy(ctx context.Context, id ID, body SetOrganisationPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetStatus request
+	GetStatus(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReverseTransfer request
+	ReverseTransfer(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetUserLimitsWithBody request with any body
+	SetUserLimitsWithBody(ctx context.Context, username string, currency CurrencyCode, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetUserLimits(ctx context.Context, username string, currency CurrencyCode, body SetUserLimitsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetUserTierWithBody request with any body
+	SetUserTierWithBody(ctx context.Context, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetUserTier(ctx context.Context, username string, body SetUserTierJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// VerifyUserEmail request
+	VerifyUserEmail(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTransferApproval request
+	GetTransferApproval(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ApproveTransferWithBody request with any body
+	ApproveTransferWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ApproveTransfer(ctx context.Context, id ID, body ApproveTransferJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CancelTransferApprovalWithBody request with any body
+	CancelTransferApprovalWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CancelTransferApproval(ctx context.Context, id ID, body CancelTransferApprovalJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RejectTransferWithBody request with any body
+	RejectTransferWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RejectTransfer(ctx context.Context, id ID, body RejectTransferJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListEnabledCurrencies request
+	ListEnabledCurrencies(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDocs request
+	GetDocs(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDocsAsset request
+	GetDocsAsset(ctx context.Context, asset string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListEscrows request
+	ListEscrows(ctx context.Context, params *ListEscrowsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateEscrowWithBody request with any body
+	CreateEscrowWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateEscrow(ctx context.Context, body CreateEscrowJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetEscrow request
+	GetEscrow(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ConfirmEscrowWithBody request with any body
+	ConfirmEscrowWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ConfirmEscrow(ctx context.Context, id ID, body ConfirmEscrowJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DisputeEscrowWithBody request with any body
+	DisputeEscrowWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DisputeEscrow(ctx context.Context, id ID, body DisputeEscrowJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateFxQuoteWithBody request with any body
+	CreateFxQuoteWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateFxQuote(ctx context.Context, body CreateFxQuoteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Healthz request
+	Healthz(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetHold request
+	GetHold(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CaptureHoldWithBody request with any body
+	CaptureHoldWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CaptureHold(ctx context.Context, id ID, body CaptureHoldJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReleaseHold request
+	ReleaseHold(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListNotifications request
+	ListNotifications(ctx context.Context, params *ListNotificationsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReadNotification request
+	ReadNotification(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetOpenAPI request
+	GetOpenAPI(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListOrganisations request
+	ListOrganisations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListTransferApprovals request
+	ListTransferApprovals(ctx context.Context, id ID, params *ListTransferApprovalsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListOrganisationMembers request
+	ListOrganisationMembers(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListPaymentRequests request
+	ListPaymentRequests(ctx context.Context, params *ListPaymentRequestsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreatePaymentRequestWithBody request with any body
+	CreatePaymentRequestWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreatePaymentRequest(ctx context.Context, body CreatePaymentRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AcceptPaymentRequestWithBody request with any body
+	AcceptPaymentRequestWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AcceptPaymentRequest(ctx context.Context, id ID, body AcceptPaymentRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeclinePaymentRequest request
+	DeclinePaymentRequest(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Readyz request
+	Readyz(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LookupRecipient request
+	LookupRecipient(ctx context.Context, params *LookupRecipientParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListScheduledTransfers request
+	ListScheduledTransfers(ctx context.Context, params *ListScheduledTransfersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateScheduledTransferWithBody request with any body
+	CreateScheduledTransferWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateScheduledTransfer(ctx context.Context, body CreateScheduledTransferJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CancelScheduledTransfer request
+	CancelScheduledTransfer(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetScheduledTransfer request
+	GetScheduledTransfer(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateScheduledTransferWithBody request with any body
+	UpdateScheduledTransferWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateScheduledTransfer(ctx context.Context, id ID, body UpdateScheduledTransferJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RefreshTokenWithBody request with any body
+	RefreshTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RefreshToken(ctx context.Context, body RefreshTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListTransferBatches request
+	ListTransferBatches(ctx context.Context, params *ListTransferBatchesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateTransferBatchWithBody request with any body
+	CreateTransferBatchWithBody(ctx context.Context, params *CreateTransferBatchParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateTransferBatch(ctx context.Context, params *CreateTransferBatchParams, body CreateTransferBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTransferBatch request
+	GetTransferBatch(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListTransferBatchItems request
+	ListTransferBatchItems(ctx context.Context, id ID, params *ListTransferBatchItemsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateTransferWithBody request with any body
+	CreateTransferWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateTransfer(ctx context.Context, body CreateTransferJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// QuoteTransferWithBody request with any body
+	QuoteTransferWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	QuoteTransfer(ctx context.Context, body QuoteTransferJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTransfer request
+	GetTransfer(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUser request
+	GetUser(ctx context.Context, params *GetUserParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateUserWithBody request with any body
+	CreateUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateUser(ctx context.Context, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAlias request
+	DeleteAlias(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetAliasWithBody request with any body
+	SetAliasWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetAlias(ctx context.Context, body SetAliasJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWallet request
+	GetWallet(ctx context.Context, params *GetWalletParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAllUsers request
+	GetAllUsers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LoginUserWithBody request with any body
+	LoginUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	LoginUser(ctx context.Context, body LoginUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) Welcome(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWelcomeRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListAllAccounts(ctx context.Context, params *ListAllAccountsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListAllAccountsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateAccountWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateAccountRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateAccount(ctx context.Context, body CreateAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateAccountRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAccountById(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAccountByIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateDepositWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDepositRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateDeposit(ctx context.Context, id ID, body CreateDepositJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDepositRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetFundingTransaction(ctx context.Context, id ID, fundingId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetFundingTransactionRequest(c.Server, id, fundingId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListHolds(ctx context.Context, id ID, params *ListHoldsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListHoldsRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateHoldWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateHoldRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateHold(ctx context.Context, id ID, body CreateHoldJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateHoldRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListInterestPayouts(ctx context.Context, id ID, params *ListInterestPayoutsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListInterestPayoutsRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAccountLimits(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAccountLimitsRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListOverdraftCharges(ctx context.Context, id ID, params *ListOverdraftChargesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListOverdraftChargesRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListAccountTransfers(ctx context.Context, id ID, params *ListAccountTransfersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListAccountTransfersRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateWithdrawalWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateWithdrawalRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateWithdrawal(ctx context.Context, id ID, body CreateWithdrawalJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateWithdrawalRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetInterestProductWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetInterestProductRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetInterestProduct(ctx context.Context, id ID, body SetInterestProductJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetInterestProductRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetAccountLimitsWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetAccountLimitsRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetAccountLimits(ctx context.Context, id ID, body SetAccountLimitsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetAccountLimitsRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetOverdraftWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetOverdraftRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetOverdraft(ctx context.Context, id ID, body SetOverdraftJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetOverdraftRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListCurrencies(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListCurrenciesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateCurrencyWithBody(ctx context.Context, code string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateCurrencyRequestWithBody(c.Server, code, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateCurrency(ctx context.Context, code string, body UpdateCurrencyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateCurrencyRequest(c.Server, code, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetFeeSchedule(ctx context.Context, currency CurrencyCode, params *GetFeeScheduleParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetFeeScheduleRequest(c.Server, currency, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetFeeScheduleWithBody(ctx context.Context, currency CurrencyCode, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetFeeScheduleRequestWithBody(c.Server, currency, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetFeeSchedule(ctx context.Context, currency CurrencyCode, body SetFeeScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetFeeScheduleRequest(c.Server, currency, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListFeeSchedules(ctx context.Context, currency CurrencyCode, params *ListFeeSchedulesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListFeeSchedulesRequest(c.Server, currency, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListInterestProducts(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListInterestProductsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateInterestProductWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateInterestProductRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateInterestProduct(ctx context.Context, body CreateInterestProductJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateInterestProductRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) BackfillInterestWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBackfillInterestRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) BackfillInterest(ctx context.Context, body BackfillInterestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBackfillInterestRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteTransferLimit(ctx context.Context, limitId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteTransferLimitRequest(c.Server, limitId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetMetrics(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMetricsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateOrganisationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateOrganisationRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateOrganisation(ctx context.Context, body CreateOrganisationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateOrganisationRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) LinkOrganisationAccount(ctx context.Context, id ID, accountId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLinkOrganisationAccountRequest(c.Server, id, accountId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RemoveOrganisationMember(ctx context.Context, id ID, username string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemoveOrganisationMemberRequest(c.Server, id, username)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AddOrganisationMemberWithBody(ctx context.Context, id ID, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddOrganisationMemberRequestWithBody(c.Server, id, username, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AddOrganisationMember(ctx context.Context, id ID, username string, body AddOrganisationMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddOrganisationMemberRequest(c.Server, id, username, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetOrganisationPolicyWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetOrganisationPolicyRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetOrganisationPolicy(ctx context.Context, id ID, body SetOrganisationPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetOrganisationPolicyRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetStatus(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetStatusRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReverseTransfer(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReverseTransferRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetUserLimitsWithBody(ctx context.Context, username string, currency CurrencyCode, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetUserLimitsRequestWithBody(c.Server, username, currency, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetUserLimits(ctx context.Context, username string, currency CurrencyCode, body SetUserLimitsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetUserLimitsRequest(c.Server, username, currency, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetUserTierWithBody(ctx context.Context, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetUserTierRequestWithBody(c.Server, username, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetUserTier(ctx context.Context, username string, body SetUserTierJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetUserTierRequest(c.Server, username, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) VerifyUserEmail(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVerifyUserEmailRequest(c.Server, username)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTransferApproval(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTransferApprovalRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ApproveTransferWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApproveTransferRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ApproveTransfer(ctx context.Context, id ID, body ApproveTransferJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApproveTransferRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CancelTransferApprovalWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCancelTransferApprovalRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CancelTransferApproval(ctx context.Context, id ID, body CancelTransferApprovalJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCancelTransferApprovalRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RejectTransferWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRejectTransferRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RejectTransfer(ctx context.Context, id ID, body RejectTransferJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRejectTransferRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListEnabledCurrencies(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListEnabledCurrenciesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetDocs(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDocsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetDocsAsset(ctx context.Context, asset string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDocsAssetRequest(c.Server, asset)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListEscrows(ctx context.Context, params *ListEscrowsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListEscrowsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateEscrowWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateEscrowRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateEscrow(ctx context.Context, body CreateEscrowJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateEscrowRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetEscrow(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEscrowRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ConfirmEscrowWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConfirmEscrowRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ConfirmEscrow(ctx context.Context, id ID, body ConfirmEscrowJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConfirmEscrowRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DisputeEscrowWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDisputeEscrowRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DisputeEscrow(ctx context.Context, id ID, body DisputeEscrowJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDisputeEscrowRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateFxQuoteWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateFxQuoteRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateFxQuote(ctx context.Context, body CreateFxQuoteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateFxQuoteRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Healthz(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHealthzRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetHold(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetHoldRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CaptureHoldWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCaptureHoldRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CaptureHold(ctx context.Context, id ID, body CaptureHoldJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCaptureHoldRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReleaseHold(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReleaseHoldRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListNotifications(ctx context.Context, params *ListNotificationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListNotificationsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReadNotification(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReadNotificationRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetOpenAPI(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOpenAPIRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListOrganisations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListOrganisationsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListTransferApprovals(ctx context.Context, id ID, params *ListTransferApprovalsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListTransferApprovalsRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListOrganisationMembers(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListOrganisationMembersRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListPaymentRequests(ctx context.Context, params *ListPaymentRequestsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListPaymentRequestsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreatePaymentRequestWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePaymentRequestRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreatePaymentRequest(ctx context.Context, body CreatePaymentRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePaymentRequestRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AcceptPaymentRequestWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAcceptPaymentRequestRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AcceptPaymentRequest(ctx context.Context, id ID, body AcceptPaymentRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAcceptPaymentRequestRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeclinePaymentRequest(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeclinePaymentRequestRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Readyz(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReadyzRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) LookupRecipient(ctx context.Context, params *LookupRecipientParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLookupRecipientRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListScheduledTransfers(ctx context.Context, params *ListScheduledTransfersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListScheduledTransfersRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateScheduledTransferWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateScheduledTransferRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateScheduledTransfer(ctx context.Context, body CreateScheduledTransferJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateScheduledTransferRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CancelScheduledTransfer(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCancelScheduledTransferRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetScheduledTransfer(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetScheduledTransferRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateScheduledTransferWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateScheduledTransferRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateScheduledTransfer(ctx context.Context, id ID, body UpdateScheduledTransferJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateScheduledTransferRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RefreshTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRefreshTokenRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RefreshToken(ctx context.Context, body RefreshTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRefreshTokenRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListTransferBatches(ctx context.Context, params *ListTransferBatchesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListTransferBatchesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateTransferBatchWithBody(ctx context.Context, params *CreateTransferBatchParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTransferBatchRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateTransferBatch(ctx context.Context, params *CreateTransferBatchParams, body CreateTransferBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTransferBatchRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTransferBatch(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTransferBatchRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListTransferBatchItems(ctx context.Context, id ID, params *ListTransferBatchItemsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListTransferBatchItemsRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateTransferWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTransferRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateTransfer(ctx context.Context, body CreateTransferJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTransferRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) QuoteTransferWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewQuoteTransferRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) QuoteTransfer(ctx context.Context, body QuoteTransferJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewQuoteTransferRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTransfer(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTransferRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUser(ctx context.Context, params *GetUserParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUserRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateUserRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateUser(ctx context.Context, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateUserRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteAlias(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAliasRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetAliasWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetAliasRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetAlias(ctx context.Context, body SetAliasJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetAliasRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetWallet(ctx context.Context, params *GetWalletParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWalletRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAllUsers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAllUsersRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) LoginUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLoginUserRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) LoginUser(ctx context.Context, body LoginUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLoginUserRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewWelcomeRequest generates requests for Welcome
+func NewWelcomeRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListAllAccountsRequest generates requests for ListAllAccounts
+func NewListAllAccountsRequest(server string, params *ListAllAccountsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/accounts")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.PageNum != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_num", runtime.ParamLocationQuery, *params.PageNum); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_size", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateAccountRequest calls the generic CreateAccount builder with application/json body
+func NewCreateAccountRequest(server string, body CreateAccountJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateAccountRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateAccountRequestWithBody generates requests for CreateAccount with any type of body
+func NewCreateAccountRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/accounts")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetAccountByIdRequest generates requests for GetAccountById
+func NewGetAccountByIdRequest(server string, id ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/accounts/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateDepositRequest calls the generic CreateDeposit builder with application/json body
+func NewCreateDepositRequest(server string, id ID, body CreateDepositJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateDepositRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewCreateDepositRequestWithBody generates requests for CreateDeposit with any type of body
+func NewCreateDepositRequestWithBody(server string, id ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/accounts/%s/deposits", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetFundingTransactionRequest generates requests for GetFundingTransaction
+func NewGetFundingTransactionRequest(server string, id ID, fundingId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "funding_id", runtime.ParamLocationPath, fundingId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/accounts/%s/funding/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListHoldsRequest generates requests for ListHolds
+func NewListHoldsRequest(server string, id ID, params *ListHoldsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/accounts/%s/holds", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.PageNum != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_num", runtime.ParamLocationQuery, *params.PageNum); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_size", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateHoldRequest calls the generic CreateHold builder with application/json body
+func NewCreateHoldRequest(server string, id ID, body CreateHoldJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateHoldRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewCreateHoldRequestWithBody generates requests for CreateHold with any type of body
+func NewCreateHoldRequestWithBody(server string, id ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/accounts/%s/holds", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListInterestPayoutsRequest generates requests for ListInterestPayouts
+func NewListInterestPayoutsRequest(server string, id ID, params *ListInterestPayoutsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/accounts/%s/interest-payouts", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.PageNum != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_num", runtime.ParamLocationQuery, *params.PageNum); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_size", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAccountLimitsRequest generates requests for GetAccountLimits
+func NewGetAccountLimitsRequest(server string, id ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/accounts/%s/limits", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListOverdraftChargesRequest generates requests for ListOverdraftCharges
+func NewListOverdraftChargesRequest(server string, id ID, params *ListOverdraftChargesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/accounts/%s/overdraft-charges", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.PageNum != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_num", runtime.ParamLocationQuery, *params.PageNum); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_size", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListAccountTransfersRequest generates requests for ListAccountTransfers
+func NewListAccountTransfersRequest(server string, id ID, params *ListAccountTransfersParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/accounts/%s/transfers", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Q != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "q", runtime.ParamLocationQuery, *params.Q); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Reference != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "reference", runtime.ParamLocationQuery, *params.Reference); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Metadata != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "metadata", runtime.ParamLocationQuery, *params.Metadata); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageNum != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_num", runtime.ParamLocationQuery, *params.PageNum); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_size", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateWithdrawalRequest calls the generic CreateWithdrawal builder with application/json body
+func NewCreateWithdrawalRequest(server string, id ID, body CreateWithdrawalJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateWithdrawalRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewCreateWithdrawalRequestWithBody generates requests for CreateWithdrawal with any type of body
+func NewCreateWithdrawalRequestWithBody(server string, id ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/accounts/%s/withdrawals", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSetInterestProductRequest calls the generic SetInterestProduct builder with application/json body
+func NewSetInterestProductRequest(server string, id ID, body SetInterestProductJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetInterestProductRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewSetInterestProductRequestWithBody generates requests for SetInterestProduct with any type of body
+func NewSetInterestProductRequestWithBody(server string, id ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/accounts/%s/interest-product", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSetAccountLimitsRequest calls the generic SetAccountLimits builder with application/json body
+func NewSetAccountLimitsRequest(server string, id ID, body SetAccountLimitsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetAccountLimitsRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewSetAccountLimitsRequestWithBody generates requests for SetAccountLimits with any type of body
+func NewSetAccountLimitsRequestWithBody(server string, id ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/accounts/%s/limits", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSetOverdraftRequest calls the generic SetOverdraft builder with application/json body
+func NewSetOverdraftRequest(server string, id ID, body SetOverdraftJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetOverdraftRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewSetOverdraftRequestWithBody generates requests for SetOverdraft with any type of body
+func NewSetOverdraftRequestWithBody(server string, id ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/accounts/%s/overdraft", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListCurrenciesRequest generates requests for ListCurrencies
+func NewListCurrenciesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/currencies")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateCurrencyRequest calls the generic UpdateCurrency builder with application/json body
+func NewUpdateCurrencyRequest(server string, code string, body UpdateCurrencyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateCurrencyRequestWithBody(server, code, "application/json", bodyReader)
+}
+
+// NewUpdateCurrencyRequestWithBody generates requests for UpdateCurrency with any type of body
+func NewUpdateCurrencyRequestWithBody(server string, code string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "code", runtime.ParamLocationPath, code)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/currencies/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetFeeScheduleRequest generates requests for GetFeeSchedule
+func NewGetFeeScheduleRequest(server string, currency CurrencyCode, params *GetFeeScheduleParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "currency", runtime.ParamLocationPath, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/fees/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Version != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "version", runtime.ParamLocationQuery, *params.Version); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSetFeeScheduleRequest calls the generic SetFeeSchedule builder with application/json body
+func NewSetFeeScheduleRequest(server string, currency CurrencyCode, body SetFeeScheduleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetFeeScheduleRequestWithBody(server, currency, "application/json", bodyReader)
+}
+
+// NewSetFeeScheduleRequestWithBody generates requests for SetFeeSchedule with any type of body
+func NewSetFeeScheduleRequestWithBody(server string, currency CurrencyCode, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "currency", runtime.ParamLocationPath, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/fees/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListFeeSchedulesRequest generates requests for ListFeeSchedules
+func NewListFeeSchedulesRequest(server string, currency CurrencyCode, params *ListFeeSchedulesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "currency", runtime.ParamLocationPath, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/fees/%s/versions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.PageNum != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_num", runtime.ParamLocationQuery, *params.PageNum); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_size", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListInterestProductsRequest generates requests for ListInterestProducts
+func NewListInterestProductsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/interest-products")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateInterestProductRequest calls the generic CreateInterestProduct builder with application/json body
+func NewCreateInterestProductRequest(server string, body CreateInterestProductJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateInterestProductRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateInterestProductRequestWithBody generates requests for CreateInterestProduct with any type of body
+func NewCreateInterestProductRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/interest-products")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewBackfillInterestRequest calls the generic BackfillInterest builder with application/json body
+func NewBackfillInterestRequest(server string, body BackfillInterestJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewBackfillInterestRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewBackfillInterestRequestWithBody generates requests for BackfillInterest with any type of body
+func NewBackfillInterestRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/interest/backfill")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteTransferLimitRequest generates requests for DeleteTransferLimit
+func NewDeleteTransferLimitRequest(server string, limitId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "limit_id", runtime.ParamLocationPath, limitId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/limits/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetMetricsRequest generates requests for GetMetrics
+func NewGetMetricsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/metrics")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateOrganisationRequest calls the generic CreateOrganisation builder with application/json body
+func NewCreateOrganisationRequest(server string, body CreateOrganisationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateOrganisationRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateOrganisationRequestWithBody generates requests for CreateOrganisation with any type of body
+func NewCreateOrganisationRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/organisations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewLinkOrganisationAccountRequest generates requests for LinkOrganisationAccount
+func NewLinkOrganisationAccountRequest(server string, id ID, accountId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "account_id", runtime.ParamLocationPath, accountId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/organisations/%s/accounts/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRemoveOrganisationMemberRequest generates requests for RemoveOrganisationMember
+func NewRemoveOrganisationMemberRequest(server string, id ID, username string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/organisations/%s/members/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddOrganisationMemberRequest calls the generic AddOrganisationMember builder with application/json body
+func NewAddOrganisationMemberRequest(server string, id ID, username string, body AddOrganisationMemberJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddOrganisationMemberRequestWithBody(server, id, username, "application/json", bodyReader)
+}
+
+// NewAddOrganisationMemberRequestWithBody generates requests for AddOrganisationMember with any type of body
+func NewAddOrganisationMemberRequestWithBody(server string, id ID, username string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/organisations/%s/members/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSetOrganisationPolicyRequest calls the generic SetOrganisationPolicy builder with application/json body
+func NewSetOrganisationPolicyRequest(server string, id ID, body SetOrganisationPolicyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetOrganisationPolicyRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewSetOrganisationPolicyRequestWithBody generates requests for SetOrganisationPolicy with any type of body
+func NewSetOrganisationPolicyRequestWithBody(server string, id ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/organisations/%s/policy", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetStatusRequest generates requests for GetStatus
+func NewGetStatusRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/status")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewReverseTransferRequest generates requests for ReverseTransfer
+func NewReverseTransferRequest(server string, id ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/transfers/%s/reverse", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSetUserLimitsRequest calls the generic SetUserLimits builder with application/json body
+func NewSetUserLimitsRequest(server string, username string, currency CurrencyCode, body SetUserLimitsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetUserLimitsRequestWithBody(server, username, currency, "application/json", bodyReader)
+}
+
+// NewSetUserLimitsRequestWithBody generates requests for SetUserLimits with any type of body
+func NewSetUserLimitsRequestWithBody(server string, username string, currency CurrencyCode, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "currency", runtime.ParamLocationPath, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/users/%s/limits/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSetUserTierRequest calls the generic SetUserTier builder with application/json body
+func NewSetUserTierRequest(server string, username string, body SetUserTierJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetUserTierRequestWithBody(server, username, "application/json", bodyReader)
+}
+
+// NewSetUserTierRequestWithBody generates requests for SetUserTier with any type of body
+func NewSetUserTierRequestWithBody(server string, username string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/users/%s/tier", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewVerifyUserEmailRequest generates requests for VerifyUserEmail
+func NewVerifyUserEmailRequest(server string, username string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/users/%s/verify-email", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTransferApprovalRequest generates requests for GetTransferApproval
+func NewGetTransferApprovalRequest(server string, id ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/approvals/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewApproveTransferRequest calls the generic ApproveTransfer builder with application/json body
+func NewApproveTransferRequest(server string, id ID, body ApproveTransferJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewApproveTransferRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewApproveTransferRequestWithBody generates requests for ApproveTransfer with any type of body
+func NewApproveTransferRequestWithBody(server string, id ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/approvals/%s/approve", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCancelTransferApprovalRequest calls the generic CancelTransferApproval builder with application/json body
+func NewCancelTransferApprovalRequest(server string, id ID, body CancelTransferApprovalJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCancelTransferApprovalRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewCancelTransferApprovalRequestWithBody generates requests for CancelTransferApproval with any type of body
+func NewCancelTransferApprovalRequestWithBody(server string, id ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/approvals/%s/cancel", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRejectTransferRequest calls the generic RejectTransfer builder with application/json body
+func NewRejectTransferRequest(server string, id ID, body RejectTransferJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRejectTransferRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewRejectTransferRequestWithBody generates requests for RejectTransfer with any type of body
+func NewRejectTransferRequestWithBody(server string, id ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/approvals/%s/reject", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListEnabledCurrenciesRequest generates requests for ListEnabledCurrencies
+func NewListEnabledCurrenciesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/currencies")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetDocsRequest generates requests for GetDocs
+func NewGetDocsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/docs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetDocsAssetRequest generates requests for GetDocsAsset
+func NewGetDocsAssetRequest(server string, asset string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "asset", runtime.ParamLocationPath, asset)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/docs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListEscrowsRequest generates requests for ListEscrows
+func NewListEscrowsRequest(server string, params *ListEscrowsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/escrows")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.PageNum != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_num", runtime.ParamLocationQuery, *params.PageNum); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_size", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateEscrowRequest calls the generic CreateEscrow builder with application/json body
+func NewCreateEscrowRequest(server string, body CreateEscrowJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateEscrowRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateEscrowRequestWithBody generates requests for CreateEscrow with any type of body
+func NewCreateEscrowRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/escrows")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetEscrowRequest generates requests for GetEscrow
+func NewGetEscrowRequest(server string, id ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/escrows/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewConfirmEscrowRequest calls the generic ConfirmEscrow builder with application/json body
+func NewConfirmEscrowRequest(server string, id ID, body ConfirmEscrowJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewConfirmEscrowRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewConfirmEscrowRequestWithBody generates requests for ConfirmEscrow with any type of body
+func NewConfirmEscrowRequestWithBody(server string, id ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/escrows/%s/confirm", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDisputeEscrowRequest calls the generic DisputeEscrow builder with application/json body
+func NewDisputeEscrowRequest(server string, id ID, body DisputeEscrowJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDisputeEscrowRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewDisputeEscrowRequestWithBody generates requests for DisputeEscrow with any type of body
+func NewDisputeEscrowRequestWithBody(server string, id ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/escrows/%s/dispute", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCreateFxQuoteRequest calls the generic CreateFxQuote builder with application/json body
+func NewCreateFxQuoteRequest(server string, body CreateFxQuoteJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateFxQuoteRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateFxQuoteRequestWithBody generates requests for CreateFxQuote with any type of body
+func NewCreateFxQuoteRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/fx/quotes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewHealthzRequest generates requests for Healthz
+func NewHealthzRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/healthz")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetHoldRequest generates requests for GetHold
+func NewGetHoldRequest(server string, id ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/holds/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCaptureHoldRequest calls the generic CaptureHold builder with application/json body
+func NewCaptureHoldRequest(server string, id ID, body CaptureHoldJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCaptureHoldRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewCaptureHoldRequestWithBody generates requests for CaptureHold with any type of body
+func NewCaptureHoldRequestWithBody(server string, id ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/holds/%s/capture", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewReleaseHoldRequest generates requests for ReleaseHold
+func NewReleaseHoldRequest(server string, id ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/holds/%s/release", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListNotificationsRequest generates requests for ListNotifications
+func NewListNotificationsRequest(server string, params *ListNotificationsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/notifications")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.UnreadOnly != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "unread_only", runtime.ParamLocationQuery, *params.UnreadOnly); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageNum != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_num", runtime.ParamLocationQuery, *params.PageNum); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_size", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewReadNotificationRequest generates requests for ReadNotification
+func NewReadNotificationRequest(server string, id ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/notifications/%s/read", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetOpenAPIRequest generates requests for GetOpenAPI
+func NewGetOpenAPIRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/openapi.json")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListOrganisationsRequest generates requests for ListOrganisations
+func NewListOrganisationsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/organisations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListTransferApprovalsRequest generates requests for ListTransferApprovals
+func NewListTransferApprovalsRequest(server string, id ID, params *ListTransferApprovalsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/organisations/%s/approvals", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageNum != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_num", runtime.ParamLocationQuery, *params.PageNum); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_size", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListOrganisationMembersRequest generates requests for ListOrganisationMembers
+func NewListOrganisationMembersRequest(server string, id ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/organisations/%s/members", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListPaymentRequestsRequest generates requests for ListPaymentRequests
+func NewListPaymentRequestsRequest(server string, params *ListPaymentRequestsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/payment-requests")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Direction != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "direction", runtime.ParamLocationQuery, *params.Direction); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageNum != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_num", runtime.ParamLocationQuery, *params.PageNum); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_size", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreatePaymentRequestRequest calls the generic CreatePaymentRequest builder with application/json body
+func NewCreatePaymentRequestRequest(server string, body CreatePaymentRequestJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreatePaymentRequestRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreatePaymentRequestRequestWithBody generates requests for CreatePaymentRequest with any type of body
+func NewCreatePaymentRequestRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/payment-requests")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAcceptPaymentRequestRequest calls the generic AcceptPaymentRequest builder with application/json body
+func NewAcceptPaymentRequestRequest(server string, id ID, body AcceptPaymentRequestJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAcceptPaymentRequestRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewAcceptPaymentRequestRequestWithBody generates requests for AcceptPaymentRequest with any type of body
+func NewAcceptPaymentRequestRequestWithBody(server string, id ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/payment-requests/%s/accept", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeclinePaymentRequestRequest generates requests for DeclinePaymentRequest
+func NewDeclinePaymentRequestRequest(server string, id ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/payment-requests/%s/decline", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewReadyzRequest generates requests for Readyz
+func NewReadyzRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/readyz")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewLookupRecipientRequest generates requests for LookupRecipient
+func NewLookupRecipientRequest(server string, params *LookupRecipientParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/recipients")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "handle", runtime.ParamLocationQuery, params.Handle); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "currency", runtime.ParamLocationQuery, params.Currency); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListScheduledTransfersRequest generates requests for ListScheduledTransfers
+func NewListScheduledTransfersRequest(server string, params *ListScheduledTransfersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scheduled-transfers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.PageNum != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_num", runtime.ParamLocationQuery, *params.PageNum); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_size", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateScheduledTransferRequest calls the generic CreateScheduledTransfer builder with application/json body
+func NewCreateScheduledTransferRequest(server string, body CreateScheduledTransferJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateScheduledTransferRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateScheduledTransferRequestWithBody generates requests for CreateScheduledTransfer with any type of body
+func NewCreateScheduledTransferRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scheduled-transfers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCancelScheduledTransferRequest generates requests for CancelScheduledTransfer
+func NewCancelScheduledTransferRequest(server string, id ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scheduled-transfers/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetScheduledTransferRequest generates requests for GetScheduledTransfer
+func NewGetScheduledTransferRequest(server string, id ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scheduled-transfers/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateScheduledTransferRequest calls the generic UpdateScheduledTransfer builder with application/json body
+func NewUpdateScheduledTransferRequest(server string, id ID, body UpdateScheduledTransferJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateScheduledTransferRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateScheduledTransferRequestWithBody generates requests for UpdateScheduledTransfer with any type of body
+func NewUpdateScheduledTransferRequestWithBody(server string, id ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scheduled-transfers/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRefreshTokenRequest calls the generic RefreshToken builder with application/json body
+func NewRefreshTokenRequest(server string, body RefreshTokenJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRefreshTokenRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewRefreshTokenRequestWithBody generates requests for RefreshToken with any type of body
+func NewRefreshTokenRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/token/refresh")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListTransferBatchesRequest generates requests for ListTransferBatches
+func NewListTransferBatchesRequest(server string, params *ListTransferBatchesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/transfer-batches")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.PageNum != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_num", runtime.ParamLocationQuery, *params.PageNum); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_size", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateTransferBatchRequest calls the generic CreateTransferBatch builder with application/json body
+func NewCreateTransferBatchRequest(server string, params *CreateTransferBatchParams, body CreateTransferBatchJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateTransferBatchRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewCreateTransferBatchRequestWithBody generates requests for CreateTransferBatch with any type of body
+func NewCreateTransferBatchRequestWithBody(server string, params *CreateTransferBatchParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/transfer-batches")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.FromAccountId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from_account_id", runtime.ParamLocationQuery, *params.FromAccountId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Currency != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "currency", runtime.ParamLocationQuery, *params.Currency); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Mode != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "mode", runtime.ParamLocationQuery, *params.Mode); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetTransferBatchRequest generates requests for GetTransferBatch
+func NewGetTransferBatchRequest(server string, id ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/transfer-batches/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListTransferBatchItemsRequest generates requests for ListTransferBatchItems
+func NewListTransferBatchItemsRequest(server string, id ID, params *ListTransferBatchItemsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/transfer-batches/%s/items", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.PageNum != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_num", runtime.ParamLocationQuery, *params.PageNum); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_size", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateTransferRequest calls the generic CreateTransfer builder with application/json body
+func NewCreateTransferRequest(server string, body CreateTransferJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateTransferRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateTransferRequestWithBody generates requests for CreateTransfer with any type of body
+func NewCreateTransferRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/transfers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewQuoteTransferRequest calls the generic QuoteTransfer builder with application/json body
+func NewQuoteTransferRequest(server string, body QuoteTransferJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewQuoteTransferRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewQuoteTransferRequestWithBody generates requests for QuoteTransfer with any type of body
+func NewQuoteTransferRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/transfers/quote")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetTransferRequest generates requests for GetTransfer
+func NewGetTransferRequest(server string, id ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/transfers/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetUserRequest generates requests for GetUser
+func NewGetUserRequest(server string, params *GetUserParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/user")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, params.Username); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateUserRequest calls the generic CreateUser builder with application/json body
+func NewCreateUserRequest(server string, body CreateUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateUserRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateUserRequestWithBody generates requests for CreateUser with any type of body
+func NewCreateUserRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/user")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteAliasRequest generates requests for DeleteAlias
+func NewDeleteAliasRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/user/alias")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSetAliasRequest calls the generic SetAlias builder with application/json body
+func NewSetAliasRequest(server string, body SetAliasJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetAliasRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSetAliasRequestWithBody generates requests for SetAlias with any type of body
+func NewSetAliasRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/user/alias")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetWalletRequest generates requests for GetWallet
+func NewGetWalletRequest(server string, params *GetWalletParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/user/wallet")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ReportingCurrency != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "reporting_currency", runtime.ParamLocationQuery, *params.ReportingCurrency); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAllUsersRequest generates requests for GetAllUsers
+func NewGetAllUsersRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewLoginUserRequest calls the generic LoginUser builder with application/json body
+func NewLoginUserRequest(server string, body LoginUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLoginUserRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewLoginUserRequestWithBody generates requests for LoginUser with any type of body
+func NewLoginUserRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/login")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// WelcomeWithResponse request
+	WelcomeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*WelcomeResponse, error)
+
+	// ListAllAccountsWithResponse request
+	ListAllAccountsWithResponse(ctx context.Context, params *ListAllAccountsParams, reqEditors ...RequestEditorFn) (*ListAllAccountsResponse, error)
+
+	// CreateAccountWithBodyWithResponse request with any body
+	CreateAccountWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAccountResponse, error)
+
+	CreateAccountWithResponse(ctx context.Context, body CreateAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAccountResponse, error)
+
+	// GetAccountByIdWithResponse request
+	GetAccountByIdWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*GetAccountByIdResponse, error)
+
+	// CreateDepositWithBodyWithResponse request with any body
+	CreateDepositWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDepositResponse, error)
+
+	CreateDepositWithResponse(ctx context.Context, id ID, body CreateDepositJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDepositResponse, error)
+
+	// GetFundingTransactionWithResponse request
+	GetFundingTransactionWithResponse(ctx context.Context, id ID, fundingId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetFundingTransactionResponse, error)
+
+	// ListHoldsWithResponse request
+	ListHoldsWithResponse(ctx context.Context, id ID, params *ListHoldsParams, reqEditors ...RequestEditorFn) (*ListHoldsResponse, error)
+
+	// CreateHoldWithBodyWithResponse request with any body
+	CreateHoldWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateHoldResponse, error)
+
+	CreateHoldWithResponse(ctx context.Context, id ID, body CreateHoldJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateHoldResponse, error)
+
+	// ListInterestPayoutsWithResponse request
+	ListInterestPayoutsWithResponse(ctx context.Context, id ID, params *ListInterestPayoutsParams, reqEditors ...RequestEditorFn) (*ListInterestPayoutsResponse, error)
+
+	// GetAccountLimitsWithResponse request
+	GetAccountLimitsWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*GetAccountLimitsResponse, error)
+
+	// ListOverdraftChargesWithResponse request
+	ListOverdraftChargesWithResponse(ctx context.Context, id ID, params *ListOverdraftChargesParams, reqEditors ...RequestEditorFn) (*ListOverdraftChargesResponse, error)
+
+	// ListAccountTransfersWithResponse request
+	ListAccountTransfersWithResponse(ctx context.Context, id ID, params *ListAccountTransfersParams, reqEditors ...RequestEditorFn) (*ListAccountTransfersResponse, error)
+
+	// CreateWithdrawalWithBodyWithResponse request with any body
+	CreateWithdrawalWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateWithdrawalResponse, error)
+
+	CreateWithdrawalWithResponse(ctx context.Context, id ID, body CreateWithdrawalJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateWithdrawalResponse, error)
+
+	// SetInterestProductWithBodyWithResponse request with any body
+	SetInterestProductWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetInterestProductResponse, error)
+
+	SetInterestProductWithResponse(ctx context.Context, id ID, body SetInterestProductJSONRequestBody, reqEditors ...RequestEditorFn) (*SetInterestProductResponse, error)
+
+	// SetAccountLimitsWithBodyWithResponse request with any body
+	SetAccountLimitsWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetAccountLimitsResponse, error)
+
+	SetAccountLimitsWithResponse(ctx context.Context, id ID, body SetAccountLimitsJSONRequestBody, reqEditors ...RequestEditorFn) (*SetAccountLimitsResponse, error)
+
+	// SetOverdraftWithBodyWithResponse request with any body
+	SetOverdraftWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetOverdraftResponse, error)
+
+	SetOverdraftWithResponse(ctx context.Context, id ID, body SetOverdraftJSONRequestBody, reqEditors ...RequestEditorFn) (*SetOverdraftResponse, error)
+
+	// ListCurrenciesWithResponse request
+	ListCurrenciesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListCurrenciesResponse, error)
+
+	// UpdateCurrencyWithBodyWithResponse request with any body
+	UpdateCurrencyWithBodyWithResponse(ctx context.Context, code string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCurrencyResponse, error)
+
+	UpdateCurrencyWithResponse(ctx context.Context, code string, body UpdateCurrencyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCurrencyResponse, error)
+
+	// GetFeeScheduleWithResponse request
+	GetFeeScheduleWithResponse(ctx context.Context, currency CurrencyCode, params *GetFeeScheduleParams, reqEditors ...RequestEditorFn) (*GetFeeScheduleResponse, error)
+
+	// SetFeeScheduleWithBodyWithResponse request with any body
+	SetFeeScheduleWithBodyWithResponse(ctx context.Context, currency CurrencyCode, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetFeeScheduleResponse, error)
+
+	SetFeeScheduleWithResponse(ctx context.Context, currency CurrencyCode, body SetFeeScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*SetFeeScheduleResponse, error)
+
+	// ListFeeSchedulesWithResponse request
+	ListFeeSchedulesWithResponse(ctx context.Context, currency CurrencyCode, params *ListFeeSchedulesParams, reqEditors ...RequestEditorFn) (*ListFeeSchedulesResponse, error)
+
+	// ListInterestProductsWithResponse request
+	ListInterestProductsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListInterestProductsResponse, error)
+
+	// CreateInterestProductWithBodyWithResponse request with any body
+	CreateInterestProductWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateInterestProductResponse, error)
+
+	CreateInterestProductWithResponse(ctx context.Context, body CreateInterestProductJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateInterestProductResponse, error)
+
+	// BackfillInterestWithBodyWithResponse request with any body
+	BackfillInterestWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BackfillInterestResponse, error)
+
+	BackfillInterestWithResponse(ctx context.Context, body BackfillInterestJSONRequestBody, reqEditors ...RequestEditorFn) (*BackfillInterestResponse, error)
+
+	// DeleteTransferLimitWithResponse request
+	DeleteTransferLimitWithResponse(ctx context.Context, limitId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteTransferLimitResponse, error)
+
+	// GetMetricsWithResponse request
+	GetMetricsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetMetricsResponse, error)
+
+	// CreateOrganisationWithBodyWithResponse request with any body
+	CreateOrganisationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganisationResponse, error)
+
+	CreateOrganisationWithResponse(ctx context.Context, body CreateOrganisationJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganisationResponse, error)
+
+	// LinkOrganisationAccountWithResponse request
+	LinkOrganisationAccountWithResponse(ctx context.Context, id ID, accountId openapi_types.UUID, reqEditors ...RequestEditorFn) (*LinkOrganisationAccountResponse, error)
+
+	// RemoveOrganisationMemberWithResponse request
+	RemoveOrganisationMemberWithResponse(ctx context.Context, id ID, username string, reqEditors ...RequestEditorFn) (*RemoveOrganisationMemberResponse, error)
+
+	// AddOrganisationMemberWithBodyWithResponse request with any body
+	AddOrganisationMemberWithBodyWithResponse(ctx context.Context, id ID, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddOrganisationMemberResponse, error)
+
+	AddOrganisationMemberWithResponse(ctx context.Context, id ID, username string, body AddOrganisationMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*AddOrganisationMemberResponse, error)
+
+	// SetOrganisationPolicyWithBodyWithResponse request with any body
+	SetOrganisationPolicyWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetOrganisationPolicyResponse, error)
+
+	SetOrganisationPolicyWithResponse(ctx context.Context, id ID, body SetOrganisationPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*SetOrganisationPolicyResponse, error)
+
+	// GetStatusWithResponse request
+	GetStatusWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetStatusResponse, error)
+
+	// ReverseTransferWithResponse request
+	ReverseTransferWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*ReverseTransferResponse, error)
+
+	// SetUserLimitsWithBodyWithResponse request with any body
+	SetUserLimitsWithBodyWithResponse(ctx context.Context, username string, currency CurrencyCode, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetUserLimitsResponse, error)
+
+	SetUserLimitsWithResponse(ctx context.Context, username string, currency CurrencyCode, body SetUserLimitsJSONRequestBody, reqEditors ...RequestEditorFn) (*SetUserLimitsResponse, error)
+
+	// SetUserTierWithBodyWithResponse request with any body
+	SetUserTierWithBodyWithResponse(ctx context.Context, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetUserTierResponse, error)
+
+	SetUserTierWithResponse(ctx context.Context, username string, body SetUserTierJSONRequestBody, reqEditors ...RequestEditorFn) (*SetUserTierResponse, error)
+
+	// VerifyUserEmailWithResponse request
+	VerifyUserEmailWithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*VerifyUserEmailResponse, error)
+
+	// GetTransferApprovalWithResponse request
+	GetTransferApprovalWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*GetTransferApprovalResponse, error)
+
+	// ApproveTransferWithBodyWithResponse request with any body
+	ApproveTransferWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApproveTransferResponse, error)
+
+	ApproveTransferWithResponse(ctx context.Context, id ID, body ApproveTransferJSONRequestBody, reqEditors ...RequestEditorFn) (*ApproveTransferResponse, error)
+
+	// CancelTransferApprovalWithBodyWithResponse request with any body
+	CancelTransferApprovalWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CancelTransferApprovalResponse, error)
+
+	CancelTransferApprovalWithResponse(ctx context.Context, id ID, body CancelTransferApprovalJSONRequestBody, reqEditors ...RequestEditorFn) (*CancelTransferApprovalResponse, error)
+
+	// RejectTransferWithBodyWithResponse request with any body
+	RejectTransferWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RejectTransferResponse, error)
+
+	RejectTransferWithResponse(ctx context.Context, id ID, body RejectTransferJSONRequestBody, reqEditors ...RequestEditorFn) (*RejectTransferResponse, error)
+
+	// ListEnabledCurrenciesWithResponse request
+	ListEnabledCurrenciesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListEnabledCurrenciesResponse, error)
+
+	// GetDocsWithResponse request
+	GetDocsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDocsResponse, error)
+
+	// GetDocsAssetWithResponse request
+	GetDocsAssetWithResponse(ctx context.Context, asset string, reqEditors ...RequestEditorFn) (*GetDocsAssetResponse, error)
+
+	// ListEscrowsWithResponse request
+	ListEscrowsWithResponse(ctx context.Context, params *ListEscrowsParams, reqEditors ...RequestEditorFn) (*ListEscrowsResponse, error)
+
+	// CreateEscrowWithBodyWithResponse request with any body
+	CreateEscrowWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateEscrowResponse, error)
+
+	CreateEscrowWithResponse(ctx context.Context, body CreateEscrowJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateEscrowResponse, error)
+
+	// GetEscrowWithResponse request
+	GetEscrowWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*GetEscrowResponse, error)
+
+	// ConfirmEscrowWithBodyWithResponse request with any body
+	ConfirmEscrowWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConfirmEscrowResponse, error)
+
+	ConfirmEscrowWithResponse(ctx context.Context, id ID, body ConfirmEscrowJSONRequestBody, reqEditors ...RequestEditorFn) (*ConfirmEscrowResponse, error)
+
+	// DisputeEscrowWithBodyWithResponse request with any body
+	DisputeEscrowWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DisputeEscrowResponse, error)
+
+	DisputeEscrowWithResponse(ctx context.Context, id ID, body DisputeEscrowJSONRequestBody, reqEditors ...RequestEditorFn) (*DisputeEscrowResponse, error)
+
+	// CreateFxQuoteWithBodyWithResponse request with any body
+	CreateFxQuoteWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateFxQuoteResponse, error)
+
+	CreateFxQuoteWithResponse(ctx context.Context, body CreateFxQuoteJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateFxQuoteResponse, error)
+
+	// HealthzWithResponse request
+	HealthzWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthzResponse, error)
+
+	// GetHoldWithResponse request
+	GetHoldWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*GetHoldResponse, error)
+
+	// CaptureHoldWithBodyWithResponse request with any body
+	CaptureHoldWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CaptureHoldResponse, error)
+
+	CaptureHoldWithResponse(ctx context.Context, id ID, body CaptureHoldJSONRequestBody, reqEditors ...RequestEditorFn) (*CaptureHoldResponse, error)
+
+	// ReleaseHoldWithResponse request
+	ReleaseHoldWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*ReleaseHoldResponse, error)
+
+	// ListNotificationsWithResponse request
+	ListNotificationsWithResponse(ctx context.Context, params *ListNotificationsParams, reqEditors ...RequestEditorFn) (*ListNotificationsResponse, error)
+
+	// ReadNotificationWithResponse request
+	ReadNotificationWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*ReadNotificationResponse, error)
+
+	// GetOpenAPIWithResponse request
+	GetOpenAPIWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOpenAPIResponse, error)
+
+	// ListOrganisationsWithResponse request
+	ListOrganisationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListOrganisationsResponse, error)
+
+	// ListTransferApprovalsWithResponse request
+	ListTransferApprovalsWithResponse(ctx context.Context, id ID, params *ListTransferApprovalsParams, reqEditors ...RequestEditorFn) (*ListTransferApprovalsResponse, error)
+
+	// ListOrganisationMembersWithResponse request
+	ListOrganisationMembersWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*ListOrganisationMembersResponse, error)
+
+	// ListPaymentRequestsWithResponse request
+	ListPaymentRequestsWithResponse(ctx context.Context, params *ListPaymentRequestsParams, reqEditors ...RequestEditorFn) (*ListPaymentRequestsResponse, error)
+
+	// CreatePaymentRequestWithBodyWithResponse request with any body
+	CreatePaymentRequestWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePaymentRequestResponse, error)
+
+	CreatePaymentRequestWithResponse(ctx context.Context, body CreatePaymentRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePaymentRequestResponse, error)
+
+	// AcceptPaymentRequestWithBodyWithResponse request with any body
+	AcceptPaymentRequestWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AcceptPaymentRequestResponse, error)
+
+	AcceptPaymentRequestWithResponse(ctx context.Context, id ID, body AcceptPaymentRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*AcceptPaymentRequestResponse, error)
+
+	// DeclinePaymentRequestWithResponse request
+	DeclinePaymentRequestWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*DeclinePaymentRequestResponse, error)
+
+	// ReadyzWithResponse request
+	ReadyzWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ReadyzResponse, error)
+
+	// LookupRecipientWithResponse request
+	LookupRecipientWithResponse(ctx context.Context, params *LookupRecipientParams, reqEditors ...RequestEditorFn) (*LookupRecipientResponse, error)
+
+	// ListScheduledTransfersWithResponse request
+	ListScheduledTransfersWithResponse(ctx context.Context, params *ListScheduledTransfersParams, reqEditors ...RequestEditorFn) (*ListScheduledTransfersResponse, error)
+
+	// CreateScheduledTransferWithBodyWithResponse request with any body
+	CreateScheduledTransferWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateScheduledTransferResponse, error)
+
+	CreateScheduledTransferWithResponse(ctx context.Context, body CreateScheduledTransferJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateScheduledTransferResponse, error)
+
+	// CancelScheduledTransferWithResponse request
+	CancelScheduledTransferWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*CancelScheduledTransferResponse, error)
+
+	// GetScheduledTransferWithResponse request
+	GetScheduledTransferWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*GetScheduledTransferResponse, error)
+
+	// UpdateScheduledTransferWithBodyWithResponse request with any body
+	UpdateScheduledTransferWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateScheduledTransferResponse, error)
+
+	UpdateScheduledTransferWithResponse(ctx context.Context, id ID, body UpdateScheduledTransferJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateScheduledTransferResponse, error)
+
+	// RefreshTokenWithBodyWithResponse request with any body
+	RefreshTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RefreshTokenResponse, error)
+
+	RefreshTokenWithResponse(ctx context.Context, body RefreshTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*RefreshTokenResponse, error)
+
+	// ListTransferBatchesWithResponse request
+	ListTransferBatchesWithResponse(ctx context.Context, params *ListTransferBatchesParams, reqEditors ...RequestEditorFn) (*ListTransferBatchesResponse, error)
+
+	// CreateTransferBatchWithBodyWithResponse request with any body
+	CreateTransferBatchWithBodyWithResponse(ctx context.Context, params *CreateTransferBatchParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTransferBatchResponse, error)
+
+	CreateTransferBatchWithResponse(ctx context.Context, params *CreateTransferBatchParams, body CreateTransferBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTransferBatchResponse, error)
+
+	// GetTransferBatchWithResponse request
+	GetTransferBatchWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*GetTransferBatchResponse, error)
+
+	// ListTransferBatchItemsWithResponse request
+	ListTransferBatchItemsWithResponse(ctx context.Context, id ID, params *ListTransferBatchItemsParams, reqEditors ...RequestEditorFn) (*ListTransferBatchItemsResponse, error)
+
+	// CreateTransferWithBodyWithResponse request with any body
+	CreateTransferWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTransferResponse, error)
+
+	CreateTransferWithResponse(ctx context.Context, body CreateTransferJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTransferResponse, error)
+
+	// QuoteTransferWithBodyWithResponse request with any body
+	QuoteTransferWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QuoteTransferResponse, error)
+
+	QuoteTransferWithResponse(ctx context.Context, body QuoteTransferJSONRequestBody, reqEditors ...RequestEditorFn) (*QuoteTransferResponse, error)
+
+	// GetTransferWithResponse request
+	GetTransferWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*GetTransferResponse, error)
+
+	// GetUserWithResponse request
+	GetUserWithResponse(ctx context.Context, params *GetUserParams, reqEditors ...RequestEditorFn) (*GetUserResponse, error)
+
+	// CreateUserWithBodyWithResponse request with any body
+	CreateUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUserResponse, error)
+
+	CreateUserWithResponse(ctx context.Context, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUserResponse, error)
+
+	// DeleteAliasWithResponse request
+	DeleteAliasWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteAliasResponse, error)
+
+	// SetAliasWithBodyWithResponse request with any body
+	SetAliasWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetAliasResponse, error)
+
+	SetAliasWithResponse(ctx context.Context, body SetAliasJSONRequestBody, reqEditors ...RequestEditorFn) (*SetAliasResponse, error)
+
+	// GetWalletWithResponse request
+	GetWalletWithResponse(ctx context.Context, params *GetWalletParams, reqEditors ...RequestEditorFn) (*GetWalletResponse, error)
+
+	// GetAllUsersWithResponse request
+	GetAllUsersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAllUsersResponse, error)
+
+	// LoginUserWithBodyWithResponse request with any body
+	LoginUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginUserResponse, error)
+
+	LoginUserWithResponse(ctx context.Context, body LoginUserJSONRequestBody, reqEditors ...RequestEditorFn) (*LoginUserResponse, error)
+}
+
+type WelcomeResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *string
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r WelcomeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WelcomeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListAllAccountsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *[]Account
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ListAllAccountsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListAllAccountsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateAccountResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Account
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateAccountResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateAccountResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAccountByIdResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Account
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAccountByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAccountByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateDepositResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *FundingTxResult
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateDepositResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateDepositResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetFundingTransactionResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *FundingTxResult
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetFundingTransactionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetFundingTransactionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListHoldsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *[]Hold
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ListHoldsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListHoldsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateHoldResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *HoldTxResult
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateHoldResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateHoldResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListInterestPayoutsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *[]InterestPayout
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ListInterestPayoutsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListInterestPayoutsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAccountLimitsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *AccountLimits
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAccountLimitsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAccountLimitsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListOverdraftChargesResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *[]OverdraftInterestCharge
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ListOverdraftChargesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListOverdraftChargesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListAccountTransfersResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *[]Transfer
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ListAccountTransfersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListAccountTransfersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateWithdrawalResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *FundingTxResult
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateWithdrawalResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateWithdrawalResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetInterestProductResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Account
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r SetInterestProductResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetInterestProductResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetAccountLimitsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *TransferLimit
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r SetAccountLimitsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetAccountLimitsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetOverdraftResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Account
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r SetOverdraftResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetOverdraftResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListCurrenciesResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *[]CurrencyRecord
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ListCurrenciesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListCurrenciesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateCurrencyResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *CurrencyRecord
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateCurrencyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateCurrencyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetFeeScheduleResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *FeeScheduleResult
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetFeeScheduleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetFeeScheduleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetFeeScheduleResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *FeeScheduleResult
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r SetFeeScheduleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetFeeScheduleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListFeeSchedulesResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *[]FeeSchedule
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ListFeeSchedulesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListFeeSchedulesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListInterestProductsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *[]InterestProduct
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ListInterestProductsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListInterestProductsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateInterestProductResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *InterestProduct
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateInterestProductResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateInterestProductResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type BackfillInterestResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *[]InterestRun
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r BackfillInterestResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r BackfillInterestResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteTransferLimitResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteTransferLimitResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteTransferLimitResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMetricsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *map[string]interface{}
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMetricsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMetricsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateOrganisationResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Organisation
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateOrganisationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateOrganisationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LinkOrganisationAccountResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Account
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r LinkOrganisationAccountResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LinkOrganisationAccountResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RemoveOrganisationMemberResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r RemoveOrganisationMemberResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RemoveOrganisationMemberResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddOrganisationMemberResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *OrganisationMember
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r AddOrganisationMemberResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddOrganisationMemberResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetOrganisationPolicyResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Organisation
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r SetOrganisationPolicyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetOrganisationPolicyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetStatusResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Status
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReverseTransferResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *ReverseTransferTxResult
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ReverseTransferResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReverseTransferResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetUserLimitsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *TransferLimit
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r SetUserLimitsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetUserLimitsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetUserTierResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *UserTier
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r SetUserTierResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetUserTierResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type VerifyUserEmailResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *EmailVerification
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r VerifyUserEmailResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r VerifyUserEmailResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTransferApprovalResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *TransferApprovalWithAuditLog
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTransferApprovalResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTransferApprovalResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApproveTransferResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *TransferApprovalTxResult
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ApproveTransferResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApproveTransferResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CancelTransferApprovalResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *TransferApprovalTxResult
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r CancelTransferApprovalResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CancelTransferApprovalResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RejectTransferResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *TransferApprovalTxResult
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r RejectTransferResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RejectTransferResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListEnabledCurrenciesResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *[]Currency
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ListEnabledCurrenciesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListEnabledCurrenciesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDocsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDocsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDocsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDocsAssetResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDocsAssetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDocsAssetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListEscrowsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *[]Escrow
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ListEscrowsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListEscrowsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateEscrowResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *EscrowTxResult
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateEscrowResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateEscrowResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetEscrowResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *EscrowWithEvents
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEscrowResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEscrowResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ConfirmEscrowResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *EscrowTxResult
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ConfirmEscrowResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ConfirmEscrowResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DisputeEscrowResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *EscrowTxResult
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r DisputeEscrowResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DisputeEscrowResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateFxQuoteResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *FxQuote
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateFxQuoteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateFxQuoteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HealthzResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Health
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r HealthzResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HealthzResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetHoldResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Hold
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetHoldResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetHoldResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CaptureHoldResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *CaptureHoldTxResult
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r CaptureHoldResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CaptureHoldResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReleaseHoldResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *HoldTxResult
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ReleaseHoldResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReleaseHoldResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListNotificationsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *[]Notification
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ListNotificationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListNotificationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReadNotificationResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Notification
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ReadNotificationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReadNotificationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOpenAPIResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *map[string]interface{}
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOpenAPIResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOpenAPIResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListOrganisationsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *[]Organisation
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ListOrganisationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListOrganisationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListTransferApprovalsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *[]TransferApproval
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ListTransferApprovalsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListTransferApprovalsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListOrganisationMembersResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *[]OrganisationMember
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ListOrganisationMembersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListOrganisationMembersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListPaymentRequestsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *[]PaymentRequest
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ListPaymentRequestsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListPaymentRequestsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreatePaymentRequestResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *PaymentRequest
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r CreatePaymentRequestResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreatePaymentRequestResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AcceptPaymentRequestResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *AcceptPaymentRequestTxResult
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r AcceptPaymentRequestResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AcceptPaymentRequestResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeclinePaymentRequestResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *PaymentRequest
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r DeclinePaymentRequestResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeclinePaymentRequestResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReadyzResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Readiness
+	JSON503                       *Readiness
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ReadyzResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReadyzResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LookupRecipientResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Recipient
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r LookupRecipientResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LookupRecipientResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListScheduledTransfersResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *[]ScheduledTransfer
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ListScheduledTransfersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListScheduledTransfersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateScheduledTransferResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *ScheduledTransfer
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateScheduledTransferResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateScheduledTransferResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CancelScheduledTransferResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *ScheduledTransfer
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r CancelScheduledTransferResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CancelScheduledTransferResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetScheduledTransferResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *ScheduledTransferWithRuns
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetScheduledTransferResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetScheduledTransferResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateScheduledTransferResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *ScheduledTransfer
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateScheduledTransferResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateScheduledTransferResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RefreshTokenResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *RefreshedToken
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r RefreshTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RefreshTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListTransferBatchesResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *[]TransferBatch
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ListTransferBatchesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListTransferBatchesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateTransferBatchResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON202                       *TransferBatch
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateTransferBatchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateTransferBatchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTransferBatchResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *TransferBatch
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTransferBatchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTransferBatchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListTransferBatchItemsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *[]TransferBatchItem
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ListTransferBatchItemsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListTransferBatchItemsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateTransferResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *TransferResult
+	JSON202                       *AcceptedTransfer
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateTransferResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateTransferResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type QuoteTransferResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *FeeQuote
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r QuoteTransferResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r QuoteTransferResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTransferResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Transfer
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTransferResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTransferResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUserResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *User
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateUserResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *User
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAliasResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAliasResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAliasResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetAliasResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Alias
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r SetAliasResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetAliasResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWalletResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Wallet
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWalletResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWalletResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAllUsersResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *[]User
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAllUsersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAllUsersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LoginUserResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Login
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r LoginUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LoginUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// WelcomeWithResponse request returning *WelcomeResponse
+func (c *ClientWithResponses) WelcomeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*WelcomeResponse, error) {
+	rsp, err := c.Welcome(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWelcomeResponse(rsp)
+}
+
+// ListAllAccountsWithResponse request returning *ListAllAccountsResponse
+func (c *ClientWithResponses) ListAllAccountsWithResponse(ctx context.Context, params *ListAllAccountsParams, reqEditors ...RequestEditorFn) (*ListAllAccountsResponse, error) {
+	rsp, err := c.ListAllAccounts(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAllAccountsResponse(rsp)
+}
+
+// CreateAccountWithBodyWithResponse request with arbitrary body returning *CreateAccountResponse
+func (c *ClientWithResponses) CreateAccountWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAccountResponse, error) {
+	rsp, err := c.CreateAccountWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAccountResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateAccountWithResponse(ctx context.Context, body CreateAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAccountResponse, error) {
+	rsp, err := c.CreateAccount(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAccountResponse(rsp)
+}
+
+// GetAccountByIdWithResponse request returning *GetAccountByIdResponse
+func (c *ClientWithResponses) GetAccountByIdWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*GetAccountByIdResponse, error) {
+	rsp, err := c.GetAccountById(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAccountByIdResponse(rsp)
+}
+
+// CreateDepositWithBodyWithResponse request with arbitrary body returning *CreateDepositResponse
+func (c *ClientWithResponses) CreateDepositWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDepositResponse, error) {
+	rsp, err := c.CreateDepositWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDepositResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateDepositWithResponse(ctx context.Context, id ID, body CreateDepositJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDepositResponse, error) {
+	rsp, err := c.CreateDeposit(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDepositResponse(rsp)
+}
+
+// GetFundingTransactionWithResponse request returning *GetFundingTransactionResponse
+func (c *ClientWithResponses) GetFundingTransactionWithResponse(ctx context.Context, id ID, fundingId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetFundingTransactionResponse, error) {
+	rsp, err := c.GetFundingTransaction(ctx, id, fundingId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetFundingTransactionResponse(rsp)
+}
+
+// ListHoldsWithResponse request returning *ListHoldsResponse
+func (c *ClientWithResponses) ListHoldsWithResponse(ctx context.Context, id ID, params *ListHoldsParams, reqEditors ...RequestEditorFn) (*ListHoldsResponse, error) {
+	rsp, err := c.ListHolds(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListHoldsResponse(rsp)
+}
+
+// CreateHoldWithBodyWithResponse request with arbitrary body returning *CreateHoldResponse
+func (c *ClientWithResponses) CreateHoldWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateHoldResponse, error) {
+	rsp, err := c.CreateHoldWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateHoldResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateHoldWithResponse(ctx context.Context, id ID, body CreateHoldJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateHoldResponse, error) {
+	rsp, err := c.CreateHold(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateHoldResponse(rsp)
+}
+
+// ListInterestPayoutsWithResponse request returning *ListInterestPayoutsResponse
+func (c *ClientWithResponses) ListInterestPayoutsWithResponse(ctx context.Context, id ID, params *ListInterestPayoutsParams, reqEditors ...RequestEditorFn) (*ListInterestPayoutsResponse, error) {
+	rsp, err := c.ListInterestPayouts(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListInterestPayoutsResponse(rsp)
+}
+
+// GetAccountLimitsWithResponse request returning *GetAccountLimitsResponse
+func (c *ClientWithResponses) GetAccountLimitsWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*GetAccountLimitsResponse, error) {
+	rsp, err := c.GetAccountLimits(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAccountLimitsResponse(rsp)
+}
+
+// ListOverdraftChargesWithResponse request returning *ListOverdraftChargesResponse
+func (c *ClientWithResponses) ListOverdraftChargesWithResponse(ctx context.Context, id ID, params *ListOverdraftChargesParams, reqEditors ...RequestEditorFn) (*ListOverdraftChargesResponse, error) {
+	rsp, err := c.ListOverdraftCharges(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListOverdraftChargesResponse(rsp)
+}
+
+// ListAccountTransfersWithResponse request returning *ListAccountTransfersResponse
+func (c *ClientWithResponses) ListAccountTransfersWithResponse(ctx context.Context, id ID, params *ListAccountTransfersParams, reqEditors ...RequestEditorFn) (*ListAccountTransfersResponse, error) {
+	rsp, err := c.ListAccountTransfers(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAccountTransfersResponse(rsp)
+}
+
+// CreateWithdrawalWithBodyWithResponse request with arbitrary body returning *CreateWithdrawalResponse
+func (c *ClientWithResponses) CreateWithdrawalWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateWithdrawalResponse, error) {
+	rsp, err := c.CreateWithdrawalWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateWithdrawalResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateWithdrawalWithResponse(ctx context.Context, id ID, body CreateWithdrawalJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateWithdrawalResponse, error) {
+	rsp, err := c.CreateWithdrawal(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateWithdrawalResponse(rsp)
+}
+
+// SetInterestProductWithBodyWithResponse request with arbitrary body returning *SetInterestProductResponse
+func (c *ClientWithResponses) SetInterestProductWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetInterestProductResponse, error) {
+	rsp, err := c.SetInterestProductWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetInterestProductResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetInterestProductWithResponse(ctx context.Context, id ID, body SetInterestProductJSONRequestBody, reqEditors ...RequestEditorFn) (*SetInterestProductResponse, error) {
+	rsp, err := c.SetInterestProduct(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetInterestProductResponse(rsp)
+}
+
+// SetAccountLimitsWithBodyWithResponse request with arbitrary body returning *SetAccountLimitsResponse
+func (c *ClientWithResponses) SetAccountLimitsWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetAccountLimitsResponse, error) {
+	rsp, err := c.SetAccountLimitsWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetAccountLimitsResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetAccountLimitsWithResponse(ctx context.Context, id ID, body SetAccountLimitsJSONRequestBody, reqEditors ...RequestEditorFn) (*SetAccountLimitsResponse, error) {
+	rsp, err := c.SetAccountLimits(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetAccountLimitsResponse(rsp)
+}
+
+// SetOverdraftWithBodyWithResponse request with arbitrary body returning *SetOverdraftResponse
+func (c *ClientWithResponses) SetOverdraftWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetOverdraftResponse, error) {
+	rsp, err := c.SetOverdraftWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetOverdraftResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetOverdraftWithResponse(ctx context.Context, id ID, body SetOverdraftJSONRequestBody, reqEditors ...RequestEditorFn) (*SetOverdraftResponse, error) {
+	rsp, err := c.SetOverdraft(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetOverdraftResponse(rsp)
+}
+
+// ListCurrenciesWithResponse request returning *ListCurrenciesResponse
+func (c *ClientWithResponses) ListCurrenciesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListCurrenciesResponse, error) {
+	rsp, err := c.ListCurrencies(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListCurrenciesResponse(rsp)
+}
+
+// UpdateCurrencyWithBodyWithResponse request with arbitrary body returning *UpdateCurrencyResponse
+func (c *ClientWithResponses) UpdateCurrencyWithBodyWithResponse(ctx context.Context, code string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCurrencyResponse, error) {
+	rsp, err := c.UpdateCurrencyWithBody(ctx, code, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCurrencyResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateCurrencyWithResponse(ctx context.Context, code string, body UpdateCurrencyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCurrencyResponse, error) {
+	rsp, err := c.UpdateCurrency(ctx, code, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCurrencyResponse(rsp)
+}
+
+// GetFeeScheduleWithResponse request returning *GetFeeScheduleResponse
+func (c *ClientWithResponses) GetFeeScheduleWithResponse(ctx context.Context, currency CurrencyCode, params *GetFeeScheduleParams, reqEditors ...RequestEditorFn) (*GetFeeScheduleResponse, error) {
+	rsp, err := c.GetFeeSchedule(ctx, currency, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetFeeScheduleResponse(rsp)
+}
+
+// SetFeeScheduleWithBodyWithResponse request with arbitrary body returning *SetFeeScheduleResponse
+func (c *ClientWithResponses) SetFeeScheduleWithBodyWithResponse(ctx context.Context, currency CurrencyCode, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetFeeScheduleResponse, error) {
+	rsp, err := c.SetFeeScheduleWithBody(ctx, currency, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetFeeScheduleResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetFeeScheduleWithResponse(ctx context.Context, currency CurrencyCode, body SetFeeScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*SetFeeScheduleResponse, error) {
+	rsp, err := c.SetFeeSchedule(ctx, currency, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetFeeScheduleResponse(rsp)
+}
+
+// ListFeeSchedulesWithResponse request returning *ListFeeSchedulesResponse
+func (c *ClientWithResponses) ListFeeSchedulesWithResponse(ctx context.Context, currency CurrencyCode, params *ListFeeSchedulesParams, reqEditors ...RequestEditorFn) (*ListFeeSchedulesResponse, error) {
+	rsp, err := c.ListFeeSchedules(ctx, currency, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListFeeSchedulesResponse(rsp)
+}
+
+// ListInterestProductsWithResponse request returning *ListInterestProductsResponse
+func (c *ClientWithResponses) ListInterestProductsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListInterestProductsResponse, error) {
+	rsp, err := c.ListInterestProducts(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListInterestProductsResponse(rsp)
+}
+
+// CreateInterestProductWithBodyWithResponse request with arbitrary body returning *CreateInterestProductResponse
+func (c *ClientWithResponses) CreateInterestProductWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateInterestProductResponse, error) {
+	rsp, err := c.CreateInterestProductWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateInterestProductResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateInterestProductWithResponse(ctx context.Context, body CreateInterestProductJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateInterestProductResponse, error) {
+	rsp, err := c.CreateInterestProduct(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateInterestProductResponse(rsp)
+}
+
+// BackfillInterestWithBodyWithResponse request with arbitrary body returning *BackfillInterestResponse
+func (c *ClientWithResponses) BackfillInterestWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BackfillInterestResponse, error) {
+	rsp, err := c.BackfillInterestWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBackfillInterestResponse(rsp)
+}
+
+func (c *ClientWithResponses) BackfillInterestWithResponse(ctx context.Context, body BackfillInterestJSONRequestBody, reqEditors ...RequestEditorFn) (*BackfillInterestResponse, error) {
+	rsp, err := c.BackfillInterest(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBackfillInterestResponse(rsp)
+}
+
+// DeleteTransferLimitWithResponse request returning *DeleteTransferLimitResponse
+func (c *ClientWithResponses) DeleteTransferLimitWithResponse(ctx context.Context, limitId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteTransferLimitResponse, error) {
+	rsp, err := c.DeleteTransferLimit(ctx, limitId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteTransferLimitResponse(rsp)
+}
+
+// GetMetricsWithResponse request returning *GetMetricsResponse
+func (c *ClientWithResponses) GetMetricsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetMetricsResponse, error) {
+	rsp, err := c.GetMetrics(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMetricsResponse(rsp)
+}
+
+// CreateOrganisationWithBodyWithResponse request with arbitrary body returning *CreateOrganisationResponse
+func (c *ClientWithResponses) CreateOrganisationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganisationResponse, error) {
+	rsp, err := c.CreateOrganisationWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateOrganisationResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateOrganisationWithResponse(ctx context.Context, body CreateOrganisationJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganisationResponse, error) {
+	rsp, err := c.CreateOrganisation(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateOrganisationResponse(rsp)
+}
+
+// LinkOrganisationAccountWithResponse request returning *LinkOrganisationAccountResponse
+func (c *ClientWithResponses) LinkOrganisationAccountWithResponse(ctx context.Context, id ID, accountId openapi_types.UUID, reqEditors ...RequestEditorFn) (*LinkOrganisationAccountResponse, error) {
+	rsp, err := c.LinkOrganisationAccount(ctx, id, accountId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLinkOrganisationAccountResponse(rsp)
+}
+
+// RemoveOrganisationMemberWithResponse request returning *RemoveOrganisationMemberResponse
+func (c *ClientWithResponses) RemoveOrganisationMemberWithResponse(ctx context.Context, id ID, username string, reqEditors ...RequestEditorFn) (*RemoveOrganisationMemberResponse, error) {
+	rsp, err := c.RemoveOrganisationMember(ctx, id, username, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemoveOrganisationMemberResponse(rsp)
+}
+
+// AddOrganisationMemberWithBodyWithResponse request with arbitrary body returning *AddOrganisationMemberResponse
+func (c *ClientWithResponses) AddOrganisationMemberWithBodyWithResponse(ctx context.Context, id ID, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddOrganisationMemberResponse, error) {
+	rsp, err := c.AddOrganisationMemberWithBody(ctx, id, username, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddOrganisationMemberResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddOrganisationMemberWithResponse(ctx context.Context, id ID, username string, body AddOrganisationMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*AddOrganisationMemberResponse, error) {
+	rsp, err := c.AddOrganisationMember(ctx, id, username, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddOrganisationMemberResponse(rsp)
+}
+
+// SetOrganisationPolicyWithBodyWithResponse request with arbitrary body returning *SetOrganisationPolicyResponse
+func (c *ClientWithResponses) SetOrganisationPolicyWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetOrganisationPolicyResponse, error) {
+	rsp, err := c.SetOrganisationPolicyWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetOrganisationPolicyResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetOrganisationPolicyWithResponse(ctx context.Context, id ID, body SetOrganisationPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*SetOrganisationPolicyResponse, error) {
+	rsp, err := c.SetOrganisationPolicy(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetOrganisationPolicyResponse(rsp)
+}
+
+// GetStatusWithResponse request returning *GetStatusResponse
+func (c *ClientWithResponses) GetStatusWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetStatusResponse, error) {
+	rsp, err := c.GetStatus(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetStatusResponse(rsp)
+}
+
+// ReverseTransferWithResponse request returning *ReverseTransferResponse
+func (c *ClientWithResponses) ReverseTransferWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*ReverseTransferResponse, error) {
+	rsp, err := c.ReverseTransfer(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReverseTransferResponse(rsp)
+}
+
+// SetUserLimitsWithBodyWithResponse request with arbitrary body returning *SetUserLimitsResponse
+func (c *ClientWithResponses) SetUserLimitsWithBodyWithResponse(ctx context.Context, username string, currency CurrencyCode, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetUserLimitsResponse, error) {
+	rsp, err := c.SetUserLimitsWithBody(ctx, username, currency, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetUserLimitsResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetUserLimitsWithResponse(ctx context.Context, username string, currency CurrencyCode, body SetUserLimitsJSONRequestBody, reqEditors ...RequestEditorFn) (*SetUserLimitsResponse, error) {
+	rsp, err := c.SetUserLimits(ctx, username, currency, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetUserLimitsResponse(rsp)
+}
+
+// SetUserTierWithBodyWithResponse request with arbitrary body returning *SetUserTierResponse
+func (c *ClientWithResponses) SetUserTierWithBodyWithResponse(ctx context.Context, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetUserTierResponse, error) {
+	rsp, err := c.SetUserTierWithBody(ctx, username, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetUserTierResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetUserTierWithResponse(ctx context.Context, username string, body SetUserTierJSONRequestBody, reqEditors ...RequestEditorFn) (*SetUserTierResponse, error) {
+	rsp, err := c.SetUserTier(ctx, username, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetUserTierResponse(rsp)
+}
+
+// VerifyUserEmailWithResponse request returning *VerifyUserEmailResponse
+func (c *ClientWithResponses) VerifyUserEmailWithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*VerifyUserEmailResponse, error) {
+	rsp, err := c.VerifyUserEmail(ctx, username, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVerifyUserEmailResponse(rsp)
+}
+
+// GetTransferApprovalWithResponse request returning *GetTransferApprovalResponse
+func (c *ClientWithResponses) GetTransferApprovalWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*GetTransferApprovalResponse, error) {
+	rsp, err := c.GetTransferApproval(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTransferApprovalResponse(rsp)
+}
+
+// ApproveTransferWithBodyWithResponse request with arbitrary body returning *ApproveTransferResponse
+func (c *ClientWithResponses) ApproveTransferWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApproveTransferResponse, error) {
+	rsp, err := c.ApproveTransferWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApproveTransferResponse(rsp)
+}
+
+func (c *ClientWithResponses) ApproveTransferWithResponse(ctx context.Context, id ID, body ApproveTransferJSONRequestBody, reqEditors ...RequestEditorFn) (*ApproveTransferResponse, error) {
+	rsp, err := c.ApproveTransfer(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApproveTransferResponse(rsp)
+}
+
+// CancelTransferApprovalWithBodyWithResponse request with arbitrary body returning *CancelTransferApprovalResponse
+func (c *ClientWithResponses) CancelTransferApprovalWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CancelTransferApprovalResponse, error) {
+	rsp, err := c.CancelTransferApprovalWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCancelTransferApprovalResponse(rsp)
+}
+
+func (c *ClientWithResponses) CancelTransferApprovalWithResponse(ctx context.Context, id ID, body CancelTransferApprovalJSONRequestBody, reqEditors ...RequestEditorFn) (*CancelTransferApprovalResponse, error) {
+	rsp, err := c.CancelTransferApproval(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCancelTransferApprovalResponse(rsp)
+}
+
+// RejectTransferWithBodyWithResponse request with arbitrary body returning *RejectTransferResponse
+func (c *ClientWithResponses) RejectTransferWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RejectTransferResponse, error) {
+	rsp, err := c.RejectTransferWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRejectTransferResponse(rsp)
+}
+
+func (c *ClientWithResponses) RejectTransferWithResponse(ctx context.Context, id ID, body RejectTransferJSONRequestBody, reqEditors ...RequestEditorFn) (*RejectTransferResponse, error) {
+	rsp, err := c.RejectTransfer(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRejectTransferResponse(rsp)
+}
+
+// ListEnabledCurrenciesWithResponse request returning *ListEnabledCurrenciesResponse
+func (c *ClientWithResponses) ListEnabledCurrenciesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListEnabledCurrenciesResponse, error) {
+	rsp, err := c.ListEnabledCurrencies(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListEnabledCurrenciesResponse(rsp)
+}
+
+// GetDocsWithResponse request returning *GetDocsResponse
+func (c *ClientWithResponses) GetDocsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDocsResponse, error) {
+	rsp, err := c.GetDocs(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDocsResponse(rsp)
+}
+
+// GetDocsAssetWithResponse request returning *GetDocsAssetResponse
+func (c *ClientWithResponses) GetDocsAssetWithResponse(ctx context.Context, asset string, reqEditors ...RequestEditorFn) (*GetDocsAssetResponse, error) {
+	rsp, err := c.GetDocsAsset(ctx, asset, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDocsAssetResponse(rsp)
+}
+
+// ListEscrowsWithResponse request returning *ListEscrowsResponse
+func (c *ClientWithResponses) ListEscrowsWithResponse(ctx context.Context, params *ListEscrowsParams, reqEditors ...RequestEditorFn) (*ListEscrowsResponse, error) {
+	rsp, err := c.ListEscrows(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListEscrowsResponse(rsp)
+}
+
+// CreateEscrowWithBodyWithResponse request with arbitrary body returning *CreateEscrowResponse
+func (c *ClientWithResponses) CreateEscrowWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateEscrowResponse, error) {
+	rsp, err := c.CreateEscrowWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateEscrowResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateEscrowWithResponse(ctx context.Context, body CreateEscrowJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateEscrowResponse, error) {
+	rsp, err := c.CreateEscrow(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateEscrowResponse(rsp)
+}
+
+// GetEscrowWithResponse request returning *GetEscrowResponse
+func (c *ClientWithResponses) GetEscrowWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*GetEscrowResponse, error) {
+	rsp, err := c.GetEscrow(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEscrowResponse(rsp)
+}
+
+// ConfirmEscrowWithBodyWithResponse request with arbitrary body returning *ConfirmEscrowResponse
+func (c *ClientWithResponses) ConfirmEscrowWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConfirmEscrowResponse, error) {
+	rsp, err := c.ConfirmEscrowWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfirmEscrowResponse(rsp)
+}
+
+func (c *ClientWithResponses) ConfirmEscrowWithResponse(ctx context.Context, id ID, body ConfirmEscrowJSONRequestBody, reqEditors ...RequestEditorFn) (*ConfirmEscrowResponse, error) {
+	rsp, err := c.ConfirmEscrow(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfirmEscrowResponse(rsp)
+}
+
+// DisputeEscrowWithBodyWithResponse request with arbitrary body returning *DisputeEscrowResponse
+func (c *ClientWithResponses) DisputeEscrowWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DisputeEscrowResponse, error) {
+	rsp, err := c.DisputeEscrowWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDisputeEscrowResponse(rsp)
+}
+
+func (c *ClientWithResponses) DisputeEscrowWithResponse(ctx context.Context, id ID, body DisputeEscrowJSONRequestBody, reqEditors ...RequestEditorFn) (*DisputeEscrowResponse, error) {
+	rsp, err := c.DisputeEscrow(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDisputeEscrowResponse(rsp)
+}
+
+// CreateFxQuoteWithBodyWithResponse request with arbitrary body returning *CreateFxQuoteResponse
+func (c *ClientWithResponses) CreateFxQuoteWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateFxQuoteResponse, error) {
+	rsp, err := c.CreateFxQuoteWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateFxQuoteResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateFxQuoteWithResponse(ctx context.Context, body CreateFxQuoteJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateFxQuoteResponse, error) {
+	rsp, err := c.CreateFxQuote(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateFxQuoteResponse(rsp)
+}
+
+// HealthzWithResponse request returning *HealthzResponse
+func (c *ClientWithResponses) HealthzWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthzResponse, error) {
+	rsp, err := c.Healthz(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHealthzResponse(rsp)
+}
+
+// GetHoldWithResponse request returning *GetHoldResponse
+func (c *ClientWithResponses) GetHoldWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*GetHoldResponse, error) {
+	rsp, err := c.GetHold(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetHoldResponse(rsp)
+}
+
+// CaptureHoldWithBodyWithResponse request with arbitrary body returning *CaptureHoldResponse
+func (c *ClientWithResponses) CaptureHoldWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CaptureHoldResponse, error) {
+	rsp, err := c.CaptureHoldWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCaptureHoldResponse(rsp)
+}
+
+func (c *ClientWithResponses) CaptureHoldWithResponse(ctx context.Context, id ID, body CaptureHoldJSONRequestBody, reqEditors ...RequestEditorFn) (*CaptureHoldResponse, error) {
+	rsp, err := c.CaptureHold(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCaptureHoldResponse(rsp)
+}
+
+// ReleaseHoldWithResponse request returning *ReleaseHoldResponse
+func (c *ClientWithResponses) ReleaseHoldWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*ReleaseHoldResponse, error) {
+	rsp, err := c.ReleaseHold(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReleaseHoldResponse(rsp)
+}
+
+// ListNotificationsWithResponse request returning *ListNotificationsResponse
+func (c *ClientWithResponses) ListNotificationsWithResponse(ctx context.Context, params *ListNotificationsParams, reqEditors ...RequestEditorFn) (*ListNotificationsResponse, error) {
+	rsp, err := c.ListNotifications(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListNotificationsResponse(rsp)
+}
+
+// ReadNotificationWithResponse request returning *ReadNotificationResponse
+func (c *ClientWithResponses) ReadNotificationWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*ReadNotificationResponse, error) {
+	rsp, err := c.ReadNotification(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReadNotificationResponse(rsp)
+}
+
+// GetOpenAPIWithResponse request returning *GetOpenAPIResponse
+func (c *ClientWithResponses) GetOpenAPIWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOpenAPIResponse, error) {
+	rsp, err := c.GetOpenAPI(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOpenAPIResponse(rsp)
+}
+
+// ListOrganisationsWithResponse request returning *ListOrganisationsResponse
+func (c *ClientWithResponses) ListOrganisationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListOrganisationsResponse, error) {
+	rsp, err := c.ListOrganisations(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListOrganisationsResponse(rsp)
+}
+
+// ListTransferApprovalsWithResponse request returning *ListTransferApprovalsResponse
+func (c *ClientWithResponses) ListTransferApprovalsWithResponse(ctx context.Context, id ID, params *ListTransferApprovalsParams, reqEditors ...RequestEditorFn) (*ListTransferApprovalsResponse, error) {
+	rsp, err := c.ListTransferApprovals(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListTransferApprovalsResponse(rsp)
+}
+
+// ListOrganisationMembersWithResponse request returning *ListOrganisationMembersResponse
+func (c *ClientWithResponses) ListOrganisationMembersWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*ListOrganisationMembersResponse, error) {
+	rsp, err := c.ListOrganisationMembers(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListOrganisationMembersResponse(rsp)
+}
+
+// ListPaymentRequestsWithResponse request returning *ListPaymentRequestsResponse
+func (c *ClientWithResponses) ListPaymentRequestsWithResponse(ctx context.Context, params *ListPaymentRequestsParams, reqEditors ...RequestEditorFn) (*ListPaymentRequestsResponse, error) {
+	rsp, err := c.ListPaymentRequests(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListPaymentRequestsResponse(rsp)
+}
+
+// CreatePaymentRequestWithBodyWithResponse request with arbitrary body returning *CreatePaymentRequestResponse
+func (c *ClientWithResponses) CreatePaymentRequestWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePaymentRequestResponse, error) {
+	rsp, err := c.CreatePaymentRequestWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePaymentRequestResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreatePaymentRequestWithResponse(ctx context.Context, body CreatePaymentRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePaymentRequestResponse, error) {
+	rsp, err := c.CreatePaymentRequest(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePaymentRequestResponse(rsp)
+}
+
+// AcceptPaymentRequestWithBodyWithResponse request with arbitrary body returning *AcceptPaymentRequestResponse
+func (c *ClientWithResponses) AcceptPaymentRequestWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AcceptPaymentRequestResponse, error) {
+	rsp, err := c.AcceptPaymentRequestWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAcceptPaymentRequestResponse(rsp)
+}
+
+func (c *ClientWithResponses) AcceptPaymentRequestWithResponse(ctx context.Context, id ID, body AcceptPaymentRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*AcceptPaymentRequestResponse, error) {
+	rsp, err := c.AcceptPaymentRequest(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAcceptPaymentRequestResponse(rsp)
+}
+
+// DeclinePaymentRequestWithResponse request returning *DeclinePaymentRequestResponse
+func (c *ClientWithResponses) DeclinePaymentRequestWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*DeclinePaymentRequestResponse, error) {
+	rsp, err := c.DeclinePaymentRequest(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeclinePaymentRequestResponse(rsp)
+}
+
+// ReadyzWithResponse request returning *ReadyzResponse
+func (c *ClientWithResponses) ReadyzWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ReadyzResponse, error) {
+	rsp, err := c.Readyz(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReadyzResponse(rsp)
+}
+
+// LookupRecipientWithResponse request returning *LookupRecipientResponse
+func (c *ClientWithResponses) LookupRecipientWithResponse(ctx context.Context, params *LookupRecipientParams, reqEditors ...RequestEditorFn) (*LookupRecipientResponse, error) {
+	rsp, err := c.LookupRecipient(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLookupRecipientResponse(rsp)
+}
+
+// ListScheduledTransfersWithResponse request returning *ListScheduledTransfersResponse
+func (c *ClientWithResponses) ListScheduledTransfersWithResponse(ctx context.Context, params *ListScheduledTransfersParams, reqEditors ...RequestEditorFn) (*ListScheduledTransfersResponse, error) {
+	rsp, err := c.ListScheduledTransfers(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListScheduledTransfersResponse(rsp)
+}
+
+// CreateScheduledTransferWithBodyWithResponse request with arbitrary body returning *CreateScheduledTransferResponse
+func (c *ClientWithResponses) CreateScheduledTransferWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateScheduledTransferResponse, error) {
+	rsp, err := c.CreateScheduledTransferWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateScheduledTransferResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateScheduledTransferWithResponse(ctx context.Context, body CreateScheduledTransferJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateScheduledTransferResponse, error) {
+	rsp, err := c.CreateScheduledTransfer(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateScheduledTransferResponse(rsp)
+}
+
+// CancelScheduledTransferWithResponse request returning *CancelScheduledTransferResponse
+func (c *ClientWithResponses) CancelScheduledTransferWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*CancelScheduledTransferResponse, error) {
+	rsp, err := c.CancelScheduledTransfer(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCancelScheduledTransferResponse(rsp)
+}
+
+// GetScheduledTransferWithResponse request returning *GetScheduledTransferResponse
+func (c *ClientWithResponses) GetScheduledTransferWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*GetScheduledTransferResponse, error) {
+	rsp, err := c.GetScheduledTransfer(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetScheduledTransferResponse(rsp)
+}
+
+// UpdateScheduledTransferWithBodyWithResponse request with arbitrary body returning *UpdateScheduledTransferResponse
+func (c *ClientWithResponses) UpdateScheduledTransferWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateScheduledTransferResponse, error) {
+	rsp, err := c.UpdateScheduledTransferWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateScheduledTransferResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateScheduledTransferWithResponse(ctx context.Context, id ID, body UpdateScheduledTransferJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateScheduledTransferResponse, error) {
+	rsp, err := c.UpdateScheduledTransfer(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateScheduledTransferResponse(rsp)
+}
+
+// RefreshTokenWithBodyWithResponse request with arbitrary body returning *RefreshTokenResponse
+func (c *ClientWithResponses) RefreshTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RefreshTokenResponse, error) {
+	rsp, err := c.RefreshTokenWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRefreshTokenResponse(rsp)
+}
+
+func (c *ClientWithResponses) RefreshTokenWithResponse(ctx context.Context, body RefreshTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*RefreshTokenResponse, error) {
+	rsp, err := c.RefreshToken(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRefreshTokenResponse(rsp)
+}
+
+// ListTransferBatchesWithResponse request returning *ListTransferBatchesResponse
+func (c *ClientWithResponses) ListTransferBatchesWithResponse(ctx context.Context, params *ListTransferBatchesParams, reqEditors ...RequestEditorFn) (*ListTransferBatchesResponse, error) {
+	rsp, err := c.ListTransferBatches(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListTransferBatchesResponse(rsp)
+}
+
+// CreateTransferBatchWithBodyWithResponse request with arbitrary body returning *CreateTransferBatchResponse
+func (c *ClientWithResponses) CreateTransferBatchWithBodyWithResponse(ctx context.Context, params *CreateTransferBatchParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTransferBatchResponse, error) {
+	rsp, err := c.CreateTransferBatchWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTransferBatchResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateTransferBatchWithResponse(ctx context.Context, params *CreateTransferBatchParams, body CreateTransferBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTransferBatchResponse, error) {
+	rsp, err := c.CreateTransferBatch(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTransferBatchResponse(rsp)
+}
+
+// GetTransferBatchWithResponse request returning *GetTransferBatchResponse
+func (c *ClientWithResponses) GetTransferBatchWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*GetTransferBatchResponse, error) {
+	rsp, err := c.GetTransferBatch(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTransferBatchResponse(rsp)
+}
+
+// ListTransferBatchItemsWithResponse request returning *ListTransferBatchItemsResponse
+func (c *ClientWithResponses) ListTransferBatchItemsWithResponse(ctx context.Context, id ID, params *ListTransferBatchItemsParams, reqEditors ...RequestEditorFn) (*ListTransferBatchItemsResponse, error) {
+	rsp, err := c.ListTransferBatchItems(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListTransferBatchItemsResponse(rsp)
+}
+
+// CreateTransferWithBodyWithResponse request with arbitrary body returning *CreateTransferResponse
+func (c *ClientWithResponses) CreateTransferWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTransferResponse, error) {
+	rsp, err := c.CreateTransferWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTransferResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateTransferWithResponse(ctx context.Context, body CreateTransferJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTransferResponse, error) {
+	rsp, err := c.CreateTransfer(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTransferResponse(rsp)
+}
+
+// QuoteTransferWithBodyWithResponse request with arbitrary body returning *QuoteTransferResponse
+func (c *ClientWithResponses) QuoteTransferWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QuoteTransferResponse, error) {
+	rsp, err := c.QuoteTransferWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQuoteTransferResponse(rsp)
+}
+
+func (c *ClientWithResponses) QuoteTransferWithResponse(ctx context.Context, body QuoteTransferJSONRequestBody, reqEditors ...RequestEditorFn) (*QuoteTransferResponse, error) {
+	rsp, err := c.QuoteTransfer(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQuoteTransferResponse(rsp)
+}
+
+// GetTransferWithResponse request returning *GetTransferResponse
+func (c *ClientWithResponses) GetTransferWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*GetTransferResponse, error) {
+	rsp, err := c.GetTransfer(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTransferResponse(rsp)
+}
+
+// GetUserWithResponse request returning *GetUserResponse
+func (c *ClientWithResponses) GetUserWithResponse(ctx context.Context, params *GetUserParams, reqEditors ...RequestEditorFn) (*GetUserResponse, error) {
+	rsp, err := c.GetUser(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUserResponse(rsp)
+}
+
+// CreateUserWithBodyWithResponse request with arbitrary body returning *CreateUserResponse
+func (c *ClientWithResponses) CreateUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUserResponse, error) {
+	rsp, err := c.CreateUserWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateUserResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateUserWithResponse(ctx context.Context, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUserResponse, error) {
+	rsp, err := c.CreateUser(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateUserResponse(rsp)
+}
+
+// DeleteAliasWithResponse request returning *DeleteAliasResponse
+func (c *ClientWithResponses) DeleteAliasWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteAliasResponse, error) {
+	rsp, err := c.DeleteAlias(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAliasResponse(rsp)
+}
+
+// SetAliasWithBodyWithResponse request with arbitrary body returning *SetAliasResponse
+func (c *ClientWithResponses) SetAliasWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetAliasResponse, error) {
+	rsp, err := c.SetAliasWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetAliasResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetAliasWithResponse(ctx context.Context, body SetAliasJSONRequestBody, reqEditors ...RequestEditorFn) (*SetAliasResponse, error) {
+	rsp, err := c.SetAlias(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetAliasResponse(rsp)
+}
+
+// GetWalletWithResponse request returning *GetWalletResponse
+func (c *ClientWithResponses) GetWalletWithResponse(ctx context.Context, params *GetWalletParams, reqEditors ...RequestEditorFn) (*GetWalletResponse, error) {
+	rsp, err := c.GetWallet(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWalletResponse(rsp)
+}
+
+// GetAllUsersWithResponse request returning *GetAllUsersResponse
+func (c *ClientWithResponses) GetAllUsersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAllUsersResponse, error) {
+	rsp, err := c.GetAllUsers(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAllUsersResponse(rsp)
+}
+
+// LoginUserWithBodyWithResponse request with arbitrary body returning *LoginUserResponse
+func (c *ClientWithResponses) LoginUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginUserResponse, error) {
+	rsp, err := c.LoginUserWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLoginUserResponse(rsp)
+}
+
+func (c *ClientWithResponses) LoginUserWithResponse(ctx context.Context, body LoginUserJSONRequestBody, reqEditors ...RequestEditorFn) (*LoginUserResponse, error) {
+	rsp, err := c.LoginUser(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLoginUserResponse(rsp)
+}
+
+// ParseWelcomeResponse parses an HTTP response from a WelcomeWithResponse call
+func ParseWelcomeResponse(rsp *http.Response) (*WelcomeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &WelcomeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListAllAccountsResponse parses an HTTP response from a ListAllAccountsWithResponse call
+func ParseListAllAccountsResponse(rsp *http.Response) (*ListAllAccountsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListAllAccountsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Account
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateAccountResponse parses an HTTP response from a CreateAccountWithResponse call
+func ParseCreateAccountResponse(rsp *http.Response) (*CreateAccountResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateAccountResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Account
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAccountByIdResponse parses an HTTP response from a GetAccountByIdWithResponse call
+func ParseGetAccountByIdResponse(rsp *http.Response) (*GetAccountByIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAccountByIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Account
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateDepositResponse parses an HTTP response from a CreateDepositWithResponse call
+func ParseCreateDepositResponse(rsp *http.Response) (*CreateDepositResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateDepositResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FundingTxResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetFundingTransactionResponse parses an HTTP response from a GetFundingTransactionWithResponse call
+func ParseGetFundingTransactionResponse(rsp *http.Response) (*GetFundingTransactionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetFundingTransactionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FundingTxResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListHoldsResponse parses an HTTP response from a ListHoldsWithResponse call
+func ParseListHoldsResponse(rsp *http.Response) (*ListHoldsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListHoldsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Hold
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateHoldResponse parses an HTTP response from a CreateHoldWithResponse call
+func ParseCreateHoldResponse(rsp *http.Response) (*CreateHoldResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateHoldResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HoldTxResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListInterestPayoutsResponse parses an HTTP response from a ListInterestPayoutsWithResponse call
+func ParseListInterestPayoutsResponse(rsp *http.Response) (*ListInterestPayoutsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListInterestPayoutsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []InterestPayout
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAccountLimitsResponse parses an HTTP response from a GetAccountLimitsWithResponse call
+func ParseGetAccountLimitsResponse(rsp *http.Response) (*GetAccountLimitsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAccountLimitsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AccountLimits
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListOverdraftChargesResponse parses an HTTP response from a ListOverdraftChargesWithResponse call
+func ParseListOverdraftChargesResponse(rsp *http.Response) (*ListOverdraftChargesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListOverdraftChargesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []OverdraftInterestCharge
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListAccountTransfersResponse parses an HTTP response from a ListAccountTransfersWithResponse call
+func ParseListAccountTransfersResponse(rsp *http.Response) (*ListAccountTransfersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListAccountTransfersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Transfer
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateWithdrawalResponse parses an HTTP response from a CreateWithdrawalWithResponse call
+func ParseCreateWithdrawalResponse(rsp *http.Response) (*CreateWithdrawalResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateWithdrawalResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FundingTxResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetInterestProductResponse parses an HTTP response from a SetInterestProductWithResponse call
+func ParseSetInterestProductResponse(rsp *http.Response) (*SetInterestProductResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetInterestProductResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Account
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetAccountLimitsResponse parses an HTTP response from a SetAccountLimitsWithResponse call
+func ParseSetAccountLimitsResponse(rsp *http.Response) (*SetAccountLimitsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetAccountLimitsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TransferLimit
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetOverdraftResponse parses an HTTP response from a SetOverdraftWithResponse call
+func ParseSetOverdraftResponse(rsp *http.Response) (*SetOverdraftResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetOverdraftResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Account
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListCurrenciesResponse parses an HTTP response from a ListCurrenciesWithResponse call
+func ParseListCurrenciesResponse(rsp *http.Response) (*ListCurrenciesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListCurrenciesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []CurrencyRecord
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateCurrencyResponse parses an HTTP response from a UpdateCurrencyWithResponse call
+func ParseUpdateCurrencyResponse(rsp *http.Response) (*UpdateCurrencyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateCurrencyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CurrencyRecord
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetFeeScheduleResponse parses an HTTP response from a GetFeeScheduleWithResponse call
+func ParseGetFeeScheduleResponse(rsp *http.Response) (*GetFeeScheduleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetFeeScheduleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FeeScheduleResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetFeeScheduleResponse parses an HTTP response from a SetFeeScheduleWithResponse call
+func ParseSetFeeScheduleResponse(rsp *http.Response) (*SetFeeScheduleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetFeeScheduleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FeeScheduleResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListFeeSchedulesResponse parses an HTTP response from a ListFeeSchedulesWithResponse call
+func ParseListFeeSchedulesResponse(rsp *http.Response) (*ListFeeSchedulesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListFeeSchedulesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []FeeSchedule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListInterestProductsResponse parses an HTTP response from a ListInterestProductsWithResponse call
+func ParseListInterestProductsResponse(rsp *http.Response) (*ListInterestProductsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListInterestProductsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []InterestProduct
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateInterestProductResponse parses an HTTP response from a CreateInterestProductWithResponse call
+func ParseCreateInterestProductResponse(rsp *http.Response) (*CreateInterestProductResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateInterestProductResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest InterestProduct
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseBackfillInterestResponse parses an HTTP response from a BackfillInterestWithResponse call
+func ParseBackfillInterestResponse(rsp *http.Response) (*BackfillInterestResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &BackfillInterestResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []InterestRun
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteTransferLimitResponse parses an HTTP response from a DeleteTransferLimitWithResponse call
+func ParseDeleteTransferLimitResponse(rsp *http.Response) (*DeleteTransferLimitResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteTransferLimitResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetMetricsResponse parses an HTTP response from a GetMetricsWithResponse call
+func ParseGetMetricsResponse(rsp *http.Response) (*GetMetricsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetMetricsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest map[string]interface{}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateOrganisationResponse parses an HTTP response from a CreateOrganisationWithResponse call
+func ParseCreateOrganisationResponse(rsp *http.Response) (*CreateOrganisationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateOrganisationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Organisation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLinkOrganisationAccountResponse parses an HTTP response from a LinkOrganisationAccountWithResponse call
+func ParseLinkOrganisationAccountResponse(rsp *http.Response) (*LinkOrganisationAccountResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LinkOrganisationAccountResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Account
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRemoveOrganisationMemberResponse parses an HTTP response from a RemoveOrganisationMemberWithResponse call
+func ParseRemoveOrganisationMemberResponse(rsp *http.Response) (*RemoveOrganisationMemberResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RemoveOrganisationMemberResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAddOrganisationMemberResponse parses an HTTP response from a AddOrganisationMemberWithResponse call
+func ParseAddOrganisationMemberResponse(rsp *http.Response) (*AddOrganisationMemberResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AddOrganisationMemberResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OrganisationMember
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetOrganisationPolicyResponse parses an HTTP response from a SetOrganisationPolicyWithResponse call
+func ParseSetOrganisationPolicyResponse(rsp *http.Response) (*SetOrganisationPolicyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetOrganisationPolicyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Organisation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetStatusResponse parses an HTTP response from a GetStatusWithResponse call
+func ParseGetStatusResponse(rsp *http.Response) (*GetStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseReverseTransferResponse parses an HTTP response from a ReverseTransferWithResponse call
+func ParseReverseTransferResponse(rsp *http.Response) (*ReverseTransferResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReverseTransferResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ReverseTransferTxResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetUserLimitsResponse parses an HTTP response from a SetUserLimitsWithResponse call
+func ParseSetUserLimitsResponse(rsp *http.Response) (*SetUserLimitsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetUserLimitsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TransferLimit
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetUserTierResponse parses an HTTP response from a SetUserTierWithResponse call
+func ParseSetUserTierResponse(rsp *http.Response) (*SetUserTierResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetUserTierResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserTier
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseVerifyUserEmailResponse parses an HTTP response from a VerifyUserEmailWithResponse call
+func ParseVerifyUserEmailResponse(rsp *http.Response) (*VerifyUserEmailResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &VerifyUserEmailResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EmailVerification
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTransferApprovalResponse parses an HTTP response from a GetTransferApprovalWithResponse call
+func ParseGetTransferApprovalResponse(rsp *http.Response) (*GetTransferApprovalResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTransferApprovalResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TransferApprovalWithAuditLog
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseApproveTransferResponse parses an HTTP response from a ApproveTransferWithResponse call
+func ParseApproveTransferResponse(rsp *http.Response) (*ApproveTransferResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ApproveTransferResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TransferApprovalTxResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCancelTransferApprovalResponse parses an HTTP response from a CancelTransferApprovalWithResponse call
+func ParseCancelTransferApprovalResponse(rsp *http.Response) (*CancelTransferApprovalResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CancelTransferApprovalResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TransferApprovalTxResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRejectTransferResponse parses an HTTP response from a RejectTransferWithResponse call
+func ParseRejectTransferResponse(rsp *http.Response) (*RejectTransferResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RejectTransferResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TransferApprovalTxResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListEnabledCurrenciesResponse parses an HTTP response from a ListEnabledCurrenciesWithResponse call
+func ParseListEnabledCurrenciesResponse(rsp *http.Response) (*ListEnabledCurrenciesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListEnabledCurrenciesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Currency
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDocsResponse parses an HTTP response from a GetDocsWithResponse call
+func ParseGetDocsResponse(rsp *http.Response) (*GetDocsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDocsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDocsAssetResponse parses an HTTP response from a GetDocsAssetWithResponse call
+func ParseGetDocsAssetResponse(rsp *http.Response) (*GetDocsAssetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDocsAssetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListEscrowsResponse parses an HTTP response from a ListEscrowsWithResponse call
+func ParseListEscrowsResponse(rsp *http.Response) (*ListEscrowsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListEscrowsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Escrow
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateEscrowResponse parses an HTTP response from a CreateEscrowWithResponse call
+func ParseCreateEscrowResponse(rsp *http.Response) (*CreateEscrowResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateEscrowResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EscrowTxResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetEscrowResponse parses an HTTP response from a GetEscrowWithResponse call
+func ParseGetEscrowResponse(rsp *http.Response) (*GetEscrowResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetEscrowResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EscrowWithEvents
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseConfirmEscrowResponse parses an HTTP response from a ConfirmEscrowWithResponse call
+func ParseConfirmEscrowResponse(rsp *http.Response) (*ConfirmEscrowResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ConfirmEscrowResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EscrowTxResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDisputeEscrowResponse parses an HTTP response from a DisputeEscrowWithResponse call
+func ParseDisputeEscrowResponse(rsp *http.Response) (*DisputeEscrowResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DisputeEscrowResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EscrowTxResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateFxQuoteResponse parses an HTTP response from a CreateFxQuoteWithResponse call
+func ParseCreateFxQuoteResponse(rsp *http.Response) (*CreateFxQuoteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateFxQuoteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FxQuote
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseHealthzResponse parses an HTTP response from a HealthzWithResponse call
+func ParseHealthzResponse(rsp *http.Response) (*HealthzResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HealthzResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Health
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetHoldResponse parses an HTTP response from a GetHoldWithResponse call
+func ParseGetHoldResponse(rsp *http.Response) (*GetHoldResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetHoldResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Hold
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCaptureHoldResponse parses an HTTP response from a CaptureHoldWithResponse call
+func ParseCaptureHoldResponse(rsp *http.Response) (*CaptureHoldResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CaptureHoldResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CaptureHoldTxResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseReleaseHoldResponse parses an HTTP response from a ReleaseHoldWithResponse call
+func ParseReleaseHoldResponse(rsp *http.Response) (*ReleaseHoldResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReleaseHoldResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HoldTxResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListNotificationsResponse parses an HTTP response from a ListNotificationsWithResponse call
+func ParseListNotificationsResponse(rsp *http.Response) (*ListNotificationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListNotificationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Notification
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseReadNotificationResponse parses an HTTP response from a ReadNotificationWithResponse call
+func ParseReadNotificationResponse(rsp *http.Response) (*ReadNotificationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReadNotificationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Notification
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetOpenAPIResponse parses an HTTP response from a GetOpenAPIWithResponse call
+func ParseGetOpenAPIResponse(rsp *http.Response) (*GetOpenAPIResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOpenAPIResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest map[string]interface{}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListOrganisationsResponse parses an HTTP response from a ListOrganisationsWithResponse call
+func ParseListOrganisationsResponse(rsp *http.Response) (*ListOrganisationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListOrganisationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Organisation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListTransferApprovalsResponse parses an HTTP response from a ListTransferApprovalsWithResponse call
+func ParseListTransferApprovalsResponse(rsp *http.Response) (*ListTransferApprovalsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListTransferApprovalsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []TransferApproval
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListOrganisationMembersResponse parses an HTTP response from a ListOrganisationMembersWithResponse call
+func ParseListOrganisationMembersResponse(rsp *http.Response) (*ListOrganisationMembersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListOrganisationMembersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []OrganisationMember
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListPaymentRequestsResponse parses an HTTP response from a ListPaymentRequestsWithResponse call
+func ParseListPaymentRequestsResponse(rsp *http.Response) (*ListPaymentRequestsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListPaymentRequestsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []PaymentRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreatePaymentRequestResponse parses an HTTP response from a CreatePaymentRequestWithResponse call
+func ParseCreatePaymentRequestResponse(rsp *http.Response) (*CreatePaymentRequestResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreatePaymentRequestResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PaymentRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAcceptPaymentRequestResponse parses an HTTP response from a AcceptPaymentRequestWithResponse call
+func ParseAcceptPaymentRequestResponse(rsp *http.Response) (*AcceptPaymentRequestResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AcceptPaymentRequestResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AcceptPaymentRequestTxResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeclinePaymentRequestResponse parses an HTTP response from a DeclinePaymentRequestWithResponse call
+func ParseDeclinePaymentRequestResponse(rsp *http.Response) (*DeclinePaymentRequestResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeclinePaymentRequestResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PaymentRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseReadyzResponse parses an HTTP response from a ReadyzWithResponse call
+func ParseReadyzResponse(rsp *http.Response) (*ReadyzResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReadyzResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Readiness
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Readiness
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLookupRecipientResponse parses an HTTP response from a LookupRecipientWithResponse call
+func ParseLookupRecipientResponse(rsp *http.Response) (*LookupRecipientResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LookupRecipientResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Recipient
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListScheduledTransfersResponse parses an HTTP response from a ListScheduledTransfersWithResponse call
+func ParseListScheduledTransfersResponse(rsp *http.Response) (*ListScheduledTransfersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListScheduledTransfersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ScheduledTransfer
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateScheduledTransferResponse parses an HTTP response from a CreateScheduledTransferWithResponse call
+func ParseCreateScheduledTransferResponse(rsp *http.Response) (*CreateScheduledTransferResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateScheduledTransferResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ScheduledTransfer
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCancelScheduledTransferResponse parses an HTTP response from a CancelScheduledTransferWithResponse call
+func ParseCancelScheduledTransferResponse(rsp *http.Response) (*CancelScheduledTransferResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CancelScheduledTransferResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ScheduledTransfer
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetScheduledTransferResponse parses an HTTP response from a GetScheduledTransferWithResponse call
+func ParseGetScheduledTransferResponse(rsp *http.Response) (*GetScheduledTransferResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetScheduledTransferResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ScheduledTransferWithRuns
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateScheduledTransferResponse parses an HTTP response from a UpdateScheduledTransferWithResponse call
+func ParseUpdateScheduledTransferResponse(rsp *http.Response) (*UpdateScheduledTransferResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateScheduledTransferResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ScheduledTransfer
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRefreshTokenResponse parses an HTTP response from a RefreshTokenWithResponse call
+func ParseRefreshTokenResponse(rsp *http.Response) (*RefreshTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RefreshTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RefreshedToken
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListTransferBatchesResponse parses an HTTP response from a ListTransferBatchesWithResponse call
+func ParseListTransferBatchesResponse(rsp *http.Response) (*ListTransferBatchesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListTransferBatchesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []TransferBatch
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateTransferBatchResponse parses an HTTP response from a CreateTransferBatchWithResponse call
+func ParseCreateTransferBatchResponse(rsp *http.Response) (*CreateTransferBatchResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateTransferBatchResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest TransferBatch
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTransferBatchResponse parses an HTTP response from a GetTransferBatchWithResponse call
+func ParseGetTransferBatchResponse(rsp *http.Response) (*GetTransferBatchResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTransferBatchResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TransferBatch
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListTransferBatchItemsResponse parses an HTTP response from a ListTransferBatchItemsWithResponse call
+func ParseListTransferBatchItemsResponse(rsp *http.Response) (*ListTransferBatchItemsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListTransferBatchItemsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []TransferBatchItem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateTransferResponse parses an HTTP response from a CreateTransferWithResponse call
+func ParseCreateTransferResponse(rsp *http.Response) (*CreateTransferResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateTransferResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TransferResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest AcceptedTransfer
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseQuoteTransferResponse parses an HTTP response from a QuoteTransferWithResponse call
+func ParseQuoteTransferResponse(rsp *http.Response) (*QuoteTransferResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &QuoteTransferResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FeeQuote
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTransferResponse parses an HTTP response from a GetTransferWithResponse call
+func ParseGetTransferResponse(rsp *http.Response) (*GetTransferResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTransferResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Transfer
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUserResponse parses an HTTP response from a GetUserWithResponse call
+func ParseGetUserResponse(rsp *http.Response) (*GetUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateUserResponse parses an HTTP response from a CreateUserWithResponse call
+func ParseCreateUserResponse(rsp *http.Response) (*CreateUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAliasResponse parses an HTTP response from a DeleteAliasWithResponse call
+func ParseDeleteAliasResponse(rsp *http.Response) (*DeleteAliasResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAliasResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetAliasResponse parses an HTTP response from a SetAliasWithResponse call
+func ParseSetAliasResponse(rsp *http.Response) (*SetAliasResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetAliasResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Alias
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetWalletResponse parses an HTTP response from a GetWalletWithResponse call
+func ParseGetWalletResponse(rsp *http.Response) (*GetWalletResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWalletResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Wallet
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAllUsersResponse parses an HTTP response from a GetAllUsersWithResponse call
+func ParseGetAllUsersResponse(rsp *http.Response) (*GetAllUsersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAllUsersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLoginUserResponse parses an HTTP response from a LoginUserWithResponse call
+func ParseLoginUserResponse(rsp *http.Response) (*LoginUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LoginUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Login
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
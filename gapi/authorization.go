@@ -0,0 +1,72 @@
+package gapi
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Glenn444/banking-app/internal/token"
+	"github.com/Glenn444/banking-app/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	authorizationHeader     = "authorization"
+	authorizationTypeBearer = "Bearer"
+)
+
+// publicMethods can be called without an access token
+var publicMethods = map[string]bool{
+	pb.UserService_CreateUser_FullMethodName: true,
+	pb.UserService_LoginUser_FullMethodName:  true,
+}
+
+type payloadKey struct{}
+
+// authInterceptor checks the bearer access token in the authorization
+// metadata of every call but the public ones, like authMiddleware does for
+// the HTTP API, and puts its payload in the context
+func authInterceptor(tokenMaker token.Maker) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if publicMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+
+		payload, err := authorizeUser(ctx, tokenMaker)
+		if err != nil {
+			return nil, err
+		}
+		return handler(context.WithValue(ctx, payloadKey{}, payload), req)
+	}
+}
+
+func authorizeUser(ctx context.Context, tokenMaker token.Maker) (*token.Payload, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing metadata")
+	}
+
+	values := md.Get(authorizationHeader)
+	if len(values) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "missing authorization header")
+	}
+
+	authParts := strings.Split(values[0], " ")
+	if len(authParts) != 2 || authParts[0] != authorizationTypeBearer {
+		return nil, status.Error(codes.Unauthenticated, "invalid or missing authorization header")
+	}
+
+	//verify that the token is valid and it's not a refreshToken
+	payload, err := tokenMaker.VerifyToken(authParts[1], token.AccessToken)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid access token: %s", err)
+	}
+	return payload, nil
+}
+
+// authPayload is the payload of the access token the call was made with
+func authPayload(ctx context.Context) *token.Payload {
+	return ctx.Value(payloadKey{}).(*token.Payload)
+}
@@ -0,0 +1,111 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	db "github.com/Glenn444/banking-app/internal/database"
+	mock_database "github.com/Glenn444/banking-app/internal/database/mock"
+	"github.com/Glenn444/banking-app/internal/token"
+	"github.com/Glenn444/banking-app/pb"
+	"github.com/Glenn444/banking-app/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthInterceptor(t *testing.T) {
+	username := util.RandomOwner()
+
+	testCases := []struct {
+		name       string
+		setupAuth  func(t *testing.T, tokenMaker token.Maker) context.Context
+		buildStubs func(store *mock_database.MockStore)
+		wantCode   codes.Code
+	}{
+		{
+			name: "OK",
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return withAuthorization(t, tokenMaker, authorizationTypeBearer, username, time.Minute)
+			},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().
+					ListAccounts(gomock.Any(), gomock.Eq(db.ListAccountsParams{Owner: username, Limit: 5, Offset: 0})).
+					Times(1).
+					Return([]db.Account{}, nil)
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name: "NoAuthorization",
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().ListAccounts(gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name: "UnsupportedAuthorization",
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return withAuthorization(t, tokenMaker, "Unsupported", username, time.Minute)
+			},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().ListAccounts(gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name: "ExpiredToken",
+			setupAuth: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return withAuthorization(t, tokenMaker, authorizationTypeBearer, username, -time.Minute)
+			},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().ListAccounts(gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantCode: codes.Unauthenticated,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_database.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			client := pb.NewAccountServiceClient(dialTestServer(t, server))
+
+			_, err := client.ListAccounts(tc.setupAuth(t, server.tokenMaker), &pb.ListAccountsRequest{})
+			require.Equal(t, tc.wantCode, status.Code(err))
+		})
+	}
+}
+
+func TestAuthInterceptorPublicMethod(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	user := db.User{Username: util.RandomOwner(), Email: util.RandomEmail()}
+
+	store := mock_database.NewMockStore(ctrl)
+	store.EXPECT().CreateUsers(gomock.Any(), gomock.Any()).Times(1).Return(user, nil)
+
+	server := newTestServer(t, store)
+	client := pb.NewUserServiceClient(dialTestServer(t, server))
+
+	rsp, err := client.CreateUser(context.Background(), &pb.CreateUserRequest{
+		Username: user.Username,
+		Email:    user.Email,
+		Password: util.RandomString(6),
+	})
+	require.NoError(t, err)
+	require.Equal(t, user.Username, rsp.GetUser().GetUsername())
+}
@@ -0,0 +1,48 @@
+package gapi
+
+import (
+	db "github.com/Glenn444/banking-app/internal/database"
+	"github.com/Glenn444/banking-app/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func convertUser(user db.User) *pb.User {
+	return &pb.User{
+		Username:          user.Username,
+		FullName:          user.FullName,
+		Email:             user.Email,
+		PasswordChangedAt: timestamppb.New(user.PasswordChangedAt),
+		CreatedAt:         timestamppb.New(user.CreatedAt),
+	}
+}
+
+func convertAccount(account db.Account) *pb.Account {
+	acc := &pb.Account{
+		Id:               account.ID.String(),
+		Owner:            account.Owner,
+		Currency:         account.Currency,
+		Balance:          account.Balance.String(),
+		AvailableBalance: account.AvailableBalance.String(),
+		AccountType:      account.AccountType,
+		CreatedAt:        timestamppb.New(account.CreatedAt),
+	}
+	if account.OrganisationID.Valid {
+		acc.OrganisationId = account.OrganisationID.UUID.String()
+	}
+	return acc
+}
+
+func convertTransfer(transfer db.Transfer) *pb.Transfer {
+	return &pb.Transfer{
+		Id:                transfer.ID.String(),
+		FromAccountId:     transfer.FromAccountID.String(),
+		ToAccountId:       transfer.ToAccountID.String(),
+		Amount:            transfer.Amount.String(),
+		Fee:               transfer.Fee.String(),
+		Description:       transfer.Description,
+		ExternalReference: transfer.ExternalReference.String,
+		Status:            transfer.Status,
+		FailureReason:     transfer.FailureReason,
+		CreatedAt:         timestamppb.New(transfer.CreatedAt),
+	}
+}
@@ -0,0 +1,60 @@
+package gapi
+
+import (
+	"errors"
+	"log"
+
+	db "github.com/Glenn444/banking-app/internal/database"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// statusKind is how an error of the store's taxonomy is answered
+type statusKind struct {
+	err  error
+	code codes.Code
+}
+
+// statusKinds are matched in order with errors.Is, like the HTTP API's
+// problem kinds, so a kind has to come before any more general kind
+var statusKinds = []statusKind{
+	{db.ErrAccountNotFound, codes.NotFound},
+	{db.ErrNotFound, codes.NotFound},
+	{db.ErrUniqueViolation, codes.AlreadyExists},
+	{db.ErrForeignKeyViolation, codes.InvalidArgument},
+	{db.ErrCheckViolation, codes.InvalidArgument},
+	{db.ErrConcurrentUpdate, codes.Aborted},
+
+	{db.ErrInsufficientFunds, codes.FailedPrecondition},
+	{db.ErrInvalidAmountScale, codes.InvalidArgument},
+	{db.ErrLimitExceeded, codes.FailedPrecondition},
+	{db.ErrApprovalCurrency, codes.InvalidArgument},
+}
+
+// internalMessage is the message of every internal error, what went wrong
+// is logged and never told to the client
+const internalMessage = "the request couldn't be completed, try again later"
+
+// storeError turns an error of the store into a status, nothing but the
+// taxonomy's messages reach the client
+func storeError(err error) error {
+	var limitErr *db.LimitExceededError
+	if errors.As(err, &limitErr) {
+		return status.Error(codes.FailedPrecondition, limitErr.Error())
+	}
+
+	translated := db.TranslateError(err)
+	for _, kind := range statusKinds {
+		if errors.Is(translated, kind.err) {
+			return status.Error(kind.code, kind.err.Error())
+		}
+	}
+
+	log.Printf("gapi: %v", err)
+	return status.Error(codes.Internal, internalMessage)
+}
+
+// invalidArgument answers a request that failed validation
+func invalidArgument(message string) error {
+	return status.Error(codes.InvalidArgument, message)
+}
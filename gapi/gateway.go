@@ -0,0 +1,78 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+
+	"github.com/Glenn444/banking-app/pb"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// gatewayBufferSize is the buffer of the in-memory connection between the
+// gateway and its gRPC server
+const gatewayBufferSize = 1024 * 1024
+
+// Gateway serves the gRPC services over HTTP/JSON
+type Gateway struct {
+	http.Handler
+	grpcServer *grpc.Server
+	conn       *grpc.ClientConn
+}
+
+// NewGateway serves the gRPC services over HTTP/JSON. Calls go through a
+// gRPC server of its own over an in-memory connection, so the interceptors
+// apply to them, the Authorization header is passed on as metadata and
+// nothing travels between the two over the network.
+func (server *Server) NewGateway(ctx context.Context) (*Gateway, error) {
+	mux := runtime.NewServeMux(runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
+		MarshalOptions: protojson.MarshalOptions{
+			UseProtoNames:   true,
+			EmitUnpopulated: true,
+		},
+		UnmarshalOptions: protojson.UnmarshalOptions{
+			DiscardUnknown: true,
+		},
+	}))
+
+	listener := bufconn.Listen(gatewayBufferSize)
+	grpcServer := server.NewGRPCServer()
+	go grpcServer.Serve(listener)
+
+	conn, err := grpc.NewClient("passthrough:///gateway",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		grpcServer.Stop()
+		return nil, fmt.Errorf("cannot connect gateway %w", err)
+	}
+
+	registers := []func(context.Context, *runtime.ServeMux, *grpc.ClientConn) error{
+		pb.RegisterUserServiceHandler,
+		pb.RegisterAccountServiceHandler,
+		pb.RegisterTransferServiceHandler,
+	}
+	for _, register := range registers {
+		if err := register(ctx, mux, conn); err != nil {
+			conn.Close()
+			grpcServer.Stop()
+			return nil, fmt.Errorf("cannot register gateway handler %w", err)
+		}
+	}
+	return &Gateway{Handler: mux, grpcServer: grpcServer, conn: conn}, nil
+}
+
+// Stop closes the gateway's gRPC server, once the HTTP server in front of
+// it has stopped sending it calls
+func (gateway *Gateway) Stop() {
+	gateway.conn.Close()
+	gateway.grpcServer.Stop()
+}
@@ -0,0 +1,72 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	db "github.com/Glenn444/banking-app/internal/database"
+	mock_database "github.com/Glenn444/banking-app/internal/database/mock"
+	"github.com/Glenn444/banking-app/internal/token"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGateway(t *testing.T) {
+	testCases := []struct {
+		name         string
+		authorize    bool
+		buildStubs   func(store *mock_database.MockStore)
+		expectStatus int
+	}{
+		{
+			name:      "OK",
+			authorize: true,
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().
+					ListAccounts(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.Account{}, nil)
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name: "NoAuthorization",
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().ListAccounts(gomock.Any(), gomock.Any()).Times(0)
+			},
+			expectStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_database.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			gateway, err := server.NewGateway(context.Background())
+			require.NoError(t, err)
+			defer gateway.Stop()
+
+			request := httptest.NewRequest(http.MethodGet, "/v1/accounts", nil)
+			if tc.authorize {
+				accessToken, err := server.tokenMaker.CreateToken("owner", token.AccessToken, time.Minute)
+				require.NoError(t, err)
+				request.Header.Set("Authorization", fmt.Sprintf("%s %s", authorizationTypeBearer, accessToken))
+			}
+
+			recorder := httptest.NewRecorder()
+			gateway.ServeHTTP(recorder, request)
+			require.Equal(t, tc.expectStatus, recorder.Code, recorder.Body.String())
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	db "github.com/Glenn444/banking-app/internal/database"
+	"github.com/Glenn444/banking-app/internal/token"
+	"github.com/Glenn444/banking-app/util"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// testCurrencies seeds the registry, the server loads it from the database
+// at startup and the tests don't have one
+var testCurrencies = []util.Currency{
+	{Code: "USD", NumericCode: 840, MinorUnits: 2, Enabled: true, Symbol: "$"},
+	{Code: "EUR", NumericCode: 978, MinorUnits: 2, Enabled: true, Symbol: "€"},
+	{Code: "CAD", NumericCode: 124, MinorUnits: 2, Enabled: true, Symbol: "CA$"},
+	{Code: "JPY", NumericCode: 392, MinorUnits: 0, Enabled: true, Symbol: "¥"},
+	{Code: "BHD", NumericCode: 48, MinorUnits: 3, Enabled: true, Symbol: "BD"},
+}
+
+func TestMain(m *testing.M) {
+	util.SetCurrencies(testCurrencies)
+	os.Exit(m.Run())
+}
+
+func newTestServer(t *testing.T, store db.Store) *Server {
+	config := util.Config{
+		TokenSymmetricKey:  util.RandomString(32),
+		AcessTokenDuration: time.Minute,
+	}
+
+	server, err := NewServer(config, store)
+	require.NoError(t, err)
+
+	return server
+}
+
+// dialTestServer serves server over an in-memory listener and returns a
+// client connection to it
+func dialTestServer(t *testing.T, server *Server) *grpc.ClientConn {
+	listener := bufconn.Listen(1024 * 1024)
+	grpcServer := server.NewGRPCServer()
+	go grpcServer.Serve(listener)
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return conn
+}
+
+func withAuthorization(t *testing.T, tokenMaker token.Maker, authorizationType string, username string, duration time.Duration) context.Context {
+	accessToken, err := tokenMaker.CreateToken(username, token.AccessToken, duration)
+	require.NoError(t, err)
+
+	authorization := fmt.Sprintf("%s %s", authorizationType, accessToken)
+	return metadata.AppendToOutgoingContext(context.Background(), authorizationHeader, authorization)
+}
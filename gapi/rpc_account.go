@@ -0,0 +1,107 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/Glenn444/banking-app/internal/database"
+	"github.com/Glenn444/banking-app/pb"
+	"github.com/Glenn444/banking-app/util"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateAccount opens an account for the authenticated user
+func (server *Server) CreateAccount(ctx context.Context, req *pb.CreateAccountRequest) (*pb.CreateAccountResponse, error) {
+	if !util.IsSupportedCurrency(req.GetCurrency()) {
+		return nil, invalidArgument("currency is not supported")
+	}
+	if !util.IsEnabledCurrency(req.GetCurrency()) {
+		return nil, invalidArgument("currency is disabled")
+	}
+	accountType := req.GetAccountType()
+	if err := validateField("account_type", accountType, "omitempty,oneof=checking savings"); err != nil {
+		return nil, err
+	}
+	if accountType == "" {
+		accountType = db.AccountTypeChecking
+	}
+
+	account, err := server.store.CreateAccount(ctx, db.CreateAccountParams{
+		Owner:       authPayload(ctx).Username,
+		Currency:    req.GetCurrency(),
+		Balance:     decimal.Zero,
+		AccountType: accountType,
+	})
+	if err != nil {
+		err = db.TranslateError(err)
+		switch {
+		case errors.Is(err, db.ErrForeignKeyViolation):
+			if db.ViolatedConstraint(err) == "fk_account_currency" {
+				return nil, invalidArgument("currency is not supported")
+			}
+			return nil, status.Error(codes.PermissionDenied, "owner does not exist")
+		case errors.Is(err, db.ErrUniqueViolation):
+			return nil, status.Error(codes.AlreadyExists, "account with this currency and type already exists for owner")
+		}
+		return nil, storeError(err)
+	}
+
+	return &pb.CreateAccountResponse{Account: convertAccount(account)}, nil
+}
+
+// GetAccount shows one of the authenticated user's accounts, the accounts of
+// other users aren't found
+func (server *Server) GetAccount(ctx context.Context, req *pb.GetAccountRequest) (*pb.GetAccountResponse, error) {
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, invalidArgument("invalid account id format")
+	}
+
+	account, err := server.store.GetAccount(ctx, id)
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "account not found")
+		}
+		return nil, storeError(err)
+	}
+	if account.Owner != authPayload(ctx).Username {
+		return nil, status.Error(codes.NotFound, "account not found")
+	}
+
+	return &pb.GetAccountResponse{Account: convertAccount(account)}, nil
+}
+
+// ListAccounts pages through the authenticated user's accounts
+func (server *Server) ListAccounts(ctx context.Context, req *pb.ListAccountsRequest) (*pb.ListAccountsResponse, error) {
+	pageNum, pageSize := req.GetPageNum(), req.GetPageSize()
+	if pageNum == 0 {
+		pageNum = 1 // default
+	}
+	if pageSize == 0 {
+		pageSize = 5 // default
+	}
+	if err := validateField("page_num", pageNum, "min=1"); err != nil {
+		return nil, err
+	}
+	if err := validateField("page_size", pageSize, "min=5,max=10"); err != nil {
+		return nil, err
+	}
+
+	accounts, err := server.store.ListAccounts(ctx, db.ListAccountsParams{
+		Owner:  authPayload(ctx).Username,
+		Limit:  pageSize,
+		Offset: (pageNum - 1) * pageSize,
+	})
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	rsp := &pb.ListAccountsResponse{Accounts: make([]*pb.Account, 0, len(accounts))}
+	for _, account := range accounts {
+		rsp.Accounts = append(rsp.Accounts, convertAccount(account))
+	}
+	return rsp, nil
+}
@@ -0,0 +1,208 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/Glenn444/banking-app/internal/database"
+	"github.com/Glenn444/banking-app/internal/fx"
+	"github.com/Glenn444/banking-app/pb"
+	"github.com/Glenn444/banking-app/util"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateTransfer moves money out of an account the authenticated user owns
+// or initiates transfers for. A transfer over the threshold of the account's
+// organisation waits for its approvers instead, and only its approval id is
+// returned.
+func (server *Server) CreateTransfer(ctx context.Context, req *pb.CreateTransferRequest) (*pb.CreateTransferResponse, error) {
+	fromAccountID, err := uuid.Parse(req.GetFromAccountId())
+	if err != nil {
+		return nil, invalidArgument("invalid from account id format")
+	}
+	toAccountID, err := uuid.Parse(req.GetToAccountId())
+	if err != nil {
+		return nil, invalidArgument("invalid to account id format")
+	}
+	if fromAccountID == toAccountID {
+		return nil, invalidArgument("can't transfer to the same account")
+	}
+	if !util.IsSupportedCurrency(req.GetCurrency()) {
+		return nil, invalidArgument("currency is not supported")
+	}
+	amount, err := decimal.NewFromString(req.GetAmount())
+	if err != nil || !amount.IsPositive() {
+		return nil, invalidArgument("amount must be a positive decimal")
+	}
+	if !util.IsValidAmount(amount, req.GetCurrency()) {
+		return nil, status.Error(codes.InvalidArgument, db.ErrInvalidAmountScale.Error())
+	}
+	if err := validateField("description", req.GetDescription(), "max=255"); err != nil {
+		return nil, err
+	}
+	if err := validateField("external_reference", req.GetExternalReference(), "omitempty,max=64,printascii"); err != nil {
+		return nil, err
+	}
+
+	fromAccount, err := server.validAccount(ctx, fromAccountID, req.GetCurrency())
+	if err != nil {
+		return nil, err
+	}
+	organisation, err := server.authorizeFromAccount(ctx, fromAccount)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := server.validAccount(ctx, toAccountID, req.GetCurrency()); err != nil {
+		return nil, err
+	}
+
+	arg := db.TransferTxParams{
+		FromAccountID: fromAccountID,
+		ToAccountID:   toAccountID,
+		Amount:        amount,
+		TransferDetails: db.TransferDetails{
+			Description: req.GetDescription(),
+			ExternalReference: pgtype.Text{
+				String: req.GetExternalReference(),
+				Valid:  req.GetExternalReference() != "",
+			},
+		},
+	}
+
+	// the threshold is in the organisation's currency, the amount is
+	// converted to it when the account holds another
+	approval := false
+	if organisation != nil {
+		approval, err = fx.Exceeds(ctx, server.fxProvider, amount, fromAccount.Currency, organisation.ApprovalThreshold, organisation.ApprovalThresholdCurrency)
+		if err != nil {
+			return nil, storeError(err)
+		}
+	}
+	if approval {
+		result, err := server.store.CreateTransferApprovalTx(ctx, db.CreateTransferApprovalTxParams{
+			OrganisationID:   organisation.ID,
+			InitiatedBy:      authPayload(ctx).Username,
+			TransferTxParams: arg,
+		})
+		if err != nil {
+			return nil, storeError(err)
+		}
+		return &pb.CreateTransferResponse{ApprovalId: result.Approval.ID.String()}, nil
+	}
+
+	result, err := server.store.TransferTx(ctx, arg)
+	if err != nil {
+		if errors.Is(db.TranslateError(err), db.ErrUniqueViolation) && arg.ExternalReference.Valid {
+			return nil, status.Error(codes.AlreadyExists, "external reference already used")
+		}
+		return nil, storeError(err)
+	}
+	return &pb.CreateTransferResponse{Transfer: convertTransfer(result.Transfer)}, nil
+}
+
+// GetTransfer shows a transfer to its sender, its recipient and the members
+// of the sender's organisation, it isn't found by anyone else
+func (server *Server) GetTransfer(ctx context.Context, req *pb.GetTransferRequest) (*pb.GetTransferResponse, error) {
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, invalidArgument("invalid transfer id format")
+	}
+
+	transfer, err := server.store.GetTransfer(ctx, id)
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "transfer not found")
+		}
+		return nil, storeError(err)
+	}
+
+	visible, err := server.canSeeTransfer(ctx, transfer)
+	if err != nil {
+		return nil, storeError(err)
+	}
+	if !visible {
+		return nil, status.Error(codes.NotFound, "transfer not found")
+	}
+
+	return &pb.GetTransferResponse{Transfer: convertTransfer(transfer)}, nil
+}
+
+// validAccount gets an account of a transfer, checking it is in the currency
+func (server *Server) validAccount(ctx context.Context, accountID uuid.UUID, currency string) (db.Account, error) {
+	account, err := server.store.GetAccount(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return account, status.Error(codes.NotFound, "account not found")
+		}
+		return account, storeError(err)
+	}
+	if account.Currency != currency {
+		return account, invalidArgument("account currency mismatch")
+	}
+	return account, nil
+}
+
+// authorizeFromAccount checks the authenticated user can move money out of
+// the account, returning the account's organisation when it has one
+func (server *Server) authorizeFromAccount(ctx context.Context, account db.Account) (*db.Organisation, error) {
+	username := authPayload(ctx).Username
+	if !account.OrganisationID.Valid {
+		if account.Owner != username {
+			return nil, status.Error(codes.PermissionDenied, "from account doesn't belong to you")
+		}
+		return nil, nil
+	}
+
+	organisation, err := server.store.GetOrganisation(ctx, account.OrganisationID.UUID)
+	if err != nil {
+		return nil, storeError(err)
+	}
+	if account.Owner == username {
+		return &organisation, nil
+	}
+
+	isInitiator, err := server.store.HasOrganisationRole(ctx, db.HasOrganisationRoleParams{
+		OrganisationID: organisation.ID,
+		Username:       username,
+		Role:           db.OrganisationRoleInitiator,
+	})
+	if err != nil {
+		return nil, storeError(err)
+	}
+	if !isInitiator {
+		return nil, status.Error(codes.PermissionDenied, "from account doesn't belong to you")
+	}
+	return &organisation, nil
+}
+
+func (server *Server) canSeeTransfer(ctx context.Context, transfer db.Transfer) (bool, error) {
+	username := authPayload(ctx).Username
+
+	fromAccount, err := server.store.GetAccount(ctx, transfer.FromAccountID)
+	if err != nil {
+		return false, err
+	}
+	if fromAccount.Owner == username {
+		return true, nil
+	}
+
+	toAccount, err := server.store.GetAccount(ctx, transfer.ToAccountID)
+	if err != nil {
+		return false, err
+	}
+	if toAccount.Owner == username {
+		return true, nil
+	}
+
+	if !fromAccount.OrganisationID.Valid {
+		return false, nil
+	}
+	return server.store.IsOrganisationMember(ctx, db.IsOrganisationMemberParams{
+		OrganisationID: fromAccount.OrganisationID.UUID,
+		Username:       username,
+	})
+}
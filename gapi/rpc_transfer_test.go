@@ -0,0 +1,174 @@
+package gapi
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	db "github.com/Glenn444/banking-app/internal/database"
+	mock_database "github.com/Glenn444/banking-app/internal/database/mock"
+	"github.com/Glenn444/banking-app/pb"
+	"github.com/Glenn444/banking-app/util"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCreateTransferRPC(t *testing.T) {
+	username := util.RandomOwner()
+	fromAccount := randomAccount(username, "USD")
+	toAccount := randomAccount(util.RandomOwner(), "USD")
+	amount := decimal.NewFromInt(10)
+
+	transfer := db.Transfer{
+		ID:            uuid.New(),
+		FromAccountID: fromAccount.ID,
+		ToAccountID:   toAccount.ID,
+		Amount:        amount,
+		Status:        "completed",
+	}
+
+	testCases := []struct {
+		name          string
+		username      string
+		req           *pb.CreateTransferRequest
+		buildStubs    func(store *mock_database.MockStore)
+		checkResponse func(t *testing.T, rsp *pb.CreateTransferResponse, err error)
+	}{
+		{
+			name:     "OK",
+			username: username,
+			req: &pb.CreateTransferRequest{
+				FromAccountId: fromAccount.ID.String(),
+				ToAccountId:   toAccount.ID.String(),
+				Amount:        "10",
+				Currency:      "USD",
+			},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(toAccount.ID)).Times(1).Return(toAccount, nil)
+				store.EXPECT().
+					TransferTx(gomock.Any(), gomock.Eq(db.TransferTxParams{
+						FromAccountID: fromAccount.ID,
+						ToAccountID:   toAccount.ID,
+						Amount:        amount,
+					})).
+					Times(1).
+					Return(db.TransferTxResult{Transfer: transfer}, nil)
+			},
+			checkResponse: func(t *testing.T, rsp *pb.CreateTransferResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, transfer.ID.String(), rsp.GetTransfer().GetId())
+				require.Equal(t, "10", rsp.GetTransfer().GetAmount())
+				require.Empty(t, rsp.GetApprovalId())
+			},
+		},
+		{
+			name:     "InsufficientFunds",
+			username: username,
+			req: &pb.CreateTransferRequest{
+				FromAccountId: fromAccount.ID.String(),
+				ToAccountId:   toAccount.ID.String(),
+				Amount:        "10",
+				Currency:      "USD",
+			},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(toAccount.ID)).Times(1).Return(toAccount, nil)
+				store.EXPECT().
+					TransferTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.TransferTxResult{}, fmt.Errorf("%w: account %v can spend 0", db.ErrInsufficientFunds, fromAccount.ID))
+			},
+			checkResponse: func(t *testing.T, rsp *pb.CreateTransferResponse, err error) {
+				require.Equal(t, codes.FailedPrecondition, status.Code(err))
+				require.Equal(t, db.ErrInsufficientFunds.Error(), status.Convert(err).Message())
+			},
+		},
+		{
+			name:     "NotOwner",
+			username: util.RandomOwner(),
+			req: &pb.CreateTransferRequest{
+				FromAccountId: fromAccount.ID.String(),
+				ToAccountId:   toAccount.ID.String(),
+				Amount:        "10",
+				Currency:      "USD",
+			},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, rsp *pb.CreateTransferResponse, err error) {
+				require.Equal(t, codes.PermissionDenied, status.Code(err))
+			},
+		},
+		{
+			name:     "InvalidAmount",
+			username: username,
+			req: &pb.CreateTransferRequest{
+				FromAccountId: fromAccount.ID.String(),
+				ToAccountId:   toAccount.ID.String(),
+				Amount:        "-10",
+				Currency:      "USD",
+			},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, rsp *pb.CreateTransferResponse, err error) {
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name:     "InternalError",
+			username: username,
+			req: &pb.CreateTransferRequest{
+				FromAccountId: fromAccount.ID.String(),
+				ToAccountId:   toAccount.ID.String(),
+				Amount:        "10",
+				Currency:      "USD",
+			},
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).
+					Times(1).
+					Return(db.Account{}, fmt.Errorf("account %v: connection refused", fromAccount.ID))
+			},
+			checkResponse: func(t *testing.T, rsp *pb.CreateTransferResponse, err error) {
+				require.Equal(t, codes.Internal, status.Code(err))
+				require.Equal(t, internalMessage, status.Convert(err).Message())
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_database.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			client := pb.NewTransferServiceClient(dialTestServer(t, server))
+
+			ctx := withAuthorization(t, server.tokenMaker, authorizationTypeBearer, tc.username, time.Minute)
+			rsp, err := client.CreateTransfer(ctx, tc.req)
+			tc.checkResponse(t, rsp, err)
+		})
+	}
+}
+
+func randomAccount(owner string, currency string) db.Account {
+	return db.Account{
+		ID:               uuid.New(),
+		Owner:            owner,
+		Balance:          decimal.NewFromInt(1000),
+		AvailableBalance: decimal.NewFromInt(1000),
+		Currency:         currency,
+	}
+}
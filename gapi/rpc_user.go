@@ -0,0 +1,92 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	db "github.com/Glenn444/banking-app/internal/database"
+	"github.com/Glenn444/banking-app/internal/token"
+	"github.com/Glenn444/banking-app/pb"
+	"github.com/Glenn444/banking-app/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CreateUser(ctx context.Context, req *pb.CreateUserRequest) (*pb.CreateUserResponse, error) {
+	if err := validateField("username", req.GetUsername(), "required,alphanum"); err != nil {
+		return nil, err
+	}
+	if err := validateField("email", req.GetEmail(), "required,email"); err != nil {
+		return nil, err
+	}
+	if err := validateField("password", req.GetPassword(), "required,min=6"); err != nil {
+		return nil, err
+	}
+
+	hashedPassword, err := util.HashPassword(req.GetPassword())
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	user, err := server.store.CreateUsers(ctx, db.CreateUsersParams{
+		Username:       req.GetUsername(),
+		FullName:       req.GetFullName(),
+		Email:          req.GetEmail(),
+		HashedPassword: hashedPassword,
+	})
+	if err != nil {
+		if errors.Is(db.TranslateError(err), db.ErrUniqueViolation) {
+			return nil, status.Error(codes.AlreadyExists, "username or email is already taken")
+		}
+		return nil, storeError(err)
+	}
+
+	return &pb.CreateUserResponse{User: convertUser(user)}, nil
+}
+
+func (server *Server) LoginUser(ctx context.Context, req *pb.LoginUserRequest) (*pb.LoginUserResponse, error) {
+	if err := validateField("username", req.GetUsername(), "required"); err != nil {
+		return nil, err
+	}
+	if err := validateField("password", req.GetPassword(), "required"); err != nil {
+		return nil, err
+	}
+
+	user, err := server.store.GetUser(ctx, req.GetUsername())
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "user does not exist, sign up")
+		}
+		return nil, storeError(err)
+	}
+
+	if err := util.CheckPassword(user.HashedPassword, req.GetPassword()); err != nil {
+		return nil, status.Error(codes.Unauthenticated, "Invalid username or password")
+	}
+
+	accessToken, err := server.tokenMaker.CreateToken(user.Username, token.AccessToken, server.config.AcessTokenDuration)
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	week := time.Hour * 24 * 7
+	refreshToken, err := server.tokenMaker.CreateToken(user.Username, token.RefreshToken, week)
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	err = server.store.UpdateRefreshToken(ctx, db.UpdateRefreshTokenParams{
+		Username:     user.Username,
+		RefreshToken: refreshToken,
+	})
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	return &pb.LoginUserResponse{
+		User:         convertUser(user),
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}, nil
+}
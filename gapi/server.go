@@ -0,0 +1,55 @@
+package gapi
+
+import (
+	"fmt"
+
+	db "github.com/Glenn444/banking-app/internal/database"
+	"github.com/Glenn444/banking-app/internal/fx"
+	"github.com/Glenn444/banking-app/internal/token"
+	"github.com/Glenn444/banking-app/pb"
+	"github.com/Glenn444/banking-app/util"
+	"google.golang.org/grpc"
+)
+
+// Server serves gRPC requests for our banking service, it shares the store
+// and the token maker's key with the HTTP server
+type Server struct {
+	pb.UnimplementedUserServiceServer
+	pb.UnimplementedAccountServiceServer
+	pb.UnimplementedTransferServiceServer
+	config     util.Config
+	store      db.Store
+	tokenMaker token.Maker
+	fxProvider fx.Provider
+}
+
+func NewServer(config util.Config, store db.Store) (*Server, error) {
+	tokenMaker, err := token.NewJWTMaker(config.TokenSymmetricKey)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create token maker %w", err)
+	}
+	fxProvider, err := fx.NewProvider(config.FXRatesFile)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create fx rate provider %w", err)
+	}
+
+	server := &Server{
+		config:     config,
+		store:      store,
+		tokenMaker: tokenMaker,
+		fxProvider: fxProvider,
+	}
+	return server, nil
+}
+
+// NewGRPCServer creates a gRPC server with the services registered and
+// every call going through the auth interceptor
+func (server *Server) NewGRPCServer(opts ...grpc.ServerOption) *grpc.Server {
+	opts = append(opts, grpc.UnaryInterceptor(authInterceptor(server.tokenMaker)))
+	grpcServer := grpc.NewServer(opts...)
+
+	pb.RegisterUserServiceServer(grpcServer, server)
+	pb.RegisterAccountServiceServer(grpcServer, server)
+	pb.RegisterTransferServiceServer(grpcServer, server)
+	return grpcServer
+}
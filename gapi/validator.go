@@ -0,0 +1,19 @@
+package gapi
+
+import (
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// validate checks request fields with the same tags the HTTP API binds with
+var validate = validator.New()
+
+// validateField answers with an invalid argument naming the field when value
+// breaks the rules in tag
+func validateField(field string, value any, tag string) error {
+	if err := validate.Var(value, tag); err != nil {
+		return invalidArgument(fmt.Sprintf("%s is invalid, it must be %s", field, tag))
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package batch
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	db "github.com/Glenn444/banking-app/internal/database"
+)
+
+const (
+	// DefaultChunkSize is how many best-effort items run per transaction
+	DefaultChunkSize = 200
+	// DefaultStaleAfter is how long a processing batch can go without
+	// progress before another worker takes it over
+	DefaultStaleAfter = 5 * time.Minute
+)
+
+// Worker executes accepted transfer batches chunk by chunk. Batches are
+// claimed with SKIP LOCKED so several workers can run side by side, and a
+// batch whose worker died is picked up again once it goes stale.
+type Worker struct {
+	store      db.Store
+	chunkSize  int32
+	staleAfter time.Duration
+}
+
+// NewWorker creates a worker, zero values fall back to the defaults
+func NewWorker(store db.Store, chunkSize int32, staleAfter time.Duration) *Worker {
+	if chunkSize <= 0 {
+		chunkSize = DefaultChunkSize
+	}
+	if staleAfter <= 0 {
+		staleAfter = DefaultStaleAfter
+	}
+	return &Worker{
+		store:      store,
+		chunkSize:  chunkSize,
+		staleAfter: staleAfter,
+	}
+}
+
+// RunOnce executes batches until none are waiting and returns how many it finished
+func (w *Worker) RunOnce(ctx context.Context) (int, error) {
+	finished := 0
+	for {
+		batch, err := w.store.ClaimTransferBatch(ctx, time.Now().Add(-w.staleAfter))
+		if err != nil {
+			if errors.Is(err, db.ErrNotFound) {
+				return finished, nil
+			}
+			return finished, err
+		}
+
+		if err = w.execute(ctx, batch); err != nil {
+			return finished, err
+		}
+		finished++
+	}
+}
+
+func (w *Worker) execute(ctx context.Context, batch db.TransferBatch) error {
+	var err error
+	for batch.Status == db.BatchStatusProcessing {
+		batch, err = w.store.ExecuteTransferBatchChunkTx(ctx, batch.ID, w.chunkSize)
+		if errors.Is(err, db.ErrBatchFinished) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
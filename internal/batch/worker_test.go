@@ -0,0 +1,90 @@
+package batch
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	db "github.com/Glenn444/banking-app/internal/database"
+	mock_database "github.com/Glenn444/banking-app/internal/database/mock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRunOnce(t *testing.T) {
+	batch := db.TransferBatch{ID: uuid.New(), Status: db.BatchStatusProcessing}
+	errChunk := errors.New("connection reset")
+
+	testCases := []struct {
+		name       string
+		buildStubs func(store *mock_database.MockStore)
+		finished   int
+		err        error
+	}{
+		{
+			name: "RunsChunksUntilFinished",
+			buildStubs: func(store *mock_database.MockStore) {
+				gomock.InOrder(
+					store.EXPECT().ClaimTransferBatch(gomock.Any(), gomock.Any()).Return(batch, nil),
+					store.EXPECT().
+						ExecuteTransferBatchChunkTx(gomock.Any(), gomock.Eq(batch.ID), gomock.Eq(int32(50))).
+						Return(db.TransferBatch{ID: batch.ID, Status: db.BatchStatusProcessing}, nil),
+					store.EXPECT().
+						ExecuteTransferBatchChunkTx(gomock.Any(), gomock.Eq(batch.ID), gomock.Eq(int32(50))).
+						Return(db.TransferBatch{ID: batch.ID, Status: db.BatchStatusPartiallyCompleted}, nil),
+					store.EXPECT().ClaimTransferBatch(gomock.Any(), gomock.Any()).Return(db.TransferBatch{}, db.ErrNotFound),
+				)
+			},
+			finished: 1,
+		},
+		{
+			name: "FinishedElsewhere",
+			buildStubs: func(store *mock_database.MockStore) {
+				gomock.InOrder(
+					store.EXPECT().ClaimTransferBatch(gomock.Any(), gomock.Any()).Return(batch, nil),
+					store.EXPECT().
+						ExecuteTransferBatchChunkTx(gomock.Any(), gomock.Any(), gomock.Any()).
+						Return(db.TransferBatch{}, db.ErrBatchFinished),
+					store.EXPECT().ClaimTransferBatch(gomock.Any(), gomock.Any()).Return(db.TransferBatch{}, db.ErrNotFound),
+				)
+			},
+			finished: 1,
+		},
+		{
+			name: "ChunkError",
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().ClaimTransferBatch(gomock.Any(), gomock.Any()).Times(1).Return(batch, nil)
+				store.EXPECT().
+					ExecuteTransferBatchChunkTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.TransferBatch{}, errChunk)
+			},
+			err: errChunk,
+		},
+		{
+			name: "NothingWaiting",
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().ClaimTransferBatch(gomock.Any(), gomock.Any()).Times(1).Return(db.TransferBatch{}, db.ErrNotFound)
+				store.EXPECT().ExecuteTransferBatchChunkTx(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_database.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			worker := NewWorker(store, 50, 0)
+			finished, err := worker.RunOnce(context.Background())
+			require.ErrorIs(t, err, tc.err)
+			require.Equal(t, tc.finished, finished)
+		})
+	}
+}
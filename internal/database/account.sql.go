@@ -12,24 +12,64 @@ import (
 	"github.com/shopspring/decimal"
 )
 
+const addAccountAvailableBalance = `-- name: AddAccountAvailableBalance :one
+UPDATE accounts
+  set available_balance = available_balance + $1
+WHERE id = $2
+RETURNING id, owner, balance, currency, created_at, updated_at, available_balance, overdraft_limit, overdraft_interest_rate, account_type, interest_product_id, organisation_id
+`
+
+type AddAccountAvailableBalanceParams struct {
+	Amount decimal.Decimal `json:"amount"`
+	ID     uuid.UUID       `json:"id"`
+}
+
+func (q *Queries) AddAccountAvailableBalance(ctx context.Context, arg AddAccountAvailableBalanceParams) (Account, error) {
+	row := q.db.QueryRow(ctx, addAccountAvailableBalance, arg.Amount, arg.ID)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Balance,
+		&i.Currency,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.AvailableBalance,
+		&i.OverdraftLimit,
+		&i.OverdraftInterestRate,
+		&i.AccountType,
+		&i.InterestProductID,
+		&i.OrganisationID,
+	)
+	return i, err
+}
+
 const createAccount = `-- name: CreateAccount :one
 INSERT INTO accounts(
     "owner",
     balance,
-    currency
+    available_balance,
+    currency,
+    account_type
 ) VALUES (
-    $1,$2,$3
-) RETURNING id, owner, balance, currency, created_at, updated_at
+    $1,$2,$2,$3,$4
+) RETURNING id, owner, balance, currency, created_at, updated_at, available_balance, overdraft_limit, overdraft_interest_rate, account_type, interest_product_id, organisation_id
 `
 
 type CreateAccountParams struct {
-	Owner    string          `json:"owner"`
-	Balance  decimal.Decimal `json:"balance"`
-	Currency string          `json:"currency"`
+	Owner       string          `json:"owner"`
+	Balance     decimal.Decimal `json:"balance"`
+	Currency    string          `json:"currency"`
+	AccountType string          `json:"account_type"`
 }
 
 func (q *Queries) CreateAccount(ctx context.Context, arg CreateAccountParams) (Account, error) {
-	row := q.db.QueryRowContext(ctx, createAccount, arg.Owner, arg.Balance, arg.Currency)
+	row := q.db.QueryRow(ctx, createAccount,
+		arg.Owner,
+		arg.Balance,
+		arg.Currency,
+		arg.AccountType,
+	)
 	var i Account
 	err := row.Scan(
 		&i.ID,
@@ -38,6 +78,12 @@ func (q *Queries) CreateAccount(ctx context.Context, arg CreateAccountParams) (A
 		&i.Currency,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.AvailableBalance,
+		&i.OverdraftLimit,
+		&i.OverdraftInterestRate,
+		&i.AccountType,
+		&i.InterestProductID,
+		&i.OrganisationID,
 	)
 	return i, err
 }
@@ -48,17 +94,17 @@ WHERE id = $1
 `
 
 func (q *Queries) DeleteAccount(ctx context.Context, id uuid.UUID) error {
-	_, err := q.db.ExecContext(ctx, deleteAccount, id)
+	_, err := q.db.Exec(ctx, deleteAccount, id)
 	return err
 }
 
 const getAccount = `-- name: GetAccount :one
-SELECT id, owner, balance, currency, created_at, updated_at FROM accounts
+SELECT id, owner, balance, currency, created_at, updated_at, available_balance, overdraft_limit, overdraft_interest_rate, account_type, interest_product_id, organisation_id FROM accounts
 WHERE id = $1 LIMIT 1
 `
 
 func (q *Queries) GetAccount(ctx context.Context, id uuid.UUID) (Account, error) {
-	row := q.db.QueryRowContext(ctx, getAccount, id)
+	row := q.db.QueryRow(ctx, getAccount, id)
 	var i Account
 	err := row.Scan(
 		&i.ID,
@@ -67,18 +113,24 @@ func (q *Queries) GetAccount(ctx context.Context, id uuid.UUID) (Account, error)
 		&i.Currency,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.AvailableBalance,
+		&i.OverdraftLimit,
+		&i.OverdraftInterestRate,
+		&i.AccountType,
+		&i.InterestProductID,
+		&i.OrganisationID,
 	)
 	return i, err
 }
 
 const getAccountByIdForUpdate = `-- name: GetAccountByIdForUpdate :one
-SELECT id, owner, balance, currency, created_at, updated_at FROM accounts
+SELECT id, owner, balance, currency, created_at, updated_at, available_balance, overdraft_limit, overdraft_interest_rate, account_type, interest_product_id, organisation_id FROM accounts
 WHERE id = $1 LIMIT 1
 FOR NO KEY UPDATE
 `
 
 func (q *Queries) GetAccountByIdForUpdate(ctx context.Context, id uuid.UUID) (Account, error) {
-	row := q.db.QueryRowContext(ctx, getAccountByIdForUpdate, id)
+	row := q.db.QueryRow(ctx, getAccountByIdForUpdate, id)
 	var i Account
 	err := row.Scan(
 		&i.ID,
@@ -87,12 +139,18 @@ func (q *Queries) GetAccountByIdForUpdate(ctx context.Context, id uuid.UUID) (Ac
 		&i.Currency,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.AvailableBalance,
+		&i.OverdraftLimit,
+		&i.OverdraftInterestRate,
+		&i.AccountType,
+		&i.InterestProductID,
+		&i.OrganisationID,
 	)
 	return i, err
 }
 
 const listAccounts = `-- name: ListAccounts :many
-SELECT id, owner, balance, currency, created_at, updated_at FROM accounts
+SELECT id, owner, balance, currency, created_at, updated_at, available_balance, overdraft_limit, overdraft_interest_rate, account_type, interest_product_id, organisation_id FROM accounts
 WHERE owner = $1
 ORDER BY id
 LIMIT $2
@@ -106,7 +164,45 @@ type ListAccountsParams struct {
 }
 
 func (q *Queries) ListAccounts(ctx context.Context, arg ListAccountsParams) ([]Account, error) {
-	rows, err := q.db.QueryContext(ctx, listAccounts, arg.Owner, arg.Limit, arg.Offset)
+	rows, err := q.db.Query(ctx, listAccounts, arg.Owner, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Account{}
+	for rows.Next() {
+		var i Account
+		if err := rows.Scan(
+			&i.ID,
+			&i.Owner,
+			&i.Balance,
+			&i.Currency,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.AvailableBalance,
+			&i.OverdraftLimit,
+			&i.OverdraftInterestRate,
+			&i.AccountType,
+			&i.InterestProductID,
+			&i.OrganisationID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAccountsByIDs = `-- name: ListAccountsByIDs :many
+SELECT id, owner, balance, currency, created_at, updated_at, available_balance, overdraft_limit, overdraft_interest_rate, account_type, interest_product_id, organisation_id FROM accounts
+WHERE id = ANY($1::uuid[])
+`
+
+func (q *Queries) ListAccountsByIDs(ctx context.Context, ids []uuid.UUID) ([]Account, error) {
+	rows, err := q.db.Query(ctx, listAccountsByIDs, ids)
 	if err != nil {
 		return nil, err
 	}
@@ -121,23 +217,121 @@ func (q *Queries) ListAccounts(ctx context.Context, arg ListAccountsParams) ([]A
 			&i.Currency,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.AvailableBalance,
+			&i.OverdraftLimit,
+			&i.OverdraftInterestRate,
+			&i.AccountType,
+			&i.InterestProductID,
+			&i.OrganisationID,
 		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
 	}
-	if err := rows.Close(); err != nil {
+	if err := rows.Err(); err != nil {
 		return nil, err
 	}
+	return items, nil
+}
+
+const listAllAccountsByOwner = `-- name: ListAllAccountsByOwner :many
+SELECT id, owner, balance, currency, created_at, updated_at, available_balance, overdraft_limit, overdraft_interest_rate, account_type, interest_product_id, organisation_id FROM accounts
+WHERE owner = $1
+ORDER BY currency
+`
+
+func (q *Queries) ListAllAccountsByOwner(ctx context.Context, owner string) ([]Account, error) {
+	rows, err := q.db.Query(ctx, listAllAccountsByOwner, owner)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Account{}
+	for rows.Next() {
+		var i Account
+		if err := rows.Scan(
+			&i.ID,
+			&i.Owner,
+			&i.Balance,
+			&i.Currency,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.AvailableBalance,
+			&i.OverdraftLimit,
+			&i.OverdraftInterestRate,
+			&i.AccountType,
+			&i.InterestProductID,
+			&i.OrganisationID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
 	if err := rows.Err(); err != nil {
 		return nil, err
 	}
 	return items, nil
 }
 
+const resolveRecipientAccount = `-- name: ResolveRecipientAccount :one
+SELECT a.id, a.owner, a.balance, a.currency, a.created_at, a.updated_at, a.available_balance, a.overdraft_limit, a.overdraft_interest_rate, a.account_type, a.interest_product_id, a.organisation_id, u.full_name FROM accounts a
+JOIN users u ON u.username = a."owner"
+WHERE a.currency = $1
+  AND u."role" <> 'system'
+  AND (
+    u.username = $2
+    OR lower(u.alias) = lower($2)
+    OR (lower(u.email) = lower($2) AND u.email_verified_at IS NOT NULL)
+  )
+ORDER BY
+  CASE
+    WHEN u.username = $2 THEN 0
+    WHEN lower(u.alias) = lower($2) THEN 1
+    ELSE 2
+  END,
+  a.account_type = 'checking' DESC,
+  a.created_at
+LIMIT 1
+`
+
+type ResolveRecipientAccountParams struct {
+	Currency string `json:"currency"`
+	Handle   string `json:"handle"`
+}
+
+type ResolveRecipientAccountRow struct {
+	Account  Account `json:"account"`
+	FullName string  `json:"full_name"`
+}
+
+// finds the account in a currency of the user known by a username, alias or
+// verified email, preferring that order and a checking account, system users
+// can't be resolved
+func (q *Queries) ResolveRecipientAccount(ctx context.Context, arg ResolveRecipientAccountParams) (ResolveRecipientAccountRow, error) {
+	row := q.db.QueryRow(ctx, resolveRecipientAccount, arg.Currency, arg.Handle)
+	var i ResolveRecipientAccountRow
+	err := row.Scan(
+		&i.Account.ID,
+		&i.Account.Owner,
+		&i.Account.Balance,
+		&i.Account.Currency,
+		&i.Account.CreatedAt,
+		&i.Account.UpdatedAt,
+		&i.Account.AvailableBalance,
+		&i.Account.OverdraftLimit,
+		&i.Account.OverdraftInterestRate,
+		&i.Account.AccountType,
+		&i.Account.InterestProductID,
+		&i.Account.OrganisationID,
+		&i.FullName,
+	)
+	return i, err
+}
+
 const updateAccount = `-- name: UpdateAccount :exec
 UPDATE accounts
-  set balance = $2
+  set available_balance = available_balance + ($2 - balance),
+      balance = $2
 WHERE id = $1
 `
 
@@ -146,7 +340,8 @@ type UpdateAccountParams struct {
 	Balance decimal.Decimal `json:"balance"`
 }
 
+// available_balance moves by the same amount, holds are unaffected
 func (q *Queries) UpdateAccount(ctx context.Context, arg UpdateAccountParams) error {
-	_, err := q.db.ExecContext(ctx, updateAccount, arg.ID, arg.Balance)
+	_, err := q.db.Exec(ctx, updateAccount, arg.ID, arg.Balance)
 	return err
 }
@@ -2,11 +2,11 @@ package database
 
 import (
 	"context"
-	"database/sql"
 	"testing"
 	"time"
 
 	"github.com/Glenn444/banking-app/util"
+	"github.com/jackc/pgx/v5"
 	"github.com/stretchr/testify/require"
 )
 
@@ -26,9 +26,10 @@ type TestingT interface {
 func TestCreateAccount(t *testing.T) {
 	user := CreateRandomUser(t)
 	arg := CreateAccountParams{
-		Owner:    user.Username,
-		Balance:  util.RandomMoney(), //NewFromFloat(100.00)
-		Currency: util.RandomCurrency(),
+		Owner:       user.Username,
+		Balance:     util.RandomMoney(), //NewFromFloat(100.00)
+		Currency:    util.RandomCurrency(),
+		AccountType: AccountTypeChecking,
 	}
 
 	account, err := testQueries.CreateAccount(context.Background(), arg)
@@ -51,9 +52,10 @@ func createRandomAccount(t TestingT) Account {
 	t.Helper()
 	user := CreateRandomUser(t)
 	arg := CreateAccountParams{
-		Owner:    user.Username,
-		Balance:  util.RandomMoney(),
-		Currency: util.RandomCurrency(),
+		Owner:       user.Username,
+		Balance:     util.RandomMoney(),
+		Currency:    util.RandomCurrency(),
+		AccountType: AccountTypeChecking,
 	}
 	account, err := testQueries.CreateAccount(context.Background(), arg)
 	require.NoError(t, err)
@@ -139,7 +141,7 @@ func TestAccountDeletion(t *testing.T) {
 
 	//Expect an SQL error
 	require.Error(t, err)
-	require.EqualError(t, err, sql.ErrNoRows.Error())
+	require.EqualError(t, err, pgx.ErrNoRows.Error())
 
 	//returned account2 should be empty
 	require.Empty(t, account2)
@@ -150,9 +152,10 @@ func createRandomAccountsForUser(t TestingT, currency string, user User) {
 	//currencies := []string{"USD", "EUR", "GBP"} // ← only 3 unique currencies available
 
 	arg := CreateAccountParams{
-		Owner:    user.Username,
-		Balance:  util.RandomMoney(),
-		Currency: currency, // ← explicitly assign unique currency
+		Owner:       user.Username,
+		Balance:     util.RandomMoney(),
+		Currency:    currency, // ← explicitly assign unique currency
+		AccountType: AccountTypeChecking,
 	}
 	account, err := testQueries.CreateAccount(context.Background(), arg)
 	require.NotEmpty(t, account)
@@ -0,0 +1,184 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Glenn444/banking-app/util"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+type testOrganisation struct {
+	Organisation Organisation
+	Initiator    User
+	Approvers    []User
+	Account      Account
+}
+
+func createRandomOrganisation(t *testing.T, requiredApprovals int32, ttl time.Duration) testOrganisation {
+	t.Helper()
+	ctx := context.Background()
+	owner := CreateRandomUser(t)
+
+	organisation, err := testQueries.CreateOrganisation(ctx, CreateOrganisationParams{
+		Name:                      util.RandomString(12),
+		ApprovalThreshold:         decimal.NewFromInt(100),
+		ApprovalThresholdCurrency: "USD",
+		RequiredApprovals:         requiredApprovals,
+		ApprovalTtlSeconds:        int32(ttl.Seconds()),
+		CreatedBy:                 owner.Username,
+	})
+	require.NoError(t, err)
+
+	result := testOrganisation{Organisation: organisation, Initiator: CreateRandomUser(t)}
+	_, err = testQueries.AddOrganisationMember(ctx, AddOrganisationMemberParams{
+		OrganisationID: organisation.ID,
+		Username:       result.Initiator.Username,
+		Role:           OrganisationRoleInitiator,
+	})
+	require.NoError(t, err)
+	for i := int32(0); i < requiredApprovals; i++ {
+		approver := CreateRandomUser(t)
+		_, err = testQueries.AddOrganisationMember(ctx, AddOrganisationMemberParams{
+			OrganisationID: organisation.ID,
+			Username:       approver.Username,
+			Role:           OrganisationRoleApprover,
+		})
+		require.NoError(t, err)
+		result.Approvers = append(result.Approvers, approver)
+	}
+
+	account := createAccountInCurrency(t, owner, "USD", decimal.NewFromInt(1000))
+	result.Account, err = testQueries.SetAccountOrganisation(ctx, SetAccountOrganisationParams{
+		ID:             account.ID,
+		OrganisationID: uuid.NullUUID{UUID: organisation.ID, Valid: true},
+	})
+	require.NoError(t, err)
+	return result
+}
+
+func createRandomTransferApproval(t *testing.T, org testOrganisation, to Account) TransferApprovalTxResult {
+	t.Helper()
+	result, err := NewStore(testDB).CreateTransferApprovalTx(context.Background(), CreateTransferApprovalTxParams{
+		OrganisationID: org.Organisation.ID,
+		InitiatedBy:    org.Initiator.Username,
+		TransferTxParams: TransferTxParams{
+			FromAccountID: org.Account.ID,
+			ToAccountID:   to.ID,
+			Amount:        decimal.NewFromInt(400),
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, ApprovalStatusPending, result.Approval.Status)
+	return result
+}
+
+func TestApproveTransferTx(t *testing.T) {
+	store := NewStore(testDB)
+	ctx := context.Background()
+	org := createRandomOrganisation(t, 2, time.Hour)
+	toAccount := createAccountInCurrency(t, CreateRandomUser(t), "USD", decimal.Zero)
+
+	created := createRandomTransferApproval(t, org, toAccount)
+	// the amount is reserved, not moved
+	require.True(t, decimal.NewFromInt(1000).Equal(created.Account.Balance))
+	require.True(t, decimal.NewFromInt(600).Equal(created.Account.AvailableBalance))
+
+	decide := func(username string) (TransferApprovalTxResult, error) {
+		return store.ApproveTransferTx(ctx, DecideTransferApprovalTxParams{
+			ApprovalID: created.Approval.ID,
+			Username:   username,
+		})
+	}
+
+	_, err := decide(org.Initiator.Username)
+	require.ErrorIs(t, err, ErrApproverIsInitiator)
+	_, err = decide(CreateRandomUser(t).Username)
+	require.ErrorIs(t, err, ErrNotApprover)
+
+	first, err := decide(org.Approvers[0].Username)
+	require.NoError(t, err)
+	require.Equal(t, ApprovalStatusPending, first.Approval.Status)
+	require.Equal(t, int32(1), first.Approval.Approvals)
+	require.Nil(t, first.Transfer)
+
+	// an approver counts once
+	_, err = decide(org.Approvers[0].Username)
+	require.ErrorIs(t, err, ErrAlreadyApproved)
+
+	second, err := decide(org.Approvers[1].Username)
+	require.NoError(t, err)
+	require.Equal(t, ApprovalStatusExecuted, second.Approval.Status)
+	require.NotNil(t, second.Transfer)
+	require.Equal(t, second.Transfer.Transfer.ID, second.Approval.TransferID.UUID)
+	require.True(t, decimal.NewFromInt(600).Equal(second.Account.Balance))
+	require.True(t, decimal.NewFromInt(600).Equal(second.Account.AvailableBalance))
+	require.True(t, decimal.NewFromInt(400).Equal(second.Transfer.ToAccount.Balance))
+
+	auditLog, err := testQueries.ListApprovalAuditLog(ctx, created.Approval.ID)
+	require.NoError(t, err)
+	decisions := make([]string, len(auditLog))
+	for i, entry := range auditLog {
+		decisions[i] = entry.Decision
+	}
+	require.Equal(t, []string{
+		ApprovalDecisionInitiated,
+		ApprovalDecisionApproved,
+		ApprovalDecisionApproved,
+		ApprovalDecisionExecuted,
+	}, decisions)
+
+	_, err = decide(org.Approvers[1].Username)
+	require.ErrorIs(t, err, ErrApprovalNotPending)
+}
+
+func TestRejectTransferTx(t *testing.T) {
+	store := NewStore(testDB)
+	ctx := context.Background()
+	org := createRandomOrganisation(t, 1, time.Hour)
+	toAccount := createAccountInCurrency(t, CreateRandomUser(t), "USD", decimal.Zero)
+
+	created := createRandomTransferApproval(t, org, toAccount)
+	rejected, err := store.RejectTransferTx(ctx, DecideTransferApprovalTxParams{
+		ApprovalID: created.Approval.ID,
+		Username:   org.Approvers[0].Username,
+		Reason:     "unknown payee",
+	})
+	require.NoError(t, err)
+	require.Equal(t, ApprovalStatusRejected, rejected.Approval.Status)
+	require.True(t, decimal.NewFromInt(1000).Equal(rejected.Account.AvailableBalance))
+
+	notifications, err := testQueries.ListNotifications(ctx, ListNotificationsParams{Username: org.Initiator.Username, Limit: 5})
+	require.NoError(t, err)
+	require.Len(t, notifications, 1)
+	require.Equal(t, NotificationTransferApprovalRejected, notifications[0].Kind)
+}
+
+func TestExpireTransferApprovalsTx(t *testing.T) {
+	store := NewStore(testDB)
+	ctx := context.Background()
+	org := createRandomOrganisation(t, 1, time.Second)
+	toAccount := createAccountInCurrency(t, CreateRandomUser(t), "USD", decimal.Zero)
+
+	created := createRandomTransferApproval(t, org, toAccount)
+
+	expired, err := store.ExpireTransferApprovalsTx(ctx, time.Now().Add(time.Minute), 1000)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, expired, 1)
+
+	approval, err := testQueries.GetTransferApproval(ctx, created.Approval.ID)
+	require.NoError(t, err)
+	require.Equal(t, ApprovalStatusExpired, approval.Status)
+	account, err := testQueries.GetAccount(ctx, org.Account.ID)
+	require.NoError(t, err)
+	require.True(t, decimal.NewFromInt(1000).Equal(account.AvailableBalance))
+
+	_, err = store.ApproveTransferTx(ctx, DecideTransferApprovalTxParams{
+		ApprovalID: created.Approval.ID,
+		Username:   org.Approvers[0].Username,
+	})
+	require.ErrorIs(t, err, ErrApprovalNotPending)
+}
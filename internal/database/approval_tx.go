@@ -0,0 +1,398 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Glenn444/banking-app/util"
+	"github.com/google/uuid"
+)
+
+const (
+	ApprovalStatusPending   = "pending_approval"
+	ApprovalStatusExecuted  = "executed"
+	ApprovalStatusRejected  = "rejected"
+	ApprovalStatusCancelled = "cancelled"
+	ApprovalStatusExpired   = "expired"
+)
+
+// organisation member roles
+const (
+	OrganisationRoleInitiator = "initiator"
+	OrganisationRoleApprover  = "approver"
+)
+
+// decisions kept in the approval audit log
+const (
+	ApprovalDecisionInitiated = "initiated"
+	ApprovalDecisionApproved  = "approved"
+	ApprovalDecisionRejected  = "rejected"
+	ApprovalDecisionCancelled = "cancelled"
+	ApprovalDecisionExpired   = "expired"
+	ApprovalDecisionExecuted  = "executed"
+)
+
+var (
+	ErrApprovalNotPending   = errors.New("transfer is no longer pending approval")
+	ErrApprovalExpired      = errors.New("transfer approval has expired")
+	ErrNotApprover          = errors.New("user can't approve transfers of this organisation")
+	ErrAlreadyApproved      = errors.New("user already approved this transfer")
+	ErrApproverIsInitiator  = errors.New("the initiator of a transfer can't approve it")
+	ErrApprovalCurrency     = errors.New("transfer accounts must share the currency")
+	ErrNotApprovalInitiator = errors.New("only the initiator can cancel a transfer approval")
+	// ErrApprovalRequired is an amount over the organisation's threshold
+	// moved some way other than a transfer, the only kind that can wait for
+	// approvers
+	ErrApprovalRequired = errors.New("amount is over the organisation's approval threshold, send it as a transfer to have it approved")
+)
+
+type CreateTransferApprovalTxParams struct {
+	OrganisationID uuid.UUID `json:"organisation_id"`
+	InitiatedBy    string    `json:"initiated_by"`
+	TransferTxParams
+}
+
+// TransferApprovalTxResult is a transfer approval with its from account
+// after the approval changed, and the transfer once it is executed
+type TransferApprovalTxResult struct {
+	Approval TransferApproval  `json:"approval"`
+	Account  Account           `json:"account"`
+	Transfer *TransferTxResult `json:"transfer,omitempty"`
+}
+
+// CreateTransferApprovalTx records a transfer that waits for the
+// organisation's approvers and reserves its amount and fee on the from
+// account, so the funds are still there when it is approved
+func (store *SQLStore) CreateTransferApprovalTx(ctx context.Context, arg CreateTransferApprovalTxParams) (TransferApprovalTxResult, error) {
+	var result TransferApprovalTxResult
+
+	err := store.execTx(ctx, nil, func(q *Queries) error {
+		organisation, err := q.GetOrganisation(ctx, arg.OrganisationID)
+		if err != nil {
+			return err
+		}
+
+		accounts, err := lockAccounts(ctx, q, arg.FromAccountID, arg.ToAccountID)
+		if err != nil {
+			return err
+		}
+		account := accounts[arg.FromAccountID]
+
+		if account.Currency != accounts[arg.ToAccountID].Currency {
+			return ErrApprovalCurrency
+		}
+		if !util.IsValidAmount(arg.Amount, account.Currency) {
+			return fmt.Errorf("%w: %v %s", ErrInvalidAmountScale, arg.Amount, account.Currency)
+		}
+		quote, err := QuoteTransferFee(ctx, q, *account, arg.Amount)
+		if err != nil {
+			return err
+		}
+		if account.SpendableBalance().LessThan(quote.Total) {
+			return fmt.Errorf("%w: account %v can spend %v, transfer amount %v, fee %v",
+				ErrInsufficientFunds, account.ID, account.SpendableBalance(), arg.Amount, quote.Fee)
+		}
+		// checked again when the transfer is made, this fails early
+		if err = checkTransferLimits(ctx, q, account, arg.Amount, uuid.Nil, time.Now()); err != nil {
+			return err
+		}
+
+		metadata := arg.Metadata
+		if len(metadata) == 0 {
+			metadata = json.RawMessage("{}")
+		}
+		result.Approval, err = q.CreateTransferApproval(ctx, CreateTransferApprovalParams{
+			OrganisationID:    organisation.ID,
+			FromAccountID:     arg.FromAccountID,
+			ToAccountID:       arg.ToAccountID,
+			Amount:            arg.Amount,
+			Currency:          account.Currency,
+			Description:       arg.Description,
+			ExternalReference: arg.ExternalReference,
+			Metadata:          metadata,
+			InitiatedBy:       arg.InitiatedBy,
+			RequiredApprovals: organisation.RequiredApprovals,
+			ExpiresAt:         time.Now().Add(time.Duration(organisation.ApprovalTtlSeconds) * time.Second),
+			ReservedAmount:    quote.Total,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Account, err = q.AddAccountAvailableBalance(ctx, AddAccountAvailableBalanceParams{
+			ID:     arg.FromAccountID,
+			Amount: quote.Total.Neg(),
+		})
+		if err != nil {
+			return err
+		}
+		return auditApproval(ctx, q, result.Approval, arg.InitiatedBy, ApprovalDecisionInitiated, "")
+	})
+	if err != nil {
+		return TransferApprovalTxResult{}, err
+	}
+	return result, nil
+}
+
+type DecideTransferApprovalTxParams struct {
+	ApprovalID uuid.UUID `json:"approval_id"`
+	Username   string    `json:"username"`
+	Reason     string    `json:"reason"`
+}
+
+// ApproveTransferTx signs a pending transfer off. The approval that reaches
+// the required number of distinct approvers gives the reserved funds back and
+// makes the transfer through TransferTx, all in one transaction. The transfer
+// is charged the fee in force when it is made.
+func (store *SQLStore) ApproveTransferTx(ctx context.Context, arg DecideTransferApprovalTxParams) (TransferApprovalTxResult, error) {
+	var result TransferApprovalTxResult
+
+	err := store.execTx(ctx, nil, func(q *Queries) error {
+		approval, err := pendingTransferApproval(ctx, q, arg.ApprovalID)
+		if err != nil {
+			return err
+		}
+		if err = checkApprover(ctx, q, approval, arg.Username); err != nil {
+			return err
+		}
+
+		approved, err := q.HasApprovalDecision(ctx, HasApprovalDecisionParams{
+			ApprovalID: approval.ID,
+			Actor:      arg.Username,
+			Decision:   ApprovalDecisionApproved,
+		})
+		if err != nil {
+			return err
+		}
+		if approved {
+			return fmt.Errorf("%w: %s on %v", ErrAlreadyApproved, arg.Username, approval.ID)
+		}
+		if err = auditApproval(ctx, q, approval, arg.Username, ApprovalDecisionApproved, arg.Reason); err != nil {
+			return err
+		}
+
+		result.Approval, err = q.AddTransferApproval(ctx, approval.ID)
+		if err != nil {
+			return err
+		}
+		if result.Approval.Approvals < result.Approval.RequiredApprovals {
+			result.Account, err = q.GetAccount(ctx, approval.FromAccountID)
+			return err
+		}
+
+		// the accounts are locked in the order every transfer locks them in
+		// before the from account's reservation is touched, otherwise this
+		// and a transfer the other way could each hold a lock the other wants
+		if _, err = lockAccounts(ctx, q, approval.FromAccountID, approval.ToAccountID); err != nil {
+			return err
+		}
+		_, err = q.AddAccountAvailableBalance(ctx, AddAccountAvailableBalanceParams{
+			ID:     approval.FromAccountID,
+			Amount: approval.ReservedAmount,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Transfer = &TransferTxResult{}
+		err = transferTx(ctx, q, TransferTxParams{
+			FromAccountID: approval.FromAccountID,
+			ToAccountID:   approval.ToAccountID,
+			Amount:        approval.Amount,
+			TransferDetails: TransferDetails{
+				Description:       approval.Description,
+				ExternalReference: approval.ExternalReference,
+				Metadata:          approval.Metadata,
+			},
+		}, result.Transfer)
+		if err != nil {
+			return err
+		}
+		result.Account = result.Transfer.FromAccount
+
+		result.Approval, err = q.FinishTransferApproval(ctx, FinishTransferApprovalParams{
+			ID:         approval.ID,
+			Status:     ApprovalStatusExecuted,
+			TransferID: uuid.NullUUID{UUID: result.Transfer.Transfer.ID, Valid: true},
+		})
+		if err != nil {
+			return err
+		}
+		if err = auditApproval(ctx, q, result.Approval, "", ApprovalDecisionExecuted, ""); err != nil {
+			return err
+		}
+		return notify(ctx, q, approval.InitiatedBy, NotificationTransferApprovalExecuted, result.Approval)
+	})
+	if err != nil {
+		return TransferApprovalTxResult{}, err
+	}
+	return result, nil
+}
+
+// RejectTransferTx turns a pending transfer down on behalf of an approver
+// and gives its reserved funds back
+func (store *SQLStore) RejectTransferTx(ctx context.Context, arg DecideTransferApprovalTxParams) (TransferApprovalTxResult, error) {
+	var result TransferApprovalTxResult
+
+	err := store.execTx(ctx, nil, func(q *Queries) error {
+		approval, err := pendingTransferApproval(ctx, q, arg.ApprovalID)
+		if err != nil {
+			return err
+		}
+		if err = checkApprover(ctx, q, approval, arg.Username); err != nil {
+			return err
+		}
+
+		result, err = closeTransferApproval(ctx, q, approval, ApprovalStatusRejected, arg.Username, arg.Reason)
+		if err != nil {
+			return err
+		}
+		return notify(ctx, q, approval.InitiatedBy, NotificationTransferApprovalRejected, result.Approval)
+	})
+	if err != nil {
+		return TransferApprovalTxResult{}, err
+	}
+	return result, nil
+}
+
+// CancelTransferApprovalTx withdraws a pending transfer on behalf of its
+// initiator and gives its reserved funds back
+func (store *SQLStore) CancelTransferApprovalTx(ctx context.Context, arg DecideTransferApprovalTxParams) (TransferApprovalTxResult, error) {
+	var result TransferApprovalTxResult
+
+	err := store.execTx(ctx, nil, func(q *Queries) error {
+		approval, err := pendingTransferApproval(ctx, q, arg.ApprovalID)
+		if err != nil {
+			return err
+		}
+		if approval.InitiatedBy != arg.Username {
+			return ErrNotApprovalInitiator
+		}
+
+		result, err = closeTransferApproval(ctx, q, approval, ApprovalStatusCancelled, arg.Username, arg.Reason)
+		return err
+	})
+	if err != nil {
+		return TransferApprovalTxResult{}, err
+	}
+	return result, nil
+}
+
+// ExpireTransferApprovalsTx cancels up to limit transfers whose approval
+// window closed before now and returns how many it expired, each one is
+// expired in its own transaction
+func (store *SQLStore) ExpireTransferApprovalsTx(ctx context.Context, now time.Time, limit int32) (int, error) {
+	ids, err := store.ListExpiredTransferApprovals(ctx, ListExpiredTransferApprovalsParams{
+		ExpiresAt: now,
+		Limit:     limit,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	expired := 0
+	for _, id := range ids {
+		var closed bool
+		err = store.execTx(ctx, nil, func(q *Queries) error {
+			closed = false
+			approval, err := q.GetTransferApprovalForUpdate(ctx, id)
+			if err != nil {
+				return err
+			}
+			if approval.Status != ApprovalStatusPending {
+				// decided since it was listed
+				return nil
+			}
+
+			result, err := closeTransferApproval(ctx, q, approval, ApprovalStatusExpired, "", "")
+			if err != nil {
+				return err
+			}
+			closed = true
+			return notify(ctx, q, approval.InitiatedBy, NotificationTransferApprovalExpired, result.Approval)
+		})
+		if err != nil {
+			return expired, err
+		}
+		if closed {
+			expired++
+		}
+	}
+	return expired, nil
+}
+
+// pendingTransferApproval locks a transfer approval and checks it can still
+// be decided
+func pendingTransferApproval(ctx context.Context, q *Queries, approvalID uuid.UUID) (TransferApproval, error) {
+	approval, err := q.GetTransferApprovalForUpdate(ctx, approvalID)
+	if err != nil {
+		return TransferApproval{}, err
+	}
+	if approval.Status != ApprovalStatusPending {
+		return TransferApproval{}, fmt.Errorf("%w: transfer approval %v is %s", ErrApprovalNotPending, approval.ID, approval.Status)
+	}
+	if !time.Now().Before(approval.ExpiresAt) {
+		return TransferApproval{}, fmt.Errorf("%w: transfer approval %v expired at %v", ErrApprovalExpired, approval.ID, approval.ExpiresAt)
+	}
+	return approval, nil
+}
+
+// checkApprover checks username is an approver of the approval's
+// organisation and not the one who initiated the transfer
+func checkApprover(ctx context.Context, q *Queries, approval TransferApproval, username string) error {
+	if approval.InitiatedBy == username {
+		return ErrApproverIsInitiator
+	}
+	isApprover, err := q.HasOrganisationRole(ctx, HasOrganisationRoleParams{
+		OrganisationID: approval.OrganisationID,
+		Username:       username,
+		Role:           OrganisationRoleApprover,
+	})
+	if err != nil {
+		return err
+	}
+	if !isApprover {
+		return fmt.Errorf("%w: %s", ErrNotApprover, username)
+	}
+	return nil
+}
+
+// closeTransferApproval closes a pending transfer without making it and
+// gives its reserved funds back
+func closeTransferApproval(ctx context.Context, q *Queries, approval TransferApproval, status, actor, reason string) (TransferApprovalTxResult, error) {
+	var result TransferApprovalTxResult
+	var err error
+
+	result.Account, err = q.AddAccountAvailableBalance(ctx, AddAccountAvailableBalanceParams{
+		ID:     approval.FromAccountID,
+		Amount: approval.ReservedAmount,
+	})
+	if err != nil {
+		return result, err
+	}
+
+	result.Approval, err = q.FinishTransferApproval(ctx, FinishTransferApprovalParams{
+		ID:     approval.ID,
+		Status: status,
+	})
+	if err != nil {
+		return result, err
+	}
+
+	// the statuses a pending approval finishes with are also their decisions
+	return result, auditApproval(ctx, q, result.Approval, actor, status, reason)
+}
+
+func auditApproval(ctx context.Context, q *Queries, approval TransferApproval, actor, decision, reason string) error {
+	_, err := q.CreateApprovalAuditEntry(ctx, CreateApprovalAuditEntryParams{
+		ApprovalID:     approval.ID,
+		OrganisationID: approval.OrganisationID,
+		Actor:          actor,
+		Decision:       decision,
+		Reason:         reason,
+	})
+	return err
+}
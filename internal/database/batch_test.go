@@ -0,0 +1,173 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Glenn444/banking-app/util"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func runTransferBatch(t *testing.T, store Store, batch TransferBatch, chunkSize int32) TransferBatch {
+	t.Helper()
+	var err error
+	for chunks := 0; batch.Status == BatchStatusPending || batch.Status == BatchStatusProcessing; chunks++ {
+		require.Less(t, chunks, int(batch.TotalItems)+1, "batch never finished")
+		batch, err = store.ExecuteTransferBatchChunkTx(context.Background(), batch.ID, chunkSize)
+		require.NoError(t, err)
+	}
+	return batch
+}
+
+func TestTransferBatch_BestEffort(t *testing.T) {
+	store := NewStore(testDB)
+	sender := CreateRandomUser(t)
+	fromAccount := createAccountInCurrency(t, sender, "USD", decimal.NewFromInt(100))
+	to1 := createAccountInCurrency(t, CreateRandomUser(t), "USD", decimal.Zero)
+	to2 := createAccountInCurrency(t, CreateRandomUser(t), "USD", decimal.Zero)
+
+	batch, err := store.CreateTransferBatchTx(context.Background(), CreateTransferBatchTxParams{
+		Owner:         sender.Username,
+		FromAccountID: fromAccount.ID,
+		Currency:      "USD",
+		Mode:          BatchModeBestEffort,
+		Items: []TransferBatchItemParams{
+			{ToAccountID: to1.ID, Amount: decimal.NewFromInt(60)},
+			{ToAccountID: to2.ID, Amount: decimal.NewFromInt(60)}, // more than what's left
+			{ToAccountID: uuid.New(), Amount: decimal.NewFromInt(10)},
+			{ToAccountID: to2.ID, Amount: decimal.NewFromInt(40)},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(4), batch.TotalItems)
+	require.True(t, decimal.NewFromInt(170).Equal(batch.TotalAmount))
+
+	// two items per transaction
+	batch = runTransferBatch(t, store, batch, 2)
+	require.Equal(t, BatchStatusPartiallyCompleted, batch.Status)
+	require.Equal(t, int32(2), batch.SucceededItems)
+	require.Equal(t, int32(2), batch.FailedItems)
+	require.True(t, batch.CompletedAt.Valid)
+
+	items, err := store.ListTransferBatchItems(context.Background(), ListTransferBatchItemsParams{
+		BatchID: batch.ID,
+		Limit:   10,
+	})
+	require.NoError(t, err)
+	require.Len(t, items, 4)
+	statuses := []string{}
+	for _, item := range items {
+		statuses = append(statuses, item.Status)
+		require.Equal(t, item.Status == BatchItemStatusSucceeded, item.TransferID.Valid)
+	}
+	require.Equal(t, []string{BatchItemStatusSucceeded, BatchItemStatusFailed, BatchItemStatusFailed, BatchItemStatusSucceeded}, statuses)
+
+	updated, err := store.GetAccount(context.Background(), fromAccount.ID)
+	require.NoError(t, err)
+	require.True(t, decimal.Zero.Equal(updated.Balance))
+	updated, err = store.GetAccount(context.Background(), to2.ID)
+	require.NoError(t, err)
+	require.True(t, decimal.NewFromInt(40).Equal(updated.Balance))
+}
+
+func TestTransferBatch_AllOrNothing(t *testing.T) {
+	store := NewStore(testDB)
+	sender := CreateRandomUser(t)
+	fromAccount := createAccountInCurrency(t, sender, "USD", decimal.NewFromInt(100))
+	to1 := createAccountInCurrency(t, CreateRandomUser(t), "USD", decimal.Zero)
+
+	batch, err := store.CreateTransferBatchTx(context.Background(), CreateTransferBatchTxParams{
+		Owner:         sender.Username,
+		FromAccountID: fromAccount.ID,
+		Currency:      "USD",
+		Mode:          BatchModeAllOrNothing,
+		Items: []TransferBatchItemParams{
+			{ToAccountID: to1.ID, Amount: decimal.NewFromInt(60)},
+			{ToAccountID: to1.ID, Amount: decimal.NewFromInt(60)},
+		},
+	})
+	require.NoError(t, err)
+
+	batch = runTransferBatch(t, store, batch, 1)
+	require.Equal(t, BatchStatusFailed, batch.Status)
+	require.Equal(t, int32(0), batch.SucceededItems)
+	require.Equal(t, int32(2), batch.FailedItems)
+	require.Contains(t, batch.Error, "line 2")
+
+	// the first item was rolled back with the second
+	updated, err := store.GetAccount(context.Background(), fromAccount.ID)
+	require.NoError(t, err)
+	require.True(t, decimal.NewFromInt(100).Equal(updated.Balance))
+	updated, err = store.GetAccount(context.Background(), to1.ID)
+	require.NoError(t, err)
+	require.True(t, decimal.Zero.Equal(updated.Balance))
+
+	_, err = store.ExecuteTransferBatchChunkTx(context.Background(), batch.ID, 1)
+	require.ErrorIs(t, err, ErrBatchFinished)
+}
+
+func TestTransferBatch_ChargesFee(t *testing.T) {
+	store := NewStore(testDB)
+	ctx := context.Background()
+
+	// the business tier keeps this rule away from the other tests' transfers
+	sender := CreateRandomUser(t)
+	_, err := testQueries.UpdateUserTier(ctx, UpdateUserTierParams{Username: sender.Username, Tier: util.BusinessTier})
+	require.NoError(t, err)
+
+	fromAccount := createAccountInCurrency(t, sender, "USD", decimal.NewFromInt(100))
+	to1 := createAccountInCurrency(t, CreateRandomUser(t), "USD", decimal.Zero)
+	to2 := createAccountInCurrency(t, CreateRandomUser(t), "USD", decimal.Zero)
+	feesAccount, err := testQueries.GetFeesAccount(ctx, "USD")
+	require.NoError(t, err)
+
+	schedule, err := store.CreateFeeScheduleTx(ctx, CreateFeeScheduleTxParams{
+		Currency:  "USD",
+		CreatedBy: sender.Username,
+		Rules: []FeeRuleParams{{
+			Tier:    util.BusinessTier,
+			FlatFee: decimal.NewFromInt(2),
+		}},
+	})
+	require.NoError(t, err)
+
+	batch, err := store.CreateTransferBatchTx(ctx, CreateTransferBatchTxParams{
+		Owner:         sender.Username,
+		FromAccountID: fromAccount.ID,
+		Currency:      "USD",
+		Mode:          BatchModeBestEffort,
+		Items: []TransferBatchItemParams{
+			{ToAccountID: to1.ID, Amount: decimal.NewFromInt(60)},
+			{ToAccountID: to2.ID, Amount: decimal.NewFromInt(37)}, // 38 is left, not enough for the fee on top
+			{ToAccountID: to2.ID, Amount: decimal.NewFromInt(36)},
+		},
+	})
+	require.NoError(t, err)
+
+	batch = runTransferBatch(t, store, batch, 10)
+	require.Equal(t, BatchStatusPartiallyCompleted, batch.Status)
+	require.Equal(t, int32(2), batch.SucceededItems)
+
+	items, err := store.ListTransferBatchItems(ctx, ListTransferBatchItemsParams{
+		BatchID: batch.ID,
+		Limit:   10,
+	})
+	require.NoError(t, err)
+	require.Len(t, items, 3)
+	require.Equal(t, BatchItemStatusFailed, items[1].Status)
+	require.Contains(t, items[1].Error, ErrInsufficientFunds.Error())
+
+	transfer, err := testQueries.GetTransfer(ctx, items[0].TransferID.UUID)
+	require.NoError(t, err)
+	require.True(t, decimal.NewFromInt(2).Equal(transfer.Fee))
+	require.Equal(t, uuid.NullUUID{UUID: schedule.Rules[0].ID, Valid: true}, transfer.FeeRuleID)
+
+	updated, err := store.GetAccount(ctx, fromAccount.ID)
+	require.NoError(t, err)
+	require.True(t, decimal.Zero.Equal(updated.Balance))
+	updatedFees, err := store.GetAccount(ctx, feesAccount.ID)
+	require.NoError(t, err)
+	require.True(t, feesAccount.Balance.Add(decimal.NewFromInt(4)).Equal(updatedFees.Balance))
+}
@@ -0,0 +1,339 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Glenn444/banking-app/util"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	BatchModeAllOrNothing = "all_or_nothing"
+	BatchModeBestEffort   = "best_effort"
+
+	BatchStatusPending            = "pending"
+	BatchStatusProcessing         = "processing"
+	BatchStatusCompleted          = "completed"
+	BatchStatusPartiallyCompleted = "partially_completed"
+	BatchStatusFailed             = "failed"
+
+	BatchItemStatusPending   = "pending"
+	BatchItemStatusSucceeded = "succeeded"
+	BatchItemStatusFailed    = "failed"
+)
+
+// MaxAllOrNothingBatchItems caps an all-or-nothing batch, its items run in a
+// single transaction that holds the locks of every account in it
+const MaxAllOrNothingBatchItems = 500
+
+var (
+	ErrBatchFinished   = errors.New("transfer batch has already finished")
+	ErrBatchItemFailed = errors.New("transfer batch item failed")
+)
+
+// TransferBatchItemParams is one line of a batch
+type TransferBatchItemParams struct {
+	ToAccountID uuid.UUID       `json:"to_account_id"`
+	Amount      decimal.Decimal `json:"amount"`
+}
+
+type CreateTransferBatchTxParams struct {
+	Owner         string                    `json:"owner"`
+	FromAccountID uuid.UUID                 `json:"from_account_id"`
+	Currency      string                    `json:"currency"`
+	Mode          string                    `json:"mode"`
+	Items         []TransferBatchItemParams `json:"items"`
+}
+
+// CreateTransferBatchTx stores a batch and its items, numbered from 1 in
+// the order given, ready for a worker to execute
+func (store *SQLStore) CreateTransferBatchTx(ctx context.Context, arg CreateTransferBatchTxParams) (TransferBatch, error) {
+	var batch TransferBatch
+
+	total := decimal.Zero
+	for _, item := range arg.Items {
+		total = total.Add(item.Amount)
+	}
+
+	err := store.execTx(ctx, nil, func(q *Queries) error {
+		var err error
+		batch, err = q.CreateTransferBatch(ctx, CreateTransferBatchParams{
+			Owner:         arg.Owner,
+			FromAccountID: arg.FromAccountID,
+			Currency:      arg.Currency,
+			Mode:          arg.Mode,
+			TotalItems:    int32(len(arg.Items)),
+			TotalAmount:   total,
+		})
+		if err != nil {
+			return err
+		}
+
+		for i, item := range arg.Items {
+			err = q.CreateTransferBatchItem(ctx, CreateTransferBatchItemParams{
+				BatchID:     batch.ID,
+				Line:        int32(i + 1),
+				ToAccountID: item.ToAccountID,
+				Amount:      item.Amount,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return TransferBatch{}, err
+	}
+	return batch, nil
+}
+
+// ExecuteTransferBatchChunkTx executes the next chunkSize pending items of a
+// best-effort batch in one transaction, a failing item is recorded and the
+// rest carry on. An all-or-nothing batch runs every item in a single
+// transaction and the first failure rolls it all back and fails the batch,
+// which is why it is capped at MaxAllOrNothingBatchItems when created.
+// The batch is finished once no pending items are left, callers keep
+// calling until its status is no longer processing.
+func (store *SQLStore) ExecuteTransferBatchChunkTx(ctx context.Context, batchID uuid.UUID, chunkSize int32) (TransferBatch, error) {
+	var batch TransferBatch
+
+	err := store.execTx(ctx, nil, func(q *Queries) error {
+		var err error
+		batch, err = q.GetTransferBatchForUpdate(ctx, batchID)
+		if err != nil {
+			return err
+		}
+		if batch.Status != BatchStatusPending && batch.Status != BatchStatusProcessing {
+			return ErrBatchFinished
+		}
+
+		limit := chunkSize
+		if batch.Mode == BatchModeAllOrNothing {
+			limit = batch.TotalItems
+		}
+		items, err := q.ListPendingTransferBatchItems(ctx, ListPendingTransferBatchItemsParams{
+			BatchID: batch.ID,
+			Limit:   limit,
+		})
+		if err != nil {
+			return err
+		}
+
+		succeeded, failed, err := executeBatchItems(ctx, q, batch, items)
+		if err != nil {
+			return err
+		}
+
+		batch, err = q.AddTransferBatchProgress(ctx, AddTransferBatchProgressParams{
+			ID:        batch.ID,
+			Succeeded: succeeded,
+			Failed:    failed,
+		})
+		if err != nil {
+			return err
+		}
+
+		if batch.SucceededItems+batch.FailedItems < batch.TotalItems {
+			return nil
+		}
+		batch, err = q.FinishTransferBatch(ctx, FinishTransferBatchParams{
+			ID:     batch.ID,
+			Status: batchOutcome(batch),
+		})
+		return err
+	})
+
+	if errors.Is(err, ErrBatchItemFailed) {
+		return store.failTransferBatch(ctx, batchID, err.Error())
+	}
+	if err != nil {
+		return TransferBatch{}, err
+	}
+	return batch, nil
+}
+
+// executeBatchItems runs the items against the locked accounts and returns how
+// many succeeded and failed. Items of an all-or-nothing batch don't fail
+// on their own, the first failure is returned as ErrBatchItemFailed.
+func executeBatchItems(ctx context.Context, q *Queries, batch TransferBatch, items []TransferBatchItem) (succeeded, failed int32, err error) {
+	ids := []uuid.UUID{batch.FromAccountID}
+	for _, item := range items {
+		ids = append(ids, item.ToAccountID)
+	}
+	accounts, err := lockExistingAccounts(ctx, q, ids...)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	fromAccount, ok := accounts[batch.FromAccountID]
+	if !ok {
+		return 0, 0, fmt.Errorf("batch source account %v: %w", batch.FromAccountID, ErrAccountNotFound)
+	}
+
+	for _, item := range items {
+		toAccount := accounts[item.ToAccountID]
+
+		// each item is priced like a transfer of its own
+		quote, err := QuoteTransferFee(ctx, q, *fromAccount, item.Amount)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		var reason error
+		switch {
+		case item.ToAccountID == fromAccount.ID:
+			reason = errors.New("cannot transfer to the batch's source account")
+		case toAccount == nil:
+			reason = fmt.Errorf("account %v not found", item.ToAccountID)
+		case toAccount.Currency != batch.Currency || fromAccount.Currency != batch.Currency:
+			reason = fmt.Errorf("account [%v] currency mismatch: %s vs %s", item.ToAccountID, toAccount.Currency, batch.Currency)
+		case !util.IsValidAmount(item.Amount, batch.Currency):
+			reason = fmt.Errorf("%w: %v %s", ErrInvalidAmountScale, item.Amount, batch.Currency)
+		case fromAccount.SpendableBalance().LessThan(quote.Total):
+			reason = fmt.Errorf("%w: account %v can spend %v, transfer amount %v, fee %v",
+				ErrInsufficientFunds, fromAccount.ID, fromAccount.SpendableBalance(), item.Amount, quote.Fee)
+		}
+		if reason == nil {
+			// every item counts towards the source account's limits
+			if err := checkTransferLimits(ctx, q, fromAccount, item.Amount, uuid.Nil, time.Now()); err != nil {
+				if !errors.Is(err, ErrLimitExceeded) {
+					return 0, 0, err
+				}
+				reason = err
+			}
+		}
+
+		if reason != nil {
+			if batch.Mode == BatchModeAllOrNothing {
+				return 0, 0, fmt.Errorf("%w: line %d: %v", ErrBatchItemFailed, item.Line, reason)
+			}
+			err = q.FinishTransferBatchItem(ctx, FinishTransferBatchItemParams{
+				ID:     item.ID,
+				Status: BatchItemStatusFailed,
+				Error:  reason.Error(),
+			})
+			if err != nil {
+				return 0, 0, err
+			}
+			failed++
+			continue
+		}
+
+		transfer, err := q.CreateTransfer(ctx, CreateTransferParams{
+			FromAccountID: fromAccount.ID,
+			ToAccountID:   toAccount.ID,
+			Amount:        item.Amount,
+		})
+		if err != nil {
+			return 0, 0, err
+		}
+		if _, err = postEntry(ctx, q, fromAccount, item.Amount.Neg()); err != nil {
+			return 0, 0, err
+		}
+		if _, err = postEntry(ctx, q, toAccount, item.Amount); err != nil {
+			return 0, 0, err
+		}
+		feeEntry, err := chargeTransferFee(ctx, q, &transfer, quote)
+		if err != nil {
+			return 0, 0, err
+		}
+		if feeEntry != nil {
+			// the fee was booked on a copy of the sender, the next items
+			// have to see the balance it left
+			if *fromAccount, err = q.GetAccount(ctx, fromAccount.ID); err != nil {
+				return 0, 0, err
+			}
+		}
+
+		err = q.FinishTransferBatchItem(ctx, FinishTransferBatchItemParams{
+			ID:         item.ID,
+			Status:     BatchItemStatusSucceeded,
+			TransferID: uuid.NullUUID{UUID: transfer.ID, Valid: true},
+		})
+		if err != nil {
+			return 0, 0, err
+		}
+		succeeded++
+	}
+	return succeeded, failed, nil
+}
+
+// failTransferBatch fails every pending item of a batch and the batch itself
+func (store *SQLStore) failTransferBatch(ctx context.Context, batchID uuid.UUID, reason string) (TransferBatch, error) {
+	var batch TransferBatch
+
+	err := store.execTx(ctx, nil, func(q *Queries) error {
+		var err error
+		if _, err = q.GetTransferBatchForUpdate(ctx, batchID); err != nil {
+			return err
+		}
+
+		failed, err := q.FailPendingTransferBatchItems(ctx, FailPendingTransferBatchItemsParams{
+			BatchID: batchID,
+			Error:   reason,
+		})
+		if err != nil {
+			return err
+		}
+
+		if _, err = q.AddTransferBatchProgress(ctx, AddTransferBatchProgressParams{
+			ID:     batchID,
+			Failed: int32(failed),
+		}); err != nil {
+			return err
+		}
+
+		batch, err = q.FinishTransferBatch(ctx, FinishTransferBatchParams{
+			ID:     batchID,
+			Status: BatchStatusFailed,
+			Error:  reason,
+		})
+		return err
+	})
+	if err != nil {
+		return TransferBatch{}, err
+	}
+	return batch, nil
+}
+
+func batchOutcome(batch TransferBatch) string {
+	switch {
+	case batch.FailedItems == 0:
+		return BatchStatusCompleted
+	case batch.SucceededItems == 0:
+		return BatchStatusFailed
+	}
+	return BatchStatusPartiallyCompleted
+}
+
+// lockExistingAccounts is lockAccounts for ids that may no longer exist,
+// missing accounts are left out of the map
+func lockExistingAccounts(ctx context.Context, q *Queries, ids ...uuid.UUID) (map[uuid.UUID]*Account, error) {
+	sorted := make([]uuid.UUID, len(ids))
+	copy(sorted, ids)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].String() < sorted[j].String()
+	})
+
+	accounts := make(map[uuid.UUID]*Account, len(ids))
+	for _, id := range sorted {
+		if _, ok := accounts[id]; ok {
+			continue
+		}
+		account, err := q.GetAccountByIdForUpdate(ctx, id)
+		if errors.Is(err, ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		accounts[id] = &account
+	}
+	return accounts, nil
+}
@@ -0,0 +1,106 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: currency.sql
+
+package database
+
+import (
+	"context"
+)
+
+const createSettlementAccount = `-- name: CreateSettlementAccount :exec
+INSERT INTO accounts(
+    "owner",
+    balance,
+    currency
+) VALUES (
+    'settlement', 0, $1
+) ON CONFLICT ("owner", currency, account_type) DO NOTHING
+`
+
+func (q *Queries) CreateSettlementAccount(ctx context.Context, currency string) error {
+	_, err := q.db.Exec(ctx, createSettlementAccount, currency)
+	return err
+}
+
+const getCurrency = `-- name: GetCurrency :one
+SELECT code, numeric_code, minor_units, enabled, symbol, created_at, updated_at FROM currencies
+WHERE code = $1 LIMIT 1
+`
+
+func (q *Queries) GetCurrency(ctx context.Context, code string) (Currency, error) {
+	row := q.db.QueryRow(ctx, getCurrency, code)
+	var i Currency
+	err := row.Scan(
+		&i.Code,
+		&i.NumericCode,
+		&i.MinorUnits,
+		&i.Enabled,
+		&i.Symbol,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listCurrencies = `-- name: ListCurrencies :many
+SELECT code, numeric_code, minor_units, enabled, symbol, created_at, updated_at FROM currencies
+ORDER BY code
+`
+
+func (q *Queries) ListCurrencies(ctx context.Context) ([]Currency, error) {
+	rows, err := q.db.Query(ctx, listCurrencies)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Currency{}
+	for rows.Next() {
+		var i Currency
+		if err := rows.Scan(
+			&i.Code,
+			&i.NumericCode,
+			&i.MinorUnits,
+			&i.Enabled,
+			&i.Symbol,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateCurrencyEnabled = `-- name: UpdateCurrencyEnabled :one
+UPDATE currencies
+  set enabled = $2,
+      updated_at = now()
+WHERE code = $1
+RETURNING code, numeric_code, minor_units, enabled, symbol, created_at, updated_at
+`
+
+type UpdateCurrencyEnabledParams struct {
+	Code    string `json:"code"`
+	Enabled bool   `json:"enabled"`
+}
+
+func (q *Queries) UpdateCurrencyEnabled(ctx context.Context, arg UpdateCurrencyEnabledParams) (Currency, error) {
+	row := q.db.QueryRow(ctx, updateCurrencyEnabled, arg.Code, arg.Enabled)
+	var i Currency
+	err := row.Scan(
+		&i.Code,
+		&i.NumericCode,
+		&i.MinorUnits,
+		&i.Enabled,
+		&i.Symbol,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,46 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestListCurrencies(t *testing.T) {
+	currencies, err := testQueries.ListCurrencies(context.Background())
+	require.NoError(t, err)
+	require.NotEmpty(t, currencies)
+
+	jpy, err := testQueries.GetCurrency(context.Background(), "JPY")
+	require.NoError(t, err)
+	require.Equal(t, int32(0), jpy.MinorUnits)
+	require.Equal(t, int32(392), jpy.NumericCode)
+}
+
+func TestEnableCurrencyCreatesSettlementAccount(t *testing.T) {
+	store := NewStore(testDB)
+
+	original, err := store.GetCurrency(context.Background(), "GBP")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, err := store.SetCurrencyEnabledTx(context.Background(), "GBP", original.Enabled)
+		require.NoError(t, err)
+	})
+
+	currency, err := store.SetCurrencyEnabledTx(context.Background(), "GBP", true)
+	require.NoError(t, err)
+	require.True(t, currency.Enabled)
+
+	settlement, err := store.GetSettlementAccount(context.Background(), "GBP")
+	require.NoError(t, err)
+	require.Equal(t, "GBP", settlement.Currency)
+
+	// enabling again must not fail on the existing settlement account
+	_, err = store.SetCurrencyEnabledTx(context.Background(), "GBP", true)
+	require.NoError(t, err)
+
+	currency, err = store.SetCurrencyEnabledTx(context.Background(), "GBP", false)
+	require.NoError(t, err)
+	require.False(t, currency.Enabled)
+}
@@ -0,0 +1,46 @@
+package database
+
+import "context"
+
+// SetCurrencyEnabledTx enables or disables a currency. Enabling a currency
+// also makes sure it has a settlement account for deposits and withdrawals,
+// an FX position account for cross-currency transfers and the system accounts
+// interest, fees and escrowed funds are booked against.
+func (store *SQLStore) SetCurrencyEnabledTx(ctx context.Context, code string, enabled bool) (Currency, error) {
+	var currency Currency
+
+	err := store.execTx(ctx, nil, func(q *Queries) error {
+		var err error
+		currency, err = q.UpdateCurrencyEnabled(ctx, UpdateCurrencyEnabledParams{
+			Code:    code,
+			Enabled: enabled,
+		})
+		if err != nil {
+			return err
+		}
+
+		if !enabled {
+			return nil
+		}
+		if err = q.CreateSettlementAccount(ctx, code); err != nil {
+			return err
+		}
+		if err = q.CreateFxPositionAccount(ctx, code); err != nil {
+			return err
+		}
+		if err = q.CreateOverdraftInterestAccount(ctx, code); err != nil {
+			return err
+		}
+		if err = q.CreateInterestExpenseAccount(ctx, code); err != nil {
+			return err
+		}
+		if err = q.CreateFeesAccount(ctx, code); err != nil {
+			return err
+		}
+		return q.CreateEscrowAccount(ctx, code)
+	})
+	if err != nil {
+		return Currency{}, err
+	}
+	return currency, nil
+}
@@ -2,12 +2,12 @@ package database
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 	"testing"
 	"time"
 
 	"github.com/Glenn444/banking-app/util"
+	"github.com/jackc/pgx/v5"
 	"github.com/stretchr/testify/require"
 )
 
@@ -98,6 +98,6 @@ func TestDeleteEntry(t *testing.T) {
 
 	deletedEntry, err := testQueries.GetEntry(context.Background(), entry.ID)
 	require.Error(t, err)
-	require.EqualError(t, err, sql.ErrNoRows.Error())
+	require.EqualError(t, err, pgx.ErrNoRows.Error())
 	require.Empty(t, deletedEntry)
 }
@@ -0,0 +1,124 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// The taxonomy of store failures that aren't specific to one kind of
+// record. Together with the sentinel errors of each transaction they are
+// what callers match with errors.Is, never the driver's errors.
+var (
+	ErrNotFound            = errors.New("record not found")
+	ErrAccountNotFound     = errors.New("account not found")
+	ErrUniqueViolation     = errors.New("record already exists")
+	ErrForeignKeyViolation = errors.New("record refers to one that doesn't exist")
+	ErrCheckViolation      = errors.New("record breaks a rule of the database")
+	ErrConcurrentUpdate    = errors.New("record was changed by another request, try again")
+)
+
+// the SQLSTATEs sorted into the taxonomy
+const (
+	uniqueViolation     = "23505"
+	foreignKeyViolation = "23503"
+	checkViolation      = "23514"
+	raiseException      = "P0001"
+)
+
+// taxonomyError is a driver error sorted into the taxonomy. It matches both
+// its kind and the driver error, so the details of the failure, such as the
+// constraint it broke, stay available to errors.As.
+type taxonomyError struct {
+	kind error
+	err  error
+}
+
+func (e *taxonomyError) Error() string {
+	return e.kind.Error() + ": " + e.err.Error()
+}
+
+func (e *taxonomyError) Unwrap() []error {
+	return []error{e.kind, e.err}
+}
+
+// TranslateError sorts err into the taxonomy. Errors already sorted, and
+// those the taxonomy doesn't know, are returned as they are.
+func TranslateError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var sorted *taxonomyError
+	if errors.As(err, &sorted) {
+		return err
+	}
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return &taxonomyError{kind: ErrNotFound, err: err}
+	}
+	if _, retryable := retryableTxError(err); retryable {
+		return &taxonomyError{kind: ErrConcurrentUpdate, err: err}
+	}
+
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+	switch pgErr.Code {
+	case uniqueViolation:
+		return &taxonomyError{kind: ErrUniqueViolation, err: err}
+	case foreignKeyViolation:
+		return &taxonomyError{kind: ErrForeignKeyViolation, err: err}
+	case checkViolation:
+		return &taxonomyError{kind: ErrCheckViolation, err: err}
+	case raiseException:
+		// the only exception raised is the transfers' balance check
+		return &taxonomyError{kind: ErrInsufficientFunds, err: err}
+	}
+	return err
+}
+
+// notFoundDB sorts the pgx.ErrNoRows of single row queries into
+// ErrNotFound, so callers of the store never check for the driver's error.
+// The result still matches pgx.ErrNoRows for the store's own checks.
+type notFoundDB struct {
+	DBTX
+}
+
+func (db notFoundDB) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	return notFoundRow{db.DBTX.QueryRow(ctx, sql, args...)}
+}
+
+type notFoundRow struct {
+	pgx.Row
+}
+
+func (row notFoundRow) Scan(dest ...any) error {
+	err := row.Row.Scan(dest...)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return &taxonomyError{kind: ErrNotFound, err: err}
+	}
+	return err
+}
+
+// accountNotFound tells an account that doesn't exist apart from other
+// missing records, the result still matches the error it wraps
+func accountNotFound(err error) error {
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return err
+	}
+	return &taxonomyError{kind: ErrAccountNotFound, err: err}
+}
+
+// ViolatedConstraint is the name of the constraint err broke, it is empty
+// when err isn't a constraint violation
+func ViolatedConstraint(err error) string {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) || !strings.HasPrefix(pgErr.Code, "23") {
+		return ""
+	}
+	return pgErr.ConstraintName
+}
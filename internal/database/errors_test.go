@@ -0,0 +1,68 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTranslateError(t *testing.T) {
+	testCases := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{"NoRows", pgx.ErrNoRows, ErrNotFound},
+		{"UniqueViolation", &pgconn.PgError{Code: "23505"}, ErrUniqueViolation},
+		{"ForeignKeyViolation", fmt.Errorf("create account: %w", &pgconn.PgError{Code: "23503"}), ErrForeignKeyViolation},
+		{"CheckViolation", &pgconn.PgError{Code: "23514"}, ErrCheckViolation},
+		{"BalanceTrigger", &pgconn.PgError{Code: "P0001"}, ErrInsufficientFunds},
+		{"Deadlock", &pgconn.PgError{Code: "40P01"}, ErrConcurrentUpdate},
+		{"AccountNotFound", accountNotFound(pgx.ErrNoRows), ErrAccountNotFound},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := TranslateError(tc.err)
+			require.ErrorIs(t, err, tc.want)
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+
+	// sentinels and errors the taxonomy doesn't know are left alone
+	require.Equal(t, ErrHoldExpired, TranslateError(ErrHoldExpired))
+	unknown := errors.New("connection refused")
+	require.Equal(t, unknown, TranslateError(unknown))
+	require.Nil(t, TranslateError(nil))
+	require.NotErrorIs(t, TranslateError(accountNotFound(pgx.ErrNoRows)), ErrNotFound)
+}
+
+// errRow is a row that fails to scan with err
+type errRow struct {
+	err error
+}
+
+func (row errRow) Scan(dest ...any) error {
+	return row.err
+}
+
+func TestNotFoundRow(t *testing.T) {
+	err := notFoundRow{errRow{pgx.ErrNoRows}}.Scan()
+	require.ErrorIs(t, err, ErrNotFound)
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+	require.ErrorIs(t, accountNotFound(err), ErrAccountNotFound)
+
+	unknown := errors.New("connection refused")
+	require.Equal(t, unknown, notFoundRow{errRow{unknown}}.Scan())
+	require.NoError(t, notFoundRow{errRow{}}.Scan())
+}
+
+func TestViolatedConstraint(t *testing.T) {
+	require.Equal(t, "fk_account_currency", ViolatedConstraint(&pgconn.PgError{Code: "23503", ConstraintName: "fk_account_currency"}))
+	require.Empty(t, ViolatedConstraint(&pgconn.PgError{Code: "40P01", ConstraintName: "ignored"}))
+	require.Empty(t, ViolatedConstraint(pgx.ErrNoRows))
+}
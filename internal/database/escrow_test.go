@@ -0,0 +1,129 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomEscrow(t *testing.T, from, to Account, expiresAt time.Time) EscrowTxResult {
+	t.Helper()
+	result, err := NewStore(testDB).CreateEscrowTx(context.Background(), CreateEscrowTxParams{
+		FromAccountID: from.ID,
+		ToAccountID:   to.ID,
+		Amount:        decimal.NewFromInt(40),
+		Description:   "camera",
+		ExpiresAt:     expiresAt,
+	})
+	require.NoError(t, err)
+	require.Equal(t, EscrowStatusFunded, result.Escrow.Status)
+	require.NotNil(t, result.Transfer)
+	return result
+}
+
+func TestNextEscrowStatus(t *testing.T) {
+	next, err := nextEscrowStatus(EscrowStatusFunded, EscrowEventConfirmed)
+	require.NoError(t, err)
+	require.Equal(t, EscrowStatusFunded, next)
+
+	next, err = nextEscrowStatus(EscrowStatusFunded, EscrowEventDisputed)
+	require.NoError(t, err)
+	require.Equal(t, EscrowStatusRefunded, next)
+
+	for _, status := range []string{EscrowStatusReleased, EscrowStatusRefunded} {
+		_, err = nextEscrowStatus(status, EscrowEventConfirmed)
+		require.ErrorIs(t, err, ErrEscrowTransition)
+	}
+}
+
+func TestConfirmEscrowTx(t *testing.T) {
+	store := NewStore(testDB)
+	ctx := context.Background()
+	payer, payee := CreateRandomUser(t), CreateRandomUser(t)
+	fromAccount := createAccountInCurrency(t, payer, "USD", decimal.NewFromInt(100))
+	toAccount := createAccountInCurrency(t, payee, "USD", decimal.Zero)
+
+	created := createRandomEscrow(t, fromAccount, toAccount, time.Now().Add(time.Hour))
+	escrowAccount, err := testQueries.GetAccount(ctx, created.Escrow.EscrowAccountID)
+	require.NoError(t, err)
+	require.Equal(t, "escrow", escrowAccount.Owner)
+
+	confirm := func(username string) (EscrowTxResult, error) {
+		return store.ConfirmEscrowTx(ctx, EscrowActionTxParams{EscrowID: created.Escrow.ID, Username: username})
+	}
+
+	_, err = confirm(CreateRandomUser(t).Username)
+	require.ErrorIs(t, err, ErrNotEscrowParty)
+
+	first, err := confirm(payer.Username)
+	require.NoError(t, err)
+	require.Equal(t, EscrowStatusFunded, first.Escrow.Status)
+	require.Nil(t, first.Transfer)
+
+	released, err := confirm(payee.Username)
+	require.NoError(t, err)
+	require.Equal(t, EscrowStatusReleased, released.Escrow.Status)
+	require.Equal(t, toAccount.ID, released.Transfer.ToAccountID)
+
+	payeeAccount, err := testQueries.GetAccount(ctx, toAccount.ID)
+	require.NoError(t, err)
+	require.True(t, decimal.NewFromInt(40).Equal(payeeAccount.Balance))
+	escrowAfter, err := testQueries.GetAccount(ctx, escrowAccount.ID)
+	require.NoError(t, err)
+	require.True(t, escrowAccount.Balance.Sub(decimal.NewFromInt(40)).Equal(escrowAfter.Balance))
+
+	events, err := testQueries.ListEscrowEvents(ctx, created.Escrow.ID)
+	require.NoError(t, err)
+	require.Len(t, events, 4)
+	require.Equal(t, EscrowEventReleased, events[3].Event)
+	require.Equal(t, EscrowStatusReleased, events[3].ToStatus)
+
+	_, err = store.DisputeEscrowTx(ctx, EscrowActionTxParams{EscrowID: created.Escrow.ID, Username: payer.Username})
+	require.ErrorIs(t, err, ErrEscrowTransition)
+}
+
+func TestDisputeEscrowTx(t *testing.T) {
+	store := NewStore(testDB)
+	ctx := context.Background()
+	payer, payee := CreateRandomUser(t), CreateRandomUser(t)
+	fromAccount := createAccountInCurrency(t, payer, "USD", decimal.NewFromInt(100))
+	toAccount := createAccountInCurrency(t, payee, "USD", decimal.Zero)
+
+	created := createRandomEscrow(t, fromAccount, toAccount, time.Now().Add(time.Hour))
+	refunded, err := store.DisputeEscrowTx(ctx, EscrowActionTxParams{
+		EscrowID: created.Escrow.ID,
+		Username: payee.Username,
+		Reason:   "item never shipped",
+	})
+	require.NoError(t, err)
+	require.Equal(t, EscrowStatusRefunded, refunded.Escrow.Status)
+	require.Equal(t, fromAccount.ID, refunded.Transfer.ToAccountID)
+
+	account, err := testQueries.GetAccount(ctx, fromAccount.ID)
+	require.NoError(t, err)
+	require.True(t, decimal.NewFromInt(100).Equal(account.Balance))
+}
+
+func TestExpireEscrowsTx(t *testing.T) {
+	store := NewStore(testDB)
+	ctx := context.Background()
+	payer, payee := CreateRandomUser(t), CreateRandomUser(t)
+	fromAccount := createAccountInCurrency(t, payer, "USD", decimal.NewFromInt(100))
+	toAccount := createAccountInCurrency(t, payee, "USD", decimal.Zero)
+
+	created := createRandomEscrow(t, fromAccount, toAccount, time.Now().Add(time.Second))
+
+	expired, err := store.ExpireEscrowsTx(ctx, time.Now().Add(time.Minute), 1000)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, expired, 1)
+
+	escrow, err := testQueries.GetEscrow(ctx, created.Escrow.ID)
+	require.NoError(t, err)
+	require.Equal(t, EscrowStatusRefunded, escrow.Status)
+	account, err := testQueries.GetAccount(ctx, fromAccount.ID)
+	require.NoError(t, err)
+	require.True(t, decimal.NewFromInt(100).Equal(account.Balance))
+}
@@ -0,0 +1,328 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	EscrowStatusFunded   = "funded"
+	EscrowStatusReleased = "released"
+	EscrowStatusRefunded = "refunded"
+)
+
+// events of the escrow state machine, kept in escrow_events
+const (
+	EscrowEventFunded    = "funded"
+	EscrowEventConfirmed = "confirmed"
+	EscrowEventReleased  = "released"
+	EscrowEventDisputed  = "disputed"
+	EscrowEventExpired   = "expired"
+)
+
+// escrowTransitions is the escrow state machine, the status each event moves
+// an escrow to from the statuses it can happen in. Released and refunded
+// escrows are settled and take no more events.
+var escrowTransitions = map[string]map[string]string{
+	EscrowStatusFunded: {
+		EscrowEventConfirmed: EscrowStatusFunded,
+		EscrowEventReleased:  EscrowStatusReleased,
+		EscrowEventDisputed:  EscrowStatusRefunded,
+		EscrowEventExpired:   EscrowStatusRefunded,
+	},
+}
+
+var (
+	ErrEscrowTransition = errors.New("escrow can't change this way")
+	ErrEscrowExpired    = errors.New("escrow has expired")
+	ErrNotEscrowParty   = errors.New("user isn't a party to the escrow")
+	ErrEscrowAccounts   = errors.New("escrow accounts must share the currency and belong to different users")
+)
+
+// nextEscrowStatus is the status event moves an escrow in status to
+func nextEscrowStatus(status, event string) (string, error) {
+	next, ok := escrowTransitions[status][event]
+	if !ok {
+		return "", fmt.Errorf("%w: %s escrow can't be %s", ErrEscrowTransition, status, event)
+	}
+	return next, nil
+}
+
+type CreateEscrowTxParams struct {
+	FromAccountID uuid.UUID       `json:"from_account_id"`
+	ToAccountID   uuid.UUID       `json:"to_account_id"`
+	Amount        decimal.Decimal `json:"amount"`
+	Description   string          `json:"description"`
+	ExpiresAt     time.Time       `json:"expires_at"`
+}
+
+// EscrowTxResult is an escrow after a transition and the transfer that moved
+// its funds in that transition, if any
+type EscrowTxResult struct {
+	Escrow   Escrow    `json:"escrow"`
+	Transfer *Transfer `json:"transfer,omitempty"`
+}
+
+// CreateEscrowTx moves the amount from the payer's account into the escrow
+// account of the currency through TransferTx, so the payer's fees and limits
+// apply, and records the funded escrow
+func (store *SQLStore) CreateEscrowTx(ctx context.Context, arg CreateEscrowTxParams) (EscrowTxResult, error) {
+	var result EscrowTxResult
+
+	err := store.execTx(ctx, nil, func(q *Queries) error {
+		fromAccount, err := q.GetAccount(ctx, arg.FromAccountID)
+		if err != nil {
+			return err
+		}
+		toAccount, err := q.GetAccount(ctx, arg.ToAccountID)
+		if err != nil {
+			return err
+		}
+		if fromAccount.Currency != toAccount.Currency || fromAccount.Owner == toAccount.Owner {
+			return ErrEscrowAccounts
+		}
+
+		escrowAccount, err := q.GetEscrowAccount(ctx, fromAccount.Currency)
+		if err != nil {
+			return fmt.Errorf("no escrow account for currency %s: %w", fromAccount.Currency, err)
+		}
+
+		var funding TransferTxResult
+		err = transferTx(ctx, q, TransferTxParams{
+			FromAccountID:   fromAccount.ID,
+			ToAccountID:     escrowAccount.ID,
+			Amount:          arg.Amount,
+			TransferDetails: TransferDetails{Description: arg.Description},
+		}, &funding)
+		if err != nil {
+			return err
+		}
+		result.Transfer = &funding.Transfer
+
+		result.Escrow, err = q.CreateEscrow(ctx, CreateEscrowParams{
+			Payer:             fromAccount.Owner,
+			Payee:             toAccount.Owner,
+			FromAccountID:     fromAccount.ID,
+			ToAccountID:       toAccount.ID,
+			EscrowAccountID:   escrowAccount.ID,
+			Amount:            arg.Amount,
+			Currency:          fromAccount.Currency,
+			Description:       arg.Description,
+			FundingTransferID: funding.Transfer.ID,
+			ExpiresAt:         arg.ExpiresAt,
+		})
+		if err != nil {
+			return err
+		}
+		if err = recordEscrowEvent(ctx, q, result.Escrow, EscrowEventFunded, "", fromAccount.Owner, ""); err != nil {
+			return err
+		}
+		return notify(ctx, q, result.Escrow.Payee, NotificationEscrowFunded, result.Escrow)
+	})
+	if err != nil {
+		return EscrowTxResult{}, err
+	}
+	return result, nil
+}
+
+type EscrowActionTxParams struct {
+	EscrowID uuid.UUID `json:"escrow_id"`
+	Username string    `json:"username"`
+	Reason   string    `json:"reason"`
+}
+
+// ConfirmEscrowTx records that one party is satisfied, the confirmation that
+// completes both releases the funds to the payee
+func (store *SQLStore) ConfirmEscrowTx(ctx context.Context, arg EscrowActionTxParams) (EscrowTxResult, error) {
+	var result EscrowTxResult
+
+	err := store.execTx(ctx, nil, func(q *Queries) error {
+		escrow, err := activeEscrow(ctx, q, arg.EscrowID, arg.Username, EscrowEventConfirmed)
+		if err != nil {
+			return err
+		}
+
+		if escrow.Payer == arg.Username {
+			result.Escrow, err = q.ConfirmEscrowPayer(ctx, escrow.ID)
+		} else {
+			result.Escrow, err = q.ConfirmEscrowPayee(ctx, escrow.ID)
+		}
+		if err != nil {
+			return err
+		}
+		if err = recordEscrowEvent(ctx, q, result.Escrow, EscrowEventConfirmed, escrow.Status, arg.Username, ""); err != nil {
+			return err
+		}
+
+		if !result.Escrow.PayerConfirmedAt.Valid || !result.Escrow.PayeeConfirmedAt.Valid {
+			return nil
+		}
+		result, err = settleEscrow(ctx, q, result.Escrow, EscrowEventReleased, "", "")
+		return err
+	})
+	if err != nil {
+		return EscrowTxResult{}, err
+	}
+	return result, nil
+}
+
+// DisputeEscrowTx lets either party call the deal off, which refunds the
+// funds to the payer
+func (store *SQLStore) DisputeEscrowTx(ctx context.Context, arg EscrowActionTxParams) (EscrowTxResult, error) {
+	var result EscrowTxResult
+
+	err := store.execTx(ctx, nil, func(q *Queries) error {
+		escrow, err := activeEscrow(ctx, q, arg.EscrowID, arg.Username, EscrowEventDisputed)
+		if err != nil {
+			return err
+		}
+
+		result, err = settleEscrow(ctx, q, escrow, EscrowEventDisputed, arg.Username, arg.Reason)
+		return err
+	})
+	if err != nil {
+		return EscrowTxResult{}, err
+	}
+	return result, nil
+}
+
+// ExpireEscrowsTx refunds up to limit escrows that expired before now
+// without being settled and returns how many it refunded, each escrow is
+// refunded in its own transaction
+func (store *SQLStore) ExpireEscrowsTx(ctx context.Context, now time.Time, limit int32) (int, error) {
+	ids, err := store.ListExpiredEscrows(ctx, ListExpiredEscrowsParams{
+		ExpiresAt: now,
+		Limit:     limit,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	expired := 0
+	for _, id := range ids {
+		var refunded bool
+		err = store.execTx(ctx, nil, func(q *Queries) error {
+			refunded = false
+			escrow, err := q.GetEscrowForUpdate(ctx, id)
+			if err != nil {
+				return err
+			}
+			if escrow.Status != EscrowStatusFunded {
+				// settled since it was listed
+				return nil
+			}
+
+			if _, err = settleEscrow(ctx, q, escrow, EscrowEventExpired, "", ""); err != nil {
+				return err
+			}
+			refunded = true
+			return nil
+		})
+		if err != nil {
+			return expired, err
+		}
+		if refunded {
+			expired++
+		}
+	}
+	return expired, nil
+}
+
+// activeEscrow locks an escrow and checks username is a party to it and the
+// event can still happen to it
+func activeEscrow(ctx context.Context, q *Queries, escrowID uuid.UUID, username, event string) (Escrow, error) {
+	escrow, err := q.GetEscrowForUpdate(ctx, escrowID)
+	if err != nil {
+		return Escrow{}, err
+	}
+	if escrow.Payer != username && escrow.Payee != username {
+		return Escrow{}, fmt.Errorf("%w: %s on escrow %v", ErrNotEscrowParty, username, escrow.ID)
+	}
+	if _, err = nextEscrowStatus(escrow.Status, event); err != nil {
+		return Escrow{}, err
+	}
+	if !time.Now().Before(escrow.ExpiresAt) {
+		return Escrow{}, fmt.Errorf("%w: escrow %v expired at %v", ErrEscrowExpired, escrow.ID, escrow.ExpiresAt)
+	}
+	return escrow, nil
+}
+
+// settleEscrow moves a locked escrow's funds out of the escrow account, to
+// the payee when it is released and back to the payer otherwise. The
+// accounts are locked in the same order as TransferTx locks them.
+func settleEscrow(ctx context.Context, q *Queries, escrow Escrow, event, actor, reason string) (EscrowTxResult, error) {
+	var result EscrowTxResult
+
+	status, err := nextEscrowStatus(escrow.Status, event)
+	if err != nil {
+		return result, err
+	}
+	toAccountID := escrow.FromAccountID
+	if status == EscrowStatusReleased {
+		toAccountID = escrow.ToAccountID
+	}
+
+	accounts, err := lockAccounts(ctx, q, escrow.EscrowAccountID, toAccountID)
+	if err != nil {
+		return result, err
+	}
+
+	transfer, err := q.CreateTransfer(ctx, CreateTransferParams{
+		FromAccountID: escrow.EscrowAccountID,
+		ToAccountID:   toAccountID,
+		Amount:        escrow.Amount,
+	})
+	if err != nil {
+		return result, err
+	}
+	if err = saveTransferDetails(ctx, q, &transfer, TransferDetails{Description: escrow.Description}); err != nil {
+		return result, err
+	}
+	if _, err = postEntry(ctx, q, accounts[escrow.EscrowAccountID], escrow.Amount.Neg()); err != nil {
+		return result, err
+	}
+	if _, err = postEntry(ctx, q, accounts[toAccountID], escrow.Amount); err != nil {
+		return result, err
+	}
+	result.Transfer = &transfer
+
+	result.Escrow, err = q.FinishEscrow(ctx, FinishEscrowParams{
+		ID:                   escrow.ID,
+		Status:               status,
+		SettlementTransferID: uuid.NullUUID{UUID: transfer.ID, Valid: true},
+	})
+	if err != nil {
+		return result, err
+	}
+	if err = recordEscrowEvent(ctx, q, result.Escrow, event, escrow.Status, actor, reason); err != nil {
+		return result, err
+	}
+
+	kind := NotificationEscrowRefunded
+	if status == EscrowStatusReleased {
+		kind = NotificationEscrowReleased
+	}
+	for _, username := range []string{escrow.Payer, escrow.Payee} {
+		if err = notify(ctx, q, username, kind, result.Escrow); err != nil {
+			return result, err
+		}
+	}
+	return result, nil
+}
+
+func recordEscrowEvent(ctx context.Context, q *Queries, escrow Escrow, event, fromStatus, actor, reason string) error {
+	_, err := q.CreateEscrowEvent(ctx, CreateEscrowEventParams{
+		EscrowID:   escrow.ID,
+		Event:      event,
+		FromStatus: fromStatus,
+		ToStatus:   escrow.Status,
+		Actor:      actor,
+		Reason:     reason,
+	})
+	return err
+}
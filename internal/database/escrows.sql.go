@@ -0,0 +1,453 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: escrows.sql
+
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+const confirmEscrowPayee = `-- name: ConfirmEscrowPayee :one
+UPDATE escrows
+  set payee_confirmed_at = COALESCE(payee_confirmed_at, now()),
+      updated_at = now()
+WHERE id = $1
+RETURNING id, payer, payee, from_account_id, to_account_id, escrow_account_id, amount, currency, description, status, payer_confirmed_at, payee_confirmed_at, funding_transfer_id, settlement_transfer_id, expires_at, created_at, updated_at
+`
+
+func (q *Queries) ConfirmEscrowPayee(ctx context.Context, id uuid.UUID) (Escrow, error) {
+	row := q.db.QueryRow(ctx, confirmEscrowPayee, id)
+	var i Escrow
+	err := row.Scan(
+		&i.ID,
+		&i.Payer,
+		&i.Payee,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.EscrowAccountID,
+		&i.Amount,
+		&i.Currency,
+		&i.Description,
+		&i.Status,
+		&i.PayerConfirmedAt,
+		&i.PayeeConfirmedAt,
+		&i.FundingTransferID,
+		&i.SettlementTransferID,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const confirmEscrowPayer = `-- name: ConfirmEscrowPayer :one
+UPDATE escrows
+  set payer_confirmed_at = COALESCE(payer_confirmed_at, now()),
+      updated_at = now()
+WHERE id = $1
+RETURNING id, payer, payee, from_account_id, to_account_id, escrow_account_id, amount, currency, description, status, payer_confirmed_at, payee_confirmed_at, funding_transfer_id, settlement_transfer_id, expires_at, created_at, updated_at
+`
+
+func (q *Queries) ConfirmEscrowPayer(ctx context.Context, id uuid.UUID) (Escrow, error) {
+	row := q.db.QueryRow(ctx, confirmEscrowPayer, id)
+	var i Escrow
+	err := row.Scan(
+		&i.ID,
+		&i.Payer,
+		&i.Payee,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.EscrowAccountID,
+		&i.Amount,
+		&i.Currency,
+		&i.Description,
+		&i.Status,
+		&i.PayerConfirmedAt,
+		&i.PayeeConfirmedAt,
+		&i.FundingTransferID,
+		&i.SettlementTransferID,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createEscrow = `-- name: CreateEscrow :one
+INSERT INTO escrows(
+    payer,
+    payee,
+    from_account_id,
+    to_account_id,
+    escrow_account_id,
+    amount,
+    currency,
+    description,
+    funding_transfer_id,
+    expires_at
+) VALUES (
+    $1,$2,$3,$4,$5,$6,$7,$8,$9,$10
+) RETURNING id, payer, payee, from_account_id, to_account_id, escrow_account_id, amount, currency, description, status, payer_confirmed_at, payee_confirmed_at, funding_transfer_id, settlement_transfer_id, expires_at, created_at, updated_at
+`
+
+type CreateEscrowParams struct {
+	Payer             string          `json:"payer"`
+	Payee             string          `json:"payee"`
+	FromAccountID     uuid.UUID       `json:"from_account_id"`
+	ToAccountID       uuid.UUID       `json:"to_account_id"`
+	EscrowAccountID   uuid.UUID       `json:"escrow_account_id"`
+	Amount            decimal.Decimal `json:"amount"`
+	Currency          string          `json:"currency"`
+	Description       string          `json:"description"`
+	FundingTransferID uuid.UUID       `json:"funding_transfer_id"`
+	ExpiresAt         time.Time       `json:"expires_at"`
+}
+
+func (q *Queries) CreateEscrow(ctx context.Context, arg CreateEscrowParams) (Escrow, error) {
+	row := q.db.QueryRow(ctx, createEscrow,
+		arg.Payer,
+		arg.Payee,
+		arg.FromAccountID,
+		arg.ToAccountID,
+		arg.EscrowAccountID,
+		arg.Amount,
+		arg.Currency,
+		arg.Description,
+		arg.FundingTransferID,
+		arg.ExpiresAt,
+	)
+	var i Escrow
+	err := row.Scan(
+		&i.ID,
+		&i.Payer,
+		&i.Payee,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.EscrowAccountID,
+		&i.Amount,
+		&i.Currency,
+		&i.Description,
+		&i.Status,
+		&i.PayerConfirmedAt,
+		&i.PayeeConfirmedAt,
+		&i.FundingTransferID,
+		&i.SettlementTransferID,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createEscrowAccount = `-- name: CreateEscrowAccount :exec
+INSERT INTO accounts(
+    "owner",
+    balance,
+    currency
+) VALUES (
+    'escrow', 0, $1
+) ON CONFLICT ("owner", currency, account_type) DO NOTHING
+`
+
+func (q *Queries) CreateEscrowAccount(ctx context.Context, currency string) error {
+	_, err := q.db.Exec(ctx, createEscrowAccount, currency)
+	return err
+}
+
+const createEscrowEvent = `-- name: CreateEscrowEvent :one
+INSERT INTO escrow_events(
+    escrow_id,
+    event,
+    from_status,
+    to_status,
+    actor,
+    reason
+) VALUES (
+    $1,$2,$3,$4,$5,$6
+) RETURNING id, escrow_id, event, from_status, to_status, actor, reason, created_at
+`
+
+type CreateEscrowEventParams struct {
+	EscrowID   uuid.UUID `json:"escrow_id"`
+	Event      string    `json:"event"`
+	FromStatus string    `json:"from_status"`
+	ToStatus   string    `json:"to_status"`
+	Actor      string    `json:"actor"`
+	Reason     string    `json:"reason"`
+}
+
+func (q *Queries) CreateEscrowEvent(ctx context.Context, arg CreateEscrowEventParams) (EscrowEvent, error) {
+	row := q.db.QueryRow(ctx, createEscrowEvent,
+		arg.EscrowID,
+		arg.Event,
+		arg.FromStatus,
+		arg.ToStatus,
+		arg.Actor,
+		arg.Reason,
+	)
+	var i EscrowEvent
+	err := row.Scan(
+		&i.ID,
+		&i.EscrowID,
+		&i.Event,
+		&i.FromStatus,
+		&i.ToStatus,
+		&i.Actor,
+		&i.Reason,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const finishEscrow = `-- name: FinishEscrow :one
+UPDATE escrows
+  set status = $2,
+      settlement_transfer_id = $3,
+      updated_at = now()
+WHERE id = $1
+RETURNING id, payer, payee, from_account_id, to_account_id, escrow_account_id, amount, currency, description, status, payer_confirmed_at, payee_confirmed_at, funding_transfer_id, settlement_transfer_id, expires_at, created_at, updated_at
+`
+
+type FinishEscrowParams struct {
+	ID                   uuid.UUID     `json:"id"`
+	Status               string        `json:"status"`
+	SettlementTransferID uuid.NullUUID `json:"settlement_transfer_id"`
+}
+
+func (q *Queries) FinishEscrow(ctx context.Context, arg FinishEscrowParams) (Escrow, error) {
+	row := q.db.QueryRow(ctx, finishEscrow, arg.ID, arg.Status, arg.SettlementTransferID)
+	var i Escrow
+	err := row.Scan(
+		&i.ID,
+		&i.Payer,
+		&i.Payee,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.EscrowAccountID,
+		&i.Amount,
+		&i.Currency,
+		&i.Description,
+		&i.Status,
+		&i.PayerConfirmedAt,
+		&i.PayeeConfirmedAt,
+		&i.FundingTransferID,
+		&i.SettlementTransferID,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getEscrow = `-- name: GetEscrow :one
+SELECT id, payer, payee, from_account_id, to_account_id, escrow_account_id, amount, currency, description, status, payer_confirmed_at, payee_confirmed_at, funding_transfer_id, settlement_transfer_id, expires_at, created_at, updated_at FROM escrows
+WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetEscrow(ctx context.Context, id uuid.UUID) (Escrow, error) {
+	row := q.db.QueryRow(ctx, getEscrow, id)
+	var i Escrow
+	err := row.Scan(
+		&i.ID,
+		&i.Payer,
+		&i.Payee,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.EscrowAccountID,
+		&i.Amount,
+		&i.Currency,
+		&i.Description,
+		&i.Status,
+		&i.PayerConfirmedAt,
+		&i.PayeeConfirmedAt,
+		&i.FundingTransferID,
+		&i.SettlementTransferID,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getEscrowAccount = `-- name: GetEscrowAccount :one
+SELECT id, owner, balance, currency, created_at, updated_at, available_balance, overdraft_limit, overdraft_interest_rate, account_type, interest_product_id, organisation_id FROM accounts
+WHERE "owner" = 'escrow' AND currency = $1
+LIMIT 1
+`
+
+func (q *Queries) GetEscrowAccount(ctx context.Context, currency string) (Account, error) {
+	row := q.db.QueryRow(ctx, getEscrowAccount, currency)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Balance,
+		&i.Currency,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.AvailableBalance,
+		&i.OverdraftLimit,
+		&i.OverdraftInterestRate,
+		&i.AccountType,
+		&i.InterestProductID,
+		&i.OrganisationID,
+	)
+	return i, err
+}
+
+const getEscrowForUpdate = `-- name: GetEscrowForUpdate :one
+SELECT id, payer, payee, from_account_id, to_account_id, escrow_account_id, amount, currency, description, status, payer_confirmed_at, payee_confirmed_at, funding_transfer_id, settlement_transfer_id, expires_at, created_at, updated_at FROM escrows
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetEscrowForUpdate(ctx context.Context, id uuid.UUID) (Escrow, error) {
+	row := q.db.QueryRow(ctx, getEscrowForUpdate, id)
+	var i Escrow
+	err := row.Scan(
+		&i.ID,
+		&i.Payer,
+		&i.Payee,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.EscrowAccountID,
+		&i.Amount,
+		&i.Currency,
+		&i.Description,
+		&i.Status,
+		&i.PayerConfirmedAt,
+		&i.PayeeConfirmedAt,
+		&i.FundingTransferID,
+		&i.SettlementTransferID,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listEscrowEvents = `-- name: ListEscrowEvents :many
+SELECT id, escrow_id, event, from_status, to_status, actor, reason, created_at FROM escrow_events
+WHERE escrow_id = $1
+ORDER BY id
+`
+
+func (q *Queries) ListEscrowEvents(ctx context.Context, escrowID uuid.UUID) ([]EscrowEvent, error) {
+	rows, err := q.db.Query(ctx, listEscrowEvents, escrowID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []EscrowEvent{}
+	for rows.Next() {
+		var i EscrowEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.EscrowID,
+			&i.Event,
+			&i.FromStatus,
+			&i.ToStatus,
+			&i.Actor,
+			&i.Reason,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEscrows = `-- name: ListEscrows :many
+SELECT id, payer, payee, from_account_id, to_account_id, escrow_account_id, amount, currency, description, status, payer_confirmed_at, payee_confirmed_at, funding_transfer_id, settlement_transfer_id, expires_at, created_at, updated_at FROM escrows
+WHERE payer = $1 OR payee = $1
+ORDER BY created_at DESC
+LIMIT $3
+OFFSET $2
+`
+
+type ListEscrowsParams struct {
+	Username string `json:"username"`
+	Offset   int32  `json:"offset"`
+	Limit    int32  `json:"limit"`
+}
+
+// the escrows a user pays or is paid by, newest first
+func (q *Queries) ListEscrows(ctx context.Context, arg ListEscrowsParams) ([]Escrow, error) {
+	rows, err := q.db.Query(ctx, listEscrows, arg.Username, arg.Offset, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Escrow{}
+	for rows.Next() {
+		var i Escrow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Payer,
+			&i.Payee,
+			&i.FromAccountID,
+			&i.ToAccountID,
+			&i.EscrowAccountID,
+			&i.Amount,
+			&i.Currency,
+			&i.Description,
+			&i.Status,
+			&i.PayerConfirmedAt,
+			&i.PayeeConfirmedAt,
+			&i.FundingTransferID,
+			&i.SettlementTransferID,
+			&i.ExpiresAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listExpiredEscrows = `-- name: ListExpiredEscrows :many
+SELECT id FROM escrows
+WHERE status = 'funded' AND expires_at <= $1
+ORDER BY expires_at
+LIMIT $2
+`
+
+type ListExpiredEscrowsParams struct {
+	ExpiresAt time.Time `json:"expires_at"`
+	Limit     int32     `json:"limit"`
+}
+
+func (q *Queries) ListExpiredEscrows(ctx context.Context, arg ListExpiredEscrowsParams) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, listExpiredEscrows, arg.ExpiresAt, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []uuid.UUID{}
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,113 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Glenn444/banking-app/util"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransferTx_ChargesVersionedFee(t *testing.T) {
+	store := NewStore(testDB)
+	ctx := context.Background()
+
+	// the business tier keeps these rules away from the other tests' transfers
+	user := CreateRandomUser(t)
+	_, err := testQueries.UpdateUserTier(ctx, UpdateUserTierParams{Username: user.Username, Tier: util.BusinessTier})
+	require.NoError(t, err)
+
+	from := createAccountInCurrency(t, user, "USD", decimal.NewFromInt(1000))
+	to := createAccountInCurrency(t, CreateRandomUser(t), "USD", decimal.Zero)
+	feesAccount, err := testQueries.GetFeesAccount(ctx, "USD")
+	require.NoError(t, err)
+
+	v1, err := store.CreateFeeScheduleTx(ctx, CreateFeeScheduleTxParams{
+		Currency:  "USD",
+		CreatedBy: user.Username,
+		Rules: []FeeRuleParams{{
+			Tier:       util.BusinessTier,
+			FlatFee:    decimal.NewFromInt(1),
+			Percentage: decimal.RequireFromString("0.01"),
+		}},
+	})
+	require.NoError(t, err)
+	require.Len(t, v1.Rules, 1)
+
+	first, err := store.TransferTx(ctx, TransferTxParams{
+		FromAccountID: from.ID,
+		ToAccountID:   to.ID,
+		Amount:        decimal.NewFromInt(100),
+	})
+	require.NoError(t, err)
+	require.True(t, decimal.NewFromInt(2).Equal(first.Fee))
+	require.NotNil(t, first.FeeEntry)
+	require.True(t, decimal.NewFromInt(-2).Equal(first.FeeEntry.Amount))
+	require.True(t, decimal.NewFromInt(898).Equal(first.FromAccount.Balance))
+	require.True(t, decimal.NewFromInt(100).Equal(first.ToAccount.Balance))
+	require.Equal(t, uuid.NullUUID{UUID: v1.Rules[0].ID, Valid: true}, first.Transfer.FeeRuleID)
+
+	v2, err := store.CreateFeeScheduleTx(ctx, CreateFeeScheduleTxParams{
+		Currency:  "USD",
+		CreatedBy: user.Username,
+		Rules: []FeeRuleParams{{
+			Tier:    util.BusinessTier,
+			FlatFee: decimal.NewFromInt(5),
+		}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, v1.Schedule.Version+1, v2.Schedule.Version)
+
+	second, err := store.TransferTx(ctx, TransferTxParams{
+		FromAccountID: from.ID,
+		ToAccountID:   to.ID,
+		Amount:        decimal.NewFromInt(100),
+	})
+	require.NoError(t, err)
+	require.True(t, decimal.NewFromInt(5).Equal(second.Fee))
+	require.Equal(t, v2.Rules[0].ID, second.Transfer.FeeRuleID.UUID)
+
+	// the first transfer still points at the rule it paid
+	transfer, err := testQueries.GetTransfer(ctx, first.Transfer.ID)
+	require.NoError(t, err)
+	require.True(t, decimal.NewFromInt(2).Equal(transfer.Fee))
+	require.Equal(t, v1.Rules[0].ID, transfer.FeeRuleID.UUID)
+
+	updatedFees, err := testQueries.GetAccount(ctx, feesAccount.ID)
+	require.NoError(t, err)
+	require.True(t, feesAccount.Balance.Add(decimal.NewFromInt(7)).Equal(updatedFees.Balance))
+
+	// 793 is left, enough for the amount but not for the fee on top
+	_, err = store.TransferTx(ctx, TransferTxParams{
+		FromAccountID: from.ID,
+		ToAccountID:   to.ID,
+		Amount:        decimal.NewFromInt(789),
+	})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+}
+
+func TestFeeRule_Fee(t *testing.T) {
+	rule := FeeRule{
+		FlatFee:    decimal.RequireFromString("0.30"),
+		Percentage: decimal.RequireFromString("0.029"),
+		MinFee:     decimal.NewFromInt(1),
+		MaxFee:     decimal.NewNullDecimal(decimal.NewFromInt(10)),
+	}
+
+	testCases := []struct {
+		amount string
+		fee    string
+	}{
+		{"10", "1"},       // 0.59 is raised to the minimum
+		{"100", "3.2"},    // 0.30 + 2.90
+		{"12.34", "1"},    // 0.6579 is raised to the minimum
+		{"55.55", "1.91"}, // 1.91095 rounds to cents
+		{"1000", "10"},    // 29.30 is capped
+	}
+	for _, tc := range testCases {
+		fee := rule.Fee(decimal.RequireFromString(tc.amount), 2)
+		require.True(t, decimal.RequireFromString(tc.fee).Equal(fee), "amount %s: got fee %v", tc.amount, fee)
+	}
+}
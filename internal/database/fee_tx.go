@@ -0,0 +1,180 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Glenn444/banking-app/util"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Fee prices a transfer of amount with the rule: the flat fee plus the
+// percentage of the amount, raised to the minimum fee and capped at the
+// maximum fee, rounded to the currency's minor units
+func (r FeeRule) Fee(amount decimal.Decimal, minorUnits int32) decimal.Decimal {
+	fee := r.FlatFee.Add(amount.Mul(r.Percentage))
+	if fee.LessThan(r.MinFee) {
+		fee = r.MinFee
+	}
+	if r.MaxFee.Valid && fee.GreaterThan(r.MaxFee.Decimal) {
+		fee = r.MaxFee.Decimal
+	}
+	return fee.Round(minorUnits)
+}
+
+// FeeQuote is what a transfer from an account would be charged, Rule is nil
+// when no rule of the current fee schedule covers the transfer
+type FeeQuote struct {
+	Amount   decimal.Decimal `json:"amount"`
+	Fee      decimal.Decimal `json:"fee"`
+	Total    decimal.Decimal `json:"total"`
+	Currency string          `json:"currency"`
+	Rule     *FeeRule        `json:"rule"`
+}
+
+// QuoteTransferFee prices a transfer of amount from account with the rule
+// of the currency's current fee schedule for the owner's tier
+func QuoteTransferFee(ctx context.Context, q Querier, account Account, amount decimal.Decimal) (FeeQuote, error) {
+	quote := FeeQuote{
+		Amount:   amount,
+		Fee:      decimal.Zero,
+		Total:    amount,
+		Currency: account.Currency,
+	}
+
+	owner, err := q.GetUser(ctx, account.Owner)
+	if err != nil {
+		return FeeQuote{}, err
+	}
+
+	rule, err := q.GetApplicableFeeRule(ctx, GetApplicableFeeRuleParams{
+		Currency: account.Currency,
+		Tier:     owner.Tier,
+		Amount:   amount,
+	})
+	if errors.Is(err, ErrNotFound) {
+		return quote, nil
+	}
+	if err != nil {
+		return FeeQuote{}, err
+	}
+
+	currency, ok := util.LookupCurrency(account.Currency)
+	if !ok {
+		return FeeQuote{}, fmt.Errorf("account %v has unknown currency %s", account.ID, account.Currency)
+	}
+
+	quote.Fee = rule.Fee(amount, currency.MinorUnits)
+	quote.Total = amount.Add(quote.Fee)
+	quote.Rule = &rule
+	return quote, nil
+}
+
+// FeeRuleParams is one rule of a new fee schedule
+type FeeRuleParams struct {
+	Tier       string              `json:"tier"`
+	MinAmount  decimal.Decimal     `json:"min_amount"`
+	MaxAmount  decimal.NullDecimal `json:"max_amount"`
+	FlatFee    decimal.Decimal     `json:"flat_fee"`
+	Percentage decimal.Decimal     `json:"percentage"`
+	MinFee     decimal.Decimal     `json:"min_fee"`
+	MaxFee     decimal.NullDecimal `json:"max_fee"`
+}
+
+type CreateFeeScheduleTxParams struct {
+	Currency  string          `json:"currency"`
+	CreatedBy string          `json:"created_by"`
+	Rules     []FeeRuleParams `json:"rules"`
+}
+
+// FeeScheduleResult is a fee schedule version with its rules
+type FeeScheduleResult struct {
+	Schedule FeeSchedule `json:"schedule"`
+	Rules    []FeeRule   `json:"rules"`
+}
+
+// CreateFeeScheduleTx saves a currency's fees as its next fee schedule
+// version, which prices every transfer from then on. Earlier versions are
+// left as they were so past transfers still point at the rule they paid.
+func (store *SQLStore) CreateFeeScheduleTx(ctx context.Context, arg CreateFeeScheduleTxParams) (FeeScheduleResult, error) {
+	var result FeeScheduleResult
+
+	err := store.execTx(ctx, nil, func(q *Queries) error {
+		// a retried transaction starts the rules over
+		result.Rules = nil
+
+		var err error
+		result.Schedule, err = q.CreateFeeSchedule(ctx, CreateFeeScheduleParams{
+			Currency:  arg.Currency,
+			CreatedBy: arg.CreatedBy,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Rules = make([]FeeRule, 0, len(arg.Rules))
+		for _, rule := range arg.Rules {
+			created, err := q.CreateFeeRule(ctx, CreateFeeRuleParams{
+				ScheduleID: result.Schedule.ID,
+				Tier:       rule.Tier,
+				MinAmount:  rule.MinAmount,
+				MaxAmount:  rule.MaxAmount,
+				FlatFee:    rule.FlatFee,
+				Percentage: rule.Percentage,
+				MinFee:     rule.MinFee,
+				MaxFee:     rule.MaxFee,
+			})
+			if err != nil {
+				return err
+			}
+			result.Rules = append(result.Rules, created)
+		}
+		return nil
+	})
+	if err != nil {
+		return FeeScheduleResult{}, err
+	}
+	return result, nil
+}
+
+// chargeTransferFee records the quoted fee on the transfer and moves it from
+// the sender to the currency's fees account, it returns the sender's fee
+// entry, or nil when there was nothing to charge
+func chargeTransferFee(ctx context.Context, q *Queries, transfer *Transfer, quote FeeQuote) (*Entry, error) {
+	if quote.Rule == nil {
+		return nil, nil
+	}
+
+	var err error
+	*transfer, err = q.SetTransferFee(ctx, SetTransferFeeParams{
+		ID:        transfer.ID,
+		Fee:       quote.Fee,
+		FeeRuleID: uuid.NullUUID{UUID: quote.Rule.ID, Valid: true},
+	})
+	if err != nil || quote.Fee.IsZero() {
+		return nil, err
+	}
+
+	feesAccount, err := q.GetFeesAccount(ctx, quote.Currency)
+	if err != nil {
+		return nil, fmt.Errorf("no fees account for currency %s: %w", quote.Currency, err)
+	}
+
+	// the sender is already locked by the transfer, the fees account is
+	// always locked after the accounts of the transfer
+	accounts, err := lockAccounts(ctx, q, transfer.FromAccountID, feesAccount.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := postEntry(ctx, q, accounts[transfer.FromAccountID], quote.Fee.Neg())
+	if err != nil {
+		return nil, err
+	}
+	if _, err = postEntry(ctx, q, accounts[feesAccount.ID], quote.Fee); err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
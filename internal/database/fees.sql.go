@@ -0,0 +1,369 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: fees.sql
+
+package database
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+const createFeeRule = `-- name: CreateFeeRule :one
+INSERT INTO fee_rules(
+    schedule_id,
+    tier,
+    min_amount,
+    max_amount,
+    flat_fee,
+    percentage,
+    min_fee,
+    max_fee
+) VALUES (
+    $1,$2,$3,$4,$5,$6,$7,$8
+) RETURNING id, schedule_id, tier, min_amount, max_amount, flat_fee, percentage, min_fee, max_fee
+`
+
+type CreateFeeRuleParams struct {
+	ScheduleID uuid.UUID           `json:"schedule_id"`
+	Tier       string              `json:"tier"`
+	MinAmount  decimal.Decimal     `json:"min_amount"`
+	MaxAmount  decimal.NullDecimal `json:"max_amount"`
+	FlatFee    decimal.Decimal     `json:"flat_fee"`
+	Percentage decimal.Decimal     `json:"percentage"`
+	MinFee     decimal.Decimal     `json:"min_fee"`
+	MaxFee     decimal.NullDecimal `json:"max_fee"`
+}
+
+func (q *Queries) CreateFeeRule(ctx context.Context, arg CreateFeeRuleParams) (FeeRule, error) {
+	row := q.db.QueryRow(ctx, createFeeRule,
+		arg.ScheduleID,
+		arg.Tier,
+		arg.MinAmount,
+		arg.MaxAmount,
+		arg.FlatFee,
+		arg.Percentage,
+		arg.MinFee,
+		arg.MaxFee,
+	)
+	var i FeeRule
+	err := row.Scan(
+		&i.ID,
+		&i.ScheduleID,
+		&i.Tier,
+		&i.MinAmount,
+		&i.MaxAmount,
+		&i.FlatFee,
+		&i.Percentage,
+		&i.MinFee,
+		&i.MaxFee,
+	)
+	return i, err
+}
+
+const createFeeSchedule = `-- name: CreateFeeSchedule :one
+INSERT INTO fee_schedules(
+    currency,
+    version,
+    created_by
+) VALUES (
+    $1,
+    (SELECT COALESCE(MAX(version), 0) + 1 FROM fee_schedules WHERE currency = $1),
+    $2
+) RETURNING id, currency, version, created_by, created_at
+`
+
+type CreateFeeScheduleParams struct {
+	Currency  string `json:"currency"`
+	CreatedBy string `json:"created_by"`
+}
+
+// adds the currency's next fee schedule version, two admins saving at once
+// collide on fee_schedule_version_unique
+func (q *Queries) CreateFeeSchedule(ctx context.Context, arg CreateFeeScheduleParams) (FeeSchedule, error) {
+	row := q.db.QueryRow(ctx, createFeeSchedule, arg.Currency, arg.CreatedBy)
+	var i FeeSchedule
+	err := row.Scan(
+		&i.ID,
+		&i.Currency,
+		&i.Version,
+		&i.CreatedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createFeesAccount = `-- name: CreateFeesAccount :exec
+INSERT INTO accounts(
+    "owner",
+    balance,
+    currency
+) VALUES (
+    'fees', 0, $1
+) ON CONFLICT ("owner", currency, account_type) DO NOTHING
+`
+
+func (q *Queries) CreateFeesAccount(ctx context.Context, currency string) error {
+	_, err := q.db.Exec(ctx, createFeesAccount, currency)
+	return err
+}
+
+const getApplicableFeeRule = `-- name: GetApplicableFeeRule :one
+SELECT r.id, r.schedule_id, r.tier, r.min_amount, r.max_amount, r.flat_fee, r.percentage, r.min_fee, r.max_fee FROM fee_rules r
+WHERE r.schedule_id = (
+    SELECT s.id FROM fee_schedules s
+    WHERE s.currency = $1
+    ORDER BY s.version DESC
+    LIMIT 1
+  )
+  AND r.tier = $2
+  AND r.min_amount <= $3
+  AND (r.max_amount IS NULL OR $3 < r.max_amount)
+LIMIT 1
+`
+
+type GetApplicableFeeRuleParams struct {
+	Currency string          `json:"currency"`
+	Tier     string          `json:"tier"`
+	Amount   decimal.Decimal `json:"amount"`
+}
+
+// the rule of the currency's current schedule whose band holds the amount
+func (q *Queries) GetApplicableFeeRule(ctx context.Context, arg GetApplicableFeeRuleParams) (FeeRule, error) {
+	row := q.db.QueryRow(ctx, getApplicableFeeRule, arg.Currency, arg.Tier, arg.Amount)
+	var i FeeRule
+	err := row.Scan(
+		&i.ID,
+		&i.ScheduleID,
+		&i.Tier,
+		&i.MinAmount,
+		&i.MaxAmount,
+		&i.FlatFee,
+		&i.Percentage,
+		&i.MinFee,
+		&i.MaxFee,
+	)
+	return i, err
+}
+
+const getCurrentFeeSchedule = `-- name: GetCurrentFeeSchedule :one
+SELECT id, currency, version, created_by, created_at FROM fee_schedules
+WHERE currency = $1
+ORDER BY version DESC
+LIMIT 1
+`
+
+func (q *Queries) GetCurrentFeeSchedule(ctx context.Context, currency string) (FeeSchedule, error) {
+	row := q.db.QueryRow(ctx, getCurrentFeeSchedule, currency)
+	var i FeeSchedule
+	err := row.Scan(
+		&i.ID,
+		&i.Currency,
+		&i.Version,
+		&i.CreatedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getFeeScheduleByVersion = `-- name: GetFeeScheduleByVersion :one
+SELECT id, currency, version, created_by, created_at FROM fee_schedules
+WHERE currency = $1 AND version = $2
+LIMIT 1
+`
+
+type GetFeeScheduleByVersionParams struct {
+	Currency string `json:"currency"`
+	Version  int32  `json:"version"`
+}
+
+func (q *Queries) GetFeeScheduleByVersion(ctx context.Context, arg GetFeeScheduleByVersionParams) (FeeSchedule, error) {
+	row := q.db.QueryRow(ctx, getFeeScheduleByVersion, arg.Currency, arg.Version)
+	var i FeeSchedule
+	err := row.Scan(
+		&i.ID,
+		&i.Currency,
+		&i.Version,
+		&i.CreatedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getFeesAccount = `-- name: GetFeesAccount :one
+SELECT id, owner, balance, currency, created_at, updated_at, available_balance, overdraft_limit, overdraft_interest_rate, account_type, interest_product_id, organisation_id FROM accounts
+WHERE "owner" = 'fees' AND currency = $1
+LIMIT 1
+`
+
+func (q *Queries) GetFeesAccount(ctx context.Context, currency string) (Account, error) {
+	row := q.db.QueryRow(ctx, getFeesAccount, currency)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Balance,
+		&i.Currency,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.AvailableBalance,
+		&i.OverdraftLimit,
+		&i.OverdraftInterestRate,
+		&i.AccountType,
+		&i.InterestProductID,
+		&i.OrganisationID,
+	)
+	return i, err
+}
+
+const listFeeRules = `-- name: ListFeeRules :many
+SELECT id, schedule_id, tier, min_amount, max_amount, flat_fee, percentage, min_fee, max_fee FROM fee_rules
+WHERE schedule_id = $1
+ORDER BY tier, min_amount
+`
+
+func (q *Queries) ListFeeRules(ctx context.Context, scheduleID uuid.UUID) ([]FeeRule, error) {
+	rows, err := q.db.Query(ctx, listFeeRules, scheduleID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FeeRule{}
+	for rows.Next() {
+		var i FeeRule
+		if err := rows.Scan(
+			&i.ID,
+			&i.ScheduleID,
+			&i.Tier,
+			&i.MinAmount,
+			&i.MaxAmount,
+			&i.FlatFee,
+			&i.Percentage,
+			&i.MinFee,
+			&i.MaxFee,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listFeeSchedules = `-- name: ListFeeSchedules :many
+SELECT id, currency, version, created_by, created_at FROM fee_schedules
+WHERE currency = $1
+ORDER BY version DESC
+LIMIT $2
+OFFSET $3
+`
+
+type ListFeeSchedulesParams struct {
+	Currency string `json:"currency"`
+	Limit    int32  `json:"limit"`
+	Offset   int32  `json:"offset"`
+}
+
+func (q *Queries) ListFeeSchedules(ctx context.Context, arg ListFeeSchedulesParams) ([]FeeSchedule, error) {
+	rows, err := q.db.Query(ctx, listFeeSchedules, arg.Currency, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FeeSchedule{}
+	for rows.Next() {
+		var i FeeSchedule
+		if err := rows.Scan(
+			&i.ID,
+			&i.Currency,
+			&i.Version,
+			&i.CreatedBy,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setTransferFee = `-- name: SetTransferFee :one
+UPDATE transfers
+  set fee = $2,
+      fee_rule_id = $3
+WHERE id = $1
+RETURNING id, from_account_id, to_account_id, amount, created_at, updated_at, fx_quote_id, converted_amount, fee, fee_rule_id, description, external_reference, metadata, status, failure_reason, processing_at, completed_at, failed_at, reversed_at, reversal_transfer_id
+`
+
+type SetTransferFeeParams struct {
+	ID        uuid.UUID       `json:"id"`
+	Fee       decimal.Decimal `json:"fee"`
+	FeeRuleID uuid.NullUUID   `json:"fee_rule_id"`
+}
+
+func (q *Queries) SetTransferFee(ctx context.Context, arg SetTransferFeeParams) (Transfer, error) {
+	row := q.db.QueryRow(ctx, setTransferFee, arg.ID, arg.Fee, arg.FeeRuleID)
+	var i Transfer
+	err := row.Scan(
+		&i.ID,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.FxQuoteID,
+		&i.ConvertedAmount,
+		&i.Fee,
+		&i.FeeRuleID,
+		&i.Description,
+		&i.ExternalReference,
+		&i.Metadata,
+		&i.Status,
+		&i.FailureReason,
+		&i.ProcessingAt,
+		&i.CompletedAt,
+		&i.FailedAt,
+		&i.ReversedAt,
+		&i.ReversalTransferID,
+	)
+	return i, err
+}
+
+const updateUserTier = `-- name: UpdateUserTier :one
+UPDATE users
+  set tier = $2
+WHERE username = $1
+RETURNING username, hashed_password, full_name, email, password_changed_at, created_at, refresh_token, role, tier, alias, email_verified_at
+`
+
+type UpdateUserTierParams struct {
+	Username string `json:"username"`
+	Tier     string `json:"tier"`
+}
+
+func (q *Queries) UpdateUserTier(ctx context.Context, arg UpdateUserTierParams) (User, error) {
+	row := q.db.QueryRow(ctx, updateUserTier, arg.Username, arg.Tier)
+	var i User
+	err := row.Scan(
+		&i.Username,
+		&i.HashedPassword,
+		&i.FullName,
+		&i.Email,
+		&i.PasswordChangedAt,
+		&i.CreatedAt,
+		&i.RefreshToken,
+		&i.Role,
+		&i.Tier,
+		&i.Alias,
+		&i.EmailVerifiedAt,
+	)
+	return i, err
+}
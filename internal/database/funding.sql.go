@@ -0,0 +1,268 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: funding.sql
+
+package database
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+)
+
+const createFundingTransaction = `-- name: CreateFundingTransaction :one
+INSERT INTO funding_transactions(
+    account_id,
+    settlement_account_id,
+    kind,
+    amount,
+    currency,
+    provider
+) VALUES (
+    $1,$2,$3,$4,$5,$6
+) RETURNING id, account_id, settlement_account_id, kind, amount, currency, status, provider, provider_reference, failure_reason, settled_at, created_at, updated_at
+`
+
+type CreateFundingTransactionParams struct {
+	AccountID           uuid.UUID       `json:"account_id"`
+	SettlementAccountID uuid.UUID       `json:"settlement_account_id"`
+	Kind                string          `json:"kind"`
+	Amount              decimal.Decimal `json:"amount"`
+	Currency            string          `json:"currency"`
+	Provider            string          `json:"provider"`
+}
+
+func (q *Queries) CreateFundingTransaction(ctx context.Context, arg CreateFundingTransactionParams) (FundingTransaction, error) {
+	row := q.db.QueryRow(ctx, createFundingTransaction,
+		arg.AccountID,
+		arg.SettlementAccountID,
+		arg.Kind,
+		arg.Amount,
+		arg.Currency,
+		arg.Provider,
+	)
+	var i FundingTransaction
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.SettlementAccountID,
+		&i.Kind,
+		&i.Amount,
+		&i.Currency,
+		&i.Status,
+		&i.Provider,
+		&i.ProviderReference,
+		&i.FailureReason,
+		&i.SettledAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getFundingTransaction = `-- name: GetFundingTransaction :one
+SELECT id, account_id, settlement_account_id, kind, amount, currency, status, provider, provider_reference, failure_reason, settled_at, created_at, updated_at FROM funding_transactions
+WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetFundingTransaction(ctx context.Context, id uuid.UUID) (FundingTransaction, error) {
+	row := q.db.QueryRow(ctx, getFundingTransaction, id)
+	var i FundingTransaction
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.SettlementAccountID,
+		&i.Kind,
+		&i.Amount,
+		&i.Currency,
+		&i.Status,
+		&i.Provider,
+		&i.ProviderReference,
+		&i.FailureReason,
+		&i.SettledAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getFundingTransactionForUpdate = `-- name: GetFundingTransactionForUpdate :one
+SELECT id, account_id, settlement_account_id, kind, amount, currency, status, provider, provider_reference, failure_reason, settled_at, created_at, updated_at FROM funding_transactions
+WHERE id = $1 LIMIT 1
+FOR UPDATE
+`
+
+func (q *Queries) GetFundingTransactionForUpdate(ctx context.Context, id uuid.UUID) (FundingTransaction, error) {
+	row := q.db.QueryRow(ctx, getFundingTransactionForUpdate, id)
+	var i FundingTransaction
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.SettlementAccountID,
+		&i.Kind,
+		&i.Amount,
+		&i.Currency,
+		&i.Status,
+		&i.Provider,
+		&i.ProviderReference,
+		&i.FailureReason,
+		&i.SettledAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getSettlementAccount = `-- name: GetSettlementAccount :one
+SELECT id, owner, balance, currency, created_at, updated_at, available_balance, overdraft_limit, overdraft_interest_rate, account_type, interest_product_id, organisation_id FROM accounts
+WHERE "owner" = 'settlement' AND currency = $1
+LIMIT 1
+`
+
+func (q *Queries) GetSettlementAccount(ctx context.Context, currency string) (Account, error) {
+	row := q.db.QueryRow(ctx, getSettlementAccount, currency)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Balance,
+		&i.Currency,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.AvailableBalance,
+		&i.OverdraftLimit,
+		&i.OverdraftInterestRate,
+		&i.AccountType,
+		&i.InterestProductID,
+		&i.OrganisationID,
+	)
+	return i, err
+}
+
+const listFundingTransactions = `-- name: ListFundingTransactions :many
+SELECT id, account_id, settlement_account_id, kind, amount, currency, status, provider, provider_reference, failure_reason, settled_at, created_at, updated_at FROM funding_transactions
+WHERE account_id = $1
+ORDER BY created_at DESC
+LIMIT $2
+OFFSET $3
+`
+
+type ListFundingTransactionsParams struct {
+	AccountID uuid.UUID `json:"account_id"`
+	Limit     int32     `json:"limit"`
+	Offset    int32     `json:"offset"`
+}
+
+func (q *Queries) ListFundingTransactions(ctx context.Context, arg ListFundingTransactionsParams) ([]FundingTransaction, error) {
+	rows, err := q.db.Query(ctx, listFundingTransactions, arg.AccountID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FundingTransaction{}
+	for rows.Next() {
+		var i FundingTransaction
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.SettlementAccountID,
+			&i.Kind,
+			&i.Amount,
+			&i.Currency,
+			&i.Status,
+			&i.Provider,
+			&i.ProviderReference,
+			&i.FailureReason,
+			&i.SettledAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setFundingProviderReference = `-- name: SetFundingProviderReference :one
+UPDATE funding_transactions
+  set provider_reference = $2,
+      updated_at = now()
+WHERE id = $1
+RETURNING id, account_id, settlement_account_id, kind, amount, currency, status, provider, provider_reference, failure_reason, settled_at, created_at, updated_at
+`
+
+type SetFundingProviderReferenceParams struct {
+	ID                uuid.UUID `json:"id"`
+	ProviderReference string    `json:"provider_reference"`
+}
+
+func (q *Queries) SetFundingProviderReference(ctx context.Context, arg SetFundingProviderReferenceParams) (FundingTransaction, error) {
+	row := q.db.QueryRow(ctx, setFundingProviderReference, arg.ID, arg.ProviderReference)
+	var i FundingTransaction
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.SettlementAccountID,
+		&i.Kind,
+		&i.Amount,
+		&i.Currency,
+		&i.Status,
+		&i.Provider,
+		&i.ProviderReference,
+		&i.FailureReason,
+		&i.SettledAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const updateFundingStatus = `-- name: UpdateFundingStatus :one
+UPDATE funding_transactions
+  set status = $2,
+      failure_reason = $3,
+      settled_at = $4,
+      updated_at = now()
+WHERE id = $1
+RETURNING id, account_id, settlement_account_id, kind, amount, currency, status, provider, provider_reference, failure_reason, settled_at, created_at, updated_at
+`
+
+type UpdateFundingStatusParams struct {
+	ID            uuid.UUID          `json:"id"`
+	Status        string             `json:"status"`
+	FailureReason string             `json:"failure_reason"`
+	SettledAt     pgtype.Timestamptz `json:"settled_at"`
+}
+
+func (q *Queries) UpdateFundingStatus(ctx context.Context, arg UpdateFundingStatusParams) (FundingTransaction, error) {
+	row := q.db.QueryRow(ctx, updateFundingStatus,
+		arg.ID,
+		arg.Status,
+		arg.FailureReason,
+		arg.SettledAt,
+	)
+	var i FundingTransaction
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.SettlementAccountID,
+		&i.Kind,
+		&i.Amount,
+		&i.Currency,
+		&i.Status,
+		&i.Provider,
+		&i.ProviderReference,
+		&i.FailureReason,
+		&i.SettledAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
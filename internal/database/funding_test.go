@@ -0,0 +1,81 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDepositSettles(t *testing.T) {
+	store := NewStore(testDB)
+	account := createRandomAccount(t)
+	amount := decimal.NewFromInt(25)
+
+	created, err := store.CreateFundingTx(context.Background(), FundingTxParams{
+		AccountID: account.ID,
+		Kind:      FundingKindDeposit,
+		Amount:    amount,
+		Currency:  account.Currency,
+		Provider:  "simulator",
+	})
+	require.NoError(t, err)
+	require.Equal(t, FundingStatusPending, created.Transaction.Status)
+	// deposits don't touch the balance until they settle
+	require.True(t, account.Balance.Equal(created.Account.Balance))
+
+	settled, err := store.SettleFundingTx(context.Background(), created.Transaction.ID)
+	require.NoError(t, err)
+	require.Equal(t, FundingStatusSettled, settled.Transaction.Status)
+	require.True(t, settled.Transaction.SettledAt.Valid)
+	require.True(t, account.Balance.Add(amount).Equal(settled.Account.Balance))
+
+	// settling twice is a no-op
+	again, err := store.SettleFundingTx(context.Background(), created.Transaction.ID)
+	require.NoError(t, err)
+	require.True(t, settled.Account.Balance.Equal(again.Account.Balance))
+
+	_, err = store.FailFundingTx(context.Background(), created.Transaction.ID, "too late")
+	require.ErrorIs(t, err, ErrFundingNotPending)
+}
+
+func TestWithdrawalFailureReleasesFunds(t *testing.T) {
+	store := NewStore(testDB)
+	account := createRandomAccount(t)
+	amount := account.Balance
+
+	created, err := store.CreateFundingTx(context.Background(), FundingTxParams{
+		AccountID: account.ID,
+		Kind:      FundingKindWithdrawal,
+		Amount:    amount,
+		Currency:  account.Currency,
+		Provider:  "simulator",
+	})
+	require.NoError(t, err)
+	require.True(t, created.Account.Balance.IsZero())
+
+	failed, err := store.FailFundingTx(context.Background(), created.Transaction.ID, "bank rejected")
+	require.NoError(t, err)
+	require.Equal(t, FundingStatusFailed, failed.Transaction.Status)
+	require.Equal(t, "bank rejected", failed.Transaction.FailureReason)
+	require.True(t, account.Balance.Equal(failed.Account.Balance))
+}
+
+func TestWithdrawalInsufficientFunds(t *testing.T) {
+	store := NewStore(testDB)
+	account := createRandomAccount(t)
+
+	_, err := store.CreateFundingTx(context.Background(), FundingTxParams{
+		AccountID: account.ID,
+		Kind:      FundingKindWithdrawal,
+		Amount:    account.Balance.Add(decimal.NewFromInt(1)),
+		Currency:  account.Currency,
+		Provider:  "simulator",
+	})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	unchanged, err := store.GetAccount(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.True(t, account.Balance.Equal(unchanged.Balance))
+}
@@ -0,0 +1,214 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Glenn444/banking-app/util"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	FundingKindDeposit    = "deposit"
+	FundingKindWithdrawal = "withdrawal"
+
+	FundingStatusPending = "pending"
+	FundingStatusSettled = "settled"
+	FundingStatusFailed  = "failed"
+)
+
+var ErrFundingNotPending = errors.New("funding transaction is no longer pending")
+
+type FundingTxParams struct {
+	AccountID uuid.UUID       `json:"account_id"`
+	Kind      string          `json:"kind"`
+	Amount    decimal.Decimal `json:"amount"`
+	Currency  string          `json:"currency"`
+	Provider  string          `json:"provider"`
+}
+
+// FundingTxResult is the result of a deposit or withdrawal step
+type FundingTxResult struct {
+	Transaction FundingTransaction `json:"transaction"`
+	Account     Account            `json:"account"`
+}
+
+// CreateFundingTx records a pending deposit or withdrawal.
+// Withdrawals reserve the funds straight away by moving them to the
+// settlement account, deposits only credit the account once settled.
+func (store *SQLStore) CreateFundingTx(ctx context.Context, arg FundingTxParams) (FundingTxResult, error) {
+	var result FundingTxResult
+
+	if !util.IsValidAmount(arg.Amount, arg.Currency) {
+		return FundingTxResult{}, fmt.Errorf("%w: %v %s", ErrInvalidAmountScale, arg.Amount, arg.Currency)
+	}
+
+	err := store.execTx(ctx, nil, func(q *Queries) error {
+		settlement, err := q.GetSettlementAccount(ctx, arg.Currency)
+		if err != nil {
+			return fmt.Errorf("no settlement account for currency %s: %w", arg.Currency, err)
+		}
+
+		result.Transaction, err = q.CreateFundingTransaction(ctx, CreateFundingTransactionParams{
+			AccountID:           arg.AccountID,
+			SettlementAccountID: settlement.ID,
+			Kind:                arg.Kind,
+			Amount:              arg.Amount,
+			Currency:            arg.Currency,
+			Provider:            arg.Provider,
+		})
+		if err != nil {
+			return err
+		}
+
+		if arg.Kind == FundingKindWithdrawal {
+			result.Account, _, err = moveMoney(ctx, q, arg.AccountID, settlement.ID, arg.Amount, true)
+			return err
+		}
+
+		result.Account, err = q.GetAccount(ctx, arg.AccountID)
+		return err
+	})
+	if err != nil {
+		return FundingTxResult{}, err
+	}
+	return result, nil
+}
+
+// SettleFundingTx completes a pending deposit or withdrawal.
+// Settling an already settled transaction is a no-op.
+func (store *SQLStore) SettleFundingTx(ctx context.Context, id uuid.UUID) (FundingTxResult, error) {
+	var result FundingTxResult
+
+	err := store.execTx(ctx, nil, func(q *Queries) error {
+		txn, err := q.GetFundingTransactionForUpdate(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		switch txn.Status {
+		case FundingStatusSettled:
+			result.Transaction = txn
+			result.Account, err = q.GetAccount(ctx, txn.AccountID)
+			return err
+		case FundingStatusFailed:
+			return ErrFundingNotPending
+		}
+
+		if txn.Kind == FundingKindDeposit {
+			_, result.Account, err = moveMoney(ctx, q, txn.SettlementAccountID, txn.AccountID, txn.Amount, false)
+		} else {
+			result.Account, err = q.GetAccount(ctx, txn.AccountID)
+		}
+		if err != nil {
+			return err
+		}
+
+		result.Transaction, err = q.UpdateFundingStatus(ctx, UpdateFundingStatusParams{
+			ID:        txn.ID,
+			Status:    FundingStatusSettled,
+			SettledAt: pgtype.Timestamptz{Time: time.Now(), Valid: true},
+		})
+		return err
+	})
+	if err != nil {
+		return FundingTxResult{}, err
+	}
+	return result, nil
+}
+
+// FailFundingTx marks a pending deposit or withdrawal as failed.
+// Funds reserved by a withdrawal are returned to the account.
+func (store *SQLStore) FailFundingTx(ctx context.Context, id uuid.UUID, reason string) (FundingTxResult, error) {
+	var result FundingTxResult
+
+	err := store.execTx(ctx, nil, func(q *Queries) error {
+		txn, err := q.GetFundingTransactionForUpdate(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		switch txn.Status {
+		case FundingStatusFailed:
+			result.Transaction = txn
+			result.Account, err = q.GetAccount(ctx, txn.AccountID)
+			return err
+		case FundingStatusSettled:
+			return ErrFundingNotPending
+		}
+
+		if txn.Kind == FundingKindWithdrawal {
+			_, result.Account, err = moveMoney(ctx, q, txn.SettlementAccountID, txn.AccountID, txn.Amount, false)
+		} else {
+			result.Account, err = q.GetAccount(ctx, txn.AccountID)
+		}
+		if err != nil {
+			return err
+		}
+
+		result.Transaction, err = q.UpdateFundingStatus(ctx, UpdateFundingStatusParams{
+			ID:            txn.ID,
+			Status:        FundingStatusFailed,
+			FailureReason: reason,
+		})
+		return err
+	})
+	if err != nil {
+		return FundingTxResult{}, err
+	}
+	return result, nil
+}
+
+// moveMoney posts a pair of entries and updates both balances.
+// Accounts are locked in the same order as TransferTx to avoid deadlocks.
+func moveMoney(ctx context.Context, q *Queries, fromID, toID uuid.UUID, amount decimal.Decimal, checkFunds bool) (fromAccount, toAccount Account, err error) {
+	if fromID.String() < toID.String() {
+		fromAccount, err = q.GetAccountByIdForUpdate(ctx, fromID)
+		if err != nil {
+			return
+		}
+		toAccount, err = q.GetAccountByIdForUpdate(ctx, toID)
+		if err != nil {
+			return
+		}
+	} else {
+		toAccount, err = q.GetAccountByIdForUpdate(ctx, toID)
+		if err != nil {
+			return
+		}
+		fromAccount, err = q.GetAccountByIdForUpdate(ctx, fromID)
+		if err != nil {
+			return
+		}
+	}
+
+	if checkFunds && fromAccount.SpendableBalance().LessThan(amount) {
+		err = fmt.Errorf("%w: account %v can spend %v, amount %v",
+			ErrInsufficientFunds, fromID, fromAccount.SpendableBalance(), amount)
+		return
+	}
+
+	_, err = q.CreateEntries(ctx, CreateEntriesParams{AccountID: fromID, Amount: amount.Neg()})
+	if err != nil {
+		return
+	}
+	_, err = q.CreateEntries(ctx, CreateEntriesParams{AccountID: toID, Amount: amount})
+	if err != nil {
+		return
+	}
+
+	fromAccount.Balance = fromAccount.Balance.Sub(amount)
+	fromAccount.AvailableBalance = fromAccount.AvailableBalance.Sub(amount)
+	err = q.UpdateAccount(ctx, UpdateAccountParams{ID: fromID, Balance: fromAccount.Balance})
+	if err != nil {
+		return
+	}
+	toAccount.Balance = toAccount.Balance.Add(amount)
+	toAccount.AvailableBalance = toAccount.AvailableBalance.Add(amount)
+	err = q.UpdateAccount(ctx, UpdateAccountParams{ID: toID, Balance: toAccount.Balance})
+	return
+}
@@ -0,0 +1,163 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: fx.sql
+
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+const createFxPositionAccount = `-- name: CreateFxPositionAccount :exec
+INSERT INTO accounts(
+    "owner",
+    balance,
+    currency
+) VALUES (
+    'fx_position', 0, $1
+) ON CONFLICT ("owner", currency, account_type) DO NOTHING
+`
+
+func (q *Queries) CreateFxPositionAccount(ctx context.Context, currency string) error {
+	_, err := q.db.Exec(ctx, createFxPositionAccount, currency)
+	return err
+}
+
+const createFxQuote = `-- name: CreateFxQuote :one
+INSERT INTO fx_quotes(
+    "owner",
+    from_currency,
+    to_currency,
+    rate,
+    from_amount,
+    to_amount,
+    expires_at
+) VALUES (
+    $1,$2,$3,$4,$5,$6,$7
+) RETURNING id, owner, from_currency, to_currency, rate, from_amount, to_amount, expires_at, used_at, created_at
+`
+
+type CreateFxQuoteParams struct {
+	Owner        string          `json:"owner"`
+	FromCurrency string          `json:"from_currency"`
+	ToCurrency   string          `json:"to_currency"`
+	Rate         decimal.Decimal `json:"rate"`
+	FromAmount   decimal.Decimal `json:"from_amount"`
+	ToAmount     decimal.Decimal `json:"to_amount"`
+	ExpiresAt    time.Time       `json:"expires_at"`
+}
+
+func (q *Queries) CreateFxQuote(ctx context.Context, arg CreateFxQuoteParams) (FxQuote, error) {
+	row := q.db.QueryRow(ctx, createFxQuote,
+		arg.Owner,
+		arg.FromCurrency,
+		arg.ToCurrency,
+		arg.Rate,
+		arg.FromAmount,
+		arg.ToAmount,
+		arg.ExpiresAt,
+	)
+	var i FxQuote
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.FromCurrency,
+		&i.ToCurrency,
+		&i.Rate,
+		&i.FromAmount,
+		&i.ToAmount,
+		&i.ExpiresAt,
+		&i.UsedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getFxPositionAccount = `-- name: GetFxPositionAccount :one
+SELECT id, owner, balance, currency, created_at, updated_at, available_balance, overdraft_limit, overdraft_interest_rate, account_type, interest_product_id, organisation_id FROM accounts
+WHERE "owner" = 'fx_position' AND currency = $1
+LIMIT 1
+`
+
+func (q *Queries) GetFxPositionAccount(ctx context.Context, currency string) (Account, error) {
+	row := q.db.QueryRow(ctx, getFxPositionAccount, currency)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Balance,
+		&i.Currency,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.AvailableBalance,
+		&i.OverdraftLimit,
+		&i.OverdraftInterestRate,
+		&i.AccountType,
+		&i.InterestProductID,
+		&i.OrganisationID,
+	)
+	return i, err
+}
+
+const getFxQuote = `-- name: GetFxQuote :one
+SELECT id, owner, from_currency, to_currency, rate, from_amount, to_amount, expires_at, used_at, created_at FROM fx_quotes
+WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetFxQuote(ctx context.Context, id uuid.UUID) (FxQuote, error) {
+	row := q.db.QueryRow(ctx, getFxQuote, id)
+	var i FxQuote
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.FromCurrency,
+		&i.ToCurrency,
+		&i.Rate,
+		&i.FromAmount,
+		&i.ToAmount,
+		&i.ExpiresAt,
+		&i.UsedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getFxQuoteForUpdate = `-- name: GetFxQuoteForUpdate :one
+SELECT id, owner, from_currency, to_currency, rate, from_amount, to_amount, expires_at, used_at, created_at FROM fx_quotes
+WHERE id = $1 LIMIT 1
+FOR UPDATE
+`
+
+func (q *Queries) GetFxQuoteForUpdate(ctx context.Context, id uuid.UUID) (FxQuote, error) {
+	row := q.db.QueryRow(ctx, getFxQuoteForUpdate, id)
+	var i FxQuote
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.FromCurrency,
+		&i.ToCurrency,
+		&i.Rate,
+		&i.FromAmount,
+		&i.ToAmount,
+		&i.ExpiresAt,
+		&i.UsedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const markFxQuoteUsed = `-- name: MarkFxQuoteUsed :exec
+UPDATE fx_quotes
+  set used_at = now()
+WHERE id = $1
+`
+
+func (q *Queries) MarkFxQuoteUsed(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, markFxQuoteUsed, id)
+	return err
+}
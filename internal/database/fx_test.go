@@ -0,0 +1,114 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func createAccountInCurrency(t *testing.T, user User, currency string, balance decimal.Decimal) Account {
+	t.Helper()
+	account, err := testQueries.CreateAccount(context.Background(), CreateAccountParams{
+		Owner:       user.Username,
+		Balance:     balance,
+		Currency:    currency,
+		AccountType: AccountTypeChecking,
+	})
+	require.NoError(t, err)
+	return account
+}
+
+func createRandomFxQuote(t *testing.T, owner string, expiresAt time.Time) FxQuote {
+	t.Helper()
+	quote, err := testQueries.CreateFxQuote(context.Background(), CreateFxQuoteParams{
+		Owner:        owner,
+		FromCurrency: "USD",
+		ToCurrency:   "EUR",
+		Rate:         decimal.RequireFromString("0.9"),
+		FromAmount:   decimal.NewFromInt(100),
+		ToAmount:     decimal.NewFromInt(90),
+		ExpiresAt:    expiresAt,
+	})
+	require.NoError(t, err)
+	return quote
+}
+
+func TestFxTransferTx(t *testing.T) {
+	store := NewStore(testDB)
+	sender := CreateRandomUser(t)
+	recipient := CreateRandomUser(t)
+
+	fromAccount := createAccountInCurrency(t, sender, "USD", decimal.NewFromInt(500))
+	toAccount := createAccountInCurrency(t, recipient, "EUR", decimal.NewFromInt(10))
+	quote := createRandomFxQuote(t, sender.Username, time.Now().Add(time.Minute))
+
+	usdPosition, err := store.GetFxPositionAccount(context.Background(), "USD")
+	require.NoError(t, err)
+
+	result, err := store.FxTransferTx(context.Background(), FxTransferTxParams{
+		FromAccountID: fromAccount.ID,
+		ToAccountID:   toAccount.ID,
+		QuoteID:       quote.ID,
+	})
+	require.NoError(t, err)
+
+	require.True(t, decimal.NewFromInt(100).Equal(result.Transfer.Amount))
+	require.True(t, result.Transfer.ConvertedAmount.Valid)
+	require.True(t, decimal.NewFromInt(90).Equal(result.Transfer.ConvertedAmount.Decimal))
+	require.Equal(t, quote.ID, result.Transfer.FxQuoteID.UUID)
+
+	require.True(t, decimal.NewFromInt(400).Equal(result.FromAccount.Balance))
+	require.True(t, decimal.NewFromInt(100).Equal(result.ToAccount.Balance))
+	require.True(t, decimal.NewFromInt(-100).Equal(result.FromEntry.Amount))
+	require.True(t, decimal.NewFromInt(90).Equal(result.ToEntry.Amount))
+
+	updatedPosition, err := store.GetAccount(context.Background(), usdPosition.ID)
+	require.NoError(t, err)
+	require.True(t, usdPosition.Balance.Add(decimal.NewFromInt(100)).Equal(updatedPosition.Balance))
+
+	// a quote can only be used once
+	_, err = store.FxTransferTx(context.Background(), FxTransferTxParams{
+		FromAccountID: fromAccount.ID,
+		ToAccountID:   toAccount.ID,
+		QuoteID:       quote.ID,
+	})
+	require.ErrorIs(t, err, ErrFxQuoteUsed)
+}
+
+func TestFxTransferTx_Expired(t *testing.T) {
+	store := NewStore(testDB)
+	sender := CreateRandomUser(t)
+	recipient := CreateRandomUser(t)
+
+	fromAccount := createAccountInCurrency(t, sender, "USD", decimal.NewFromInt(500))
+	toAccount := createAccountInCurrency(t, recipient, "EUR", decimal.Zero)
+	quote := createRandomFxQuote(t, sender.Username, time.Now().Add(-time.Second))
+
+	_, err := store.FxTransferTx(context.Background(), FxTransferTxParams{
+		FromAccountID: fromAccount.ID,
+		ToAccountID:   toAccount.ID,
+		QuoteID:       quote.ID,
+	})
+	require.ErrorIs(t, err, ErrFxQuoteExpired)
+}
+
+func TestFxTransferTx_Mismatch(t *testing.T) {
+	store := NewStore(testDB)
+	sender := CreateRandomUser(t)
+	recipient := CreateRandomUser(t)
+
+	fromAccount := createAccountInCurrency(t, sender, "USD", decimal.NewFromInt(500))
+	// the quote converts into EUR, not CAD
+	toAccount := createAccountInCurrency(t, recipient, "CAD", decimal.Zero)
+	quote := createRandomFxQuote(t, sender.Username, time.Now().Add(time.Minute))
+
+	_, err := store.FxTransferTx(context.Background(), FxTransferTxParams{
+		FromAccountID: fromAccount.ID,
+		ToAccountID:   toAccount.ID,
+		QuoteID:       quote.ID,
+	})
+	require.ErrorIs(t, err, ErrFxQuoteMismatch)
+}
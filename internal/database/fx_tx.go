@@ -0,0 +1,182 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	ErrFxQuoteExpired  = errors.New("fx quote has expired")
+	ErrFxQuoteUsed     = errors.New("fx quote has already been used")
+	ErrFxQuoteMismatch = errors.New("fx quote doesn't match the transfer")
+)
+
+type FxTransferTxParams struct {
+	FromAccountID uuid.UUID `json:"from_account_id"`
+	ToAccountID   uuid.UUID `json:"to_account_id"`
+	QuoteID       uuid.UUID `json:"quote_id"`
+	TransferDetails
+}
+
+// FxTransferTxResult is the result of a cross-currency transfer
+type FxTransferTxResult struct {
+	TransferTxResult
+	Quote FxQuote `json:"quote"`
+}
+
+// FxTransferTx performs a cross-currency transfer at the rate locked by a quote.
+// The sender is debited in the quote's from currency and the recipient credited
+// in its to currency, each leg is booked against that currency's FX position account.
+func (store *SQLStore) FxTransferTx(ctx context.Context, arg FxTransferTxParams) (FxTransferTxResult, error) {
+	var result FxTransferTxResult
+
+	err := store.execTx(ctx, nil, func(q *Queries) error {
+		var err error
+		result.Quote, err = q.GetFxQuoteForUpdate(ctx, arg.QuoteID)
+		if err != nil {
+			return err
+		}
+		quote := result.Quote
+
+		if quote.UsedAt.Valid {
+			return ErrFxQuoteUsed
+		}
+		if !time.Now().Before(quote.ExpiresAt) {
+			return ErrFxQuoteExpired
+		}
+
+		fromPosition, err := q.GetFxPositionAccount(ctx, quote.FromCurrency)
+		if err != nil {
+			return fmt.Errorf("no fx position account for currency %s: %w", quote.FromCurrency, err)
+		}
+		toPosition, err := q.GetFxPositionAccount(ctx, quote.ToCurrency)
+		if err != nil {
+			return fmt.Errorf("no fx position account for currency %s: %w", quote.ToCurrency, err)
+		}
+
+		accounts, err := lockAccounts(ctx, q, arg.FromAccountID, arg.ToAccountID, fromPosition.ID, toPosition.ID)
+		if err != nil {
+			return err
+		}
+		fromAccount := accounts[arg.FromAccountID]
+		toAccount := accounts[arg.ToAccountID]
+
+		if fromAccount.Owner != quote.Owner ||
+			fromAccount.Currency != quote.FromCurrency ||
+			toAccount.Currency != quote.ToCurrency {
+			return ErrFxQuoteMismatch
+		}
+
+		// the fee is charged in the sender's currency on what leaves the account
+		fee, err := QuoteTransferFee(ctx, q, *fromAccount, quote.FromAmount)
+		if err != nil {
+			return err
+		}
+		if fromAccount.SpendableBalance().LessThan(fee.Total) {
+			return fmt.Errorf("%w: account %v can spend %v, transfer amount %v, fee %v",
+				ErrInsufficientFunds, arg.FromAccountID, fromAccount.SpendableBalance(), quote.FromAmount, fee.Fee)
+		}
+		if err = checkTransferLimits(ctx, q, fromAccount, quote.FromAmount, uuid.Nil, time.Now()); err != nil {
+			return err
+		}
+
+		result.Transfer, err = q.CreateFxTransfer(ctx, CreateFxTransferParams{
+			FromAccountID:   arg.FromAccountID,
+			ToAccountID:     arg.ToAccountID,
+			Amount:          quote.FromAmount,
+			FxQuoteID:       uuid.NullUUID{UUID: quote.ID, Valid: true},
+			ConvertedAmount: decimal.NewNullDecimal(quote.ToAmount),
+		})
+		if err != nil {
+			return err
+		}
+		if err = saveTransferDetails(ctx, q, &result.Transfer, arg.TransferDetails); err != nil {
+			return err
+		}
+
+		result.FromEntry, err = postEntry(ctx, q, accounts[arg.FromAccountID], quote.FromAmount.Neg())
+		if err != nil {
+			return err
+		}
+		if _, err = postEntry(ctx, q, accounts[fromPosition.ID], quote.FromAmount); err != nil {
+			return err
+		}
+		if _, err = postEntry(ctx, q, accounts[toPosition.ID], quote.ToAmount.Neg()); err != nil {
+			return err
+		}
+		result.ToEntry, err = postEntry(ctx, q, accounts[arg.ToAccountID], quote.ToAmount)
+		if err != nil {
+			return err
+		}
+
+		result.Fee = fee.Fee
+		result.FeeEntry, err = chargeTransferFee(ctx, q, &result.Transfer, fee)
+		if err != nil {
+			return err
+		}
+
+		if err = q.MarkFxQuoteUsed(ctx, quote.ID); err != nil {
+			return err
+		}
+
+		// the fee was booked on a copy of the sender, read back what it left
+		result.FromAccount, err = q.GetAccount(ctx, arg.FromAccountID)
+		if err != nil {
+			return err
+		}
+		result.ToAccount = *accounts[arg.ToAccountID]
+		return nil
+	})
+	if err != nil {
+		return FxTransferTxResult{}, err
+	}
+	return result, nil
+}
+
+// lockAccounts locks every account FOR NO KEY UPDATE, always in ascending
+// id order like TransferTx, so concurrent transactions can't deadlock
+func lockAccounts(ctx context.Context, q *Queries, ids ...uuid.UUID) (map[uuid.UUID]*Account, error) {
+	sorted := make([]uuid.UUID, len(ids))
+	copy(sorted, ids)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].String() < sorted[j].String()
+	})
+
+	accounts := make(map[uuid.UUID]*Account, len(ids))
+	for _, id := range sorted {
+		if _, ok := accounts[id]; ok {
+			continue
+		}
+		account, err := q.GetAccountByIdForUpdate(ctx, id)
+		if err != nil {
+			return nil, accountNotFound(err)
+		}
+		accounts[id] = &account
+	}
+	return accounts, nil
+}
+
+// postEntry books an entry on a locked account and updates its balance
+func postEntry(ctx context.Context, q *Queries, account *Account, amount decimal.Decimal) (Entry, error) {
+	entry, err := q.CreateEntries(ctx, CreateEntriesParams{
+		AccountID: account.ID,
+		Amount:    amount,
+	})
+	if err != nil {
+		return Entry{}, err
+	}
+
+	account.Balance = account.Balance.Add(amount)
+	account.AvailableBalance = account.AvailableBalance.Add(amount)
+	err = q.UpdateAccount(ctx, UpdateAccountParams{
+		ID:      account.ID,
+		Balance: account.Balance,
+	})
+	return entry, err
+}
@@ -0,0 +1,49 @@
+package database
+
+import (
+	"context"
+	"time"
+)
+
+// PoolStats is a snapshot of the connection pool
+type PoolStats struct {
+	MaxConns             int32         `json:"max_conns"`
+	TotalConns           int32         `json:"total_conns"`
+	AcquiredConns        int32         `json:"acquired_conns"`
+	IdleConns            int32         `json:"idle_conns"`
+	ConstructingConns    int32         `json:"constructing_conns"`
+	AcquireCount         int64         `json:"acquire_count"`
+	EmptyAcquireCount    int64         `json:"empty_acquire_count"`
+	CanceledAcquireCount int64         `json:"canceled_acquire_count"`
+	AcquireDuration      time.Duration `json:"acquire_duration_ns"`
+}
+
+// Ping checks that the database can be reached
+func (store *SQLStore) Ping(ctx context.Context) error {
+	return store.db.Ping(ctx)
+}
+
+// SchemaVersion returns the latest migration goose has applied
+func (store *SQLStore) SchemaVersion(ctx context.Context) (int64, error) {
+	// goose_db_version belongs to goose rather than the schema, so sqlc
+	// doesn't know about it
+	var version int64
+	err := store.db.QueryRow(ctx, "SELECT COALESCE(MAX(version_id), 0) FROM goose_db_version WHERE is_applied").Scan(&version)
+	return version, err
+}
+
+// PoolStats returns the connection pool's current statistics
+func (store *SQLStore) PoolStats() PoolStats {
+	stat := store.db.Stat()
+	return PoolStats{
+		MaxConns:             stat.MaxConns(),
+		TotalConns:           stat.TotalConns(),
+		AcquiredConns:        stat.AcquiredConns(),
+		IdleConns:            stat.IdleConns(),
+		ConstructingConns:    stat.ConstructingConns(),
+		AcquireCount:         stat.AcquireCount(),
+		EmptyAcquireCount:    stat.EmptyAcquireCount(),
+		CanceledAcquireCount: stat.CanceledAcquireCount(),
+		AcquireDuration:      stat.AcquireDuration(),
+	}
+}
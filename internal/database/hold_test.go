@@ -0,0 +1,129 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomHold(t *testing.T, store Store, from, to Account, amount decimal.Decimal, expiresAt time.Time) Hold {
+	t.Helper()
+	result, err := store.CreateHoldTx(context.Background(), CreateHoldTxParams{
+		AccountID:   from.ID,
+		ToAccountID: to.ID,
+		Amount:      amount,
+		ExpiresAt:   expiresAt,
+	})
+	require.NoError(t, err)
+	require.Equal(t, HoldStatusActive, result.Hold.Status)
+	require.True(t, from.AvailableBalance.Sub(amount).Equal(result.Account.AvailableBalance))
+	require.True(t, from.Balance.Equal(result.Account.Balance))
+	return result.Hold
+}
+
+func TestCreateHoldTx_ReducesAvailableBalance(t *testing.T) {
+	store := NewStore(testDB)
+	payer := createAccountInCurrency(t, CreateRandomUser(t), "USD", decimal.NewFromInt(100))
+	merchant := createAccountInCurrency(t, CreateRandomUser(t), "USD", decimal.Zero)
+	other := createAccountInCurrency(t, CreateRandomUser(t), "USD", decimal.Zero)
+
+	createRandomHold(t, store, payer, merchant, decimal.NewFromInt(70), time.Now().Add(time.Hour))
+
+	// held funds can't be held again or transferred
+	_, err := store.CreateHoldTx(context.Background(), CreateHoldTxParams{
+		AccountID:   payer.ID,
+		ToAccountID: merchant.ID,
+		Amount:      decimal.NewFromInt(40),
+		ExpiresAt:   time.Now().Add(time.Hour),
+	})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	_, err = store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: payer.ID,
+		ToAccountID:   other.ID,
+		Amount:        decimal.NewFromInt(40),
+	})
+	require.Error(t, err)
+
+	result, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: payer.ID,
+		ToAccountID:   other.ID,
+		Amount:        decimal.NewFromInt(30),
+	})
+	require.NoError(t, err)
+	require.True(t, decimal.NewFromInt(70).Equal(result.FromAccount.Balance))
+	require.True(t, decimal.Zero.Equal(result.FromAccount.AvailableBalance))
+}
+
+func TestCaptureHoldTx_Partial(t *testing.T) {
+	store := NewStore(testDB)
+	payer := createAccountInCurrency(t, CreateRandomUser(t), "USD", decimal.NewFromInt(100))
+	merchant := createAccountInCurrency(t, CreateRandomUser(t), "USD", decimal.Zero)
+	hold := createRandomHold(t, store, payer, merchant, decimal.NewFromInt(60), time.Now().Add(time.Hour))
+
+	_, err := store.CaptureHoldTx(context.Background(), CaptureHoldTxParams{
+		HoldID: hold.ID,
+		Amount: decimal.NewFromInt(61),
+	})
+	require.ErrorIs(t, err, ErrCaptureExceedsHold)
+
+	result, err := store.CaptureHoldTx(context.Background(), CaptureHoldTxParams{
+		HoldID: hold.ID,
+		Amount: decimal.NewFromInt(45),
+	})
+	require.NoError(t, err)
+	require.Equal(t, HoldStatusCaptured, result.Hold.Status)
+	require.True(t, decimal.NewFromInt(45).Equal(result.Hold.CapturedAmount))
+	require.Equal(t, result.Transfer.ID, result.Hold.TransferID.UUID)
+
+	// the uncaptured 15 is available again
+	require.True(t, decimal.NewFromInt(55).Equal(result.FromAccount.Balance))
+	require.True(t, decimal.NewFromInt(55).Equal(result.FromAccount.AvailableBalance))
+	require.True(t, decimal.NewFromInt(45).Equal(result.ToAccount.Balance))
+	require.True(t, decimal.NewFromInt(45).Equal(result.ToAccount.AvailableBalance))
+
+	_, err = store.CaptureHoldTx(context.Background(), CaptureHoldTxParams{HoldID: hold.ID})
+	require.ErrorIs(t, err, ErrHoldNotActive)
+	_, err = store.ReleaseHoldTx(context.Background(), hold.ID)
+	require.ErrorIs(t, err, ErrHoldNotActive)
+}
+
+func TestReleaseHoldTx(t *testing.T) {
+	store := NewStore(testDB)
+	payer := createAccountInCurrency(t, CreateRandomUser(t), "USD", decimal.NewFromInt(100))
+	merchant := createAccountInCurrency(t, CreateRandomUser(t), "USD", decimal.Zero)
+	hold := createRandomHold(t, store, payer, merchant, decimal.NewFromInt(60), time.Now().Add(time.Hour))
+
+	result, err := store.ReleaseHoldTx(context.Background(), hold.ID)
+	require.NoError(t, err)
+	require.Equal(t, HoldStatusReleased, result.Hold.Status)
+	require.True(t, decimal.NewFromInt(100).Equal(result.Account.AvailableBalance))
+	require.True(t, decimal.NewFromInt(100).Equal(result.Account.Balance))
+}
+
+func TestExpireHoldsTx(t *testing.T) {
+	store := NewStore(testDB)
+	payer := createAccountInCurrency(t, CreateRandomUser(t), "USD", decimal.NewFromInt(100))
+	merchant := createAccountInCurrency(t, CreateRandomUser(t), "USD", decimal.Zero)
+	expired := createRandomHold(t, store, payer, merchant, decimal.NewFromInt(30), time.Now().Add(time.Second))
+	payer.AvailableBalance = payer.AvailableBalance.Sub(decimal.NewFromInt(30))
+	active := createRandomHold(t, store, payer, merchant, decimal.NewFromInt(30), time.Now().Add(time.Hour))
+
+	_, err := store.ExpireHoldsTx(context.Background(), time.Now().Add(time.Minute), 1000)
+	require.NoError(t, err)
+
+	hold, err := store.GetHold(context.Background(), expired.ID)
+	require.NoError(t, err)
+	require.Equal(t, HoldStatusExpired, hold.Status)
+
+	hold, err = store.GetHold(context.Background(), active.ID)
+	require.NoError(t, err)
+	require.Equal(t, HoldStatusActive, hold.Status)
+
+	account, err := store.GetAccount(context.Background(), payer.ID)
+	require.NoError(t, err)
+	require.True(t, decimal.NewFromInt(70).Equal(account.AvailableBalance))
+}
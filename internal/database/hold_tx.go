@@ -0,0 +1,274 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Glenn444/banking-app/util"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	HoldStatusActive   = "active"
+	HoldStatusCaptured = "captured"
+	HoldStatusReleased = "released"
+	HoldStatusExpired  = "expired"
+)
+
+var (
+	ErrHoldNotActive       = errors.New("hold is no longer active")
+	ErrHoldExpired         = errors.New("hold has expired")
+	ErrCaptureExceedsHold  = errors.New("capture amount exceeds the hold")
+	ErrHoldCurrencyInvalid = errors.New("hold accounts must share the currency")
+)
+
+type CreateHoldTxParams struct {
+	AccountID   uuid.UUID       `json:"account_id"`
+	ToAccountID uuid.UUID       `json:"to_account_id"`
+	Amount      decimal.Decimal `json:"amount"`
+	Description string          `json:"description"`
+	ExpiresAt   time.Time       `json:"expires_at"`
+}
+
+// HoldTxResult is a hold with its account after the hold changed
+type HoldTxResult struct {
+	Hold    Hold    `json:"hold"`
+	Account Account `json:"account"`
+}
+
+// CreateHoldTx reserves funds on an account for a later capture by
+// ToAccountID. The funds stay in balance but leave available_balance.
+func (store *SQLStore) CreateHoldTx(ctx context.Context, arg CreateHoldTxParams) (HoldTxResult, error) {
+	var result HoldTxResult
+
+	err := store.execTx(ctx, nil, func(q *Queries) error {
+		accounts, err := lockAccounts(ctx, q, arg.AccountID, arg.ToAccountID)
+		if err != nil {
+			return err
+		}
+		account := accounts[arg.AccountID]
+
+		if account.Currency != accounts[arg.ToAccountID].Currency {
+			return ErrHoldCurrencyInvalid
+		}
+		if !util.IsValidAmount(arg.Amount, account.Currency) {
+			return fmt.Errorf("%w: %v %s", ErrInvalidAmountScale, arg.Amount, account.Currency)
+		}
+		if account.SpendableBalance().LessThan(arg.Amount) {
+			return fmt.Errorf("%w: account %v can spend %v, hold amount %v",
+				ErrInsufficientFunds, account.ID, account.SpendableBalance(), arg.Amount)
+		}
+		// a hold is checked against the limits once, when the funds are reserved
+		if err = checkTransferLimits(ctx, q, account, arg.Amount, uuid.Nil, time.Now()); err != nil {
+			return err
+		}
+
+		result.Hold, err = q.CreateHold(ctx, CreateHoldParams{
+			AccountID:   arg.AccountID,
+			ToAccountID: arg.ToAccountID,
+			Amount:      arg.Amount,
+			Currency:    account.Currency,
+			Description: arg.Description,
+			ExpiresAt:   arg.ExpiresAt,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Account, err = q.AddAccountAvailableBalance(ctx, AddAccountAvailableBalanceParams{
+			ID:     arg.AccountID,
+			Amount: arg.Amount.Neg(),
+		})
+		return err
+	})
+	if err != nil {
+		return HoldTxResult{}, err
+	}
+	return result, nil
+}
+
+type CaptureHoldTxParams struct {
+	HoldID uuid.UUID `json:"hold_id"`
+	// Amount is how much to capture, zero captures the whole hold
+	Amount decimal.Decimal `json:"amount"`
+}
+
+// CaptureHoldTxResult is the captured hold and the transfer that paid it
+type CaptureHoldTxResult struct {
+	Hold Hold `json:"hold"`
+	TransferTxResult
+}
+
+// CaptureHoldTx transfers up to the held amount to the hold's recipient.
+// A hold is captured once, whatever isn't captured goes back to the
+// account's available balance.
+func (store *SQLStore) CaptureHoldTx(ctx context.Context, arg CaptureHoldTxParams) (CaptureHoldTxResult, error) {
+	var result CaptureHoldTxResult
+
+	err := store.execTx(ctx, nil, func(q *Queries) error {
+		hold, err := activeHold(ctx, q, arg.HoldID)
+		if err != nil {
+			return err
+		}
+
+		amount := arg.Amount
+		if amount.IsZero() {
+			amount = hold.Amount
+		}
+		if !amount.IsPositive() || amount.GreaterThan(hold.Amount) {
+			return fmt.Errorf("%w: hold %v is for %v, capture amount %v",
+				ErrCaptureExceedsHold, hold.ID, hold.Amount, amount)
+		}
+		if !util.IsValidAmount(amount, hold.Currency) {
+			return fmt.Errorf("%w: %v %s", ErrInvalidAmountScale, amount, hold.Currency)
+		}
+
+		accounts, err := lockAccounts(ctx, q, hold.AccountID, hold.ToAccountID)
+		if err != nil {
+			return err
+		}
+		fromAccount := accounts[hold.AccountID]
+		toAccount := accounts[hold.ToAccountID]
+
+		// give the whole hold back first, the transfer then spends what it captures
+		*fromAccount, err = q.AddAccountAvailableBalance(ctx, AddAccountAvailableBalanceParams{
+			ID:     hold.AccountID,
+			Amount: hold.Amount,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Transfer, err = q.CreateTransfer(ctx, CreateTransferParams{
+			FromAccountID: hold.AccountID,
+			ToAccountID:   hold.ToAccountID,
+			Amount:        amount,
+		})
+		if err != nil {
+			return err
+		}
+		result.FromEntry, err = postEntry(ctx, q, fromAccount, amount.Neg())
+		if err != nil {
+			return err
+		}
+		result.ToEntry, err = postEntry(ctx, q, toAccount, amount)
+		if err != nil {
+			return err
+		}
+
+		result.Hold, err = q.FinishHold(ctx, FinishHoldParams{
+			ID:             hold.ID,
+			Status:         HoldStatusCaptured,
+			CapturedAmount: amount,
+			TransferID:     uuid.NullUUID{UUID: result.Transfer.ID, Valid: true},
+		})
+		if err != nil {
+			return err
+		}
+
+		result.FromAccount = *fromAccount
+		result.ToAccount = *toAccount
+		return nil
+	})
+	if err != nil {
+		return CaptureHoldTxResult{}, err
+	}
+	return result, nil
+}
+
+// ReleaseHoldTx cancels an active hold and gives its funds back to the
+// account's available balance
+func (store *SQLStore) ReleaseHoldTx(ctx context.Context, holdID uuid.UUID) (HoldTxResult, error) {
+	var result HoldTxResult
+
+	err := store.execTx(ctx, nil, func(q *Queries) error {
+		hold, err := activeHold(ctx, q, holdID)
+		if err != nil {
+			return err
+		}
+
+		result, err = releaseHold(ctx, q, hold, HoldStatusReleased)
+		return err
+	})
+	if err != nil {
+		return HoldTxResult{}, err
+	}
+	return result, nil
+}
+
+// ExpireHoldsTx releases up to limit holds that expired before now and
+// returns how many it expired, each hold is expired in its own transaction
+func (store *SQLStore) ExpireHoldsTx(ctx context.Context, now time.Time, limit int32) (int, error) {
+	ids, err := store.ListExpiredHolds(ctx, ListExpiredHoldsParams{
+		ExpiresAt: now,
+		Limit:     limit,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	expired := 0
+	for _, id := range ids {
+		var released bool
+		err = store.execTx(ctx, nil, func(q *Queries) error {
+			released = false
+			hold, err := q.GetHoldForUpdate(ctx, id)
+			if err != nil {
+				return err
+			}
+			if hold.Status != HoldStatusActive {
+				// captured or released since it was listed
+				return nil
+			}
+
+			_, err = releaseHold(ctx, q, hold, HoldStatusExpired)
+			released = err == nil
+			return err
+		})
+		if err != nil {
+			return expired, err
+		}
+		if released {
+			expired++
+		}
+	}
+	return expired, nil
+}
+
+// activeHold locks a hold and checks it can still be captured or released
+func activeHold(ctx context.Context, q *Queries, holdID uuid.UUID) (Hold, error) {
+	hold, err := q.GetHoldForUpdate(ctx, holdID)
+	if err != nil {
+		return Hold{}, err
+	}
+	if hold.Status != HoldStatusActive {
+		return Hold{}, fmt.Errorf("%w: hold %v is %s", ErrHoldNotActive, hold.ID, hold.Status)
+	}
+	if !time.Now().Before(hold.ExpiresAt) {
+		return Hold{}, fmt.Errorf("%w: hold %v expired at %v", ErrHoldExpired, hold.ID, hold.ExpiresAt)
+	}
+	return hold, nil
+}
+
+func releaseHold(ctx context.Context, q *Queries, hold Hold, status string) (HoldTxResult, error) {
+	var result HoldTxResult
+	var err error
+
+	result.Account, err = q.AddAccountAvailableBalance(ctx, AddAccountAvailableBalanceParams{
+		ID:     hold.AccountID,
+		Amount: hold.Amount,
+	})
+	if err != nil {
+		return result, err
+	}
+
+	result.Hold, err = q.FinishHold(ctx, FinishHoldParams{
+		ID:             hold.ID,
+		Status:         status,
+		CapturedAmount: decimal.Zero,
+	})
+	return result, err
+}
@@ -0,0 +1,235 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: holds.sql
+
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+const createHold = `-- name: CreateHold :one
+INSERT INTO holds(
+    account_id,
+    to_account_id,
+    amount,
+    currency,
+    description,
+    expires_at
+) VALUES (
+    $1,$2,$3,$4,$5,$6
+) RETURNING id, account_id, to_account_id, amount, currency, captured_amount, description, status, transfer_id, expires_at, created_at, updated_at
+`
+
+type CreateHoldParams struct {
+	AccountID   uuid.UUID       `json:"account_id"`
+	ToAccountID uuid.UUID       `json:"to_account_id"`
+	Amount      decimal.Decimal `json:"amount"`
+	Currency    string          `json:"currency"`
+	Description string          `json:"description"`
+	ExpiresAt   time.Time       `json:"expires_at"`
+}
+
+func (q *Queries) CreateHold(ctx context.Context, arg CreateHoldParams) (Hold, error) {
+	row := q.db.QueryRow(ctx, createHold,
+		arg.AccountID,
+		arg.ToAccountID,
+		arg.Amount,
+		arg.Currency,
+		arg.Description,
+		arg.ExpiresAt,
+	)
+	var i Hold
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.Currency,
+		&i.CapturedAmount,
+		&i.Description,
+		&i.Status,
+		&i.TransferID,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const finishHold = `-- name: FinishHold :one
+UPDATE holds
+  set status = $2,
+      captured_amount = $3,
+      transfer_id = $4,
+      updated_at = now()
+WHERE id = $1
+RETURNING id, account_id, to_account_id, amount, currency, captured_amount, description, status, transfer_id, expires_at, created_at, updated_at
+`
+
+type FinishHoldParams struct {
+	ID             uuid.UUID       `json:"id"`
+	Status         string          `json:"status"`
+	CapturedAmount decimal.Decimal `json:"captured_amount"`
+	TransferID     uuid.NullUUID   `json:"transfer_id"`
+}
+
+func (q *Queries) FinishHold(ctx context.Context, arg FinishHoldParams) (Hold, error) {
+	row := q.db.QueryRow(ctx, finishHold,
+		arg.ID,
+		arg.Status,
+		arg.CapturedAmount,
+		arg.TransferID,
+	)
+	var i Hold
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.Currency,
+		&i.CapturedAmount,
+		&i.Description,
+		&i.Status,
+		&i.TransferID,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getHold = `-- name: GetHold :one
+SELECT id, account_id, to_account_id, amount, currency, captured_amount, description, status, transfer_id, expires_at, created_at, updated_at FROM holds
+WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetHold(ctx context.Context, id uuid.UUID) (Hold, error) {
+	row := q.db.QueryRow(ctx, getHold, id)
+	var i Hold
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.Currency,
+		&i.CapturedAmount,
+		&i.Description,
+		&i.Status,
+		&i.TransferID,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getHoldForUpdate = `-- name: GetHoldForUpdate :one
+SELECT id, account_id, to_account_id, amount, currency, captured_amount, description, status, transfer_id, expires_at, created_at, updated_at FROM holds
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetHoldForUpdate(ctx context.Context, id uuid.UUID) (Hold, error) {
+	row := q.db.QueryRow(ctx, getHoldForUpdate, id)
+	var i Hold
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.Currency,
+		&i.CapturedAmount,
+		&i.Description,
+		&i.Status,
+		&i.TransferID,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listExpiredHolds = `-- name: ListExpiredHolds :many
+SELECT id FROM holds
+WHERE status = 'active' AND expires_at <= $1
+ORDER BY expires_at
+LIMIT $2
+`
+
+type ListExpiredHoldsParams struct {
+	ExpiresAt time.Time `json:"expires_at"`
+	Limit     int32     `json:"limit"`
+}
+
+func (q *Queries) ListExpiredHolds(ctx context.Context, arg ListExpiredHoldsParams) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, listExpiredHolds, arg.ExpiresAt, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []uuid.UUID{}
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listHolds = `-- name: ListHolds :many
+SELECT id, account_id, to_account_id, amount, currency, captured_amount, description, status, transfer_id, expires_at, created_at, updated_at FROM holds
+WHERE account_id = $1
+ORDER BY created_at DESC
+LIMIT $2
+OFFSET $3
+`
+
+type ListHoldsParams struct {
+	AccountID uuid.UUID `json:"account_id"`
+	Limit     int32     `json:"limit"`
+	Offset    int32     `json:"offset"`
+}
+
+func (q *Queries) ListHolds(ctx context.Context, arg ListHoldsParams) ([]Hold, error) {
+	rows, err := q.db.Query(ctx, listHolds, arg.AccountID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Hold{}
+	for rows.Next() {
+		var i Hold
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.ToAccountID,
+			&i.Amount,
+			&i.Currency,
+			&i.CapturedAmount,
+			&i.Description,
+			&i.Status,
+			&i.TransferID,
+			&i.ExpiresAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
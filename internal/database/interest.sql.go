@@ -0,0 +1,520 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: interest.sql
+
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+const createInterestAccrual = `-- name: CreateInterestAccrual :one
+INSERT INTO interest_accruals(
+    account_id,
+    interest_product_id,
+    business_date,
+    balance,
+    annual_rate,
+    day_count,
+    amount
+) VALUES (
+    $1,$2,$3,$4,$5,$6,$7
+)
+ON CONFLICT (account_id, business_date) DO NOTHING
+RETURNING id, account_id, interest_product_id, business_date, balance, annual_rate, day_count, amount, payout_id, created_at
+`
+
+type CreateInterestAccrualParams struct {
+	AccountID         uuid.UUID       `json:"account_id"`
+	InterestProductID uuid.UUID       `json:"interest_product_id"`
+	BusinessDate      time.Time       `json:"business_date"`
+	Balance           decimal.Decimal `json:"balance"`
+	AnnualRate        decimal.Decimal `json:"annual_rate"`
+	DayCount          string          `json:"day_count"`
+	Amount            decimal.Decimal `json:"amount"`
+}
+
+func (q *Queries) CreateInterestAccrual(ctx context.Context, arg CreateInterestAccrualParams) (InterestAccrual, error) {
+	row := q.db.QueryRow(ctx, createInterestAccrual,
+		arg.AccountID,
+		arg.InterestProductID,
+		arg.BusinessDate,
+		arg.Balance,
+		arg.AnnualRate,
+		arg.DayCount,
+		arg.Amount,
+	)
+	var i InterestAccrual
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.InterestProductID,
+		&i.BusinessDate,
+		&i.Balance,
+		&i.AnnualRate,
+		&i.DayCount,
+		&i.Amount,
+		&i.PayoutID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createInterestExpenseAccount = `-- name: CreateInterestExpenseAccount :exec
+INSERT INTO accounts(
+    "owner",
+    balance,
+    currency
+) VALUES (
+    'interest_expense', 0, $1
+) ON CONFLICT ("owner", currency, account_type) DO NOTHING
+`
+
+func (q *Queries) CreateInterestExpenseAccount(ctx context.Context, currency string) error {
+	_, err := q.db.Exec(ctx, createInterestExpenseAccount, currency)
+	return err
+}
+
+const createInterestPayout = `-- name: CreateInterestPayout :one
+INSERT INTO interest_payouts(
+    account_id,
+    period_end,
+    accrued_amount,
+    amount,
+    residue
+) VALUES (
+    $1,$2,$3,$4,$5
+)
+ON CONFLICT (account_id, period_end) DO NOTHING
+RETURNING id, account_id, period_end, accrued_amount, amount, created_at, residue
+`
+
+type CreateInterestPayoutParams struct {
+	AccountID     uuid.UUID       `json:"account_id"`
+	PeriodEnd     time.Time       `json:"period_end"`
+	AccruedAmount decimal.Decimal `json:"accrued_amount"`
+	Amount        decimal.Decimal `json:"amount"`
+	Residue       decimal.Decimal `json:"residue"`
+}
+
+func (q *Queries) CreateInterestPayout(ctx context.Context, arg CreateInterestPayoutParams) (InterestPayout, error) {
+	row := q.db.QueryRow(ctx, createInterestPayout,
+		arg.AccountID,
+		arg.PeriodEnd,
+		arg.AccruedAmount,
+		arg.Amount,
+		arg.Residue,
+	)
+	var i InterestPayout
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.PeriodEnd,
+		&i.AccruedAmount,
+		&i.Amount,
+		&i.CreatedAt,
+		&i.Residue,
+	)
+	return i, err
+}
+
+const createInterestProduct = `-- name: CreateInterestProduct :one
+INSERT INTO interest_products(
+    name,
+    currency,
+    annual_rate,
+    day_count,
+    payout_frequency
+) VALUES (
+    $1,$2,$3,$4,$5
+) RETURNING id, name, currency, annual_rate, day_count, payout_frequency, created_at, updated_at
+`
+
+type CreateInterestProductParams struct {
+	Name            string          `json:"name"`
+	Currency        string          `json:"currency"`
+	AnnualRate      decimal.Decimal `json:"annual_rate"`
+	DayCount        string          `json:"day_count"`
+	PayoutFrequency string          `json:"payout_frequency"`
+}
+
+func (q *Queries) CreateInterestProduct(ctx context.Context, arg CreateInterestProductParams) (InterestProduct, error) {
+	row := q.db.QueryRow(ctx, createInterestProduct,
+		arg.Name,
+		arg.Currency,
+		arg.AnnualRate,
+		arg.DayCount,
+		arg.PayoutFrequency,
+	)
+	var i InterestProduct
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Currency,
+		&i.AnnualRate,
+		&i.DayCount,
+		&i.PayoutFrequency,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createInterestRun = `-- name: CreateInterestRun :one
+INSERT INTO interest_runs(
+    business_date,
+    accrued,
+    paid_out
+) VALUES (
+    $1,$2,$3
+)
+ON CONFLICT (business_date) DO UPDATE
+  set accrued = interest_runs.accrued + EXCLUDED.accrued,
+      paid_out = interest_runs.paid_out + EXCLUDED.paid_out,
+      completed_at = now()
+RETURNING business_date, accrued, paid_out, completed_at
+`
+
+type CreateInterestRunParams struct {
+	BusinessDate time.Time `json:"business_date"`
+	Accrued      int32     `json:"accrued"`
+	PaidOut      int32     `json:"paid_out"`
+}
+
+func (q *Queries) CreateInterestRun(ctx context.Context, arg CreateInterestRunParams) (InterestRun, error) {
+	row := q.db.QueryRow(ctx, createInterestRun, arg.BusinessDate, arg.Accrued, arg.PaidOut)
+	var i InterestRun
+	err := row.Scan(
+		&i.BusinessDate,
+		&i.Accrued,
+		&i.PaidOut,
+		&i.CompletedAt,
+	)
+	return i, err
+}
+
+const getAccountBalanceAt = `-- name: GetAccountBalanceAt :one
+SELECT (a.balance - COALESCE((
+    SELECT SUM(e.amount) FROM entries e
+    WHERE e.account_id = a.id AND e.created_at >= $1::timestamptz
+), 0))::numeric AS balance
+FROM accounts a
+WHERE a.id = $2
+`
+
+type GetAccountBalanceAtParams struct {
+	At        time.Time `json:"at"`
+	AccountID uuid.UUID `json:"account_id"`
+}
+
+// the balance before any entry posted at or after the given time
+func (q *Queries) GetAccountBalanceAt(ctx context.Context, arg GetAccountBalanceAtParams) (decimal.Decimal, error) {
+	row := q.db.QueryRow(ctx, getAccountBalanceAt, arg.At, arg.AccountID)
+	var balance decimal.Decimal
+	err := row.Scan(&balance)
+	return balance, err
+}
+
+const getInterestExpenseAccount = `-- name: GetInterestExpenseAccount :one
+SELECT id, owner, balance, currency, created_at, updated_at, available_balance, overdraft_limit, overdraft_interest_rate, account_type, interest_product_id, organisation_id FROM accounts
+WHERE "owner" = 'interest_expense' AND currency = $1
+LIMIT 1
+`
+
+func (q *Queries) GetInterestExpenseAccount(ctx context.Context, currency string) (Account, error) {
+	row := q.db.QueryRow(ctx, getInterestExpenseAccount, currency)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Balance,
+		&i.Currency,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.AvailableBalance,
+		&i.OverdraftLimit,
+		&i.OverdraftInterestRate,
+		&i.AccountType,
+		&i.InterestProductID,
+		&i.OrganisationID,
+	)
+	return i, err
+}
+
+const getInterestProduct = `-- name: GetInterestProduct :one
+SELECT id, name, currency, annual_rate, day_count, payout_frequency, created_at, updated_at FROM interest_products
+WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetInterestProduct(ctx context.Context, id uuid.UUID) (InterestProduct, error) {
+	row := q.db.QueryRow(ctx, getInterestProduct, id)
+	var i InterestProduct
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Currency,
+		&i.AnnualRate,
+		&i.DayCount,
+		&i.PayoutFrequency,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getLastInterestRun = `-- name: GetLastInterestRun :one
+SELECT business_date, accrued, paid_out, completed_at FROM interest_runs
+ORDER BY business_date DESC
+LIMIT 1
+`
+
+func (q *Queries) GetLastInterestRun(ctx context.Context) (InterestRun, error) {
+	row := q.db.QueryRow(ctx, getLastInterestRun)
+	var i InterestRun
+	err := row.Scan(
+		&i.BusinessDate,
+		&i.Accrued,
+		&i.PaidOut,
+		&i.CompletedAt,
+	)
+	return i, err
+}
+
+const listAccountsDueForAccrual = `-- name: ListAccountsDueForAccrual :many
+SELECT a.id FROM accounts a
+WHERE a.interest_product_id IS NOT NULL
+  AND a.created_at < $1
+  AND NOT EXISTS (
+    SELECT 1 FROM interest_accruals ia
+    WHERE ia.account_id = a.id AND ia.business_date = $2
+  )
+ORDER BY a.id
+LIMIT $3
+`
+
+type ListAccountsDueForAccrualParams struct {
+	DayEnd       time.Time `json:"day_end"`
+	BusinessDate time.Time `json:"business_date"`
+	Limit        int32     `json:"limit"`
+}
+
+// accounts on an interest product that existed at the end of the business
+// date and haven't been accrued for it
+func (q *Queries) ListAccountsDueForAccrual(ctx context.Context, arg ListAccountsDueForAccrualParams) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, listAccountsDueForAccrual, arg.DayEnd, arg.BusinessDate, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []uuid.UUID{}
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAccountsWithUnpaidAccruals = `-- name: ListAccountsWithUnpaidAccruals :many
+SELECT DISTINCT ia.account_id,
+    COALESCE(p.payout_frequency, 'monthly')::varchar AS payout_frequency
+FROM interest_accruals ia
+JOIN accounts a ON a.id = ia.account_id
+LEFT JOIN interest_products p ON p.id = a.interest_product_id
+WHERE ia.payout_id IS NULL AND ia.business_date <= $1
+ORDER BY ia.account_id
+`
+
+type ListAccountsWithUnpaidAccrualsRow struct {
+	AccountID       uuid.UUID `json:"account_id"`
+	PayoutFrequency string    `json:"payout_frequency"`
+}
+
+// accounts with interest accrued up to period_end that hasn't been paid out,
+// with the payout frequency of their product, monthly once they left it
+func (q *Queries) ListAccountsWithUnpaidAccruals(ctx context.Context, periodEnd time.Time) ([]ListAccountsWithUnpaidAccrualsRow, error) {
+	rows, err := q.db.Query(ctx, listAccountsWithUnpaidAccruals, periodEnd)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListAccountsWithUnpaidAccrualsRow{}
+	for rows.Next() {
+		var i ListAccountsWithUnpaidAccrualsRow
+		if err := rows.Scan(&i.AccountID, &i.PayoutFrequency); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listInterestPayouts = `-- name: ListInterestPayouts :many
+SELECT id, account_id, period_end, accrued_amount, amount, created_at, residue FROM interest_payouts
+WHERE account_id = $1
+ORDER BY period_end DESC
+LIMIT $2
+OFFSET $3
+`
+
+type ListInterestPayoutsParams struct {
+	AccountID uuid.UUID `json:"account_id"`
+	Limit     int32     `json:"limit"`
+	Offset    int32     `json:"offset"`
+}
+
+func (q *Queries) ListInterestPayouts(ctx context.Context, arg ListInterestPayoutsParams) ([]InterestPayout, error) {
+	rows, err := q.db.Query(ctx, listInterestPayouts, arg.AccountID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []InterestPayout{}
+	for rows.Next() {
+		var i InterestPayout
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.PeriodEnd,
+			&i.AccruedAmount,
+			&i.Amount,
+			&i.CreatedAt,
+			&i.Residue,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listInterestProducts = `-- name: ListInterestProducts :many
+SELECT id, name, currency, annual_rate, day_count, payout_frequency, created_at, updated_at FROM interest_products
+ORDER BY name
+`
+
+func (q *Queries) ListInterestProducts(ctx context.Context) ([]InterestProduct, error) {
+	rows, err := q.db.Query(ctx, listInterestProducts)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []InterestProduct{}
+	for rows.Next() {
+		var i InterestProduct
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Currency,
+			&i.AnnualRate,
+			&i.DayCount,
+			&i.PayoutFrequency,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markInterestAccrualsPaid = `-- name: MarkInterestAccrualsPaid :execrows
+UPDATE interest_accruals
+  set payout_id = $1
+WHERE account_id = $2 AND payout_id IS NULL
+  AND business_date <= $3
+`
+
+type MarkInterestAccrualsPaidParams struct {
+	PayoutID  uuid.NullUUID `json:"payout_id"`
+	AccountID uuid.UUID     `json:"account_id"`
+	PeriodEnd time.Time     `json:"period_end"`
+}
+
+func (q *Queries) MarkInterestAccrualsPaid(ctx context.Context, arg MarkInterestAccrualsPaidParams) (int64, error) {
+	result, err := q.db.Exec(ctx, markInterestAccrualsPaid, arg.PayoutID, arg.AccountID, arg.PeriodEnd)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const setAccountInterestProduct = `-- name: SetAccountInterestProduct :one
+UPDATE accounts
+  set interest_product_id = $1,
+      updated_at = now()
+WHERE id = $2
+RETURNING id, owner, balance, currency, created_at, updated_at, available_balance, overdraft_limit, overdraft_interest_rate, account_type, interest_product_id, organisation_id
+`
+
+type SetAccountInterestProductParams struct {
+	InterestProductID uuid.NullUUID `json:"interest_product_id"`
+	ID                uuid.UUID     `json:"id"`
+}
+
+func (q *Queries) SetAccountInterestProduct(ctx context.Context, arg SetAccountInterestProductParams) (Account, error) {
+	row := q.db.QueryRow(ctx, setAccountInterestProduct, arg.InterestProductID, arg.ID)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Balance,
+		&i.Currency,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.AvailableBalance,
+		&i.OverdraftLimit,
+		&i.OverdraftInterestRate,
+		&i.AccountType,
+		&i.InterestProductID,
+		&i.OrganisationID,
+	)
+	return i, err
+}
+
+const sumUnpaidInterestAccruals = `-- name: SumUnpaidInterestAccruals :one
+SELECT (COALESCE((
+    SELECT SUM(ia.amount) FROM interest_accruals ia
+    WHERE ia.account_id = $1 AND ia.payout_id IS NULL
+      AND ia.business_date <= $2
+), 0) + COALESCE((
+    SELECT ip.residue FROM interest_payouts ip
+    WHERE ip.account_id = $1 AND ip.period_end < $2
+    ORDER BY ip.period_end DESC
+    LIMIT 1
+), 0))::numeric AS accrued
+`
+
+type SumUnpaidInterestAccrualsParams struct {
+	AccountID uuid.UUID `json:"account_id"`
+	PeriodEnd time.Time `json:"period_end"`
+}
+
+// the unpaid accruals up to period_end plus the residue the account's last
+// payout carried over
+func (q *Queries) SumUnpaidInterestAccruals(ctx context.Context, arg SumUnpaidInterestAccrualsParams) (decimal.Decimal, error) {
+	row := q.db.QueryRow(ctx, sumUnpaidInterestAccruals, arg.AccountID, arg.PeriodEnd)
+	var accrued decimal.Decimal
+	err := row.Scan(&accrued)
+	return accrued, err
+}
@@ -0,0 +1,151 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Glenn444/banking-app/util"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func createSavingsAccount(t *testing.T, balance decimal.Decimal, annualRate string) Account {
+	t.Helper()
+	user := CreateRandomUser(t)
+	account, err := testQueries.CreateAccount(context.Background(), CreateAccountParams{
+		Owner:       user.Username,
+		Balance:     balance,
+		Currency:    "USD",
+		AccountType: AccountTypeSavings,
+	})
+	require.NoError(t, err)
+
+	product, err := testQueries.CreateInterestProduct(context.Background(), CreateInterestProductParams{
+		Name:            "savings " + util.RandomString(8),
+		Currency:        "USD",
+		AnnualRate:      decimal.RequireFromString(annualRate),
+		DayCount:        DayCountActual365,
+		PayoutFrequency: PayoutMonthly,
+	})
+	require.NoError(t, err)
+
+	account, err = testQueries.SetAccountInterestProduct(context.Background(), SetAccountInterestProductParams{
+		ID:                account.ID,
+		InterestProductID: uuid.NullUUID{UUID: product.ID, Valid: true},
+	})
+	require.NoError(t, err)
+	return account
+}
+
+func TestAccrueInterestTx_AccruesAndPaysOnce(t *testing.T) {
+	store := NewStore(testDB)
+	account := createSavingsAccount(t, decimal.NewFromInt(36500), "0.01")
+
+	// the last day of this month, the account exists at its end and it closes the payout period
+	today := BusinessDate(time.Now())
+	monthEnd := time.Date(today.Year(), today.Month()+1, 0, 0, 0, 0, 0, time.UTC)
+
+	_, err := store.AccrueInterestTx(context.Background(), monthEnd)
+	require.NoError(t, err)
+
+	payouts, err := testQueries.ListInterestPayouts(context.Background(), ListInterestPayoutsParams{
+		AccountID: account.ID,
+		Limit:     10,
+	})
+	require.NoError(t, err)
+	require.Len(t, payouts, 1)
+	// 36500 * 0.01 / 365
+	require.True(t, decimal.NewFromInt(1).Equal(payouts[0].Amount))
+
+	paid, err := testQueries.GetAccount(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.True(t, decimal.NewFromInt(36501).Equal(paid.Balance))
+
+	// running the date again changes nothing
+	_, err = store.AccrueInterestTx(context.Background(), monthEnd)
+	require.NoError(t, err)
+
+	again, err := testQueries.GetAccount(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.True(t, paid.Balance.Equal(again.Balance))
+
+	payouts, err = testQueries.ListInterestPayouts(context.Background(), ListInterestPayoutsParams{
+		AccountID: account.ID,
+		Limit:     10,
+	})
+	require.NoError(t, err)
+	require.Len(t, payouts, 1)
+}
+
+func TestAccrueInterestTx_CarriesResidue(t *testing.T) {
+	store := NewStore(testDB)
+	account := createSavingsAccount(t, decimal.NewFromInt(1000), "0.01")
+
+	today := BusinessDate(time.Now())
+	monthEnd := time.Date(today.Year(), today.Month()+1, 0, 0, 0, 0, 0, time.UTC)
+	nextMonthEnd := time.Date(today.Year(), today.Month()+2, 0, 0, 0, 0, 0, time.UTC)
+
+	// 1000 * 0.01 / 365 = 0.0273972603, 0.02 is paid and the rest carried
+	_, err := store.AccrueInterestTx(context.Background(), monthEnd)
+	require.NoError(t, err)
+
+	payouts, err := testQueries.ListInterestPayouts(context.Background(), ListInterestPayoutsParams{
+		AccountID: account.ID,
+		Limit:     10,
+	})
+	require.NoError(t, err)
+	require.Len(t, payouts, 1)
+	require.True(t, decimal.RequireFromString("0.02").Equal(payouts[0].Amount))
+	require.True(t, decimal.RequireFromString("0.0073972603").Equal(payouts[0].Residue))
+
+	// 1000.02 * 0.01 / 365 = 0.0273978082, with the residue 0.0347950685,
+	// without it the second period would pay 0.02 as well
+	_, err = store.AccrueInterestTx(context.Background(), nextMonthEnd)
+	require.NoError(t, err)
+
+	payouts, err = testQueries.ListInterestPayouts(context.Background(), ListInterestPayoutsParams{
+		AccountID: account.ID,
+		Limit:     10,
+	})
+	require.NoError(t, err)
+	require.Len(t, payouts, 2)
+	require.True(t, decimal.RequireFromString("0.0347950685").Equal(payouts[0].AccruedAmount))
+	require.True(t, decimal.RequireFromString("0.03").Equal(payouts[0].Amount))
+	require.True(t, decimal.RequireFromString("0.0047950685").Equal(payouts[0].Residue))
+
+	paid, err := testQueries.GetAccount(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.True(t, decimal.RequireFromString("1000.05").Equal(paid.Balance))
+}
+
+func TestDailyInterest(t *testing.T) {
+	balance := decimal.NewFromInt(36600)
+	rate := decimal.RequireFromString("0.01")
+	leapDay := time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)
+
+	require.True(t, decimal.RequireFromString("1.0027397260").Equal(DailyInterest(balance, rate, DayCountActual365, leapDay)))
+	require.True(t, decimal.RequireFromString("1.0166666667").Equal(DailyInterest(balance, rate, DayCountActual360, leapDay)))
+	require.True(t, decimal.NewFromInt(1).Equal(DailyInterest(balance, rate, DayCountActualActual, leapDay)))
+	require.True(t, decimal.Zero.Equal(DailyInterest(decimal.NewFromInt(-100), rate, DayCountActual365, leapDay)))
+}
+
+func TestInterestPayoutDue(t *testing.T) {
+	testCases := []struct {
+		frequency string
+		date      time.Time
+		due       bool
+	}{
+		{PayoutMonthly, time.Date(2026, 2, 28, 0, 0, 0, 0, time.UTC), true},
+		{PayoutMonthly, time.Date(2026, 2, 27, 0, 0, 0, 0, time.UTC), false},
+		{PayoutQuarterly, time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC), true},
+		{PayoutQuarterly, time.Date(2026, 4, 30, 0, 0, 0, 0, time.UTC), false},
+		{PayoutAnnually, time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC), true},
+		{PayoutAnnually, time.Date(2026, 6, 30, 0, 0, 0, 0, time.UTC), false},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.due, InterestPayoutDue(tc.frequency, tc.date), "%s %s", tc.frequency, tc.date.Format(time.DateOnly))
+	}
+}
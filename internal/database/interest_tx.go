@@ -0,0 +1,237 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Glenn444/banking-app/util"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	AccountTypeChecking = "checking"
+	AccountTypeSavings  = "savings"
+
+	DayCountActual365    = "actual_365"
+	DayCountActual360    = "actual_360"
+	DayCountActualActual = "actual_actual"
+
+	PayoutMonthly   = "monthly"
+	PayoutQuarterly = "quarterly"
+	PayoutAnnually  = "annually"
+)
+
+// accrualBatchSize caps how many accounts are listed at once for accrual
+const accrualBatchSize = 500
+
+// accrualScale is the number of decimals daily accruals are kept to, they
+// are only rounded to the currency when paid out
+const accrualScale = 10
+
+// DailyInterest is the interest an end-of-day balance earns on date at
+// annualRate, a day is 1/365, 1/360 or 1/days-in-the-year of a year
+// depending on dayCount. Balances at or below zero earn nothing.
+func DailyInterest(balance, annualRate decimal.Decimal, dayCount string, date time.Time) decimal.Decimal {
+	if !balance.IsPositive() {
+		return decimal.Zero
+	}
+
+	var days int64
+	switch dayCount {
+	case DayCountActual360:
+		days = 360
+	case DayCountActualActual:
+		days = int64(time.Date(date.Year(), time.December, 31, 0, 0, 0, 0, time.UTC).YearDay())
+	default:
+		days = 365
+	}
+	return balance.Mul(annualRate).Div(decimal.NewFromInt(days)).Round(accrualScale)
+}
+
+// InterestPayoutDue reports whether date closes a payout period, periods
+// end on the last day of the month, quarter or year
+func InterestPayoutDue(frequency string, date time.Time) bool {
+	if date.AddDate(0, 0, 1).Day() != 1 {
+		return false
+	}
+	switch frequency {
+	case PayoutQuarterly:
+		return date.Month()%3 == 0
+	case PayoutAnnually:
+		return date.Month() == time.December
+	default:
+		return true
+	}
+}
+
+// AccrueInterestTx accrues a day of interest on the end-of-day balance of
+// every account on an interest product, then pays out the accruals of the
+// accounts whose payout period ends on businessDate from the currency's
+// interest expense account. Each account is accrued and paid in its own
+// transaction and at most once per business date, so a date can be run
+// again, or run late, and end up with the same postings.
+func (store *SQLStore) AccrueInterestTx(ctx context.Context, businessDate time.Time) (InterestRun, error) {
+	var accruedCount, paidCount int32
+	dayEnd := businessDate.AddDate(0, 0, 1)
+
+	for {
+		ids, err := store.ListAccountsDueForAccrual(ctx, ListAccountsDueForAccrualParams{
+			DayEnd:       dayEnd,
+			BusinessDate: businessDate,
+			Limit:        accrualBatchSize,
+		})
+		if err != nil {
+			return InterestRun{}, err
+		}
+
+		for _, id := range ids {
+			var accrued bool
+			err = store.execTx(ctx, nil, func(q *Queries) error {
+				accrued, err = accrueInterest(ctx, q, id, businessDate)
+				return err
+			})
+			if err != nil {
+				return InterestRun{}, err
+			}
+			if accrued {
+				accruedCount++
+			}
+		}
+		if len(ids) < accrualBatchSize {
+			break
+		}
+	}
+
+	// only the last day of a month can close a payout period
+	if InterestPayoutDue(PayoutMonthly, businessDate) {
+		unpaid, err := store.ListAccountsWithUnpaidAccruals(ctx, businessDate)
+		if err != nil {
+			return InterestRun{}, err
+		}
+
+		for _, account := range unpaid {
+			if !InterestPayoutDue(account.PayoutFrequency, businessDate) {
+				continue
+			}
+
+			var paid bool
+			err = store.execTx(ctx, nil, func(q *Queries) error {
+				paid, err = payInterest(ctx, q, account.AccountID, businessDate)
+				return err
+			})
+			if err != nil {
+				return InterestRun{}, err
+			}
+			if paid {
+				paidCount++
+			}
+		}
+	}
+
+	// a rerun adds what it did to the date's earlier runs
+	return store.CreateInterestRun(ctx, CreateInterestRunParams{
+		BusinessDate: businessDate,
+		Accrued:      accruedCount,
+		PaidOut:      paidCount,
+	})
+}
+
+// accrueInterest records a day of interest for an account, it reports
+// false when the day was already accrued
+func accrueInterest(ctx context.Context, q *Queries, accountID uuid.UUID, businessDate time.Time) (bool, error) {
+	account, err := q.GetAccount(ctx, accountID)
+	if err != nil {
+		return false, err
+	}
+	if !account.InterestProductID.Valid {
+		// left its product since it was listed
+		return false, nil
+	}
+	product, err := q.GetInterestProduct(ctx, account.InterestProductID.UUID)
+	if err != nil {
+		return false, err
+	}
+
+	// entries posted after the day ended aren't part of its closing balance,
+	// that keeps a late or repeated run accruing on the same balance
+	balance, err := q.GetAccountBalanceAt(ctx, GetAccountBalanceAtParams{
+		AccountID: account.ID,
+		At:        businessDate.AddDate(0, 0, 1),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	_, err = q.CreateInterestAccrual(ctx, CreateInterestAccrualParams{
+		AccountID:         account.ID,
+		InterestProductID: product.ID,
+		BusinessDate:      businessDate,
+		Balance:           balance,
+		AnnualRate:        product.AnnualRate,
+		DayCount:          product.DayCount,
+		Amount:            DailyInterest(balance, product.AnnualRate, product.DayCount, businessDate),
+	})
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// payInterest pays out an account's accruals up to periodEnd in whole minor
+// units and carries the fraction left into the next payout, it reports false
+// when the period was already paid
+func payInterest(ctx context.Context, q *Queries, accountID uuid.UUID, periodEnd time.Time) (bool, error) {
+	account, err := q.GetAccount(ctx, accountID)
+	if err != nil {
+		return false, err
+	}
+	currency, ok := util.LookupCurrency(account.Currency)
+	if !ok {
+		return false, fmt.Errorf("account %v has unknown currency %s", account.ID, account.Currency)
+	}
+
+	// what the last payout couldn't pay in whole minor units is added to
+	// this period's accruals, so rounding never loses the account interest
+	accrued, err := q.SumUnpaidInterestAccruals(ctx, SumUnpaidInterestAccrualsParams{
+		AccountID: account.ID,
+		PeriodEnd: periodEnd,
+	})
+	if err != nil {
+		return false, err
+	}
+	amount := accrued.Truncate(currency.MinorUnits)
+
+	payout, err := q.CreateInterestPayout(ctx, CreateInterestPayoutParams{
+		AccountID:     account.ID,
+		PeriodEnd:     periodEnd,
+		AccruedAmount: accrued,
+		Amount:        amount,
+		Residue:       accrued.Sub(amount),
+	})
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	_, err = q.MarkInterestAccrualsPaid(ctx, MarkInterestAccrualsPaidParams{
+		PayoutID:  uuid.NullUUID{UUID: payout.ID, Valid: true},
+		AccountID: account.ID,
+		PeriodEnd: periodEnd,
+	})
+	if err != nil || amount.IsZero() {
+		return err == nil, err
+	}
+
+	expenseAccount, err := q.GetInterestExpenseAccount(ctx, account.Currency)
+	if err != nil {
+		return false, fmt.Errorf("no interest expense account for currency %s: %w", account.Currency, err)
+	}
+
+	_, _, err = moveMoney(ctx, q, expenseAccount.ID, account.ID, amount, false)
+	return err == nil, err
+}
@@ -0,0 +1,157 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	LimitScopeAccount = "account"
+	LimitScopeUser    = "user"
+
+	LimitMaxSingleAmount = "max_single_amount"
+	LimitDailyAmount     = "daily_amount"
+	LimitMonthlyAmount   = "monthly_amount"
+	LimitHourlyCount     = "hourly_count"
+)
+
+var ErrLimitExceeded = errors.New("transfer limit exceeded")
+
+// LimitExceededError tells which limit rejected a transfer and when it resets
+type LimitExceededError struct {
+	Scope string `json:"scope"`
+	Limit string `json:"limit"`
+	// Max is the configured limit and Used what the window already holds,
+	// amounts are in Currency and hourly_count in transfers
+	Max      decimal.Decimal `json:"max"`
+	Used     decimal.Decimal `json:"used"`
+	Currency string          `json:"currency"`
+	// ResetsAt is when the window restarts, it is nil for max_single_amount
+	ResetsAt *time.Time `json:"resets_at"`
+}
+
+func (e *LimitExceededError) Error() string {
+	msg := fmt.Sprintf("%s %s limit of %v exceeded", e.Scope, e.Limit, e.Max)
+	if e.ResetsAt != nil {
+		msg += fmt.Sprintf(", resets at %s", e.ResetsAt.Format(time.RFC3339))
+	}
+	return msg
+}
+
+func (e *LimitExceededError) Is(target error) bool {
+	return target == ErrLimitExceeded
+}
+
+// limitWindows are the calendar windows limits are counted in, all in UTC
+type limitWindows struct {
+	hourStart, dayStart, monthStart time.Time
+}
+
+func windowsAt(now time.Time) limitWindows {
+	now = now.UTC()
+	return limitWindows{
+		hourStart:  now.Truncate(time.Hour),
+		dayStart:   time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC),
+		monthStart: time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+// checkTransferLimits checks an outgoing amount from a locked account against
+// the account's and its owner's limits. It locks the owner's user row, so
+// transfers from any of the owner's accounts are counted one at a time.
+// When the transfer being checked is already inserted its id is passed as
+// transferID so it isn't counted twice, otherwise transferID is uuid.Nil.
+func checkTransferLimits(ctx context.Context, q *Queries, account *Account, amount decimal.Decimal, transferID uuid.UUID, now time.Time) error {
+	windows := windowsAt(now)
+
+	accountLimit, err := q.GetAccountTransferLimit(ctx, uuid.NullUUID{UUID: account.ID, Valid: true})
+	switch {
+	case err == nil:
+		usage, err := q.GetAccountOutgoingUsage(ctx, GetAccountOutgoingUsageParams{
+			AccountID:         account.ID,
+			HourStart:         windows.hourStart,
+			DayStart:          windows.dayStart,
+			MonthStart:        windows.monthStart,
+			ExcludeTransferID: transferID,
+		})
+		if err != nil {
+			return err
+		}
+		err = evaluateLimit(LimitScopeAccount, accountLimit, account.Currency, amount, windows,
+			usage.DayTotal, usage.MonthTotal, usage.HourCount)
+		if err != nil {
+			return err
+		}
+	case !errors.Is(err, ErrNotFound):
+		return err
+	}
+
+	if _, err = q.LockUserForTransfer(ctx, account.Owner); err != nil {
+		return err
+	}
+	userLimit, err := q.GetUserTransferLimit(ctx, GetUserTransferLimitParams{
+		Owner:    pgtype.Text{String: account.Owner, Valid: true},
+		Currency: account.Currency,
+	})
+	if errors.Is(err, ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	usage, err := q.GetUserOutgoingUsage(ctx, GetUserOutgoingUsageParams{
+		Owner:             account.Owner,
+		Currency:          account.Currency,
+		HourStart:         windows.hourStart,
+		DayStart:          windows.dayStart,
+		MonthStart:        windows.monthStart,
+		ExcludeTransferID: transferID,
+	})
+	if err != nil {
+		return err
+	}
+	return evaluateLimit(LimitScopeUser, userLimit, account.Currency, amount, windows,
+		usage.DayTotal, usage.MonthTotal, usage.HourCount)
+}
+
+// evaluateLimit returns a LimitExceededError for the first limit the amount breaks
+func evaluateLimit(scope string, limit TransferLimit, currency string, amount decimal.Decimal, windows limitWindows,
+	dayTotal, monthTotal decimal.Decimal, hourCount int64) error {
+	exceeded := func(name string, max, used decimal.Decimal, resetsAt time.Time) error {
+		e := &LimitExceededError{
+			Scope:    scope,
+			Limit:    name,
+			Max:      max,
+			Used:     used,
+			Currency: currency,
+		}
+		if !resetsAt.IsZero() {
+			e.ResetsAt = &resetsAt
+		}
+		return e
+	}
+
+	if limit.MaxSingleAmount.Valid && amount.GreaterThan(limit.MaxSingleAmount.Decimal) {
+		return exceeded(LimitMaxSingleAmount, limit.MaxSingleAmount.Decimal, decimal.Zero, time.Time{})
+	}
+	if limit.HourlyCount.Valid && hourCount+1 > int64(limit.HourlyCount.Int32) {
+		return exceeded(LimitHourlyCount, decimal.NewFromInt32(limit.HourlyCount.Int32), decimal.NewFromInt(hourCount),
+			windows.hourStart.Add(time.Hour))
+	}
+	if limit.DailyAmount.Valid && dayTotal.Add(amount).GreaterThan(limit.DailyAmount.Decimal) {
+		return exceeded(LimitDailyAmount, limit.DailyAmount.Decimal, dayTotal,
+			windows.dayStart.AddDate(0, 0, 1))
+	}
+	if limit.MonthlyAmount.Valid && monthTotal.Add(amount).GreaterThan(limit.MonthlyAmount.Decimal) {
+		return exceeded(LimitMonthlyAmount, limit.MonthlyAmount.Decimal, monthTotal,
+			windows.monthStart.AddDate(0, 1, 0))
+	}
+	return nil
+}
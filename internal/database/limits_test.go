@@ -0,0 +1,139 @@
+package database
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransferLimits_AccountDaily(t *testing.T) {
+	store := NewStore(testDB)
+	from := createAccountInCurrency(t, CreateRandomUser(t), "USD", decimal.NewFromInt(1000))
+	to := createAccountInCurrency(t, CreateRandomUser(t), "USD", decimal.Zero)
+
+	_, err := testQueries.UpsertAccountTransferLimit(context.Background(), UpsertAccountTransferLimitParams{
+		AccountID:       uuid.NullUUID{UUID: from.ID, Valid: true},
+		Currency:        "USD",
+		MaxSingleAmount: decimal.NewNullDecimal(decimal.NewFromInt(100)),
+		DailyAmount:     decimal.NewNullDecimal(decimal.NewFromInt(150)),
+	})
+	require.NoError(t, err)
+
+	transfer := func(amount int64) error {
+		_, err := store.TransferTx(context.Background(), TransferTxParams{
+			FromAccountID: from.ID,
+			ToAccountID:   to.ID,
+			Amount:        decimal.NewFromInt(amount),
+		})
+		return err
+	}
+
+	err = transfer(120)
+	require.ErrorIs(t, err, ErrLimitExceeded)
+	var limitErr *LimitExceededError
+	require.ErrorAs(t, err, &limitErr)
+	require.Equal(t, LimitMaxSingleAmount, limitErr.Limit)
+	require.Nil(t, limitErr.ResetsAt)
+
+	require.NoError(t, transfer(100))
+
+	err = transfer(60)
+	require.ErrorAs(t, err, &limitErr)
+	require.Equal(t, LimitScopeAccount, limitErr.Scope)
+	require.Equal(t, LimitDailyAmount, limitErr.Limit)
+	require.True(t, decimal.NewFromInt(100).Equal(limitErr.Used))
+	require.NotNil(t, limitErr.ResetsAt)
+	require.True(t, limitErr.ResetsAt.After(time.Now()))
+
+	require.NoError(t, transfer(50))
+}
+
+func TestTransferLimits_UserHourlyCountConcurrent(t *testing.T) {
+	store := NewStore(testDB)
+	user := CreateRandomUser(t)
+	from1 := createAccountInCurrency(t, user, "USD", decimal.NewFromInt(1000))
+	from2 := createAccountInCurrency(t, user, "USD", decimal.NewFromInt(1000))
+	to := createAccountInCurrency(t, CreateRandomUser(t), "USD", decimal.Zero)
+
+	_, err := testQueries.UpsertUserTransferLimit(context.Background(), UpsertUserTransferLimitParams{
+		Owner:       pgtype.Text{String: user.Username, Valid: true},
+		Currency:    "USD",
+		HourlyCount: pgtype.Int4{Int32: 3, Valid: true},
+	})
+	require.NoError(t, err)
+
+	// transfers from both accounts race for the user's three per hour
+	n := 8
+	errs := make(chan error, n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		from := from1
+		if i%2 == 1 {
+			from = from2
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := store.TransferTx(context.Background(), TransferTxParams{
+				FromAccountID: from.ID,
+				ToAccountID:   to.ID,
+				Amount:        decimal.NewFromInt(10),
+			})
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	succeeded := 0
+	for err := range errs {
+		if err == nil {
+			succeeded++
+			continue
+		}
+		var limitErr *LimitExceededError
+		require.ErrorAs(t, err, &limitErr)
+		require.Equal(t, LimitScopeUser, limitErr.Scope)
+		require.Equal(t, LimitHourlyCount, limitErr.Limit)
+	}
+	require.Equal(t, 3, succeeded)
+}
+
+func TestEvaluateLimit_Windows(t *testing.T) {
+	now := time.Date(2026, 1, 31, 22, 15, 0, 0, time.UTC)
+	windows := windowsAt(now)
+
+	limit := TransferLimit{
+		DailyAmount:   decimal.NewNullDecimal(decimal.NewFromInt(100)),
+		MonthlyAmount: decimal.NewNullDecimal(decimal.NewFromInt(1000)),
+		HourlyCount:   pgtype.Int4{Int32: 2, Valid: true},
+	}
+
+	err := evaluateLimit(LimitScopeAccount, limit, "USD", decimal.NewFromInt(10), windows,
+		decimal.Zero, decimal.Zero, 2)
+	var limitErr *LimitExceededError
+	require.ErrorAs(t, err, &limitErr)
+	require.Equal(t, LimitHourlyCount, limitErr.Limit)
+	require.Equal(t, time.Date(2026, 1, 31, 23, 0, 0, 0, time.UTC), *limitErr.ResetsAt)
+
+	err = evaluateLimit(LimitScopeAccount, limit, "USD", decimal.NewFromInt(10), windows,
+		decimal.NewFromInt(95), decimal.NewFromInt(95), 0)
+	require.ErrorAs(t, err, &limitErr)
+	require.Equal(t, LimitDailyAmount, limitErr.Limit)
+	require.Equal(t, time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC), *limitErr.ResetsAt)
+
+	err = evaluateLimit(LimitScopeAccount, limit, "USD", decimal.NewFromInt(10), windows,
+		decimal.Zero, decimal.NewFromInt(995), 0)
+	require.ErrorAs(t, err, &limitErr)
+	require.Equal(t, LimitMonthlyAmount, limitErr.Limit)
+	require.Equal(t, time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC), *limitErr.ResetsAt)
+
+	require.NoError(t, evaluateLimit(LimitScopeAccount, limit, "USD", decimal.NewFromInt(10), windows,
+		decimal.NewFromInt(90), decimal.NewFromInt(990), 1))
+}
@@ -1,21 +1,18 @@
 package database
 
 import (
-	"database/sql"
+	"context"
 	"log"
 	"os"
 	"testing"
 
+	"github.com/Glenn444/banking-app/util"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/joho/godotenv"
-	_ "github.com/lib/pq"
-)
-
-const (
-	dbDriver = "postgres"
 )
 
 var testQueries *Queries
-var testDB *sql.DB
+var testDB *pgxpool.Pool
 
 func TestMain(m *testing.M) {
     // Load .env for local dev only; silently ignore if missing (e.g. in CI)
@@ -27,16 +24,34 @@ func TestMain(m *testing.M) {
     }
 
     var err error
-    testDB, err = sql.Open(dbDriver, dbSource)
+    testDB, err = pgxpool.New(context.Background(), dbSource)
     if err != nil {
         log.Fatal("cannot connect to db:", err)
     }
 
-    err = testDB.Ping()
+    err = testDB.Ping(context.Background())
     if err != nil {
         log.Fatal("cannot ping db:", err)
     }
 
     testQueries = New(testDB)
+
+    // the registry starts empty, seed it from the currencies table like the server does
+    currencies, err := testQueries.ListCurrencies(context.Background())
+    if err != nil {
+        log.Fatal("cannot load currencies:", err)
+    }
+    registry := make([]util.Currency, 0, len(currencies))
+    for _, c := range currencies {
+        registry = append(registry, util.Currency{
+            Code:        c.Code,
+            NumericCode: c.NumericCode,
+            MinorUnits:  c.MinorUnits,
+            Enabled:     c.Enabled,
+            Symbol:      c.Symbol,
+        })
+    }
+    util.SetCurrencies(registry)
+
     os.Exit(m.Run())
 }
@@ -12,9 +12,11 @@ package mock_database
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	database "github.com/Glenn444/banking-app/internal/database"
 	uuid "github.com/google/uuid"
+	decimal "github.com/shopspring/decimal"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -42,6 +44,261 @@ func (m *MockStore) EXPECT() *MockStoreMockRecorder {
 	return m.recorder
 }
 
+// AcceptPaymentRequestTx mocks base method.
+func (m *MockStore) AcceptPaymentRequestTx(ctx context.Context, arg database.AcceptPaymentRequestTxParams) (database.AcceptPaymentRequestTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptPaymentRequestTx", ctx, arg)
+	ret0, _ := ret[0].(database.AcceptPaymentRequestTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptPaymentRequestTx indicates an expected call of AcceptPaymentRequestTx.
+func (mr *MockStoreMockRecorder) AcceptPaymentRequestTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptPaymentRequestTx", reflect.TypeOf((*MockStore)(nil).AcceptPaymentRequestTx), ctx, arg)
+}
+
+// AccrueInterestTx mocks base method.
+func (m *MockStore) AccrueInterestTx(ctx context.Context, businessDate time.Time) (database.InterestRun, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AccrueInterestTx", ctx, businessDate)
+	ret0, _ := ret[0].(database.InterestRun)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AccrueInterestTx indicates an expected call of AccrueInterestTx.
+func (mr *MockStoreMockRecorder) AccrueInterestTx(ctx, businessDate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AccrueInterestTx", reflect.TypeOf((*MockStore)(nil).AccrueInterestTx), ctx, businessDate)
+}
+
+// AccrueOverdraftInterestTx mocks base method.
+func (m *MockStore) AccrueOverdraftInterestTx(ctx context.Context, businessDate time.Time, limit int32) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AccrueOverdraftInterestTx", ctx, businessDate, limit)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AccrueOverdraftInterestTx indicates an expected call of AccrueOverdraftInterestTx.
+func (mr *MockStoreMockRecorder) AccrueOverdraftInterestTx(ctx, businessDate, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AccrueOverdraftInterestTx", reflect.TypeOf((*MockStore)(nil).AccrueOverdraftInterestTx), ctx, businessDate, limit)
+}
+
+// AddAccountAvailableBalance mocks base method.
+func (m *MockStore) AddAccountAvailableBalance(ctx context.Context, arg database.AddAccountAvailableBalanceParams) (database.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAccountAvailableBalance", ctx, arg)
+	ret0, _ := ret[0].(database.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddAccountAvailableBalance indicates an expected call of AddAccountAvailableBalance.
+func (mr *MockStoreMockRecorder) AddAccountAvailableBalance(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAccountAvailableBalance", reflect.TypeOf((*MockStore)(nil).AddAccountAvailableBalance), ctx, arg)
+}
+
+// AddOrganisationMember mocks base method.
+func (m *MockStore) AddOrganisationMember(ctx context.Context, arg database.AddOrganisationMemberParams) (database.OrganisationMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddOrganisationMember", ctx, arg)
+	ret0, _ := ret[0].(database.OrganisationMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddOrganisationMember indicates an expected call of AddOrganisationMember.
+func (mr *MockStoreMockRecorder) AddOrganisationMember(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddOrganisationMember", reflect.TypeOf((*MockStore)(nil).AddOrganisationMember), ctx, arg)
+}
+
+// AddTransferApproval mocks base method.
+func (m *MockStore) AddTransferApproval(ctx context.Context, id uuid.UUID) (database.TransferApproval, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTransferApproval", ctx, id)
+	ret0, _ := ret[0].(database.TransferApproval)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTransferApproval indicates an expected call of AddTransferApproval.
+func (mr *MockStoreMockRecorder) AddTransferApproval(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTransferApproval", reflect.TypeOf((*MockStore)(nil).AddTransferApproval), ctx, id)
+}
+
+// AddTransferBatchProgress mocks base method.
+func (m *MockStore) AddTransferBatchProgress(ctx context.Context, arg database.AddTransferBatchProgressParams) (database.TransferBatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTransferBatchProgress", ctx, arg)
+	ret0, _ := ret[0].(database.TransferBatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTransferBatchProgress indicates an expected call of AddTransferBatchProgress.
+func (mr *MockStoreMockRecorder) AddTransferBatchProgress(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTransferBatchProgress", reflect.TypeOf((*MockStore)(nil).AddTransferBatchProgress), ctx, arg)
+}
+
+// AdvanceScheduledTransfer mocks base method.
+func (m *MockStore) AdvanceScheduledTransfer(ctx context.Context, arg database.AdvanceScheduledTransferParams) (database.ScheduledTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdvanceScheduledTransfer", ctx, arg)
+	ret0, _ := ret[0].(database.ScheduledTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdvanceScheduledTransfer indicates an expected call of AdvanceScheduledTransfer.
+func (mr *MockStoreMockRecorder) AdvanceScheduledTransfer(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdvanceScheduledTransfer", reflect.TypeOf((*MockStore)(nil).AdvanceScheduledTransfer), ctx, arg)
+}
+
+// ApproveTransferTx mocks base method.
+func (m *MockStore) ApproveTransferTx(ctx context.Context, arg database.DecideTransferApprovalTxParams) (database.TransferApprovalTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveTransferTx", ctx, arg)
+	ret0, _ := ret[0].(database.TransferApprovalTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApproveTransferTx indicates an expected call of ApproveTransferTx.
+func (mr *MockStoreMockRecorder) ApproveTransferTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveTransferTx", reflect.TypeOf((*MockStore)(nil).ApproveTransferTx), ctx, arg)
+}
+
+// CancelTransferApprovalTx mocks base method.
+func (m *MockStore) CancelTransferApprovalTx(ctx context.Context, arg database.DecideTransferApprovalTxParams) (database.TransferApprovalTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelTransferApprovalTx", ctx, arg)
+	ret0, _ := ret[0].(database.TransferApprovalTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelTransferApprovalTx indicates an expected call of CancelTransferApprovalTx.
+func (mr *MockStoreMockRecorder) CancelTransferApprovalTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelTransferApprovalTx", reflect.TypeOf((*MockStore)(nil).CancelTransferApprovalTx), ctx, arg)
+}
+
+// CaptureHoldTx mocks base method.
+func (m *MockStore) CaptureHoldTx(ctx context.Context, arg database.CaptureHoldTxParams) (database.CaptureHoldTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CaptureHoldTx", ctx, arg)
+	ret0, _ := ret[0].(database.CaptureHoldTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CaptureHoldTx indicates an expected call of CaptureHoldTx.
+func (mr *MockStoreMockRecorder) CaptureHoldTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CaptureHoldTx", reflect.TypeOf((*MockStore)(nil).CaptureHoldTx), ctx, arg)
+}
+
+// ClaimTransfer mocks base method.
+func (m *MockStore) ClaimTransfer(ctx context.Context, staleBefore time.Time) (database.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimTransfer", ctx, staleBefore)
+	ret0, _ := ret[0].(database.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimTransfer indicates an expected call of ClaimTransfer.
+func (mr *MockStoreMockRecorder) ClaimTransfer(ctx, staleBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimTransfer", reflect.TypeOf((*MockStore)(nil).ClaimTransfer), ctx, staleBefore)
+}
+
+// ClaimTransferBatch mocks base method.
+func (m *MockStore) ClaimTransferBatch(ctx context.Context, staleBefore time.Time) (database.TransferBatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimTransferBatch", ctx, staleBefore)
+	ret0, _ := ret[0].(database.TransferBatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimTransferBatch indicates an expected call of ClaimTransferBatch.
+func (mr *MockStoreMockRecorder) ClaimTransferBatch(ctx, staleBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimTransferBatch", reflect.TypeOf((*MockStore)(nil).ClaimTransferBatch), ctx, staleBefore)
+}
+
+// CompleteTransfer mocks base method.
+func (m *MockStore) CompleteTransfer(ctx context.Context, id uuid.UUID) (database.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteTransfer", ctx, id)
+	ret0, _ := ret[0].(database.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteTransfer indicates an expected call of CompleteTransfer.
+func (mr *MockStoreMockRecorder) CompleteTransfer(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteTransfer", reflect.TypeOf((*MockStore)(nil).CompleteTransfer), ctx, id)
+}
+
+// ConfirmEscrowPayee mocks base method.
+func (m *MockStore) ConfirmEscrowPayee(ctx context.Context, id uuid.UUID) (database.Escrow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmEscrowPayee", ctx, id)
+	ret0, _ := ret[0].(database.Escrow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmEscrowPayee indicates an expected call of ConfirmEscrowPayee.
+func (mr *MockStoreMockRecorder) ConfirmEscrowPayee(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmEscrowPayee", reflect.TypeOf((*MockStore)(nil).ConfirmEscrowPayee), ctx, id)
+}
+
+// ConfirmEscrowPayer mocks base method.
+func (m *MockStore) ConfirmEscrowPayer(ctx context.Context, id uuid.UUID) (database.Escrow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmEscrowPayer", ctx, id)
+	ret0, _ := ret[0].(database.Escrow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmEscrowPayer indicates an expected call of ConfirmEscrowPayer.
+func (mr *MockStoreMockRecorder) ConfirmEscrowPayer(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmEscrowPayer", reflect.TypeOf((*MockStore)(nil).ConfirmEscrowPayer), ctx, id)
+}
+
+// ConfirmEscrowTx mocks base method.
+func (m *MockStore) ConfirmEscrowTx(ctx context.Context, arg database.EscrowActionTxParams) (database.EscrowTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmEscrowTx", ctx, arg)
+	ret0, _ := ret[0].(database.EscrowTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmEscrowTx indicates an expected call of ConfirmEscrowTx.
+func (mr *MockStoreMockRecorder) ConfirmEscrowTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmEscrowTx", reflect.TypeOf((*MockStore)(nil).ConfirmEscrowTx), ctx, arg)
+}
+
 // CreateAccount mocks base method.
 func (m *MockStore) CreateAccount(ctx context.Context, arg database.CreateAccountParams) (database.Account, error) {
 	m.ctrl.T.Helper()
@@ -51,232 +308,2501 @@ func (m *MockStore) CreateAccount(ctx context.Context, arg database.CreateAccoun
 	return ret0, ret1
 }
 
-// CreateAccount indicates an expected call of CreateAccount.
-func (mr *MockStoreMockRecorder) CreateAccount(ctx, arg any) *gomock.Call {
+// CreateAccount indicates an expected call of CreateAccount.
+func (mr *MockStoreMockRecorder) CreateAccount(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccount", reflect.TypeOf((*MockStore)(nil).CreateAccount), ctx, arg)
+}
+
+// CreateApprovalAuditEntry mocks base method.
+func (m *MockStore) CreateApprovalAuditEntry(ctx context.Context, arg database.CreateApprovalAuditEntryParams) (database.ApprovalAuditLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateApprovalAuditEntry", ctx, arg)
+	ret0, _ := ret[0].(database.ApprovalAuditLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateApprovalAuditEntry indicates an expected call of CreateApprovalAuditEntry.
+func (mr *MockStoreMockRecorder) CreateApprovalAuditEntry(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateApprovalAuditEntry", reflect.TypeOf((*MockStore)(nil).CreateApprovalAuditEntry), ctx, arg)
+}
+
+// CreateEntries mocks base method.
+func (m *MockStore) CreateEntries(ctx context.Context, arg database.CreateEntriesParams) (database.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEntries", ctx, arg)
+	ret0, _ := ret[0].(database.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEntries indicates an expected call of CreateEntries.
+func (mr *MockStoreMockRecorder) CreateEntries(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEntries", reflect.TypeOf((*MockStore)(nil).CreateEntries), ctx, arg)
+}
+
+// CreateEscrow mocks base method.
+func (m *MockStore) CreateEscrow(ctx context.Context, arg database.CreateEscrowParams) (database.Escrow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEscrow", ctx, arg)
+	ret0, _ := ret[0].(database.Escrow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEscrow indicates an expected call of CreateEscrow.
+func (mr *MockStoreMockRecorder) CreateEscrow(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEscrow", reflect.TypeOf((*MockStore)(nil).CreateEscrow), ctx, arg)
+}
+
+// CreateEscrowAccount mocks base method.
+func (m *MockStore) CreateEscrowAccount(ctx context.Context, currency string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEscrowAccount", ctx, currency)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateEscrowAccount indicates an expected call of CreateEscrowAccount.
+func (mr *MockStoreMockRecorder) CreateEscrowAccount(ctx, currency any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEscrowAccount", reflect.TypeOf((*MockStore)(nil).CreateEscrowAccount), ctx, currency)
+}
+
+// CreateEscrowEvent mocks base method.
+func (m *MockStore) CreateEscrowEvent(ctx context.Context, arg database.CreateEscrowEventParams) (database.EscrowEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEscrowEvent", ctx, arg)
+	ret0, _ := ret[0].(database.EscrowEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEscrowEvent indicates an expected call of CreateEscrowEvent.
+func (mr *MockStoreMockRecorder) CreateEscrowEvent(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEscrowEvent", reflect.TypeOf((*MockStore)(nil).CreateEscrowEvent), ctx, arg)
+}
+
+// CreateEscrowTx mocks base method.
+func (m *MockStore) CreateEscrowTx(ctx context.Context, arg database.CreateEscrowTxParams) (database.EscrowTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEscrowTx", ctx, arg)
+	ret0, _ := ret[0].(database.EscrowTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEscrowTx indicates an expected call of CreateEscrowTx.
+func (mr *MockStoreMockRecorder) CreateEscrowTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEscrowTx", reflect.TypeOf((*MockStore)(nil).CreateEscrowTx), ctx, arg)
+}
+
+// CreateFeeRule mocks base method.
+func (m *MockStore) CreateFeeRule(ctx context.Context, arg database.CreateFeeRuleParams) (database.FeeRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFeeRule", ctx, arg)
+	ret0, _ := ret[0].(database.FeeRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFeeRule indicates an expected call of CreateFeeRule.
+func (mr *MockStoreMockRecorder) CreateFeeRule(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFeeRule", reflect.TypeOf((*MockStore)(nil).CreateFeeRule), ctx, arg)
+}
+
+// CreateFeeSchedule mocks base method.
+func (m *MockStore) CreateFeeSchedule(ctx context.Context, arg database.CreateFeeScheduleParams) (database.FeeSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFeeSchedule", ctx, arg)
+	ret0, _ := ret[0].(database.FeeSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFeeSchedule indicates an expected call of CreateFeeSchedule.
+func (mr *MockStoreMockRecorder) CreateFeeSchedule(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFeeSchedule", reflect.TypeOf((*MockStore)(nil).CreateFeeSchedule), ctx, arg)
+}
+
+// CreateFeeScheduleTx mocks base method.
+func (m *MockStore) CreateFeeScheduleTx(ctx context.Context, arg database.CreateFeeScheduleTxParams) (database.FeeScheduleResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFeeScheduleTx", ctx, arg)
+	ret0, _ := ret[0].(database.FeeScheduleResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFeeScheduleTx indicates an expected call of CreateFeeScheduleTx.
+func (mr *MockStoreMockRecorder) CreateFeeScheduleTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFeeScheduleTx", reflect.TypeOf((*MockStore)(nil).CreateFeeScheduleTx), ctx, arg)
+}
+
+// CreateFeesAccount mocks base method.
+func (m *MockStore) CreateFeesAccount(ctx context.Context, currency string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFeesAccount", ctx, currency)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateFeesAccount indicates an expected call of CreateFeesAccount.
+func (mr *MockStoreMockRecorder) CreateFeesAccount(ctx, currency any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFeesAccount", reflect.TypeOf((*MockStore)(nil).CreateFeesAccount), ctx, currency)
+}
+
+// CreateFundingTransaction mocks base method.
+func (m *MockStore) CreateFundingTransaction(ctx context.Context, arg database.CreateFundingTransactionParams) (database.FundingTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFundingTransaction", ctx, arg)
+	ret0, _ := ret[0].(database.FundingTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFundingTransaction indicates an expected call of CreateFundingTransaction.
+func (mr *MockStoreMockRecorder) CreateFundingTransaction(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFundingTransaction", reflect.TypeOf((*MockStore)(nil).CreateFundingTransaction), ctx, arg)
+}
+
+// CreateFundingTx mocks base method.
+func (m *MockStore) CreateFundingTx(ctx context.Context, arg database.FundingTxParams) (database.FundingTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFundingTx", ctx, arg)
+	ret0, _ := ret[0].(database.FundingTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFundingTx indicates an expected call of CreateFundingTx.
+func (mr *MockStoreMockRecorder) CreateFundingTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFundingTx", reflect.TypeOf((*MockStore)(nil).CreateFundingTx), ctx, arg)
+}
+
+// CreateFxPositionAccount mocks base method.
+func (m *MockStore) CreateFxPositionAccount(ctx context.Context, currency string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFxPositionAccount", ctx, currency)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateFxPositionAccount indicates an expected call of CreateFxPositionAccount.
+func (mr *MockStoreMockRecorder) CreateFxPositionAccount(ctx, currency any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFxPositionAccount", reflect.TypeOf((*MockStore)(nil).CreateFxPositionAccount), ctx, currency)
+}
+
+// CreateFxQuote mocks base method.
+func (m *MockStore) CreateFxQuote(ctx context.Context, arg database.CreateFxQuoteParams) (database.FxQuote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFxQuote", ctx, arg)
+	ret0, _ := ret[0].(database.FxQuote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFxQuote indicates an expected call of CreateFxQuote.
+func (mr *MockStoreMockRecorder) CreateFxQuote(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFxQuote", reflect.TypeOf((*MockStore)(nil).CreateFxQuote), ctx, arg)
+}
+
+// CreateFxTransfer mocks base method.
+func (m *MockStore) CreateFxTransfer(ctx context.Context, arg database.CreateFxTransferParams) (database.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFxTransfer", ctx, arg)
+	ret0, _ := ret[0].(database.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFxTransfer indicates an expected call of CreateFxTransfer.
+func (mr *MockStoreMockRecorder) CreateFxTransfer(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFxTransfer", reflect.TypeOf((*MockStore)(nil).CreateFxTransfer), ctx, arg)
+}
+
+// CreateHold mocks base method.
+func (m *MockStore) CreateHold(ctx context.Context, arg database.CreateHoldParams) (database.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateHold", ctx, arg)
+	ret0, _ := ret[0].(database.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateHold indicates an expected call of CreateHold.
+func (mr *MockStoreMockRecorder) CreateHold(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateHold", reflect.TypeOf((*MockStore)(nil).CreateHold), ctx, arg)
+}
+
+// CreateHoldTx mocks base method.
+func (m *MockStore) CreateHoldTx(ctx context.Context, arg database.CreateHoldTxParams) (database.HoldTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateHoldTx", ctx, arg)
+	ret0, _ := ret[0].(database.HoldTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateHoldTx indicates an expected call of CreateHoldTx.
+func (mr *MockStoreMockRecorder) CreateHoldTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateHoldTx", reflect.TypeOf((*MockStore)(nil).CreateHoldTx), ctx, arg)
+}
+
+// CreateInterestAccrual mocks base method.
+func (m *MockStore) CreateInterestAccrual(ctx context.Context, arg database.CreateInterestAccrualParams) (database.InterestAccrual, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInterestAccrual", ctx, arg)
+	ret0, _ := ret[0].(database.InterestAccrual)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateInterestAccrual indicates an expected call of CreateInterestAccrual.
+func (mr *MockStoreMockRecorder) CreateInterestAccrual(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInterestAccrual", reflect.TypeOf((*MockStore)(nil).CreateInterestAccrual), ctx, arg)
+}
+
+// CreateInterestExpenseAccount mocks base method.
+func (m *MockStore) CreateInterestExpenseAccount(ctx context.Context, currency string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInterestExpenseAccount", ctx, currency)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateInterestExpenseAccount indicates an expected call of CreateInterestExpenseAccount.
+func (mr *MockStoreMockRecorder) CreateInterestExpenseAccount(ctx, currency any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInterestExpenseAccount", reflect.TypeOf((*MockStore)(nil).CreateInterestExpenseAccount), ctx, currency)
+}
+
+// CreateInterestPayout mocks base method.
+func (m *MockStore) CreateInterestPayout(ctx context.Context, arg database.CreateInterestPayoutParams) (database.InterestPayout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInterestPayout", ctx, arg)
+	ret0, _ := ret[0].(database.InterestPayout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateInterestPayout indicates an expected call of CreateInterestPayout.
+func (mr *MockStoreMockRecorder) CreateInterestPayout(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInterestPayout", reflect.TypeOf((*MockStore)(nil).CreateInterestPayout), ctx, arg)
+}
+
+// CreateInterestProduct mocks base method.
+func (m *MockStore) CreateInterestProduct(ctx context.Context, arg database.CreateInterestProductParams) (database.InterestProduct, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInterestProduct", ctx, arg)
+	ret0, _ := ret[0].(database.InterestProduct)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateInterestProduct indicates an expected call of CreateInterestProduct.
+func (mr *MockStoreMockRecorder) CreateInterestProduct(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInterestProduct", reflect.TypeOf((*MockStore)(nil).CreateInterestProduct), ctx, arg)
+}
+
+// CreateInterestRun mocks base method.
+func (m *MockStore) CreateInterestRun(ctx context.Context, arg database.CreateInterestRunParams) (database.InterestRun, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInterestRun", ctx, arg)
+	ret0, _ := ret[0].(database.InterestRun)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateInterestRun indicates an expected call of CreateInterestRun.
+func (mr *MockStoreMockRecorder) CreateInterestRun(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInterestRun", reflect.TypeOf((*MockStore)(nil).CreateInterestRun), ctx, arg)
+}
+
+// CreateNotification mocks base method.
+func (m *MockStore) CreateNotification(ctx context.Context, arg database.CreateNotificationParams) (database.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNotification", ctx, arg)
+	ret0, _ := ret[0].(database.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNotification indicates an expected call of CreateNotification.
+func (mr *MockStoreMockRecorder) CreateNotification(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNotification", reflect.TypeOf((*MockStore)(nil).CreateNotification), ctx, arg)
+}
+
+// CreateOrganisation mocks base method.
+func (m *MockStore) CreateOrganisation(ctx context.Context, arg database.CreateOrganisationParams) (database.Organisation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrganisation", ctx, arg)
+	ret0, _ := ret[0].(database.Organisation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrganisation indicates an expected call of CreateOrganisation.
+func (mr *MockStoreMockRecorder) CreateOrganisation(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganisation", reflect.TypeOf((*MockStore)(nil).CreateOrganisation), ctx, arg)
+}
+
+// CreateOverdraftInterestAccount mocks base method.
+func (m *MockStore) CreateOverdraftInterestAccount(ctx context.Context, currency string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOverdraftInterestAccount", ctx, currency)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOverdraftInterestAccount indicates an expected call of CreateOverdraftInterestAccount.
+func (mr *MockStoreMockRecorder) CreateOverdraftInterestAccount(ctx, currency any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOverdraftInterestAccount", reflect.TypeOf((*MockStore)(nil).CreateOverdraftInterestAccount), ctx, currency)
+}
+
+// CreateOverdraftInterestCharge mocks base method.
+func (m *MockStore) CreateOverdraftInterestCharge(ctx context.Context, arg database.CreateOverdraftInterestChargeParams) (database.OverdraftInterestCharge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOverdraftInterestCharge", ctx, arg)
+	ret0, _ := ret[0].(database.OverdraftInterestCharge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOverdraftInterestCharge indicates an expected call of CreateOverdraftInterestCharge.
+func (mr *MockStoreMockRecorder) CreateOverdraftInterestCharge(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOverdraftInterestCharge", reflect.TypeOf((*MockStore)(nil).CreateOverdraftInterestCharge), ctx, arg)
+}
+
+// CreatePaymentRequest mocks base method.
+func (m *MockStore) CreatePaymentRequest(ctx context.Context, arg database.CreatePaymentRequestParams) (database.PaymentRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePaymentRequest", ctx, arg)
+	ret0, _ := ret[0].(database.PaymentRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePaymentRequest indicates an expected call of CreatePaymentRequest.
+func (mr *MockStoreMockRecorder) CreatePaymentRequest(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePaymentRequest", reflect.TypeOf((*MockStore)(nil).CreatePaymentRequest), ctx, arg)
+}
+
+// CreatePaymentRequestTx mocks base method.
+func (m *MockStore) CreatePaymentRequestTx(ctx context.Context, arg database.CreatePaymentRequestParams) (database.PaymentRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePaymentRequestTx", ctx, arg)
+	ret0, _ := ret[0].(database.PaymentRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePaymentRequestTx indicates an expected call of CreatePaymentRequestTx.
+func (mr *MockStoreMockRecorder) CreatePaymentRequestTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePaymentRequestTx", reflect.TypeOf((*MockStore)(nil).CreatePaymentRequestTx), ctx, arg)
+}
+
+// CreatePendingTransfer mocks base method.
+func (m *MockStore) CreatePendingTransfer(ctx context.Context, arg database.CreatePendingTransferParams) (database.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePendingTransfer", ctx, arg)
+	ret0, _ := ret[0].(database.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePendingTransfer indicates an expected call of CreatePendingTransfer.
+func (mr *MockStoreMockRecorder) CreatePendingTransfer(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePendingTransfer", reflect.TypeOf((*MockStore)(nil).CreatePendingTransfer), ctx, arg)
+}
+
+// CreateScheduledTransfer mocks base method.
+func (m *MockStore) CreateScheduledTransfer(ctx context.Context, arg database.CreateScheduledTransferParams) (database.ScheduledTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateScheduledTransfer", ctx, arg)
+	ret0, _ := ret[0].(database.ScheduledTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateScheduledTransfer indicates an expected call of CreateScheduledTransfer.
+func (mr *MockStoreMockRecorder) CreateScheduledTransfer(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateScheduledTransfer", reflect.TypeOf((*MockStore)(nil).CreateScheduledTransfer), ctx, arg)
+}
+
+// CreateScheduledTransferRun mocks base method.
+func (m *MockStore) CreateScheduledTransferRun(ctx context.Context, arg database.CreateScheduledTransferRunParams) (database.ScheduledTransferRun, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateScheduledTransferRun", ctx, arg)
+	ret0, _ := ret[0].(database.ScheduledTransferRun)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateScheduledTransferRun indicates an expected call of CreateScheduledTransferRun.
+func (mr *MockStoreMockRecorder) CreateScheduledTransferRun(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateScheduledTransferRun", reflect.TypeOf((*MockStore)(nil).CreateScheduledTransferRun), ctx, arg)
+}
+
+// CreateSettlementAccount mocks base method.
+func (m *MockStore) CreateSettlementAccount(ctx context.Context, currency string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSettlementAccount", ctx, currency)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSettlementAccount indicates an expected call of CreateSettlementAccount.
+func (mr *MockStoreMockRecorder) CreateSettlementAccount(ctx, currency any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSettlementAccount", reflect.TypeOf((*MockStore)(nil).CreateSettlementAccount), ctx, currency)
+}
+
+// CreateTransfer mocks base method.
+func (m *MockStore) CreateTransfer(ctx context.Context, arg database.CreateTransferParams) (database.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransfer", ctx, arg)
+	ret0, _ := ret[0].(database.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTransfer indicates an expected call of CreateTransfer.
+func (mr *MockStoreMockRecorder) CreateTransfer(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransfer", reflect.TypeOf((*MockStore)(nil).CreateTransfer), ctx, arg)
+}
+
+// CreateTransferApproval mocks base method.
+func (m *MockStore) CreateTransferApproval(ctx context.Context, arg database.CreateTransferApprovalParams) (database.TransferApproval, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransferApproval", ctx, arg)
+	ret0, _ := ret[0].(database.TransferApproval)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTransferApproval indicates an expected call of CreateTransferApproval.
+func (mr *MockStoreMockRecorder) CreateTransferApproval(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransferApproval", reflect.TypeOf((*MockStore)(nil).CreateTransferApproval), ctx, arg)
+}
+
+// CreateTransferApprovalTx mocks base method.
+func (m *MockStore) CreateTransferApprovalTx(ctx context.Context, arg database.CreateTransferApprovalTxParams) (database.TransferApprovalTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransferApprovalTx", ctx, arg)
+	ret0, _ := ret[0].(database.TransferApprovalTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTransferApprovalTx indicates an expected call of CreateTransferApprovalTx.
+func (mr *MockStoreMockRecorder) CreateTransferApprovalTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransferApprovalTx", reflect.TypeOf((*MockStore)(nil).CreateTransferApprovalTx), ctx, arg)
+}
+
+// CreateTransferBatch mocks base method.
+func (m *MockStore) CreateTransferBatch(ctx context.Context, arg database.CreateTransferBatchParams) (database.TransferBatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransferBatch", ctx, arg)
+	ret0, _ := ret[0].(database.TransferBatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTransferBatch indicates an expected call of CreateTransferBatch.
+func (mr *MockStoreMockRecorder) CreateTransferBatch(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransferBatch", reflect.TypeOf((*MockStore)(nil).CreateTransferBatch), ctx, arg)
+}
+
+// CreateTransferBatchItem mocks base method.
+func (m *MockStore) CreateTransferBatchItem(ctx context.Context, arg database.CreateTransferBatchItemParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransferBatchItem", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateTransferBatchItem indicates an expected call of CreateTransferBatchItem.
+func (mr *MockStoreMockRecorder) CreateTransferBatchItem(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransferBatchItem", reflect.TypeOf((*MockStore)(nil).CreateTransferBatchItem), ctx, arg)
+}
+
+// CreateTransferBatchTx mocks base method.
+func (m *MockStore) CreateTransferBatchTx(ctx context.Context, arg database.CreateTransferBatchTxParams) (database.TransferBatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransferBatchTx", ctx, arg)
+	ret0, _ := ret[0].(database.TransferBatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTransferBatchTx indicates an expected call of CreateTransferBatchTx.
+func (mr *MockStoreMockRecorder) CreateTransferBatchTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransferBatchTx", reflect.TypeOf((*MockStore)(nil).CreateTransferBatchTx), ctx, arg)
+}
+
+// CreateUsers mocks base method.
+func (m *MockStore) CreateUsers(ctx context.Context, arg database.CreateUsersParams) (database.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUsers", ctx, arg)
+	ret0, _ := ret[0].(database.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUsers indicates an expected call of CreateUsers.
+func (mr *MockStoreMockRecorder) CreateUsers(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUsers", reflect.TypeOf((*MockStore)(nil).CreateUsers), ctx, arg)
+}
+
+// DeclinePaymentRequestTx mocks base method.
+func (m *MockStore) DeclinePaymentRequestTx(ctx context.Context, requestID uuid.UUID) (database.PaymentRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeclinePaymentRequestTx", ctx, requestID)
+	ret0, _ := ret[0].(database.PaymentRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeclinePaymentRequestTx indicates an expected call of DeclinePaymentRequestTx.
+func (mr *MockStoreMockRecorder) DeclinePaymentRequestTx(ctx, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeclinePaymentRequestTx", reflect.TypeOf((*MockStore)(nil).DeclinePaymentRequestTx), ctx, requestID)
+}
+
+// DeleteAccount mocks base method.
+func (m *MockStore) DeleteAccount(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAccount", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAccount indicates an expected call of DeleteAccount.
+func (mr *MockStoreMockRecorder) DeleteAccount(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccount", reflect.TypeOf((*MockStore)(nil).DeleteAccount), ctx, id)
+}
+
+// DeleteEntry mocks base method.
+func (m *MockStore) DeleteEntry(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEntry", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEntry indicates an expected call of DeleteEntry.
+func (mr *MockStoreMockRecorder) DeleteEntry(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEntry", reflect.TypeOf((*MockStore)(nil).DeleteEntry), ctx, id)
+}
+
+// DeleteTransfer mocks base method.
+func (m *MockStore) DeleteTransfer(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTransfer", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTransfer indicates an expected call of DeleteTransfer.
+func (mr *MockStoreMockRecorder) DeleteTransfer(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTransfer", reflect.TypeOf((*MockStore)(nil).DeleteTransfer), ctx, id)
+}
+
+// DeleteTransferLimit mocks base method.
+func (m *MockStore) DeleteTransferLimit(ctx context.Context, id uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTransferLimit", ctx, id)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTransferLimit indicates an expected call of DeleteTransferLimit.
+func (mr *MockStoreMockRecorder) DeleteTransferLimit(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTransferLimit", reflect.TypeOf((*MockStore)(nil).DeleteTransferLimit), ctx, id)
+}
+
+// DisputeEscrowTx mocks base method.
+func (m *MockStore) DisputeEscrowTx(ctx context.Context, arg database.EscrowActionTxParams) (database.EscrowTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisputeEscrowTx", ctx, arg)
+	ret0, _ := ret[0].(database.EscrowTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisputeEscrowTx indicates an expected call of DisputeEscrowTx.
+func (mr *MockStoreMockRecorder) DisputeEscrowTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisputeEscrowTx", reflect.TypeOf((*MockStore)(nil).DisputeEscrowTx), ctx, arg)
+}
+
+// ExecuteScheduledTransferTx mocks base method.
+func (m *MockStore) ExecuteScheduledTransferTx(ctx context.Context, arg database.ExecuteScheduledTransferTxParams) (database.ExecuteScheduledTransferTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteScheduledTransferTx", ctx, arg)
+	ret0, _ := ret[0].(database.ExecuteScheduledTransferTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteScheduledTransferTx indicates an expected call of ExecuteScheduledTransferTx.
+func (mr *MockStoreMockRecorder) ExecuteScheduledTransferTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteScheduledTransferTx", reflect.TypeOf((*MockStore)(nil).ExecuteScheduledTransferTx), ctx, arg)
+}
+
+// ExecuteTransferBatchChunkTx mocks base method.
+func (m *MockStore) ExecuteTransferBatchChunkTx(ctx context.Context, batchID uuid.UUID, chunkSize int32) (database.TransferBatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteTransferBatchChunkTx", ctx, batchID, chunkSize)
+	ret0, _ := ret[0].(database.TransferBatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteTransferBatchChunkTx indicates an expected call of ExecuteTransferBatchChunkTx.
+func (mr *MockStoreMockRecorder) ExecuteTransferBatchChunkTx(ctx, batchID, chunkSize any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteTransferBatchChunkTx", reflect.TypeOf((*MockStore)(nil).ExecuteTransferBatchChunkTx), ctx, batchID, chunkSize)
+}
+
+// ExpireEscrowsTx mocks base method.
+func (m *MockStore) ExpireEscrowsTx(ctx context.Context, now time.Time, limit int32) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireEscrowsTx", ctx, now, limit)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireEscrowsTx indicates an expected call of ExpireEscrowsTx.
+func (mr *MockStoreMockRecorder) ExpireEscrowsTx(ctx, now, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireEscrowsTx", reflect.TypeOf((*MockStore)(nil).ExpireEscrowsTx), ctx, now, limit)
+}
+
+// ExpireHoldsTx mocks base method.
+func (m *MockStore) ExpireHoldsTx(ctx context.Context, now time.Time, limit int32) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireHoldsTx", ctx, now, limit)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireHoldsTx indicates an expected call of ExpireHoldsTx.
+func (mr *MockStoreMockRecorder) ExpireHoldsTx(ctx, now, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireHoldsTx", reflect.TypeOf((*MockStore)(nil).ExpireHoldsTx), ctx, now, limit)
+}
+
+// ExpireTransferApprovalsTx mocks base method.
+func (m *MockStore) ExpireTransferApprovalsTx(ctx context.Context, now time.Time, limit int32) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireTransferApprovalsTx", ctx, now, limit)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireTransferApprovalsTx indicates an expected call of ExpireTransferApprovalsTx.
+func (mr *MockStoreMockRecorder) ExpireTransferApprovalsTx(ctx, now, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireTransferApprovalsTx", reflect.TypeOf((*MockStore)(nil).ExpireTransferApprovalsTx), ctx, now, limit)
+}
+
+// FailFundingTx mocks base method.
+func (m *MockStore) FailFundingTx(ctx context.Context, id uuid.UUID, reason string) (database.FundingTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailFundingTx", ctx, id, reason)
+	ret0, _ := ret[0].(database.FundingTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FailFundingTx indicates an expected call of FailFundingTx.
+func (mr *MockStoreMockRecorder) FailFundingTx(ctx, id, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailFundingTx", reflect.TypeOf((*MockStore)(nil).FailFundingTx), ctx, id, reason)
+}
+
+// FailPendingTransferBatchItems mocks base method.
+func (m *MockStore) FailPendingTransferBatchItems(ctx context.Context, arg database.FailPendingTransferBatchItemsParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailPendingTransferBatchItems", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FailPendingTransferBatchItems indicates an expected call of FailPendingTransferBatchItems.
+func (mr *MockStoreMockRecorder) FailPendingTransferBatchItems(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailPendingTransferBatchItems", reflect.TypeOf((*MockStore)(nil).FailPendingTransferBatchItems), ctx, arg)
+}
+
+// FailTransfer mocks base method.
+func (m *MockStore) FailTransfer(ctx context.Context, arg database.FailTransferParams) (database.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailTransfer", ctx, arg)
+	ret0, _ := ret[0].(database.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FailTransfer indicates an expected call of FailTransfer.
+func (mr *MockStoreMockRecorder) FailTransfer(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailTransfer", reflect.TypeOf((*MockStore)(nil).FailTransfer), ctx, arg)
+}
+
+// FinishEscrow mocks base method.
+func (m *MockStore) FinishEscrow(ctx context.Context, arg database.FinishEscrowParams) (database.Escrow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishEscrow", ctx, arg)
+	ret0, _ := ret[0].(database.Escrow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FinishEscrow indicates an expected call of FinishEscrow.
+func (mr *MockStoreMockRecorder) FinishEscrow(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishEscrow", reflect.TypeOf((*MockStore)(nil).FinishEscrow), ctx, arg)
+}
+
+// FinishHold mocks base method.
+func (m *MockStore) FinishHold(ctx context.Context, arg database.FinishHoldParams) (database.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishHold", ctx, arg)
+	ret0, _ := ret[0].(database.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FinishHold indicates an expected call of FinishHold.
+func (mr *MockStoreMockRecorder) FinishHold(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishHold", reflect.TypeOf((*MockStore)(nil).FinishHold), ctx, arg)
+}
+
+// FinishPaymentRequest mocks base method.
+func (m *MockStore) FinishPaymentRequest(ctx context.Context, arg database.FinishPaymentRequestParams) (database.PaymentRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishPaymentRequest", ctx, arg)
+	ret0, _ := ret[0].(database.PaymentRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FinishPaymentRequest indicates an expected call of FinishPaymentRequest.
+func (mr *MockStoreMockRecorder) FinishPaymentRequest(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishPaymentRequest", reflect.TypeOf((*MockStore)(nil).FinishPaymentRequest), ctx, arg)
+}
+
+// FinishScheduledTransferRun mocks base method.
+func (m *MockStore) FinishScheduledTransferRun(ctx context.Context, arg database.FinishScheduledTransferRunParams) (database.ScheduledTransferRun, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishScheduledTransferRun", ctx, arg)
+	ret0, _ := ret[0].(database.ScheduledTransferRun)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FinishScheduledTransferRun indicates an expected call of FinishScheduledTransferRun.
+func (mr *MockStoreMockRecorder) FinishScheduledTransferRun(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishScheduledTransferRun", reflect.TypeOf((*MockStore)(nil).FinishScheduledTransferRun), ctx, arg)
+}
+
+// FinishTransferApproval mocks base method.
+func (m *MockStore) FinishTransferApproval(ctx context.Context, arg database.FinishTransferApprovalParams) (database.TransferApproval, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishTransferApproval", ctx, arg)
+	ret0, _ := ret[0].(database.TransferApproval)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FinishTransferApproval indicates an expected call of FinishTransferApproval.
+func (mr *MockStoreMockRecorder) FinishTransferApproval(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishTransferApproval", reflect.TypeOf((*MockStore)(nil).FinishTransferApproval), ctx, arg)
+}
+
+// FinishTransferBatch mocks base method.
+func (m *MockStore) FinishTransferBatch(ctx context.Context, arg database.FinishTransferBatchParams) (database.TransferBatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishTransferBatch", ctx, arg)
+	ret0, _ := ret[0].(database.TransferBatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FinishTransferBatch indicates an expected call of FinishTransferBatch.
+func (mr *MockStoreMockRecorder) FinishTransferBatch(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishTransferBatch", reflect.TypeOf((*MockStore)(nil).FinishTransferBatch), ctx, arg)
+}
+
+// FinishTransferBatchItem mocks base method.
+func (m *MockStore) FinishTransferBatchItem(ctx context.Context, arg database.FinishTransferBatchItemParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishTransferBatchItem", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FinishTransferBatchItem indicates an expected call of FinishTransferBatchItem.
+func (mr *MockStoreMockRecorder) FinishTransferBatchItem(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishTransferBatchItem", reflect.TypeOf((*MockStore)(nil).FinishTransferBatchItem), ctx, arg)
+}
+
+// FxTransferTx mocks base method.
+func (m *MockStore) FxTransferTx(ctx context.Context, arg database.FxTransferTxParams) (database.FxTransferTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FxTransferTx", ctx, arg)
+	ret0, _ := ret[0].(database.FxTransferTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FxTransferTx indicates an expected call of FxTransferTx.
+func (mr *MockStoreMockRecorder) FxTransferTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FxTransferTx", reflect.TypeOf((*MockStore)(nil).FxTransferTx), ctx, arg)
+}
+
+// GetAccount mocks base method.
+func (m *MockStore) GetAccount(ctx context.Context, id uuid.UUID) (database.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccount", ctx, id)
+	ret0, _ := ret[0].(database.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccount indicates an expected call of GetAccount.
+func (mr *MockStoreMockRecorder) GetAccount(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccount", reflect.TypeOf((*MockStore)(nil).GetAccount), ctx, id)
+}
+
+// GetAccountBalanceAt mocks base method.
+func (m *MockStore) GetAccountBalanceAt(ctx context.Context, arg database.GetAccountBalanceAtParams) (decimal.Decimal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountBalanceAt", ctx, arg)
+	ret0, _ := ret[0].(decimal.Decimal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountBalanceAt indicates an expected call of GetAccountBalanceAt.
+func (mr *MockStoreMockRecorder) GetAccountBalanceAt(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountBalanceAt", reflect.TypeOf((*MockStore)(nil).GetAccountBalanceAt), ctx, arg)
+}
+
+// GetAccountByIdForUpdate mocks base method.
+func (m *MockStore) GetAccountByIdForUpdate(ctx context.Context, id uuid.UUID) (database.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountByIdForUpdate", ctx, id)
+	ret0, _ := ret[0].(database.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountByIdForUpdate indicates an expected call of GetAccountByIdForUpdate.
+func (mr *MockStoreMockRecorder) GetAccountByIdForUpdate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountByIdForUpdate", reflect.TypeOf((*MockStore)(nil).GetAccountByIdForUpdate), ctx, id)
+}
+
+// GetAccountOutgoingUsage mocks base method.
+func (m *MockStore) GetAccountOutgoingUsage(ctx context.Context, arg database.GetAccountOutgoingUsageParams) (database.GetAccountOutgoingUsageRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountOutgoingUsage", ctx, arg)
+	ret0, _ := ret[0].(database.GetAccountOutgoingUsageRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountOutgoingUsage indicates an expected call of GetAccountOutgoingUsage.
+func (mr *MockStoreMockRecorder) GetAccountOutgoingUsage(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountOutgoingUsage", reflect.TypeOf((*MockStore)(nil).GetAccountOutgoingUsage), ctx, arg)
+}
+
+// GetAccountTransferLimit mocks base method.
+func (m *MockStore) GetAccountTransferLimit(ctx context.Context, accountID uuid.NullUUID) (database.TransferLimit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountTransferLimit", ctx, accountID)
+	ret0, _ := ret[0].(database.TransferLimit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountTransferLimit indicates an expected call of GetAccountTransferLimit.
+func (mr *MockStoreMockRecorder) GetAccountTransferLimit(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountTransferLimit", reflect.TypeOf((*MockStore)(nil).GetAccountTransferLimit), ctx, accountID)
+}
+
+// GetAllUsers mocks base method.
+func (m *MockStore) GetAllUsers(ctx context.Context) ([]database.GetAllUsersRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllUsers", ctx)
+	ret0, _ := ret[0].([]database.GetAllUsersRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllUsers indicates an expected call of GetAllUsers.
+func (mr *MockStoreMockRecorder) GetAllUsers(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllUsers", reflect.TypeOf((*MockStore)(nil).GetAllUsers), ctx)
+}
+
+// GetApplicableFeeRule mocks base method.
+func (m *MockStore) GetApplicableFeeRule(ctx context.Context, arg database.GetApplicableFeeRuleParams) (database.FeeRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicableFeeRule", ctx, arg)
+	ret0, _ := ret[0].(database.FeeRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicableFeeRule indicates an expected call of GetApplicableFeeRule.
+func (mr *MockStoreMockRecorder) GetApplicableFeeRule(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicableFeeRule", reflect.TypeOf((*MockStore)(nil).GetApplicableFeeRule), ctx, arg)
+}
+
+// GetCurrency mocks base method.
+func (m *MockStore) GetCurrency(ctx context.Context, code string) (database.Currency, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCurrency", ctx, code)
+	ret0, _ := ret[0].(database.Currency)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCurrency indicates an expected call of GetCurrency.
+func (mr *MockStoreMockRecorder) GetCurrency(ctx, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrency", reflect.TypeOf((*MockStore)(nil).GetCurrency), ctx, code)
+}
+
+// GetCurrentFeeSchedule mocks base method.
+func (m *MockStore) GetCurrentFeeSchedule(ctx context.Context, currency string) (database.FeeSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCurrentFeeSchedule", ctx, currency)
+	ret0, _ := ret[0].(database.FeeSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCurrentFeeSchedule indicates an expected call of GetCurrentFeeSchedule.
+func (mr *MockStoreMockRecorder) GetCurrentFeeSchedule(ctx, currency any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentFeeSchedule", reflect.TypeOf((*MockStore)(nil).GetCurrentFeeSchedule), ctx, currency)
+}
+
+// GetEntry mocks base method.
+func (m *MockStore) GetEntry(ctx context.Context, id uuid.UUID) (database.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEntry", ctx, id)
+	ret0, _ := ret[0].(database.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEntry indicates an expected call of GetEntry.
+func (mr *MockStoreMockRecorder) GetEntry(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntry", reflect.TypeOf((*MockStore)(nil).GetEntry), ctx, id)
+}
+
+// GetEscrow mocks base method.
+func (m *MockStore) GetEscrow(ctx context.Context, id uuid.UUID) (database.Escrow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEscrow", ctx, id)
+	ret0, _ := ret[0].(database.Escrow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEscrow indicates an expected call of GetEscrow.
+func (mr *MockStoreMockRecorder) GetEscrow(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEscrow", reflect.TypeOf((*MockStore)(nil).GetEscrow), ctx, id)
+}
+
+// GetEscrowAccount mocks base method.
+func (m *MockStore) GetEscrowAccount(ctx context.Context, currency string) (database.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEscrowAccount", ctx, currency)
+	ret0, _ := ret[0].(database.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEscrowAccount indicates an expected call of GetEscrowAccount.
+func (mr *MockStoreMockRecorder) GetEscrowAccount(ctx, currency any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEscrowAccount", reflect.TypeOf((*MockStore)(nil).GetEscrowAccount), ctx, currency)
+}
+
+// GetEscrowForUpdate mocks base method.
+func (m *MockStore) GetEscrowForUpdate(ctx context.Context, id uuid.UUID) (database.Escrow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEscrowForUpdate", ctx, id)
+	ret0, _ := ret[0].(database.Escrow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEscrowForUpdate indicates an expected call of GetEscrowForUpdate.
+func (mr *MockStoreMockRecorder) GetEscrowForUpdate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEscrowForUpdate", reflect.TypeOf((*MockStore)(nil).GetEscrowForUpdate), ctx, id)
+}
+
+// GetFeeScheduleByVersion mocks base method.
+func (m *MockStore) GetFeeScheduleByVersion(ctx context.Context, arg database.GetFeeScheduleByVersionParams) (database.FeeSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeeScheduleByVersion", ctx, arg)
+	ret0, _ := ret[0].(database.FeeSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeeScheduleByVersion indicates an expected call of GetFeeScheduleByVersion.
+func (mr *MockStoreMockRecorder) GetFeeScheduleByVersion(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeeScheduleByVersion", reflect.TypeOf((*MockStore)(nil).GetFeeScheduleByVersion), ctx, arg)
+}
+
+// GetFeesAccount mocks base method.
+func (m *MockStore) GetFeesAccount(ctx context.Context, currency string) (database.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeesAccount", ctx, currency)
+	ret0, _ := ret[0].(database.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeesAccount indicates an expected call of GetFeesAccount.
+func (mr *MockStoreMockRecorder) GetFeesAccount(ctx, currency any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeesAccount", reflect.TypeOf((*MockStore)(nil).GetFeesAccount), ctx, currency)
+}
+
+// GetFundingTransaction mocks base method.
+func (m *MockStore) GetFundingTransaction(ctx context.Context, id uuid.UUID) (database.FundingTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFundingTransaction", ctx, id)
+	ret0, _ := ret[0].(database.FundingTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFundingTransaction indicates an expected call of GetFundingTransaction.
+func (mr *MockStoreMockRecorder) GetFundingTransaction(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFundingTransaction", reflect.TypeOf((*MockStore)(nil).GetFundingTransaction), ctx, id)
+}
+
+// GetFundingTransactionForUpdate mocks base method.
+func (m *MockStore) GetFundingTransactionForUpdate(ctx context.Context, id uuid.UUID) (database.FundingTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFundingTransactionForUpdate", ctx, id)
+	ret0, _ := ret[0].(database.FundingTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFundingTransactionForUpdate indicates an expected call of GetFundingTransactionForUpdate.
+func (mr *MockStoreMockRecorder) GetFundingTransactionForUpdate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFundingTransactionForUpdate", reflect.TypeOf((*MockStore)(nil).GetFundingTransactionForUpdate), ctx, id)
+}
+
+// GetFxPositionAccount mocks base method.
+func (m *MockStore) GetFxPositionAccount(ctx context.Context, currency string) (database.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFxPositionAccount", ctx, currency)
+	ret0, _ := ret[0].(database.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFxPositionAccount indicates an expected call of GetFxPositionAccount.
+func (mr *MockStoreMockRecorder) GetFxPositionAccount(ctx, currency any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFxPositionAccount", reflect.TypeOf((*MockStore)(nil).GetFxPositionAccount), ctx, currency)
+}
+
+// GetFxQuote mocks base method.
+func (m *MockStore) GetFxQuote(ctx context.Context, id uuid.UUID) (database.FxQuote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFxQuote", ctx, id)
+	ret0, _ := ret[0].(database.FxQuote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFxQuote indicates an expected call of GetFxQuote.
+func (mr *MockStoreMockRecorder) GetFxQuote(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFxQuote", reflect.TypeOf((*MockStore)(nil).GetFxQuote), ctx, id)
+}
+
+// GetFxQuoteForUpdate mocks base method.
+func (m *MockStore) GetFxQuoteForUpdate(ctx context.Context, id uuid.UUID) (database.FxQuote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFxQuoteForUpdate", ctx, id)
+	ret0, _ := ret[0].(database.FxQuote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFxQuoteForUpdate indicates an expected call of GetFxQuoteForUpdate.
+func (mr *MockStoreMockRecorder) GetFxQuoteForUpdate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFxQuoteForUpdate", reflect.TypeOf((*MockStore)(nil).GetFxQuoteForUpdate), ctx, id)
+}
+
+// GetHold mocks base method.
+func (m *MockStore) GetHold(ctx context.Context, id uuid.UUID) (database.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHold", ctx, id)
+	ret0, _ := ret[0].(database.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHold indicates an expected call of GetHold.
+func (mr *MockStoreMockRecorder) GetHold(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHold", reflect.TypeOf((*MockStore)(nil).GetHold), ctx, id)
+}
+
+// GetHoldForUpdate mocks base method.
+func (m *MockStore) GetHoldForUpdate(ctx context.Context, id uuid.UUID) (database.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHoldForUpdate", ctx, id)
+	ret0, _ := ret[0].(database.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHoldForUpdate indicates an expected call of GetHoldForUpdate.
+func (mr *MockStoreMockRecorder) GetHoldForUpdate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHoldForUpdate", reflect.TypeOf((*MockStore)(nil).GetHoldForUpdate), ctx, id)
+}
+
+// GetInterestExpenseAccount mocks base method.
+func (m *MockStore) GetInterestExpenseAccount(ctx context.Context, currency string) (database.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInterestExpenseAccount", ctx, currency)
+	ret0, _ := ret[0].(database.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInterestExpenseAccount indicates an expected call of GetInterestExpenseAccount.
+func (mr *MockStoreMockRecorder) GetInterestExpenseAccount(ctx, currency any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInterestExpenseAccount", reflect.TypeOf((*MockStore)(nil).GetInterestExpenseAccount), ctx, currency)
+}
+
+// GetInterestProduct mocks base method.
+func (m *MockStore) GetInterestProduct(ctx context.Context, id uuid.UUID) (database.InterestProduct, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInterestProduct", ctx, id)
+	ret0, _ := ret[0].(database.InterestProduct)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInterestProduct indicates an expected call of GetInterestProduct.
+func (mr *MockStoreMockRecorder) GetInterestProduct(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInterestProduct", reflect.TypeOf((*MockStore)(nil).GetInterestProduct), ctx, id)
+}
+
+// GetLastInterestRun mocks base method.
+func (m *MockStore) GetLastInterestRun(ctx context.Context) (database.InterestRun, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastInterestRun", ctx)
+	ret0, _ := ret[0].(database.InterestRun)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastInterestRun indicates an expected call of GetLastInterestRun.
+func (mr *MockStoreMockRecorder) GetLastInterestRun(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastInterestRun", reflect.TypeOf((*MockStore)(nil).GetLastInterestRun), ctx)
+}
+
+// GetOrganisation mocks base method.
+func (m *MockStore) GetOrganisation(ctx context.Context, id uuid.UUID) (database.Organisation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganisation", ctx, id)
+	ret0, _ := ret[0].(database.Organisation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganisation indicates an expected call of GetOrganisation.
+func (mr *MockStoreMockRecorder) GetOrganisation(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganisation", reflect.TypeOf((*MockStore)(nil).GetOrganisation), ctx, id)
+}
+
+// GetOverdraftInterestAccount mocks base method.
+func (m *MockStore) GetOverdraftInterestAccount(ctx context.Context, currency string) (database.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOverdraftInterestAccount", ctx, currency)
+	ret0, _ := ret[0].(database.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOverdraftInterestAccount indicates an expected call of GetOverdraftInterestAccount.
+func (mr *MockStoreMockRecorder) GetOverdraftInterestAccount(ctx, currency any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOverdraftInterestAccount", reflect.TypeOf((*MockStore)(nil).GetOverdraftInterestAccount), ctx, currency)
+}
+
+// GetPaymentRequest mocks base method.
+func (m *MockStore) GetPaymentRequest(ctx context.Context, id uuid.UUID) (database.PaymentRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentRequest", ctx, id)
+	ret0, _ := ret[0].(database.PaymentRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentRequest indicates an expected call of GetPaymentRequest.
+func (mr *MockStoreMockRecorder) GetPaymentRequest(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentRequest", reflect.TypeOf((*MockStore)(nil).GetPaymentRequest), ctx, id)
+}
+
+// GetPaymentRequestForUpdate mocks base method.
+func (m *MockStore) GetPaymentRequestForUpdate(ctx context.Context, id uuid.UUID) (database.PaymentRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentRequestForUpdate", ctx, id)
+	ret0, _ := ret[0].(database.PaymentRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentRequestForUpdate indicates an expected call of GetPaymentRequestForUpdate.
+func (mr *MockStoreMockRecorder) GetPaymentRequestForUpdate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentRequestForUpdate", reflect.TypeOf((*MockStore)(nil).GetPaymentRequestForUpdate), ctx, id)
+}
+
+// GetScheduledTransfer mocks base method.
+func (m *MockStore) GetScheduledTransfer(ctx context.Context, id uuid.UUID) (database.ScheduledTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScheduledTransfer", ctx, id)
+	ret0, _ := ret[0].(database.ScheduledTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScheduledTransfer indicates an expected call of GetScheduledTransfer.
+func (mr *MockStoreMockRecorder) GetScheduledTransfer(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScheduledTransfer", reflect.TypeOf((*MockStore)(nil).GetScheduledTransfer), ctx, id)
+}
+
+// GetScheduledTransferForUpdate mocks base method.
+func (m *MockStore) GetScheduledTransferForUpdate(ctx context.Context, id uuid.UUID) (database.ScheduledTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScheduledTransferForUpdate", ctx, id)
+	ret0, _ := ret[0].(database.ScheduledTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScheduledTransferForUpdate indicates an expected call of GetScheduledTransferForUpdate.
+func (mr *MockStoreMockRecorder) GetScheduledTransferForUpdate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScheduledTransferForUpdate", reflect.TypeOf((*MockStore)(nil).GetScheduledTransferForUpdate), ctx, id)
+}
+
+// GetSettlementAccount mocks base method.
+func (m *MockStore) GetSettlementAccount(ctx context.Context, currency string) (database.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSettlementAccount", ctx, currency)
+	ret0, _ := ret[0].(database.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSettlementAccount indicates an expected call of GetSettlementAccount.
+func (mr *MockStoreMockRecorder) GetSettlementAccount(ctx, currency any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSettlementAccount", reflect.TypeOf((*MockStore)(nil).GetSettlementAccount), ctx, currency)
+}
+
+// GetTransfer mocks base method.
+func (m *MockStore) GetTransfer(ctx context.Context, id uuid.UUID) (database.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransfer", ctx, id)
+	ret0, _ := ret[0].(database.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransfer indicates an expected call of GetTransfer.
+func (mr *MockStoreMockRecorder) GetTransfer(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransfer", reflect.TypeOf((*MockStore)(nil).GetTransfer), ctx, id)
+}
+
+// GetTransferApproval mocks base method.
+func (m *MockStore) GetTransferApproval(ctx context.Context, id uuid.UUID) (database.TransferApproval, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransferApproval", ctx, id)
+	ret0, _ := ret[0].(database.TransferApproval)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransferApproval indicates an expected call of GetTransferApproval.
+func (mr *MockStoreMockRecorder) GetTransferApproval(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransferApproval", reflect.TypeOf((*MockStore)(nil).GetTransferApproval), ctx, id)
+}
+
+// GetTransferApprovalForUpdate mocks base method.
+func (m *MockStore) GetTransferApprovalForUpdate(ctx context.Context, id uuid.UUID) (database.TransferApproval, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransferApprovalForUpdate", ctx, id)
+	ret0, _ := ret[0].(database.TransferApproval)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransferApprovalForUpdate indicates an expected call of GetTransferApprovalForUpdate.
+func (mr *MockStoreMockRecorder) GetTransferApprovalForUpdate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransferApprovalForUpdate", reflect.TypeOf((*MockStore)(nil).GetTransferApprovalForUpdate), ctx, id)
+}
+
+// GetTransferBatch mocks base method.
+func (m *MockStore) GetTransferBatch(ctx context.Context, id uuid.UUID) (database.TransferBatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransferBatch", ctx, id)
+	ret0, _ := ret[0].(database.TransferBatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransferBatch indicates an expected call of GetTransferBatch.
+func (mr *MockStoreMockRecorder) GetTransferBatch(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransferBatch", reflect.TypeOf((*MockStore)(nil).GetTransferBatch), ctx, id)
+}
+
+// GetTransferBatchForUpdate mocks base method.
+func (m *MockStore) GetTransferBatchForUpdate(ctx context.Context, id uuid.UUID) (database.TransferBatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransferBatchForUpdate", ctx, id)
+	ret0, _ := ret[0].(database.TransferBatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransferBatchForUpdate indicates an expected call of GetTransferBatchForUpdate.
+func (mr *MockStoreMockRecorder) GetTransferBatchForUpdate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransferBatchForUpdate", reflect.TypeOf((*MockStore)(nil).GetTransferBatchForUpdate), ctx, id)
+}
+
+// GetTransferByExternalReference mocks base method.
+func (m *MockStore) GetTransferByExternalReference(ctx context.Context, arg database.GetTransferByExternalReferenceParams) (database.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransferByExternalReference", ctx, arg)
+	ret0, _ := ret[0].(database.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransferByExternalReference indicates an expected call of GetTransferByExternalReference.
+func (mr *MockStoreMockRecorder) GetTransferByExternalReference(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransferByExternalReference", reflect.TypeOf((*MockStore)(nil).GetTransferByExternalReference), ctx, arg)
+}
+
+// GetTransferForUpdate mocks base method.
+func (m *MockStore) GetTransferForUpdate(ctx context.Context, id uuid.UUID) (database.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransferForUpdate", ctx, id)
+	ret0, _ := ret[0].(database.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransferForUpdate indicates an expected call of GetTransferForUpdate.
+func (mr *MockStoreMockRecorder) GetTransferForUpdate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransferForUpdate", reflect.TypeOf((*MockStore)(nil).GetTransferForUpdate), ctx, id)
+}
+
+// GetUser mocks base method.
+func (m *MockStore) GetUser(ctx context.Context, username string) (database.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUser", ctx, username)
+	ret0, _ := ret[0].(database.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockStoreMockRecorder) GetUser(ctx, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockStore)(nil).GetUser), ctx, username)
+}
+
+// GetUserOutgoingUsage mocks base method.
+func (m *MockStore) GetUserOutgoingUsage(ctx context.Context, arg database.GetUserOutgoingUsageParams) (database.GetUserOutgoingUsageRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserOutgoingUsage", ctx, arg)
+	ret0, _ := ret[0].(database.GetUserOutgoingUsageRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserOutgoingUsage indicates an expected call of GetUserOutgoingUsage.
+func (mr *MockStoreMockRecorder) GetUserOutgoingUsage(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserOutgoingUsage", reflect.TypeOf((*MockStore)(nil).GetUserOutgoingUsage), ctx, arg)
+}
+
+// GetUserTransferLimit mocks base method.
+func (m *MockStore) GetUserTransferLimit(ctx context.Context, arg database.GetUserTransferLimitParams) (database.TransferLimit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserTransferLimit", ctx, arg)
+	ret0, _ := ret[0].(database.TransferLimit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserTransferLimit indicates an expected call of GetUserTransferLimit.
+func (mr *MockStoreMockRecorder) GetUserTransferLimit(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserTransferLimit", reflect.TypeOf((*MockStore)(nil).GetUserTransferLimit), ctx, arg)
+}
+
+// HasApprovalDecision mocks base method.
+func (m *MockStore) HasApprovalDecision(ctx context.Context, arg database.HasApprovalDecisionParams) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasApprovalDecision", ctx, arg)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasApprovalDecision indicates an expected call of HasApprovalDecision.
+func (mr *MockStoreMockRecorder) HasApprovalDecision(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasApprovalDecision", reflect.TypeOf((*MockStore)(nil).HasApprovalDecision), ctx, arg)
+}
+
+// HasOrganisationRole mocks base method.
+func (m *MockStore) HasOrganisationRole(ctx context.Context, arg database.HasOrganisationRoleParams) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasOrganisationRole", ctx, arg)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasOrganisationRole indicates an expected call of HasOrganisationRole.
+func (mr *MockStoreMockRecorder) HasOrganisationRole(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasOrganisationRole", reflect.TypeOf((*MockStore)(nil).HasOrganisationRole), ctx, arg)
+}
+
+// IsOrganisationMember mocks base method.
+func (m *MockStore) IsOrganisationMember(ctx context.Context, arg database.IsOrganisationMemberParams) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsOrganisationMember", ctx, arg)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsOrganisationMember indicates an expected call of IsOrganisationMember.
+func (mr *MockStoreMockRecorder) IsOrganisationMember(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsOrganisationMember", reflect.TypeOf((*MockStore)(nil).IsOrganisationMember), ctx, arg)
+}
+
+// ListAccounts mocks base method.
+func (m *MockStore) ListAccounts(ctx context.Context, arg database.ListAccountsParams) ([]database.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccounts", ctx, arg)
+	ret0, _ := ret[0].([]database.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccounts indicates an expected call of ListAccounts.
+func (mr *MockStoreMockRecorder) ListAccounts(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccounts", reflect.TypeOf((*MockStore)(nil).ListAccounts), ctx, arg)
+}
+
+// ListAccountsByIDs mocks base method.
+func (m *MockStore) ListAccountsByIDs(ctx context.Context, ids []uuid.UUID) ([]database.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccountsByIDs", ctx, ids)
+	ret0, _ := ret[0].([]database.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountsByIDs indicates an expected call of ListAccountsByIDs.
+func (mr *MockStoreMockRecorder) ListAccountsByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountsByIDs", reflect.TypeOf((*MockStore)(nil).ListAccountsByIDs), ctx, ids)
+}
+
+// ListAccountsDueForAccrual mocks base method.
+func (m *MockStore) ListAccountsDueForAccrual(ctx context.Context, arg database.ListAccountsDueForAccrualParams) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccountsDueForAccrual", ctx, arg)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountsDueForAccrual indicates an expected call of ListAccountsDueForAccrual.
+func (mr *MockStoreMockRecorder) ListAccountsDueForAccrual(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountsDueForAccrual", reflect.TypeOf((*MockStore)(nil).ListAccountsDueForAccrual), ctx, arg)
+}
+
+// ListAccountsWithUnpaidAccruals mocks base method.
+func (m *MockStore) ListAccountsWithUnpaidAccruals(ctx context.Context, periodEnd time.Time) ([]database.ListAccountsWithUnpaidAccrualsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccountsWithUnpaidAccruals", ctx, periodEnd)
+	ret0, _ := ret[0].([]database.ListAccountsWithUnpaidAccrualsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountsWithUnpaidAccruals indicates an expected call of ListAccountsWithUnpaidAccruals.
+func (mr *MockStoreMockRecorder) ListAccountsWithUnpaidAccruals(ctx, periodEnd any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountsWithUnpaidAccruals", reflect.TypeOf((*MockStore)(nil).ListAccountsWithUnpaidAccruals), ctx, periodEnd)
+}
+
+// ListAllAccountsByOwner mocks base method.
+func (m *MockStore) ListAllAccountsByOwner(ctx context.Context, owner string) ([]database.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAllAccountsByOwner", ctx, owner)
+	ret0, _ := ret[0].([]database.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAllAccountsByOwner indicates an expected call of ListAllAccountsByOwner.
+func (mr *MockStoreMockRecorder) ListAllAccountsByOwner(ctx, owner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllAccountsByOwner", reflect.TypeOf((*MockStore)(nil).ListAllAccountsByOwner), ctx, owner)
+}
+
+// ListApprovalAuditLog mocks base method.
+func (m *MockStore) ListApprovalAuditLog(ctx context.Context, approvalID uuid.UUID) ([]database.ApprovalAuditLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListApprovalAuditLog", ctx, approvalID)
+	ret0, _ := ret[0].([]database.ApprovalAuditLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListApprovalAuditLog indicates an expected call of ListApprovalAuditLog.
+func (mr *MockStoreMockRecorder) ListApprovalAuditLog(ctx, approvalID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListApprovalAuditLog", reflect.TypeOf((*MockStore)(nil).ListApprovalAuditLog), ctx, approvalID)
+}
+
+// ListCurrencies mocks base method.
+func (m *MockStore) ListCurrencies(ctx context.Context) ([]database.Currency, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCurrencies", ctx)
+	ret0, _ := ret[0].([]database.Currency)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCurrencies indicates an expected call of ListCurrencies.
+func (mr *MockStoreMockRecorder) ListCurrencies(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCurrencies", reflect.TypeOf((*MockStore)(nil).ListCurrencies), ctx)
+}
+
+// ListDueScheduledTransfers mocks base method.
+func (m *MockStore) ListDueScheduledTransfers(ctx context.Context, arg database.ListDueScheduledTransfersParams) ([]database.ScheduledTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDueScheduledTransfers", ctx, arg)
+	ret0, _ := ret[0].([]database.ScheduledTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDueScheduledTransfers indicates an expected call of ListDueScheduledTransfers.
+func (mr *MockStoreMockRecorder) ListDueScheduledTransfers(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDueScheduledTransfers", reflect.TypeOf((*MockStore)(nil).ListDueScheduledTransfers), ctx, arg)
+}
+
+// ListEntries mocks base method.
+func (m *MockStore) ListEntries(ctx context.Context, arg database.ListEntriesParams) ([]database.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEntries", ctx, arg)
+	ret0, _ := ret[0].([]database.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEntries indicates an expected call of ListEntries.
+func (mr *MockStoreMockRecorder) ListEntries(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntries", reflect.TypeOf((*MockStore)(nil).ListEntries), ctx, arg)
+}
+
+// ListEscrowEvents mocks base method.
+func (m *MockStore) ListEscrowEvents(ctx context.Context, escrowID uuid.UUID) ([]database.EscrowEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEscrowEvents", ctx, escrowID)
+	ret0, _ := ret[0].([]database.EscrowEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEscrowEvents indicates an expected call of ListEscrowEvents.
+func (mr *MockStoreMockRecorder) ListEscrowEvents(ctx, escrowID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEscrowEvents", reflect.TypeOf((*MockStore)(nil).ListEscrowEvents), ctx, escrowID)
+}
+
+// ListEscrows mocks base method.
+func (m *MockStore) ListEscrows(ctx context.Context, arg database.ListEscrowsParams) ([]database.Escrow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEscrows", ctx, arg)
+	ret0, _ := ret[0].([]database.Escrow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEscrows indicates an expected call of ListEscrows.
+func (mr *MockStoreMockRecorder) ListEscrows(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEscrows", reflect.TypeOf((*MockStore)(nil).ListEscrows), ctx, arg)
+}
+
+// ListExpiredEscrows mocks base method.
+func (m *MockStore) ListExpiredEscrows(ctx context.Context, arg database.ListExpiredEscrowsParams) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExpiredEscrows", ctx, arg)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExpiredEscrows indicates an expected call of ListExpiredEscrows.
+func (mr *MockStoreMockRecorder) ListExpiredEscrows(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExpiredEscrows", reflect.TypeOf((*MockStore)(nil).ListExpiredEscrows), ctx, arg)
+}
+
+// ListExpiredHolds mocks base method.
+func (m *MockStore) ListExpiredHolds(ctx context.Context, arg database.ListExpiredHoldsParams) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExpiredHolds", ctx, arg)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExpiredHolds indicates an expected call of ListExpiredHolds.
+func (mr *MockStoreMockRecorder) ListExpiredHolds(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExpiredHolds", reflect.TypeOf((*MockStore)(nil).ListExpiredHolds), ctx, arg)
+}
+
+// ListExpiredTransferApprovals mocks base method.
+func (m *MockStore) ListExpiredTransferApprovals(ctx context.Context, arg database.ListExpiredTransferApprovalsParams) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExpiredTransferApprovals", ctx, arg)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExpiredTransferApprovals indicates an expected call of ListExpiredTransferApprovals.
+func (mr *MockStoreMockRecorder) ListExpiredTransferApprovals(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExpiredTransferApprovals", reflect.TypeOf((*MockStore)(nil).ListExpiredTransferApprovals), ctx, arg)
+}
+
+// ListFeeRules mocks base method.
+func (m *MockStore) ListFeeRules(ctx context.Context, scheduleID uuid.UUID) ([]database.FeeRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFeeRules", ctx, scheduleID)
+	ret0, _ := ret[0].([]database.FeeRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFeeRules indicates an expected call of ListFeeRules.
+func (mr *MockStoreMockRecorder) ListFeeRules(ctx, scheduleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFeeRules", reflect.TypeOf((*MockStore)(nil).ListFeeRules), ctx, scheduleID)
+}
+
+// ListFeeSchedules mocks base method.
+func (m *MockStore) ListFeeSchedules(ctx context.Context, arg database.ListFeeSchedulesParams) ([]database.FeeSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFeeSchedules", ctx, arg)
+	ret0, _ := ret[0].([]database.FeeSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFeeSchedules indicates an expected call of ListFeeSchedules.
+func (mr *MockStoreMockRecorder) ListFeeSchedules(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFeeSchedules", reflect.TypeOf((*MockStore)(nil).ListFeeSchedules), ctx, arg)
+}
+
+// ListFundingTransactions mocks base method.
+func (m *MockStore) ListFundingTransactions(ctx context.Context, arg database.ListFundingTransactionsParams) ([]database.FundingTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFundingTransactions", ctx, arg)
+	ret0, _ := ret[0].([]database.FundingTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFundingTransactions indicates an expected call of ListFundingTransactions.
+func (mr *MockStoreMockRecorder) ListFundingTransactions(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFundingTransactions", reflect.TypeOf((*MockStore)(nil).ListFundingTransactions), ctx, arg)
+}
+
+// ListHolds mocks base method.
+func (m *MockStore) ListHolds(ctx context.Context, arg database.ListHoldsParams) ([]database.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListHolds", ctx, arg)
+	ret0, _ := ret[0].([]database.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHolds indicates an expected call of ListHolds.
+func (mr *MockStoreMockRecorder) ListHolds(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHolds", reflect.TypeOf((*MockStore)(nil).ListHolds), ctx, arg)
+}
+
+// ListIncomingPaymentRequests mocks base method.
+func (m *MockStore) ListIncomingPaymentRequests(ctx context.Context, arg database.ListIncomingPaymentRequestsParams) ([]database.PaymentRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIncomingPaymentRequests", ctx, arg)
+	ret0, _ := ret[0].([]database.PaymentRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIncomingPaymentRequests indicates an expected call of ListIncomingPaymentRequests.
+func (mr *MockStoreMockRecorder) ListIncomingPaymentRequests(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIncomingPaymentRequests", reflect.TypeOf((*MockStore)(nil).ListIncomingPaymentRequests), ctx, arg)
+}
+
+// ListInterestPayouts mocks base method.
+func (m *MockStore) ListInterestPayouts(ctx context.Context, arg database.ListInterestPayoutsParams) ([]database.InterestPayout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInterestPayouts", ctx, arg)
+	ret0, _ := ret[0].([]database.InterestPayout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInterestPayouts indicates an expected call of ListInterestPayouts.
+func (mr *MockStoreMockRecorder) ListInterestPayouts(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInterestPayouts", reflect.TypeOf((*MockStore)(nil).ListInterestPayouts), ctx, arg)
+}
+
+// ListInterestProducts mocks base method.
+func (m *MockStore) ListInterestProducts(ctx context.Context) ([]database.InterestProduct, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInterestProducts", ctx)
+	ret0, _ := ret[0].([]database.InterestProduct)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInterestProducts indicates an expected call of ListInterestProducts.
+func (mr *MockStoreMockRecorder) ListInterestProducts(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInterestProducts", reflect.TypeOf((*MockStore)(nil).ListInterestProducts), ctx)
+}
+
+// ListNotifications mocks base method.
+func (m *MockStore) ListNotifications(ctx context.Context, arg database.ListNotificationsParams) ([]database.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNotifications", ctx, arg)
+	ret0, _ := ret[0].([]database.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNotifications indicates an expected call of ListNotifications.
+func (mr *MockStoreMockRecorder) ListNotifications(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNotifications", reflect.TypeOf((*MockStore)(nil).ListNotifications), ctx, arg)
+}
+
+// ListOrganisationMembers mocks base method.
+func (m *MockStore) ListOrganisationMembers(ctx context.Context, organisationID uuid.UUID) ([]database.OrganisationMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrganisationMembers", ctx, organisationID)
+	ret0, _ := ret[0].([]database.OrganisationMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrganisationMembers indicates an expected call of ListOrganisationMembers.
+func (mr *MockStoreMockRecorder) ListOrganisationMembers(ctx, organisationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrganisationMembers", reflect.TypeOf((*MockStore)(nil).ListOrganisationMembers), ctx, organisationID)
+}
+
+// ListOutgoingPaymentRequests mocks base method.
+func (m *MockStore) ListOutgoingPaymentRequests(ctx context.Context, arg database.ListOutgoingPaymentRequestsParams) ([]database.PaymentRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOutgoingPaymentRequests", ctx, arg)
+	ret0, _ := ret[0].([]database.PaymentRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOutgoingPaymentRequests indicates an expected call of ListOutgoingPaymentRequests.
+func (mr *MockStoreMockRecorder) ListOutgoingPaymentRequests(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOutgoingPaymentRequests", reflect.TypeOf((*MockStore)(nil).ListOutgoingPaymentRequests), ctx, arg)
+}
+
+// ListOverdraftInterestCharges mocks base method.
+func (m *MockStore) ListOverdraftInterestCharges(ctx context.Context, arg database.ListOverdraftInterestChargesParams) ([]database.OverdraftInterestCharge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOverdraftInterestCharges", ctx, arg)
+	ret0, _ := ret[0].([]database.OverdraftInterestCharge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOverdraftInterestCharges indicates an expected call of ListOverdraftInterestCharges.
+func (mr *MockStoreMockRecorder) ListOverdraftInterestCharges(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOverdraftInterestCharges", reflect.TypeOf((*MockStore)(nil).ListOverdraftInterestCharges), ctx, arg)
+}
+
+// ListOverdrawnAccountsDue mocks base method.
+func (m *MockStore) ListOverdrawnAccountsDue(ctx context.Context, arg database.ListOverdrawnAccountsDueParams) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOverdrawnAccountsDue", ctx, arg)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOverdrawnAccountsDue indicates an expected call of ListOverdrawnAccountsDue.
+func (mr *MockStoreMockRecorder) ListOverdrawnAccountsDue(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOverdrawnAccountsDue", reflect.TypeOf((*MockStore)(nil).ListOverdrawnAccountsDue), ctx, arg)
+}
+
+// ListPendingTransferBatchItems mocks base method.
+func (m *MockStore) ListPendingTransferBatchItems(ctx context.Context, arg database.ListPendingTransferBatchItemsParams) ([]database.TransferBatchItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPendingTransferBatchItems", ctx, arg)
+	ret0, _ := ret[0].([]database.TransferBatchItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPendingTransferBatchItems indicates an expected call of ListPendingTransferBatchItems.
+func (mr *MockStoreMockRecorder) ListPendingTransferBatchItems(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingTransferBatchItems", reflect.TypeOf((*MockStore)(nil).ListPendingTransferBatchItems), ctx, arg)
+}
+
+// ListScheduledTransferRuns mocks base method.
+func (m *MockStore) ListScheduledTransferRuns(ctx context.Context, arg database.ListScheduledTransferRunsParams) ([]database.ScheduledTransferRun, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListScheduledTransferRuns", ctx, arg)
+	ret0, _ := ret[0].([]database.ScheduledTransferRun)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListScheduledTransferRuns indicates an expected call of ListScheduledTransferRuns.
+func (mr *MockStoreMockRecorder) ListScheduledTransferRuns(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListScheduledTransferRuns", reflect.TypeOf((*MockStore)(nil).ListScheduledTransferRuns), ctx, arg)
+}
+
+// ListScheduledTransfers mocks base method.
+func (m *MockStore) ListScheduledTransfers(ctx context.Context, arg database.ListScheduledTransfersParams) ([]database.ScheduledTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListScheduledTransfers", ctx, arg)
+	ret0, _ := ret[0].([]database.ScheduledTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListScheduledTransfers indicates an expected call of ListScheduledTransfers.
+func (mr *MockStoreMockRecorder) ListScheduledTransfers(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListScheduledTransfers", reflect.TypeOf((*MockStore)(nil).ListScheduledTransfers), ctx, arg)
+}
+
+// ListTransferApprovals mocks base method.
+func (m *MockStore) ListTransferApprovals(ctx context.Context, arg database.ListTransferApprovalsParams) ([]database.TransferApproval, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTransferApprovals", ctx, arg)
+	ret0, _ := ret[0].([]database.TransferApproval)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTransferApprovals indicates an expected call of ListTransferApprovals.
+func (mr *MockStoreMockRecorder) ListTransferApprovals(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransferApprovals", reflect.TypeOf((*MockStore)(nil).ListTransferApprovals), ctx, arg)
+}
+
+// ListTransferBatchItems mocks base method.
+func (m *MockStore) ListTransferBatchItems(ctx context.Context, arg database.ListTransferBatchItemsParams) ([]database.TransferBatchItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTransferBatchItems", ctx, arg)
+	ret0, _ := ret[0].([]database.TransferBatchItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTransferBatchItems indicates an expected call of ListTransferBatchItems.
+func (mr *MockStoreMockRecorder) ListTransferBatchItems(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransferBatchItems", reflect.TypeOf((*MockStore)(nil).ListTransferBatchItems), ctx, arg)
+}
+
+// ListTransferBatches mocks base method.
+func (m *MockStore) ListTransferBatches(ctx context.Context, arg database.ListTransferBatchesParams) ([]database.TransferBatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTransferBatches", ctx, arg)
+	ret0, _ := ret[0].([]database.TransferBatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTransferBatches indicates an expected call of ListTransferBatches.
+func (mr *MockStoreMockRecorder) ListTransferBatches(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransferBatches", reflect.TypeOf((*MockStore)(nil).ListTransferBatches), ctx, arg)
+}
+
+// ListTransfers mocks base method.
+func (m *MockStore) ListTransfers(ctx context.Context, arg database.ListTransfersParams) ([]database.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTransfers", ctx, arg)
+	ret0, _ := ret[0].([]database.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTransfers indicates an expected call of ListTransfers.
+func (mr *MockStoreMockRecorder) ListTransfers(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransfers", reflect.TypeOf((*MockStore)(nil).ListTransfers), ctx, arg)
+}
+
+// ListUserOrganisations mocks base method.
+func (m *MockStore) ListUserOrganisations(ctx context.Context, username string) ([]database.Organisation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserOrganisations", ctx, username)
+	ret0, _ := ret[0].([]database.Organisation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserOrganisations indicates an expected call of ListUserOrganisations.
+func (mr *MockStoreMockRecorder) ListUserOrganisations(ctx, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserOrganisations", reflect.TypeOf((*MockStore)(nil).ListUserOrganisations), ctx, username)
+}
+
+// LockUserForTransfer mocks base method.
+func (m *MockStore) LockUserForTransfer(ctx context.Context, username string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockUserForTransfer", ctx, username)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockUserForTransfer indicates an expected call of LockUserForTransfer.
+func (mr *MockStoreMockRecorder) LockUserForTransfer(ctx, username any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccount", reflect.TypeOf((*MockStore)(nil).CreateAccount), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockUserForTransfer", reflect.TypeOf((*MockStore)(nil).LockUserForTransfer), ctx, username)
 }
 
-// CreateEntries mocks base method.
-func (m *MockStore) CreateEntries(ctx context.Context, arg database.CreateEntriesParams) (database.Entry, error) {
+// MarkFxQuoteUsed mocks base method.
+func (m *MockStore) MarkFxQuoteUsed(ctx context.Context, id uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateEntries", ctx, arg)
-	ret0, _ := ret[0].(database.Entry)
+	ret := m.ctrl.Call(m, "MarkFxQuoteUsed", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkFxQuoteUsed indicates an expected call of MarkFxQuoteUsed.
+func (mr *MockStoreMockRecorder) MarkFxQuoteUsed(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkFxQuoteUsed", reflect.TypeOf((*MockStore)(nil).MarkFxQuoteUsed), ctx, id)
+}
+
+// MarkInterestAccrualsPaid mocks base method.
+func (m *MockStore) MarkInterestAccrualsPaid(ctx context.Context, arg database.MarkInterestAccrualsPaidParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkInterestAccrualsPaid", ctx, arg)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateEntries indicates an expected call of CreateEntries.
-func (mr *MockStoreMockRecorder) CreateEntries(ctx, arg any) *gomock.Call {
+// MarkInterestAccrualsPaid indicates an expected call of MarkInterestAccrualsPaid.
+func (mr *MockStoreMockRecorder) MarkInterestAccrualsPaid(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEntries", reflect.TypeOf((*MockStore)(nil).CreateEntries), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkInterestAccrualsPaid", reflect.TypeOf((*MockStore)(nil).MarkInterestAccrualsPaid), ctx, arg)
 }
 
-// CreateTransfer mocks base method.
-func (m *MockStore) CreateTransfer(ctx context.Context, arg database.CreateTransferParams) (database.Transfer, error) {
+// MarkNotificationRead mocks base method.
+func (m *MockStore) MarkNotificationRead(ctx context.Context, arg database.MarkNotificationReadParams) (database.Notification, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateTransfer", ctx, arg)
-	ret0, _ := ret[0].(database.Transfer)
+	ret := m.ctrl.Call(m, "MarkNotificationRead", ctx, arg)
+	ret0, _ := ret[0].(database.Notification)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateTransfer indicates an expected call of CreateTransfer.
-func (mr *MockStoreMockRecorder) CreateTransfer(ctx, arg any) *gomock.Call {
+// MarkNotificationRead indicates an expected call of MarkNotificationRead.
+func (mr *MockStoreMockRecorder) MarkNotificationRead(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransfer", reflect.TypeOf((*MockStore)(nil).CreateTransfer), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkNotificationRead", reflect.TypeOf((*MockStore)(nil).MarkNotificationRead), ctx, arg)
 }
 
-// CreateUsers mocks base method.
-func (m *MockStore) CreateUsers(ctx context.Context, arg database.CreateUsersParams) (database.User, error) {
+// MarkUserEmailVerified mocks base method.
+func (m *MockStore) MarkUserEmailVerified(ctx context.Context, username string) (database.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateUsers", ctx, arg)
+	ret := m.ctrl.Call(m, "MarkUserEmailVerified", ctx, username)
 	ret0, _ := ret[0].(database.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateUsers indicates an expected call of CreateUsers.
-func (mr *MockStoreMockRecorder) CreateUsers(ctx, arg any) *gomock.Call {
+// MarkUserEmailVerified indicates an expected call of MarkUserEmailVerified.
+func (mr *MockStoreMockRecorder) MarkUserEmailVerified(ctx, username any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUsers", reflect.TypeOf((*MockStore)(nil).CreateUsers), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkUserEmailVerified", reflect.TypeOf((*MockStore)(nil).MarkUserEmailVerified), ctx, username)
 }
 
-// DeleteAccount mocks base method.
-func (m *MockStore) DeleteAccount(ctx context.Context, id uuid.UUID) error {
+// Ping mocks base method.
+func (m *MockStore) Ping(ctx context.Context) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteAccount", ctx, id)
+	ret := m.ctrl.Call(m, "Ping", ctx)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteAccount indicates an expected call of DeleteAccount.
-func (mr *MockStoreMockRecorder) DeleteAccount(ctx, id any) *gomock.Call {
+// Ping indicates an expected call of Ping.
+func (mr *MockStoreMockRecorder) Ping(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccount", reflect.TypeOf((*MockStore)(nil).DeleteAccount), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockStore)(nil).Ping), ctx)
 }
 
-// DeleteEntry mocks base method.
-func (m *MockStore) DeleteEntry(ctx context.Context, id uuid.UUID) error {
+// PoolStats mocks base method.
+func (m *MockStore) PoolStats() database.PoolStats {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteEntry", ctx, id)
-	ret0, _ := ret[0].(error)
+	ret := m.ctrl.Call(m, "PoolStats")
+	ret0, _ := ret[0].(database.PoolStats)
 	return ret0
 }
 
-// DeleteEntry indicates an expected call of DeleteEntry.
-func (mr *MockStoreMockRecorder) DeleteEntry(ctx, id any) *gomock.Call {
+// PoolStats indicates an expected call of PoolStats.
+func (mr *MockStoreMockRecorder) PoolStats() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEntry", reflect.TypeOf((*MockStore)(nil).DeleteEntry), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PoolStats", reflect.TypeOf((*MockStore)(nil).PoolStats))
 }
 
-// DeleteTransfer mocks base method.
-func (m *MockStore) DeleteTransfer(ctx context.Context, id uuid.UUID) error {
+// ProcessTransferTx mocks base method.
+func (m *MockStore) ProcessTransferTx(ctx context.Context, transferID uuid.UUID) (database.Transfer, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteTransfer", ctx, id)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ProcessTransferTx", ctx, transferID)
+	ret0, _ := ret[0].(database.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteTransfer indicates an expected call of DeleteTransfer.
-func (mr *MockStoreMockRecorder) DeleteTransfer(ctx, id any) *gomock.Call {
+// ProcessTransferTx indicates an expected call of ProcessTransferTx.
+func (mr *MockStoreMockRecorder) ProcessTransferTx(ctx, transferID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTransfer", reflect.TypeOf((*MockStore)(nil).DeleteTransfer), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessTransferTx", reflect.TypeOf((*MockStore)(nil).ProcessTransferTx), ctx, transferID)
 }
 
-// GetAccount mocks base method.
-func (m *MockStore) GetAccount(ctx context.Context, id uuid.UUID) (database.Account, error) {
+// RejectTransferTx mocks base method.
+func (m *MockStore) RejectTransferTx(ctx context.Context, arg database.DecideTransferApprovalTxParams) (database.TransferApprovalTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAccount", ctx, id)
+	ret := m.ctrl.Call(m, "RejectTransferTx", ctx, arg)
+	ret0, _ := ret[0].(database.TransferApprovalTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RejectTransferTx indicates an expected call of RejectTransferTx.
+func (mr *MockStoreMockRecorder) RejectTransferTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectTransferTx", reflect.TypeOf((*MockStore)(nil).RejectTransferTx), ctx, arg)
+}
+
+// ReleaseHoldTx mocks base method.
+func (m *MockStore) ReleaseHoldTx(ctx context.Context, holdID uuid.UUID) (database.HoldTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseHoldTx", ctx, holdID)
+	ret0, _ := ret[0].(database.HoldTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseHoldTx indicates an expected call of ReleaseHoldTx.
+func (mr *MockStoreMockRecorder) ReleaseHoldTx(ctx, holdID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseHoldTx", reflect.TypeOf((*MockStore)(nil).ReleaseHoldTx), ctx, holdID)
+}
+
+// RemoveOrganisationMember mocks base method.
+func (m *MockStore) RemoveOrganisationMember(ctx context.Context, arg database.RemoveOrganisationMemberParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveOrganisationMember", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveOrganisationMember indicates an expected call of RemoveOrganisationMember.
+func (mr *MockStoreMockRecorder) RemoveOrganisationMember(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveOrganisationMember", reflect.TypeOf((*MockStore)(nil).RemoveOrganisationMember), ctx, arg)
+}
+
+// ResolveRecipientAccount mocks base method.
+func (m *MockStore) ResolveRecipientAccount(ctx context.Context, arg database.ResolveRecipientAccountParams) (database.ResolveRecipientAccountRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveRecipientAccount", ctx, arg)
+	ret0, _ := ret[0].(database.ResolveRecipientAccountRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveRecipientAccount indicates an expected call of ResolveRecipientAccount.
+func (mr *MockStoreMockRecorder) ResolveRecipientAccount(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveRecipientAccount", reflect.TypeOf((*MockStore)(nil).ResolveRecipientAccount), ctx, arg)
+}
+
+// ReverseTransfer mocks base method.
+func (m *MockStore) ReverseTransfer(ctx context.Context, arg database.ReverseTransferParams) (database.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReverseTransfer", ctx, arg)
+	ret0, _ := ret[0].(database.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReverseTransfer indicates an expected call of ReverseTransfer.
+func (mr *MockStoreMockRecorder) ReverseTransfer(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReverseTransfer", reflect.TypeOf((*MockStore)(nil).ReverseTransfer), ctx, arg)
+}
+
+// ReverseTransferTx mocks base method.
+func (m *MockStore) ReverseTransferTx(ctx context.Context, transferID uuid.UUID) (database.ReverseTransferTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReverseTransferTx", ctx, transferID)
+	ret0, _ := ret[0].(database.ReverseTransferTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReverseTransferTx indicates an expected call of ReverseTransferTx.
+func (mr *MockStoreMockRecorder) ReverseTransferTx(ctx, transferID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReverseTransferTx", reflect.TypeOf((*MockStore)(nil).ReverseTransferTx), ctx, transferID)
+}
+
+// SchemaVersion mocks base method.
+func (m *MockStore) SchemaVersion(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SchemaVersion", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SchemaVersion indicates an expected call of SchemaVersion.
+func (mr *MockStoreMockRecorder) SchemaVersion(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SchemaVersion", reflect.TypeOf((*MockStore)(nil).SchemaVersion), ctx)
+}
+
+// SearchAccountTransfers mocks base method.
+func (m *MockStore) SearchAccountTransfers(ctx context.Context, arg database.SearchAccountTransfersParams) ([]database.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchAccountTransfers", ctx, arg)
+	ret0, _ := ret[0].([]database.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchAccountTransfers indicates an expected call of SearchAccountTransfers.
+func (mr *MockStoreMockRecorder) SearchAccountTransfers(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchAccountTransfers", reflect.TypeOf((*MockStore)(nil).SearchAccountTransfers), ctx, arg)
+}
+
+// SetAccountInterestProduct mocks base method.
+func (m *MockStore) SetAccountInterestProduct(ctx context.Context, arg database.SetAccountInterestProductParams) (database.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAccountInterestProduct", ctx, arg)
 	ret0, _ := ret[0].(database.Account)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAccount indicates an expected call of GetAccount.
-func (mr *MockStoreMockRecorder) GetAccount(ctx, id any) *gomock.Call {
+// SetAccountInterestProduct indicates an expected call of SetAccountInterestProduct.
+func (mr *MockStoreMockRecorder) SetAccountInterestProduct(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccount", reflect.TypeOf((*MockStore)(nil).GetAccount), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAccountInterestProduct", reflect.TypeOf((*MockStore)(nil).SetAccountInterestProduct), ctx, arg)
 }
 
-// GetAccountByIdForUpdate mocks base method.
-func (m *MockStore) GetAccountByIdForUpdate(ctx context.Context, id uuid.UUID) (database.Account, error) {
+// SetAccountOrganisation mocks base method.
+func (m *MockStore) SetAccountOrganisation(ctx context.Context, arg database.SetAccountOrganisationParams) (database.Account, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAccountByIdForUpdate", ctx, id)
+	ret := m.ctrl.Call(m, "SetAccountOrganisation", ctx, arg)
 	ret0, _ := ret[0].(database.Account)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAccountByIdForUpdate indicates an expected call of GetAccountByIdForUpdate.
-func (mr *MockStoreMockRecorder) GetAccountByIdForUpdate(ctx, id any) *gomock.Call {
+// SetAccountOrganisation indicates an expected call of SetAccountOrganisation.
+func (mr *MockStoreMockRecorder) SetAccountOrganisation(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountByIdForUpdate", reflect.TypeOf((*MockStore)(nil).GetAccountByIdForUpdate), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAccountOrganisation", reflect.TypeOf((*MockStore)(nil).SetAccountOrganisation), ctx, arg)
 }
 
-// GetAllUsers mocks base method.
-func (m *MockStore) GetAllUsers(ctx context.Context) ([]database.GetAllUsersRow, error) {
+// SetAccountOverdraft mocks base method.
+func (m *MockStore) SetAccountOverdraft(ctx context.Context, arg database.SetAccountOverdraftParams) (database.Account, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllUsers", ctx)
-	ret0, _ := ret[0].([]database.GetAllUsersRow)
+	ret := m.ctrl.Call(m, "SetAccountOverdraft", ctx, arg)
+	ret0, _ := ret[0].(database.Account)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAllUsers indicates an expected call of GetAllUsers.
-func (mr *MockStoreMockRecorder) GetAllUsers(ctx any) *gomock.Call {
+// SetAccountOverdraft indicates an expected call of SetAccountOverdraft.
+func (mr *MockStoreMockRecorder) SetAccountOverdraft(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllUsers", reflect.TypeOf((*MockStore)(nil).GetAllUsers), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAccountOverdraft", reflect.TypeOf((*MockStore)(nil).SetAccountOverdraft), ctx, arg)
 }
 
-// GetEntry mocks base method.
-func (m *MockStore) GetEntry(ctx context.Context, id uuid.UUID) (database.Entry, error) {
+// SetCurrencyEnabledTx mocks base method.
+func (m *MockStore) SetCurrencyEnabledTx(ctx context.Context, code string, enabled bool) (database.Currency, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetEntry", ctx, id)
-	ret0, _ := ret[0].(database.Entry)
+	ret := m.ctrl.Call(m, "SetCurrencyEnabledTx", ctx, code, enabled)
+	ret0, _ := ret[0].(database.Currency)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetEntry indicates an expected call of GetEntry.
-func (mr *MockStoreMockRecorder) GetEntry(ctx, id any) *gomock.Call {
+// SetCurrencyEnabledTx indicates an expected call of SetCurrencyEnabledTx.
+func (mr *MockStoreMockRecorder) SetCurrencyEnabledTx(ctx, code, enabled any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntry", reflect.TypeOf((*MockStore)(nil).GetEntry), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCurrencyEnabledTx", reflect.TypeOf((*MockStore)(nil).SetCurrencyEnabledTx), ctx, code, enabled)
 }
 
-// GetTransfer mocks base method.
-func (m *MockStore) GetTransfer(ctx context.Context, id uuid.UUID) (database.Transfer, error) {
+// SetFundingProviderReference mocks base method.
+func (m *MockStore) SetFundingProviderReference(ctx context.Context, arg database.SetFundingProviderReferenceParams) (database.FundingTransaction, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTransfer", ctx, id)
+	ret := m.ctrl.Call(m, "SetFundingProviderReference", ctx, arg)
+	ret0, _ := ret[0].(database.FundingTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetFundingProviderReference indicates an expected call of SetFundingProviderReference.
+func (mr *MockStoreMockRecorder) SetFundingProviderReference(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFundingProviderReference", reflect.TypeOf((*MockStore)(nil).SetFundingProviderReference), ctx, arg)
+}
+
+// SetTransferDetails mocks base method.
+func (m *MockStore) SetTransferDetails(ctx context.Context, arg database.SetTransferDetailsParams) (database.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTransferDetails", ctx, arg)
 	ret0, _ := ret[0].(database.Transfer)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTransfer indicates an expected call of GetTransfer.
-func (mr *MockStoreMockRecorder) GetTransfer(ctx, id any) *gomock.Call {
+// SetTransferDetails indicates an expected call of SetTransferDetails.
+func (mr *MockStoreMockRecorder) SetTransferDetails(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransfer", reflect.TypeOf((*MockStore)(nil).GetTransfer), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTransferDetails", reflect.TypeOf((*MockStore)(nil).SetTransferDetails), ctx, arg)
 }
 
-// GetUser mocks base method.
-func (m *MockStore) GetUser(ctx context.Context, username string) (database.User, error) {
+// SetTransferFee mocks base method.
+func (m *MockStore) SetTransferFee(ctx context.Context, arg database.SetTransferFeeParams) (database.Transfer, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUser", ctx, username)
+	ret := m.ctrl.Call(m, "SetTransferFee", ctx, arg)
+	ret0, _ := ret[0].(database.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTransferFee indicates an expected call of SetTransferFee.
+func (mr *MockStoreMockRecorder) SetTransferFee(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTransferFee", reflect.TypeOf((*MockStore)(nil).SetTransferFee), ctx, arg)
+}
+
+// SetUserAlias mocks base method.
+func (m *MockStore) SetUserAlias(ctx context.Context, arg database.SetUserAliasParams) (database.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUserAlias", ctx, arg)
 	ret0, _ := ret[0].(database.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUser indicates an expected call of GetUser.
-func (mr *MockStoreMockRecorder) GetUser(ctx, username any) *gomock.Call {
+// SetUserAlias indicates an expected call of SetUserAlias.
+func (mr *MockStoreMockRecorder) SetUserAlias(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockStore)(nil).GetUser), ctx, username)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserAlias", reflect.TypeOf((*MockStore)(nil).SetUserAlias), ctx, arg)
 }
 
-// ListAccounts mocks base method.
-func (m *MockStore) ListAccounts(ctx context.Context, arg database.ListAccountsParams) ([]database.Account, error) {
+// SettleFundingTx mocks base method.
+func (m *MockStore) SettleFundingTx(ctx context.Context, id uuid.UUID) (database.FundingTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListAccounts", ctx, arg)
-	ret0, _ := ret[0].([]database.Account)
+	ret := m.ctrl.Call(m, "SettleFundingTx", ctx, id)
+	ret0, _ := ret[0].(database.FundingTxResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListAccounts indicates an expected call of ListAccounts.
-func (mr *MockStoreMockRecorder) ListAccounts(ctx, arg any) *gomock.Call {
+// SettleFundingTx indicates an expected call of SettleFundingTx.
+func (mr *MockStoreMockRecorder) SettleFundingTx(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccounts", reflect.TypeOf((*MockStore)(nil).ListAccounts), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SettleFundingTx", reflect.TypeOf((*MockStore)(nil).SettleFundingTx), ctx, id)
 }
 
-// ListEntries mocks base method.
-func (m *MockStore) ListEntries(ctx context.Context, arg database.ListEntriesParams) ([]database.Entry, error) {
+// SubmitTransferTx mocks base method.
+func (m *MockStore) SubmitTransferTx(ctx context.Context, arg database.TransferTxParams) (database.Transfer, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListEntries", ctx, arg)
-	ret0, _ := ret[0].([]database.Entry)
+	ret := m.ctrl.Call(m, "SubmitTransferTx", ctx, arg)
+	ret0, _ := ret[0].(database.Transfer)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListEntries indicates an expected call of ListEntries.
-func (mr *MockStoreMockRecorder) ListEntries(ctx, arg any) *gomock.Call {
+// SubmitTransferTx indicates an expected call of SubmitTransferTx.
+func (mr *MockStoreMockRecorder) SubmitTransferTx(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntries", reflect.TypeOf((*MockStore)(nil).ListEntries), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitTransferTx", reflect.TypeOf((*MockStore)(nil).SubmitTransferTx), ctx, arg)
 }
 
-// ListTransfers mocks base method.
-func (m *MockStore) ListTransfers(ctx context.Context, arg database.ListTransfersParams) ([]database.Transfer, error) {
+// SumUnpaidInterestAccruals mocks base method.
+func (m *MockStore) SumUnpaidInterestAccruals(ctx context.Context, arg database.SumUnpaidInterestAccrualsParams) (decimal.Decimal, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListTransfers", ctx, arg)
-	ret0, _ := ret[0].([]database.Transfer)
+	ret := m.ctrl.Call(m, "SumUnpaidInterestAccruals", ctx, arg)
+	ret0, _ := ret[0].(decimal.Decimal)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListTransfers indicates an expected call of ListTransfers.
-func (mr *MockStoreMockRecorder) ListTransfers(ctx, arg any) *gomock.Call {
+// SumUnpaidInterestAccruals indicates an expected call of SumUnpaidInterestAccruals.
+func (mr *MockStoreMockRecorder) SumUnpaidInterestAccruals(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransfers", reflect.TypeOf((*MockStore)(nil).ListTransfers), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SumUnpaidInterestAccruals", reflect.TypeOf((*MockStore)(nil).SumUnpaidInterestAccruals), ctx, arg)
 }
 
 // TransferTx mocks base method.
@@ -308,6 +2834,21 @@ func (mr *MockStoreMockRecorder) UpdateAccount(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccount", reflect.TypeOf((*MockStore)(nil).UpdateAccount), ctx, arg)
 }
 
+// UpdateCurrencyEnabled mocks base method.
+func (m *MockStore) UpdateCurrencyEnabled(ctx context.Context, arg database.UpdateCurrencyEnabledParams) (database.Currency, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCurrencyEnabled", ctx, arg)
+	ret0, _ := ret[0].(database.Currency)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCurrencyEnabled indicates an expected call of UpdateCurrencyEnabled.
+func (mr *MockStoreMockRecorder) UpdateCurrencyEnabled(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCurrencyEnabled", reflect.TypeOf((*MockStore)(nil).UpdateCurrencyEnabled), ctx, arg)
+}
+
 // UpdateEntry mocks base method.
 func (m *MockStore) UpdateEntry(ctx context.Context, arg database.UpdateEntryParams) error {
 	m.ctrl.T.Helper()
@@ -322,6 +2863,36 @@ func (mr *MockStoreMockRecorder) UpdateEntry(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEntry", reflect.TypeOf((*MockStore)(nil).UpdateEntry), ctx, arg)
 }
 
+// UpdateFundingStatus mocks base method.
+func (m *MockStore) UpdateFundingStatus(ctx context.Context, arg database.UpdateFundingStatusParams) (database.FundingTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFundingStatus", ctx, arg)
+	ret0, _ := ret[0].(database.FundingTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateFundingStatus indicates an expected call of UpdateFundingStatus.
+func (mr *MockStoreMockRecorder) UpdateFundingStatus(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFundingStatus", reflect.TypeOf((*MockStore)(nil).UpdateFundingStatus), ctx, arg)
+}
+
+// UpdateOrganisationPolicy mocks base method.
+func (m *MockStore) UpdateOrganisationPolicy(ctx context.Context, arg database.UpdateOrganisationPolicyParams) (database.Organisation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOrganisationPolicy", ctx, arg)
+	ret0, _ := ret[0].(database.Organisation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateOrganisationPolicy indicates an expected call of UpdateOrganisationPolicy.
+func (mr *MockStoreMockRecorder) UpdateOrganisationPolicy(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrganisationPolicy", reflect.TypeOf((*MockStore)(nil).UpdateOrganisationPolicy), ctx, arg)
+}
+
 // UpdateRefreshToken mocks base method.
 func (m *MockStore) UpdateRefreshToken(ctx context.Context, arg database.UpdateRefreshTokenParams) error {
 	m.ctrl.T.Helper()
@@ -336,6 +2907,21 @@ func (mr *MockStoreMockRecorder) UpdateRefreshToken(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRefreshToken", reflect.TypeOf((*MockStore)(nil).UpdateRefreshToken), ctx, arg)
 }
 
+// UpdateScheduledTransfer mocks base method.
+func (m *MockStore) UpdateScheduledTransfer(ctx context.Context, arg database.UpdateScheduledTransferParams) (database.ScheduledTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateScheduledTransfer", ctx, arg)
+	ret0, _ := ret[0].(database.ScheduledTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateScheduledTransfer indicates an expected call of UpdateScheduledTransfer.
+func (mr *MockStoreMockRecorder) UpdateScheduledTransfer(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateScheduledTransfer", reflect.TypeOf((*MockStore)(nil).UpdateScheduledTransfer), ctx, arg)
+}
+
 // UpdateTransfer mocks base method.
 func (m *MockStore) UpdateTransfer(ctx context.Context, arg database.UpdateTransferParams) error {
 	m.ctrl.T.Helper()
@@ -349,3 +2935,48 @@ func (mr *MockStoreMockRecorder) UpdateTransfer(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTransfer", reflect.TypeOf((*MockStore)(nil).UpdateTransfer), ctx, arg)
 }
+
+// UpdateUserTier mocks base method.
+func (m *MockStore) UpdateUserTier(ctx context.Context, arg database.UpdateUserTierParams) (database.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserTier", ctx, arg)
+	ret0, _ := ret[0].(database.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserTier indicates an expected call of UpdateUserTier.
+func (mr *MockStoreMockRecorder) UpdateUserTier(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserTier", reflect.TypeOf((*MockStore)(nil).UpdateUserTier), ctx, arg)
+}
+
+// UpsertAccountTransferLimit mocks base method.
+func (m *MockStore) UpsertAccountTransferLimit(ctx context.Context, arg database.UpsertAccountTransferLimitParams) (database.TransferLimit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertAccountTransferLimit", ctx, arg)
+	ret0, _ := ret[0].(database.TransferLimit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertAccountTransferLimit indicates an expected call of UpsertAccountTransferLimit.
+func (mr *MockStoreMockRecorder) UpsertAccountTransferLimit(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertAccountTransferLimit", reflect.TypeOf((*MockStore)(nil).UpsertAccountTransferLimit), ctx, arg)
+}
+
+// UpsertUserTransferLimit mocks base method.
+func (m *MockStore) UpsertUserTransferLimit(ctx context.Context, arg database.UpsertUserTransferLimitParams) (database.TransferLimit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertUserTransferLimit", ctx, arg)
+	ret0, _ := ret[0].(database.TransferLimit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertUserTransferLimit indicates an expected call of UpsertUserTransferLimit.
+func (mr *MockStoreMockRecorder) UpsertUserTransferLimit(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertUserTransferLimit", reflect.TypeOf((*MockStore)(nil).UpsertUserTransferLimit), ctx, arg)
+}
@@ -5,45 +5,372 @@
 package database
 
 import (
-	"database/sql"
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/shopspring/decimal"
 )
 
 type Account struct {
-	ID        uuid.UUID       `json:"id"`
-	Owner     string          `json:"owner"`
-	Balance   decimal.Decimal `json:"balance"`
-	Currency  string          `json:"currency"`
-	CreatedAt time.Time       `json:"created_at"`
-	UpdatedAt time.Time       `json:"updated_at"`
+	ID                    uuid.UUID       `json:"id"`
+	Owner                 string          `json:"owner"`
+	Balance               decimal.Decimal `json:"balance"`
+	Currency              string          `json:"currency"`
+	CreatedAt             time.Time       `json:"created_at"`
+	UpdatedAt             time.Time       `json:"updated_at"`
+	AvailableBalance      decimal.Decimal `json:"available_balance"`
+	OverdraftLimit        decimal.Decimal `json:"overdraft_limit"`
+	OverdraftInterestRate decimal.Decimal `json:"overdraft_interest_rate"`
+	AccountType           string          `json:"account_type"`
+	InterestProductID     uuid.NullUUID   `json:"interest_product_id"`
+	OrganisationID        uuid.NullUUID   `json:"organisation_id"`
+}
+
+type ApprovalAuditLog struct {
+	ID             int64     `json:"id"`
+	ApprovalID     uuid.UUID `json:"approval_id"`
+	OrganisationID uuid.UUID `json:"organisation_id"`
+	Actor          string    `json:"actor"`
+	Decision       string    `json:"decision"`
+	Reason         string    `json:"reason"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+type Currency struct {
+	Code        string    `json:"code"`
+	NumericCode int32     `json:"numeric_code"`
+	MinorUnits  int32     `json:"minor_units"`
+	Enabled     bool      `json:"enabled"`
+	Symbol      string    `json:"symbol"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
 }
 
 type Entry struct {
-	ID        uuid.UUID       `json:"id"`
-	AccountID uuid.UUID       `json:"account_id"`
-	Amount    decimal.Decimal `json:"amount"`
-	CreatedAt sql.NullTime    `json:"created_at"`
-	UpdatedAt sql.NullTime    `json:"updated_at"`
+	ID        uuid.UUID          `json:"id"`
+	AccountID uuid.UUID          `json:"account_id"`
+	Amount    decimal.Decimal    `json:"amount"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
 }
 
-type Transfer struct {
+type Escrow struct {
+	ID                   uuid.UUID          `json:"id"`
+	Payer                string             `json:"payer"`
+	Payee                string             `json:"payee"`
+	FromAccountID        uuid.UUID          `json:"from_account_id"`
+	ToAccountID          uuid.UUID          `json:"to_account_id"`
+	EscrowAccountID      uuid.UUID          `json:"escrow_account_id"`
+	Amount               decimal.Decimal    `json:"amount"`
+	Currency             string             `json:"currency"`
+	Description          string             `json:"description"`
+	Status               string             `json:"status"`
+	PayerConfirmedAt     pgtype.Timestamptz `json:"payer_confirmed_at"`
+	PayeeConfirmedAt     pgtype.Timestamptz `json:"payee_confirmed_at"`
+	FundingTransferID    uuid.UUID          `json:"funding_transfer_id"`
+	SettlementTransferID uuid.NullUUID      `json:"settlement_transfer_id"`
+	ExpiresAt            time.Time          `json:"expires_at"`
+	CreatedAt            time.Time          `json:"created_at"`
+	UpdatedAt            time.Time          `json:"updated_at"`
+}
+
+type EscrowEvent struct {
+	ID         int64     `json:"id"`
+	EscrowID   uuid.UUID `json:"escrow_id"`
+	Event      string    `json:"event"`
+	FromStatus string    `json:"from_status"`
+	ToStatus   string    `json:"to_status"`
+	Actor      string    `json:"actor"`
+	Reason     string    `json:"reason"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type FeeRule struct {
+	ID         uuid.UUID           `json:"id"`
+	ScheduleID uuid.UUID           `json:"schedule_id"`
+	Tier       string              `json:"tier"`
+	MinAmount  decimal.Decimal     `json:"min_amount"`
+	MaxAmount  decimal.NullDecimal `json:"max_amount"`
+	FlatFee    decimal.Decimal     `json:"flat_fee"`
+	Percentage decimal.Decimal     `json:"percentage"`
+	MinFee     decimal.Decimal     `json:"min_fee"`
+	MaxFee     decimal.NullDecimal `json:"max_fee"`
+}
+
+type FeeSchedule struct {
+	ID        uuid.UUID `json:"id"`
+	Currency  string    `json:"currency"`
+	Version   int32     `json:"version"`
+	CreatedBy string    `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type FundingTransaction struct {
+	ID                  uuid.UUID          `json:"id"`
+	AccountID           uuid.UUID          `json:"account_id"`
+	SettlementAccountID uuid.UUID          `json:"settlement_account_id"`
+	Kind                string             `json:"kind"`
+	Amount              decimal.Decimal    `json:"amount"`
+	Currency            string             `json:"currency"`
+	Status              string             `json:"status"`
+	Provider            string             `json:"provider"`
+	ProviderReference   string             `json:"provider_reference"`
+	FailureReason       string             `json:"failure_reason"`
+	SettledAt           pgtype.Timestamptz `json:"settled_at"`
+	CreatedAt           time.Time          `json:"created_at"`
+	UpdatedAt           time.Time          `json:"updated_at"`
+}
+
+type FxQuote struct {
+	ID           uuid.UUID          `json:"id"`
+	Owner        string             `json:"owner"`
+	FromCurrency string             `json:"from_currency"`
+	ToCurrency   string             `json:"to_currency"`
+	Rate         decimal.Decimal    `json:"rate"`
+	FromAmount   decimal.Decimal    `json:"from_amount"`
+	ToAmount     decimal.Decimal    `json:"to_amount"`
+	ExpiresAt    time.Time          `json:"expires_at"`
+	UsedAt       pgtype.Timestamptz `json:"used_at"`
+	CreatedAt    time.Time          `json:"created_at"`
+}
+
+type Hold struct {
+	ID             uuid.UUID       `json:"id"`
+	AccountID      uuid.UUID       `json:"account_id"`
+	ToAccountID    uuid.UUID       `json:"to_account_id"`
+	Amount         decimal.Decimal `json:"amount"`
+	Currency       string          `json:"currency"`
+	CapturedAmount decimal.Decimal `json:"captured_amount"`
+	Description    string          `json:"description"`
+	Status         string          `json:"status"`
+	TransferID     uuid.NullUUID   `json:"transfer_id"`
+	ExpiresAt      time.Time       `json:"expires_at"`
+	CreatedAt      time.Time       `json:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+}
+
+type InterestAccrual struct {
+	ID                uuid.UUID       `json:"id"`
+	AccountID         uuid.UUID       `json:"account_id"`
+	InterestProductID uuid.UUID       `json:"interest_product_id"`
+	BusinessDate      time.Time       `json:"business_date"`
+	Balance           decimal.Decimal `json:"balance"`
+	AnnualRate        decimal.Decimal `json:"annual_rate"`
+	DayCount          string          `json:"day_count"`
+	Amount            decimal.Decimal `json:"amount"`
+	PayoutID          uuid.NullUUID   `json:"payout_id"`
+	CreatedAt         time.Time       `json:"created_at"`
+}
+
+type InterestPayout struct {
 	ID            uuid.UUID       `json:"id"`
-	FromAccountID uuid.UUID       `json:"from_account_id"`
-	ToAccountID   uuid.UUID       `json:"to_account_id"`
+	AccountID     uuid.UUID       `json:"account_id"`
+	PeriodEnd     time.Time       `json:"period_end"`
+	AccruedAmount decimal.Decimal `json:"accrued_amount"`
 	Amount        decimal.Decimal `json:"amount"`
 	CreatedAt     time.Time       `json:"created_at"`
-	UpdatedAt     time.Time       `json:"updated_at"`
+	Residue       decimal.Decimal `json:"residue"`
+}
+
+type InterestProduct struct {
+	ID              uuid.UUID       `json:"id"`
+	Name            string          `json:"name"`
+	Currency        string          `json:"currency"`
+	AnnualRate      decimal.Decimal `json:"annual_rate"`
+	DayCount        string          `json:"day_count"`
+	PayoutFrequency string          `json:"payout_frequency"`
+	CreatedAt       time.Time       `json:"created_at"`
+	UpdatedAt       time.Time       `json:"updated_at"`
+}
+
+type InterestRun struct {
+	BusinessDate time.Time `json:"business_date"`
+	Accrued      int32     `json:"accrued"`
+	PaidOut      int32     `json:"paid_out"`
+	CompletedAt  time.Time `json:"completed_at"`
+}
+
+type Notification struct {
+	ID        uuid.UUID          `json:"id"`
+	Username  string             `json:"username"`
+	Kind      string             `json:"kind"`
+	Payload   json.RawMessage    `json:"payload"`
+	ReadAt    pgtype.Timestamptz `json:"read_at"`
+	CreatedAt time.Time          `json:"created_at"`
+}
+
+type Organisation struct {
+	ID                        uuid.UUID       `json:"id"`
+	Name                      string          `json:"name"`
+	ApprovalThreshold         decimal.Decimal `json:"approval_threshold"`
+	RequiredApprovals         int32           `json:"required_approvals"`
+	ApprovalTtlSeconds        int32           `json:"approval_ttl_seconds"`
+	CreatedBy                 string          `json:"created_by"`
+	CreatedAt                 time.Time       `json:"created_at"`
+	UpdatedAt                 time.Time       `json:"updated_at"`
+	ApprovalThresholdCurrency string          `json:"approval_threshold_currency"`
+}
+
+type OrganisationMember struct {
+	OrganisationID uuid.UUID `json:"organisation_id"`
+	Username       string    `json:"username"`
+	Role           string    `json:"role"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+type OverdraftInterestCharge struct {
+	ID           uuid.UUID       `json:"id"`
+	AccountID    uuid.UUID       `json:"account_id"`
+	BusinessDate time.Time       `json:"business_date"`
+	Balance      decimal.Decimal `json:"balance"`
+	InterestRate decimal.Decimal `json:"interest_rate"`
+	Amount       decimal.Decimal `json:"amount"`
+	CreatedAt    time.Time       `json:"created_at"`
+}
+
+type PaymentRequest struct {
+	ID         uuid.UUID       `json:"id"`
+	Requester  string          `json:"requester"`
+	Payer      string          `json:"payer"`
+	Amount     decimal.Decimal `json:"amount"`
+	Currency   string          `json:"currency"`
+	Memo       string          `json:"memo"`
+	Status     string          `json:"status"`
+	TransferID uuid.NullUUID   `json:"transfer_id"`
+	ExpiresAt  time.Time       `json:"expires_at"`
+	CreatedAt  time.Time       `json:"created_at"`
+	UpdatedAt  time.Time       `json:"updated_at"`
+}
+
+type ScheduledTransfer struct {
+	ID            uuid.UUID          `json:"id"`
+	Owner         string             `json:"owner"`
+	FromAccountID uuid.UUID          `json:"from_account_id"`
+	ToAccountID   uuid.UUID          `json:"to_account_id"`
+	Amount        decimal.Decimal    `json:"amount"`
+	Currency      string             `json:"currency"`
+	Frequency     string             `json:"frequency"`
+	StartAt       time.Time          `json:"start_at"`
+	EndAt         pgtype.Timestamptz `json:"end_at"`
+	NextRunAt     time.Time          `json:"next_run_at"`
+	NextAttemptAt time.Time          `json:"next_attempt_at"`
+	Attempts      int32              `json:"attempts"`
+	Status        string             `json:"status"`
+	LastError     string             `json:"last_error"`
+	CreatedAt     time.Time          `json:"created_at"`
+	UpdatedAt     time.Time          `json:"updated_at"`
+}
+
+type ScheduledTransferRun struct {
+	ID                  uuid.UUID          `json:"id"`
+	ScheduledTransferID uuid.UUID          `json:"scheduled_transfer_id"`
+	ScheduledFor        time.Time          `json:"scheduled_for"`
+	Attempt             int32              `json:"attempt"`
+	Status              string             `json:"status"`
+	TransferID          uuid.NullUUID      `json:"transfer_id"`
+	Error               string             `json:"error"`
+	CreatedAt           time.Time          `json:"created_at"`
+	FinishedAt          pgtype.Timestamptz `json:"finished_at"`
+}
+
+type Transfer struct {
+	ID                 uuid.UUID           `json:"id"`
+	FromAccountID      uuid.UUID           `json:"from_account_id"`
+	ToAccountID        uuid.UUID           `json:"to_account_id"`
+	Amount             decimal.Decimal     `json:"amount"`
+	CreatedAt          time.Time           `json:"created_at"`
+	UpdatedAt          time.Time           `json:"updated_at"`
+	FxQuoteID          uuid.NullUUID       `json:"fx_quote_id"`
+	ConvertedAmount    decimal.NullDecimal `json:"converted_amount"`
+	Fee                decimal.Decimal     `json:"fee"`
+	FeeRuleID          uuid.NullUUID       `json:"fee_rule_id"`
+	Description        string              `json:"description"`
+	ExternalReference  pgtype.Text         `json:"external_reference"`
+	Metadata           json.RawMessage     `json:"metadata"`
+	Status             string              `json:"status"`
+	FailureReason      string              `json:"failure_reason"`
+	ProcessingAt       pgtype.Timestamptz  `json:"processing_at"`
+	CompletedAt        pgtype.Timestamptz  `json:"completed_at"`
+	FailedAt           pgtype.Timestamptz  `json:"failed_at"`
+	ReversedAt         pgtype.Timestamptz  `json:"reversed_at"`
+	ReversalTransferID uuid.NullUUID       `json:"reversal_transfer_id"`
+}
+
+type TransferApproval struct {
+	ID                uuid.UUID       `json:"id"`
+	OrganisationID    uuid.UUID       `json:"organisation_id"`
+	FromAccountID     uuid.UUID       `json:"from_account_id"`
+	ToAccountID       uuid.UUID       `json:"to_account_id"`
+	Amount            decimal.Decimal `json:"amount"`
+	Currency          string          `json:"currency"`
+	Description       string          `json:"description"`
+	ExternalReference pgtype.Text     `json:"external_reference"`
+	Metadata          json.RawMessage `json:"metadata"`
+	InitiatedBy       string          `json:"initiated_by"`
+	RequiredApprovals int32           `json:"required_approvals"`
+	Approvals         int32           `json:"approvals"`
+	Status            string          `json:"status"`
+	TransferID        uuid.NullUUID   `json:"transfer_id"`
+	ExpiresAt         time.Time       `json:"expires_at"`
+	CreatedAt         time.Time       `json:"created_at"`
+	UpdatedAt         time.Time       `json:"updated_at"`
+	ReservedAmount    decimal.Decimal `json:"reserved_amount"`
+}
+
+type TransferBatch struct {
+	ID             uuid.UUID          `json:"id"`
+	Owner          string             `json:"owner"`
+	FromAccountID  uuid.UUID          `json:"from_account_id"`
+	Currency       string             `json:"currency"`
+	Mode           string             `json:"mode"`
+	Status         string             `json:"status"`
+	TotalItems     int32              `json:"total_items"`
+	TotalAmount    decimal.Decimal    `json:"total_amount"`
+	SucceededItems int32              `json:"succeeded_items"`
+	FailedItems    int32              `json:"failed_items"`
+	Error          string             `json:"error"`
+	CreatedAt      time.Time          `json:"created_at"`
+	UpdatedAt      time.Time          `json:"updated_at"`
+	CompletedAt    pgtype.Timestamptz `json:"completed_at"`
+}
+
+type TransferBatchItem struct {
+	ID          uuid.UUID          `json:"id"`
+	BatchID     uuid.UUID          `json:"batch_id"`
+	Line        int32              `json:"line"`
+	ToAccountID uuid.UUID          `json:"to_account_id"`
+	Amount      decimal.Decimal    `json:"amount"`
+	Status      string             `json:"status"`
+	TransferID  uuid.NullUUID      `json:"transfer_id"`
+	Error       string             `json:"error"`
+	ProcessedAt pgtype.Timestamptz `json:"processed_at"`
+}
+
+type TransferLimit struct {
+	ID              uuid.UUID           `json:"id"`
+	AccountID       uuid.NullUUID       `json:"account_id"`
+	Owner           pgtype.Text         `json:"owner"`
+	Currency        string              `json:"currency"`
+	MaxSingleAmount decimal.NullDecimal `json:"max_single_amount"`
+	DailyAmount     decimal.NullDecimal `json:"daily_amount"`
+	MonthlyAmount   decimal.NullDecimal `json:"monthly_amount"`
+	HourlyCount     pgtype.Int4         `json:"hourly_count"`
+	CreatedAt       time.Time           `json:"created_at"`
+	UpdatedAt       time.Time           `json:"updated_at"`
 }
 
 type User struct {
-	Username          string    `json:"username"`
-	HashedPassword    string    `json:"hashed_password"`
-	FullName          string    `json:"full_name"`
-	Email             string    `json:"email"`
-	PasswordChangedAt time.Time `json:"password_changed_at"`
-	CreatedAt         time.Time `json:"created_at"`
-	RefreshToken      string    `json:"refresh_token"`
+	Username          string             `json:"username"`
+	HashedPassword    string             `json:"hashed_password"`
+	FullName          string             `json:"full_name"`
+	Email             string             `json:"email"`
+	PasswordChangedAt time.Time          `json:"password_changed_at"`
+	CreatedAt         time.Time          `json:"created_at"`
+	RefreshToken      string             `json:"refresh_token"`
+	Role              string             `json:"role"`
+	Tier              string             `json:"tier"`
+	Alias             pgtype.Text        `json:"alias"`
+	EmailVerifiedAt   pgtype.Timestamptz `json:"email_verified_at"`
 }
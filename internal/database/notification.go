@@ -0,0 +1,40 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// notification kinds
+const (
+	NotificationPaymentRequestReceived = "payment_request.received"
+	NotificationPaymentRequestPaid     = "payment_request.paid"
+	NotificationPaymentRequestDeclined = "payment_request.declined"
+
+	NotificationTransferApprovalExecuted = "transfer_approval.executed"
+	NotificationTransferApprovalRejected = "transfer_approval.rejected"
+	NotificationTransferApprovalExpired  = "transfer_approval.expired"
+
+	NotificationTransferCompleted = "transfer.completed"
+	NotificationTransferFailed    = "transfer.failed"
+	NotificationTransferReversed  = "transfer.reversed"
+
+	NotificationEscrowFunded   = "escrow.funded"
+	NotificationEscrowReleased = "escrow.released"
+	NotificationEscrowRefunded = "escrow.refunded"
+)
+
+// notify adds a notification to a user's inbox in the transaction of q, so it
+// is only sent if what it tells about commits
+func notify(ctx context.Context, q *Queries, username, kind string, payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	_, err = q.CreateNotification(ctx, CreateNotificationParams{
+		Username: username,
+		Kind:     kind,
+		Payload:  data,
+	})
+	return err
+}
@@ -0,0 +1,117 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: notifications.sql
+
+package database
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+)
+
+const createNotification = `-- name: CreateNotification :one
+INSERT INTO notifications(
+    username,
+    kind,
+    payload
+) VALUES (
+    $1,$2,$3
+) RETURNING id, username, kind, payload, read_at, created_at
+`
+
+type CreateNotificationParams struct {
+	Username string          `json:"username"`
+	Kind     string          `json:"kind"`
+	Payload  json.RawMessage `json:"payload"`
+}
+
+func (q *Queries) CreateNotification(ctx context.Context, arg CreateNotificationParams) (Notification, error) {
+	row := q.db.QueryRow(ctx, createNotification, arg.Username, arg.Kind, arg.Payload)
+	var i Notification
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Kind,
+		&i.Payload,
+		&i.ReadAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listNotifications = `-- name: ListNotifications :many
+SELECT id, username, kind, payload, read_at, created_at FROM notifications
+WHERE username = $1
+  AND (NOT $2::boolean OR read_at IS NULL)
+ORDER BY created_at DESC
+LIMIT $4
+OFFSET $3
+`
+
+type ListNotificationsParams struct {
+	Username   string `json:"username"`
+	UnreadOnly bool   `json:"unread_only"`
+	Offset     int32  `json:"offset"`
+	Limit      int32  `json:"limit"`
+}
+
+func (q *Queries) ListNotifications(ctx context.Context, arg ListNotificationsParams) ([]Notification, error) {
+	rows, err := q.db.Query(ctx, listNotifications,
+		arg.Username,
+		arg.UnreadOnly,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Notification{}
+	for rows.Next() {
+		var i Notification
+		if err := rows.Scan(
+			&i.ID,
+			&i.Username,
+			&i.Kind,
+			&i.Payload,
+			&i.ReadAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markNotificationRead = `-- name: MarkNotificationRead :one
+UPDATE notifications
+  set read_at = COALESCE(read_at, now())
+WHERE id = $1 AND username = $2
+RETURNING id, username, kind, payload, read_at, created_at
+`
+
+type MarkNotificationReadParams struct {
+	ID       uuid.UUID `json:"id"`
+	Username string    `json:"username"`
+}
+
+func (q *Queries) MarkNotificationRead(ctx context.Context, arg MarkNotificationReadParams) (Notification, error) {
+	row := q.db.QueryRow(ctx, markNotificationRead, arg.ID, arg.Username)
+	var i Notification
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Kind,
+		&i.Payload,
+		&i.ReadAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
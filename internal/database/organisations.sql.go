@@ -0,0 +1,715 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: organisations.sql
+
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+)
+
+const addOrganisationMember = `-- name: AddOrganisationMember :one
+INSERT INTO organisation_members(
+    organisation_id,
+    username,
+    "role"
+) VALUES (
+    $1,$2,$3
+) ON CONFLICT (organisation_id, username, "role") DO UPDATE
+  set "role" = EXCLUDED."role"
+RETURNING organisation_id, username, role, created_at
+`
+
+type AddOrganisationMemberParams struct {
+	OrganisationID uuid.UUID `json:"organisation_id"`
+	Username       string    `json:"username"`
+	Role           string    `json:"role"`
+}
+
+func (q *Queries) AddOrganisationMember(ctx context.Context, arg AddOrganisationMemberParams) (OrganisationMember, error) {
+	row := q.db.QueryRow(ctx, addOrganisationMember, arg.OrganisationID, arg.Username, arg.Role)
+	var i OrganisationMember
+	err := row.Scan(
+		&i.OrganisationID,
+		&i.Username,
+		&i.Role,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const addTransferApproval = `-- name: AddTransferApproval :one
+UPDATE transfer_approvals
+  set approvals = approvals + 1,
+      updated_at = now()
+WHERE id = $1
+RETURNING id, organisation_id, from_account_id, to_account_id, amount, currency, description, external_reference, metadata, initiated_by, required_approvals, approvals, status, transfer_id, expires_at, created_at, updated_at, reserved_amount
+`
+
+func (q *Queries) AddTransferApproval(ctx context.Context, id uuid.UUID) (TransferApproval, error) {
+	row := q.db.QueryRow(ctx, addTransferApproval, id)
+	var i TransferApproval
+	err := row.Scan(
+		&i.ID,
+		&i.OrganisationID,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.Currency,
+		&i.Description,
+		&i.ExternalReference,
+		&i.Metadata,
+		&i.InitiatedBy,
+		&i.RequiredApprovals,
+		&i.Approvals,
+		&i.Status,
+		&i.TransferID,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ReservedAmount,
+	)
+	return i, err
+}
+
+const createApprovalAuditEntry = `-- name: CreateApprovalAuditEntry :one
+INSERT INTO approval_audit_log(
+    approval_id,
+    organisation_id,
+    actor,
+    decision,
+    reason
+) VALUES (
+    $1,$2,$3,$4,$5
+) RETURNING id, approval_id, organisation_id, actor, decision, reason, created_at
+`
+
+type CreateApprovalAuditEntryParams struct {
+	ApprovalID     uuid.UUID `json:"approval_id"`
+	OrganisationID uuid.UUID `json:"organisation_id"`
+	Actor          string    `json:"actor"`
+	Decision       string    `json:"decision"`
+	Reason         string    `json:"reason"`
+}
+
+// fails with a unique violation when the actor already approved
+func (q *Queries) CreateApprovalAuditEntry(ctx context.Context, arg CreateApprovalAuditEntryParams) (ApprovalAuditLog, error) {
+	row := q.db.QueryRow(ctx, createApprovalAuditEntry,
+		arg.ApprovalID,
+		arg.OrganisationID,
+		arg.Actor,
+		arg.Decision,
+		arg.Reason,
+	)
+	var i ApprovalAuditLog
+	err := row.Scan(
+		&i.ID,
+		&i.ApprovalID,
+		&i.OrganisationID,
+		&i.Actor,
+		&i.Decision,
+		&i.Reason,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createOrganisation = `-- name: CreateOrganisation :one
+INSERT INTO organisations(
+    "name",
+    approval_threshold,
+    approval_threshold_currency,
+    required_approvals,
+    approval_ttl_seconds,
+    created_by
+) VALUES (
+    $1,$2,$3,$4,$5,$6
+) RETURNING id, name, approval_threshold, required_approvals, approval_ttl_seconds, created_by, created_at, updated_at, approval_threshold_currency
+`
+
+type CreateOrganisationParams struct {
+	Name                      string          `json:"name"`
+	ApprovalThreshold         decimal.Decimal `json:"approval_threshold"`
+	ApprovalThresholdCurrency string          `json:"approval_threshold_currency"`
+	RequiredApprovals         int32           `json:"required_approvals"`
+	ApprovalTtlSeconds        int32           `json:"approval_ttl_seconds"`
+	CreatedBy                 string          `json:"created_by"`
+}
+
+func (q *Queries) CreateOrganisation(ctx context.Context, arg CreateOrganisationParams) (Organisation, error) {
+	row := q.db.QueryRow(ctx, createOrganisation,
+		arg.Name,
+		arg.ApprovalThreshold,
+		arg.ApprovalThresholdCurrency,
+		arg.RequiredApprovals,
+		arg.ApprovalTtlSeconds,
+		arg.CreatedBy,
+	)
+	var i Organisation
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.ApprovalThreshold,
+		&i.RequiredApprovals,
+		&i.ApprovalTtlSeconds,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ApprovalThresholdCurrency,
+	)
+	return i, err
+}
+
+const createTransferApproval = `-- name: CreateTransferApproval :one
+INSERT INTO transfer_approvals(
+    organisation_id,
+    from_account_id,
+    to_account_id,
+    amount,
+    currency,
+    description,
+    external_reference,
+    metadata,
+    initiated_by,
+    required_approvals,
+    expires_at,
+    reserved_amount
+) VALUES (
+    $1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12
+) RETURNING id, organisation_id, from_account_id, to_account_id, amount, currency, description, external_reference, metadata, initiated_by, required_approvals, approvals, status, transfer_id, expires_at, created_at, updated_at, reserved_amount
+`
+
+type CreateTransferApprovalParams struct {
+	OrganisationID    uuid.UUID       `json:"organisation_id"`
+	FromAccountID     uuid.UUID       `json:"from_account_id"`
+	ToAccountID       uuid.UUID       `json:"to_account_id"`
+	Amount            decimal.Decimal `json:"amount"`
+	Currency          string          `json:"currency"`
+	Description       string          `json:"description"`
+	ExternalReference pgtype.Text     `json:"external_reference"`
+	Metadata          json.RawMessage `json:"metadata"`
+	InitiatedBy       string          `json:"initiated_by"`
+	RequiredApprovals int32           `json:"required_approvals"`
+	ExpiresAt         time.Time       `json:"expires_at"`
+	ReservedAmount    decimal.Decimal `json:"reserved_amount"`
+}
+
+func (q *Queries) CreateTransferApproval(ctx context.Context, arg CreateTransferApprovalParams) (TransferApproval, error) {
+	row := q.db.QueryRow(ctx, createTransferApproval,
+		arg.OrganisationID,
+		arg.FromAccountID,
+		arg.ToAccountID,
+		arg.Amount,
+		arg.Currency,
+		arg.Description,
+		arg.ExternalReference,
+		arg.Metadata,
+		arg.InitiatedBy,
+		arg.RequiredApprovals,
+		arg.ExpiresAt,
+		arg.ReservedAmount,
+	)
+	var i TransferApproval
+	err := row.Scan(
+		&i.ID,
+		&i.OrganisationID,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.Currency,
+		&i.Description,
+		&i.ExternalReference,
+		&i.Metadata,
+		&i.InitiatedBy,
+		&i.RequiredApprovals,
+		&i.Approvals,
+		&i.Status,
+		&i.TransferID,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ReservedAmount,
+	)
+	return i, err
+}
+
+const finishTransferApproval = `-- name: FinishTransferApproval :one
+UPDATE transfer_approvals
+  set status = $2,
+      transfer_id = $3,
+      updated_at = now()
+WHERE id = $1
+RETURNING id, organisation_id, from_account_id, to_account_id, amount, currency, description, external_reference, metadata, initiated_by, required_approvals, approvals, status, transfer_id, expires_at, created_at, updated_at, reserved_amount
+`
+
+type FinishTransferApprovalParams struct {
+	ID         uuid.UUID     `json:"id"`
+	Status     string        `json:"status"`
+	TransferID uuid.NullUUID `json:"transfer_id"`
+}
+
+func (q *Queries) FinishTransferApproval(ctx context.Context, arg FinishTransferApprovalParams) (TransferApproval, error) {
+	row := q.db.QueryRow(ctx, finishTransferApproval, arg.ID, arg.Status, arg.TransferID)
+	var i TransferApproval
+	err := row.Scan(
+		&i.ID,
+		&i.OrganisationID,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.Currency,
+		&i.Description,
+		&i.ExternalReference,
+		&i.Metadata,
+		&i.InitiatedBy,
+		&i.RequiredApprovals,
+		&i.Approvals,
+		&i.Status,
+		&i.TransferID,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ReservedAmount,
+	)
+	return i, err
+}
+
+const getOrganisation = `-- name: GetOrganisation :one
+SELECT id, name, approval_threshold, required_approvals, approval_ttl_seconds, created_by, created_at, updated_at, approval_threshold_currency FROM organisations
+WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetOrganisation(ctx context.Context, id uuid.UUID) (Organisation, error) {
+	row := q.db.QueryRow(ctx, getOrganisation, id)
+	var i Organisation
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.ApprovalThreshold,
+		&i.RequiredApprovals,
+		&i.ApprovalTtlSeconds,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ApprovalThresholdCurrency,
+	)
+	return i, err
+}
+
+const getTransferApproval = `-- name: GetTransferApproval :one
+SELECT id, organisation_id, from_account_id, to_account_id, amount, currency, description, external_reference, metadata, initiated_by, required_approvals, approvals, status, transfer_id, expires_at, created_at, updated_at, reserved_amount FROM transfer_approvals
+WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetTransferApproval(ctx context.Context, id uuid.UUID) (TransferApproval, error) {
+	row := q.db.QueryRow(ctx, getTransferApproval, id)
+	var i TransferApproval
+	err := row.Scan(
+		&i.ID,
+		&i.OrganisationID,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.Currency,
+		&i.Description,
+		&i.ExternalReference,
+		&i.Metadata,
+		&i.InitiatedBy,
+		&i.RequiredApprovals,
+		&i.Approvals,
+		&i.Status,
+		&i.TransferID,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ReservedAmount,
+	)
+	return i, err
+}
+
+const getTransferApprovalForUpdate = `-- name: GetTransferApprovalForUpdate :one
+SELECT id, organisation_id, from_account_id, to_account_id, amount, currency, description, external_reference, metadata, initiated_by, required_approvals, approvals, status, transfer_id, expires_at, created_at, updated_at, reserved_amount FROM transfer_approvals
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetTransferApprovalForUpdate(ctx context.Context, id uuid.UUID) (TransferApproval, error) {
+	row := q.db.QueryRow(ctx, getTransferApprovalForUpdate, id)
+	var i TransferApproval
+	err := row.Scan(
+		&i.ID,
+		&i.OrganisationID,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.Currency,
+		&i.Description,
+		&i.ExternalReference,
+		&i.Metadata,
+		&i.InitiatedBy,
+		&i.RequiredApprovals,
+		&i.Approvals,
+		&i.Status,
+		&i.TransferID,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ReservedAmount,
+	)
+	return i, err
+}
+
+const hasApprovalDecision = `-- name: HasApprovalDecision :one
+SELECT EXISTS (
+    SELECT 1 FROM approval_audit_log
+    WHERE approval_id = $1 AND actor = $2 AND decision = $3
+)
+`
+
+type HasApprovalDecisionParams struct {
+	ApprovalID uuid.UUID `json:"approval_id"`
+	Actor      string    `json:"actor"`
+	Decision   string    `json:"decision"`
+}
+
+func (q *Queries) HasApprovalDecision(ctx context.Context, arg HasApprovalDecisionParams) (bool, error) {
+	row := q.db.QueryRow(ctx, hasApprovalDecision, arg.ApprovalID, arg.Actor, arg.Decision)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const hasOrganisationRole = `-- name: HasOrganisationRole :one
+SELECT EXISTS (
+    SELECT 1 FROM organisation_members
+    WHERE organisation_id = $1 AND username = $2 AND "role" = $3
+)
+`
+
+type HasOrganisationRoleParams struct {
+	OrganisationID uuid.UUID `json:"organisation_id"`
+	Username       string    `json:"username"`
+	Role           string    `json:"role"`
+}
+
+func (q *Queries) HasOrganisationRole(ctx context.Context, arg HasOrganisationRoleParams) (bool, error) {
+	row := q.db.QueryRow(ctx, hasOrganisationRole, arg.OrganisationID, arg.Username, arg.Role)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const isOrganisationMember = `-- name: IsOrganisationMember :one
+SELECT EXISTS (
+    SELECT 1 FROM organisation_members
+    WHERE organisation_id = $1 AND username = $2
+)
+`
+
+type IsOrganisationMemberParams struct {
+	OrganisationID uuid.UUID `json:"organisation_id"`
+	Username       string    `json:"username"`
+}
+
+func (q *Queries) IsOrganisationMember(ctx context.Context, arg IsOrganisationMemberParams) (bool, error) {
+	row := q.db.QueryRow(ctx, isOrganisationMember, arg.OrganisationID, arg.Username)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const listApprovalAuditLog = `-- name: ListApprovalAuditLog :many
+SELECT id, approval_id, organisation_id, actor, decision, reason, created_at FROM approval_audit_log
+WHERE approval_id = $1
+ORDER BY id
+`
+
+func (q *Queries) ListApprovalAuditLog(ctx context.Context, approvalID uuid.UUID) ([]ApprovalAuditLog, error) {
+	rows, err := q.db.Query(ctx, listApprovalAuditLog, approvalID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ApprovalAuditLog{}
+	for rows.Next() {
+		var i ApprovalAuditLog
+		if err := rows.Scan(
+			&i.ID,
+			&i.ApprovalID,
+			&i.OrganisationID,
+			&i.Actor,
+			&i.Decision,
+			&i.Reason,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listExpiredTransferApprovals = `-- name: ListExpiredTransferApprovals :many
+SELECT id FROM transfer_approvals
+WHERE status = 'pending_approval' AND expires_at <= $1
+ORDER BY expires_at
+LIMIT $2
+`
+
+type ListExpiredTransferApprovalsParams struct {
+	ExpiresAt time.Time `json:"expires_at"`
+	Limit     int32     `json:"limit"`
+}
+
+func (q *Queries) ListExpiredTransferApprovals(ctx context.Context, arg ListExpiredTransferApprovalsParams) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, listExpiredTransferApprovals, arg.ExpiresAt, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []uuid.UUID{}
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOrganisationMembers = `-- name: ListOrganisationMembers :many
+SELECT organisation_id, username, role, created_at FROM organisation_members
+WHERE organisation_id = $1
+ORDER BY username, "role"
+`
+
+func (q *Queries) ListOrganisationMembers(ctx context.Context, organisationID uuid.UUID) ([]OrganisationMember, error) {
+	rows, err := q.db.Query(ctx, listOrganisationMembers, organisationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []OrganisationMember{}
+	for rows.Next() {
+		var i OrganisationMember
+		if err := rows.Scan(
+			&i.OrganisationID,
+			&i.Username,
+			&i.Role,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTransferApprovals = `-- name: ListTransferApprovals :many
+SELECT id, organisation_id, from_account_id, to_account_id, amount, currency, description, external_reference, metadata, initiated_by, required_approvals, approvals, status, transfer_id, expires_at, created_at, updated_at, reserved_amount FROM transfer_approvals
+WHERE organisation_id = $1
+  AND ($2::varchar IS NULL OR status = $2)
+ORDER BY created_at DESC
+LIMIT $4
+OFFSET $3
+`
+
+type ListTransferApprovalsParams struct {
+	OrganisationID uuid.UUID   `json:"organisation_id"`
+	Status         pgtype.Text `json:"status"`
+	Offset         int32       `json:"offset"`
+	Limit          int32       `json:"limit"`
+}
+
+// an organisation's transfer approvals, status filters them when set
+func (q *Queries) ListTransferApprovals(ctx context.Context, arg ListTransferApprovalsParams) ([]TransferApproval, error) {
+	rows, err := q.db.Query(ctx, listTransferApprovals,
+		arg.OrganisationID,
+		arg.Status,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []TransferApproval{}
+	for rows.Next() {
+		var i TransferApproval
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganisationID,
+			&i.FromAccountID,
+			&i.ToAccountID,
+			&i.Amount,
+			&i.Currency,
+			&i.Description,
+			&i.ExternalReference,
+			&i.Metadata,
+			&i.InitiatedBy,
+			&i.RequiredApprovals,
+			&i.Approvals,
+			&i.Status,
+			&i.TransferID,
+			&i.ExpiresAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ReservedAmount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserOrganisations = `-- name: ListUserOrganisations :many
+SELECT DISTINCT o.id, o.name, o.approval_threshold, o.required_approvals, o.approval_ttl_seconds, o.created_by, o.created_at, o.updated_at, o.approval_threshold_currency FROM organisations o
+JOIN organisation_members m ON m.organisation_id = o.id
+WHERE m.username = $1
+ORDER BY o.created_at
+`
+
+func (q *Queries) ListUserOrganisations(ctx context.Context, username string) ([]Organisation, error) {
+	rows, err := q.db.Query(ctx, listUserOrganisations, username)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Organisation{}
+	for rows.Next() {
+		var i Organisation
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.ApprovalThreshold,
+			&i.RequiredApprovals,
+			&i.ApprovalTtlSeconds,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ApprovalThresholdCurrency,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const removeOrganisationMember = `-- name: RemoveOrganisationMember :execrows
+DELETE FROM organisation_members
+WHERE organisation_id = $1 AND username = $2 AND "role" = $3
+`
+
+type RemoveOrganisationMemberParams struct {
+	OrganisationID uuid.UUID `json:"organisation_id"`
+	Username       string    `json:"username"`
+	Role           string    `json:"role"`
+}
+
+func (q *Queries) RemoveOrganisationMember(ctx context.Context, arg RemoveOrganisationMemberParams) (int64, error) {
+	result, err := q.db.Exec(ctx, removeOrganisationMember, arg.OrganisationID, arg.Username, arg.Role)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const setAccountOrganisation = `-- name: SetAccountOrganisation :one
+UPDATE accounts
+  set organisation_id = $2,
+      updated_at = now()
+WHERE id = $1
+RETURNING id, owner, balance, currency, created_at, updated_at, available_balance, overdraft_limit, overdraft_interest_rate, account_type, interest_product_id, organisation_id
+`
+
+type SetAccountOrganisationParams struct {
+	ID             uuid.UUID     `json:"id"`
+	OrganisationID uuid.NullUUID `json:"organisation_id"`
+}
+
+func (q *Queries) SetAccountOrganisation(ctx context.Context, arg SetAccountOrganisationParams) (Account, error) {
+	row := q.db.QueryRow(ctx, setAccountOrganisation, arg.ID, arg.OrganisationID)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Balance,
+		&i.Currency,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.AvailableBalance,
+		&i.OverdraftLimit,
+		&i.OverdraftInterestRate,
+		&i.AccountType,
+		&i.InterestProductID,
+		&i.OrganisationID,
+	)
+	return i, err
+}
+
+const updateOrganisationPolicy = `-- name: UpdateOrganisationPolicy :one
+UPDATE organisations
+  set approval_threshold = $2,
+      approval_threshold_currency = $3,
+      required_approvals = $4,
+      approval_ttl_seconds = $5,
+      updated_at = now()
+WHERE id = $1
+RETURNING id, name, approval_threshold, required_approvals, approval_ttl_seconds, created_by, created_at, updated_at, approval_threshold_currency
+`
+
+type UpdateOrganisationPolicyParams struct {
+	ID                        uuid.UUID       `json:"id"`
+	ApprovalThreshold         decimal.Decimal `json:"approval_threshold"`
+	ApprovalThresholdCurrency string          `json:"approval_threshold_currency"`
+	RequiredApprovals         int32           `json:"required_approvals"`
+	ApprovalTtlSeconds        int32           `json:"approval_ttl_seconds"`
+}
+
+func (q *Queries) UpdateOrganisationPolicy(ctx context.Context, arg UpdateOrganisationPolicyParams) (Organisation, error) {
+	row := q.db.QueryRow(ctx, updateOrganisationPolicy,
+		arg.ID,
+		arg.ApprovalThreshold,
+		arg.ApprovalThresholdCurrency,
+		arg.RequiredApprovals,
+		arg.ApprovalTtlSeconds,
+	)
+	var i Organisation
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.ApprovalThreshold,
+		&i.RequiredApprovals,
+		&i.ApprovalTtlSeconds,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ApprovalThresholdCurrency,
+	)
+	return i, err
+}
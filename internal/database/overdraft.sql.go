@@ -0,0 +1,219 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: overdraft.sql
+
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+const createOverdraftInterestAccount = `-- name: CreateOverdraftInterestAccount :exec
+INSERT INTO accounts(
+    "owner",
+    balance,
+    currency
+) VALUES (
+    'overdraft_interest', 0, $1
+) ON CONFLICT ("owner", currency, account_type) DO NOTHING
+`
+
+func (q *Queries) CreateOverdraftInterestAccount(ctx context.Context, currency string) error {
+	_, err := q.db.Exec(ctx, createOverdraftInterestAccount, currency)
+	return err
+}
+
+const createOverdraftInterestCharge = `-- name: CreateOverdraftInterestCharge :one
+INSERT INTO overdraft_interest_charges(
+    account_id,
+    business_date,
+    balance,
+    interest_rate,
+    amount
+) VALUES (
+    $1,$2,$3,$4,$5
+)
+ON CONFLICT (account_id, business_date) DO NOTHING
+RETURNING id, account_id, business_date, balance, interest_rate, amount, created_at
+`
+
+type CreateOverdraftInterestChargeParams struct {
+	AccountID    uuid.UUID       `json:"account_id"`
+	BusinessDate time.Time       `json:"business_date"`
+	Balance      decimal.Decimal `json:"balance"`
+	InterestRate decimal.Decimal `json:"interest_rate"`
+	Amount       decimal.Decimal `json:"amount"`
+}
+
+func (q *Queries) CreateOverdraftInterestCharge(ctx context.Context, arg CreateOverdraftInterestChargeParams) (OverdraftInterestCharge, error) {
+	row := q.db.QueryRow(ctx, createOverdraftInterestCharge,
+		arg.AccountID,
+		arg.BusinessDate,
+		arg.Balance,
+		arg.InterestRate,
+		arg.Amount,
+	)
+	var i OverdraftInterestCharge
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.BusinessDate,
+		&i.Balance,
+		&i.InterestRate,
+		&i.Amount,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getOverdraftInterestAccount = `-- name: GetOverdraftInterestAccount :one
+SELECT id, owner, balance, currency, created_at, updated_at, available_balance, overdraft_limit, overdraft_interest_rate, account_type, interest_product_id, organisation_id FROM accounts
+WHERE "owner" = 'overdraft_interest' AND currency = $1
+LIMIT 1
+`
+
+func (q *Queries) GetOverdraftInterestAccount(ctx context.Context, currency string) (Account, error) {
+	row := q.db.QueryRow(ctx, getOverdraftInterestAccount, currency)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Balance,
+		&i.Currency,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.AvailableBalance,
+		&i.OverdraftLimit,
+		&i.OverdraftInterestRate,
+		&i.AccountType,
+		&i.InterestProductID,
+		&i.OrganisationID,
+	)
+	return i, err
+}
+
+const listOverdraftInterestCharges = `-- name: ListOverdraftInterestCharges :many
+SELECT id, account_id, business_date, balance, interest_rate, amount, created_at FROM overdraft_interest_charges
+WHERE account_id = $1
+ORDER BY business_date DESC
+LIMIT $2
+OFFSET $3
+`
+
+type ListOverdraftInterestChargesParams struct {
+	AccountID uuid.UUID `json:"account_id"`
+	Limit     int32     `json:"limit"`
+	Offset    int32     `json:"offset"`
+}
+
+func (q *Queries) ListOverdraftInterestCharges(ctx context.Context, arg ListOverdraftInterestChargesParams) ([]OverdraftInterestCharge, error) {
+	rows, err := q.db.Query(ctx, listOverdraftInterestCharges, arg.AccountID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []OverdraftInterestCharge{}
+	for rows.Next() {
+		var i OverdraftInterestCharge
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.BusinessDate,
+			&i.Balance,
+			&i.InterestRate,
+			&i.Amount,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOverdrawnAccountsDue = `-- name: ListOverdrawnAccountsDue :many
+SELECT a.id FROM accounts a
+WHERE a.overdraft_interest_rate > 0
+  AND a.created_at < $1
+  AND a.balance - COALESCE((
+    SELECT SUM(e.amount) FROM entries e
+    WHERE e.account_id = a.id AND e.created_at >= $1
+  ), 0) < 0
+  AND NOT EXISTS (
+    SELECT 1 FROM overdraft_interest_charges c
+    WHERE c.account_id = a.id AND c.business_date = $2
+  )
+ORDER BY a.id
+LIMIT $3
+`
+
+type ListOverdrawnAccountsDueParams struct {
+	DayEnd       time.Time `json:"day_end"`
+	BusinessDate time.Time `json:"business_date"`
+	Limit        int32     `json:"limit"`
+}
+
+// accounts that closed the business date overdrawn and haven't been charged
+// interest for it, the closing balance leaves out entries posted since
+func (q *Queries) ListOverdrawnAccountsDue(ctx context.Context, arg ListOverdrawnAccountsDueParams) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, listOverdrawnAccountsDue, arg.DayEnd, arg.BusinessDate, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []uuid.UUID{}
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setAccountOverdraft = `-- name: SetAccountOverdraft :one
+UPDATE accounts
+  set overdraft_limit = $1,
+      overdraft_interest_rate = $2,
+      updated_at = now()
+WHERE id = $3
+RETURNING id, owner, balance, currency, created_at, updated_at, available_balance, overdraft_limit, overdraft_interest_rate, account_type, interest_product_id, organisation_id
+`
+
+type SetAccountOverdraftParams struct {
+	OverdraftLimit        decimal.Decimal `json:"overdraft_limit"`
+	OverdraftInterestRate decimal.Decimal `json:"overdraft_interest_rate"`
+	ID                    uuid.UUID       `json:"id"`
+}
+
+func (q *Queries) SetAccountOverdraft(ctx context.Context, arg SetAccountOverdraftParams) (Account, error) {
+	row := q.db.QueryRow(ctx, setAccountOverdraft, arg.OverdraftLimit, arg.OverdraftInterestRate, arg.ID)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Balance,
+		&i.Currency,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.AvailableBalance,
+		&i.OverdraftLimit,
+		&i.OverdraftInterestRate,
+		&i.AccountType,
+		&i.InterestProductID,
+		&i.OrganisationID,
+	)
+	return i, err
+}
@@ -0,0 +1,118 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransferTx_Overdraft(t *testing.T) {
+	store := NewStore(testDB)
+	from := createAccountInCurrency(t, CreateRandomUser(t), "USD", decimal.NewFromInt(50))
+	to := createAccountInCurrency(t, CreateRandomUser(t), "USD", decimal.Zero)
+
+	_, err := testQueries.SetAccountOverdraft(context.Background(), SetAccountOverdraftParams{
+		ID:                    from.ID,
+		OverdraftLimit:        decimal.NewFromInt(100),
+		OverdraftInterestRate: decimal.RequireFromString("0.1825"),
+	})
+	require.NoError(t, err)
+
+	result, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: from.ID,
+		ToAccountID:   to.ID,
+		Amount:        decimal.NewFromInt(120),
+	})
+	require.NoError(t, err)
+	require.True(t, decimal.NewFromInt(-70).Equal(result.FromAccount.Balance))
+	require.True(t, decimal.NewFromInt(-70).Equal(result.FromAccount.AvailableBalance))
+
+	// only 30 of the overdraft is left
+	_, err = store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: from.ID,
+		ToAccountID:   to.ID,
+		Amount:        decimal.NewFromInt(31),
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Insufficient balance")
+}
+
+func TestAccrueOverdraftInterestTx_OncePerDay(t *testing.T) {
+	store := NewStore(testDB)
+	from := createAccountInCurrency(t, CreateRandomUser(t), "USD", decimal.Zero)
+	to := createAccountInCurrency(t, CreateRandomUser(t), "USD", decimal.Zero)
+
+	_, err := testQueries.SetAccountOverdraft(context.Background(), SetAccountOverdraftParams{
+		ID:                    from.ID,
+		OverdraftLimit:        decimal.NewFromInt(1000),
+		OverdraftInterestRate: decimal.RequireFromString("0.1825"),
+	})
+	require.NoError(t, err)
+
+	_, err = store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: from.ID,
+		ToAccountID:   to.ID,
+		Amount:        decimal.NewFromInt(1000),
+	})
+	require.NoError(t, err)
+
+	interestAccount, err := testQueries.GetOverdraftInterestAccount(context.Background(), "USD")
+	require.NoError(t, err)
+
+	// other tests' overdrawn accounts may be charged too, so run until none are left
+	accrue := func(businessDate time.Time) {
+		for {
+			charged, err := store.AccrueOverdraftInterestTx(context.Background(), businessDate, 100)
+			require.NoError(t, err)
+			if charged == 0 {
+				return
+			}
+		}
+	}
+
+	// the account didn't close yesterday overdrawn
+	businessDate := BusinessDate(time.Now())
+	accrue(businessDate.AddDate(0, 0, -1))
+	account, err := testQueries.GetAccount(context.Background(), from.ID)
+	require.NoError(t, err)
+	require.True(t, decimal.NewFromInt(-1000).Equal(account.Balance))
+
+	accrue(businessDate)
+
+	// 1000 * 0.1825 / 365 = 0.50
+	account, err = testQueries.GetAccount(context.Background(), from.ID)
+	require.NoError(t, err)
+	require.True(t, decimal.RequireFromString("-1000.50").Equal(account.Balance))
+
+	interestAfter, err := testQueries.GetAccount(context.Background(), interestAccount.ID)
+	require.NoError(t, err)
+
+	accrue(businessDate)
+	account, err = testQueries.GetAccount(context.Background(), from.ID)
+	require.NoError(t, err)
+	require.True(t, decimal.RequireFromString("-1000.50").Equal(account.Balance))
+
+	charges, err := testQueries.ListOverdraftInterestCharges(context.Background(), ListOverdraftInterestChargesParams{
+		AccountID: from.ID,
+		Limit:     10,
+	})
+	require.NoError(t, err)
+	require.Len(t, charges, 1)
+	require.True(t, decimal.NewFromInt(-1000).Equal(charges[0].Balance))
+	require.True(t, decimal.RequireFromString("0.50").Equal(charges[0].Amount))
+
+	interestAgain, err := testQueries.GetAccount(context.Background(), interestAccount.ID)
+	require.NoError(t, err)
+	require.True(t, interestAfter.Balance.Equal(interestAgain.Balance))
+}
+
+func TestDailyOverdraftInterest(t *testing.T) {
+	rate := decimal.RequireFromString("0.20")
+
+	require.True(t, decimal.Zero.Equal(DailyOverdraftInterest(decimal.NewFromInt(100), rate, 2)))
+	require.True(t, decimal.RequireFromString("0.55").Equal(DailyOverdraftInterest(decimal.NewFromInt(-1000), rate, 2)))
+	require.True(t, decimal.NewFromInt(1).Equal(DailyOverdraftInterest(decimal.NewFromInt(-1000), rate, 0)))
+}
@@ -0,0 +1,115 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Glenn444/banking-app/util"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// daysPerYear turns a yearly overdraft interest rate into a daily one
+const daysPerYear = 365
+
+// SpendableBalance is what the account can still pay out, its available
+// balance plus the overdraft it's allowed to use
+func (a Account) SpendableBalance() decimal.Decimal {
+	return a.AvailableBalance.Add(a.OverdraftLimit)
+}
+
+// BusinessDate is the UTC day t falls on, at midnight. Daily jobs charge
+// and accrue once per business date.
+func BusinessDate(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// DailyOverdraftInterest is one day of interest on a negative balance at a
+// yearly rate, rounded to the currency's minor units
+func DailyOverdraftInterest(balance, yearlyRate decimal.Decimal, minorUnits int32) decimal.Decimal {
+	if !balance.IsNegative() {
+		return decimal.Zero
+	}
+	return balance.Neg().Mul(yearlyRate).Div(decimal.NewFromInt(daysPerYear)).Round(minorUnits)
+}
+
+// AccrueOverdraftInterestTx charges a day of interest on the end-of-day
+// balance to up to limit accounts that closed businessDate overdrawn and
+// haven't been charged for it yet, and returns how many it charged. The
+// interest is paid into the currency's overdraft interest account, each
+// account is charged in its own transaction and at most once per business
+// date, so a run can be repeated safely.
+func (store *SQLStore) AccrueOverdraftInterestTx(ctx context.Context, businessDate time.Time, limit int32) (int, error) {
+	ids, err := store.ListOverdrawnAccountsDue(ctx, ListOverdrawnAccountsDueParams{
+		DayEnd:       businessDate.AddDate(0, 0, 1),
+		BusinessDate: businessDate,
+		Limit:        limit,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	charged := 0
+	for _, id := range ids {
+		err = store.execTx(ctx, nil, func(q *Queries) error {
+			return chargeOverdraftInterest(ctx, q, id, businessDate)
+		})
+		if err != nil {
+			return charged, err
+		}
+		charged++
+	}
+	return charged, nil
+}
+
+func chargeOverdraftInterest(ctx context.Context, q *Queries, accountID uuid.UUID, businessDate time.Time) error {
+	account, err := q.GetAccountByIdForUpdate(ctx, accountID)
+	if err != nil {
+		return err
+	}
+
+	currency, ok := util.LookupCurrency(account.Currency)
+	if !ok {
+		return fmt.Errorf("account %v has unknown currency %s", account.ID, account.Currency)
+	}
+
+	// like interest, the charge is on the day's closing balance, so a late
+	// or repeated run charges the same amount
+	balance, err := q.GetAccountBalanceAt(ctx, GetAccountBalanceAtParams{
+		AccountID: account.ID,
+		At:        businessDate.AddDate(0, 0, 1),
+	})
+	if err != nil {
+		return err
+	}
+	amount := DailyOverdraftInterest(balance, account.OverdraftInterestRate, currency.MinorUnits)
+
+	// the charge is recorded even when it rounds to zero, so the account
+	// isn't picked up again that day
+	_, err = q.CreateOverdraftInterestCharge(ctx, CreateOverdraftInterestChargeParams{
+		AccountID:    account.ID,
+		BusinessDate: businessDate,
+		Balance:      balance,
+		InterestRate: account.OverdraftInterestRate,
+		Amount:       amount,
+	})
+	if errors.Is(err, ErrNotFound) {
+		// charged by another run
+		return nil
+	}
+	if err != nil || amount.IsZero() {
+		return err
+	}
+
+	interestAccount, err := q.GetOverdraftInterestAccount(ctx, account.Currency)
+	if err != nil {
+		return fmt.Errorf("no overdraft interest account for currency %s: %w", account.Currency, err)
+	}
+
+	// interest is owed whatever the limit, so it may take the account past it
+	_, _, err = moveMoney(ctx, q, account.ID, interestAccount.ID, amount, false)
+	return err
+}
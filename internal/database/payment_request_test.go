@@ -0,0 +1,115 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomPaymentRequest(t *testing.T, requester, payer User, expiresAt time.Time) PaymentRequest {
+	t.Helper()
+	request, err := NewStore(testDB).CreatePaymentRequestTx(context.Background(), CreatePaymentRequestParams{
+		Requester: requester.Username,
+		Payer:     payer.Username,
+		Amount:    decimal.NewFromInt(30),
+		Currency:  "USD",
+		Memo:      "lunch",
+		ExpiresAt: expiresAt,
+	})
+	require.NoError(t, err)
+	require.Equal(t, PaymentRequestStatusPending, request.Status)
+	return request
+}
+
+func TestAcceptPaymentRequestTx(t *testing.T) {
+	store := NewStore(testDB)
+	ctx := context.Background()
+	requester, payer := CreateRandomUser(t), CreateRandomUser(t)
+	toAccount := createAccountInCurrency(t, requester, "USD", decimal.Zero)
+	fromAccount := createAccountInCurrency(t, payer, "USD", decimal.NewFromInt(100))
+
+	request := createRandomPaymentRequest(t, requester, payer, time.Now().Add(time.Hour))
+
+	received, err := testQueries.ListNotifications(ctx, ListNotificationsParams{Username: payer.Username, Limit: 5})
+	require.NoError(t, err)
+	require.Len(t, received, 1)
+	require.Equal(t, NotificationPaymentRequestReceived, received[0].Kind)
+
+	result, err := store.AcceptPaymentRequestTx(ctx, AcceptPaymentRequestTxParams{
+		RequestID:     request.ID,
+		FromAccountID: fromAccount.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, PaymentRequestStatusPaid, result.Request.Status)
+	require.Equal(t, result.Transfer.ID, result.Request.TransferID.UUID)
+	require.Equal(t, toAccount.ID, result.ToAccount.ID)
+	require.True(t, decimal.NewFromInt(70).Equal(result.FromAccount.Balance))
+	require.True(t, decimal.NewFromInt(30).Equal(result.ToAccount.Balance))
+
+	paid, err := testQueries.ListNotifications(ctx, ListNotificationsParams{
+		Username:   requester.Username,
+		UnreadOnly: true,
+		Limit:      5,
+	})
+	require.NoError(t, err)
+	require.Len(t, paid, 1)
+	require.Equal(t, NotificationPaymentRequestPaid, paid[0].Kind)
+	var payload PaymentRequest
+	require.NoError(t, json.Unmarshal(paid[0].Payload, &payload))
+	require.Equal(t, request.ID, payload.ID)
+
+	// a request is only paid once
+	_, err = store.AcceptPaymentRequestTx(ctx, AcceptPaymentRequestTxParams{
+		RequestID:     request.ID,
+		FromAccountID: fromAccount.ID,
+	})
+	require.ErrorIs(t, err, ErrPaymentRequestNotPending)
+}
+
+func TestAcceptPaymentRequestTx_RollsBack(t *testing.T) {
+	store := NewStore(testDB)
+	ctx := context.Background()
+	requester, payer := CreateRandomUser(t), CreateRandomUser(t)
+	createAccountInCurrency(t, requester, "USD", decimal.Zero)
+	fromAccount := createAccountInCurrency(t, payer, "USD", decimal.NewFromInt(10))
+
+	request := createRandomPaymentRequest(t, requester, payer, time.Now().Add(time.Hour))
+
+	_, err := store.AcceptPaymentRequestTx(ctx, AcceptPaymentRequestTxParams{
+		RequestID:     request.ID,
+		FromAccountID: fromAccount.ID,
+	})
+	require.Error(t, err)
+
+	// the failed transfer leaves the request pending and nobody notified
+	unchanged, err := testQueries.GetPaymentRequest(ctx, request.ID)
+	require.NoError(t, err)
+	require.Equal(t, PaymentRequestStatusPending, unchanged.Status)
+	notifications, err := testQueries.ListNotifications(ctx, ListNotificationsParams{Username: requester.Username, Limit: 5})
+	require.NoError(t, err)
+	require.Empty(t, notifications)
+}
+
+func TestDeclinePaymentRequestTx(t *testing.T) {
+	store := NewStore(testDB)
+	ctx := context.Background()
+	requester, payer := CreateRandomUser(t), CreateRandomUser(t)
+
+	request := createRandomPaymentRequest(t, requester, payer, time.Now().Add(time.Hour))
+	declined, err := store.DeclinePaymentRequestTx(ctx, request.ID)
+	require.NoError(t, err)
+	require.Equal(t, PaymentRequestStatusDeclined, declined.Status)
+
+	notifications, err := testQueries.ListNotifications(ctx, ListNotificationsParams{Username: requester.Username, Limit: 5})
+	require.NoError(t, err)
+	require.Len(t, notifications, 1)
+	require.Equal(t, NotificationPaymentRequestDeclined, notifications[0].Kind)
+
+	expired := createRandomPaymentRequest(t, requester, payer, time.Now().Add(-time.Minute))
+	_, err = store.DeclinePaymentRequestTx(ctx, expired.ID)
+	require.ErrorIs(t, err, ErrPaymentRequestExpired)
+}
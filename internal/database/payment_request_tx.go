@@ -0,0 +1,149 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	PaymentRequestStatusPending  = "pending"
+	PaymentRequestStatusPaid     = "paid"
+	PaymentRequestStatusDeclined = "declined"
+)
+
+var (
+	ErrPaymentRequestNotPending = errors.New("payment request is no longer pending")
+	ErrPaymentRequestExpired    = errors.New("payment request has expired")
+	ErrPaymentRequestAccount    = errors.New("payment request can't be paid between these accounts")
+)
+
+// CreatePaymentRequestTx asks the payer for money and notifies them
+func (store *SQLStore) CreatePaymentRequestTx(ctx context.Context, arg CreatePaymentRequestParams) (PaymentRequest, error) {
+	var request PaymentRequest
+
+	err := store.execTx(ctx, nil, func(q *Queries) error {
+		var err error
+		request, err = q.CreatePaymentRequest(ctx, arg)
+		if err != nil {
+			return err
+		}
+		return notify(ctx, q, request.Payer, NotificationPaymentRequestReceived, request)
+	})
+	if err != nil {
+		return PaymentRequest{}, err
+	}
+	return request, nil
+}
+
+type AcceptPaymentRequestTxParams struct {
+	RequestID uuid.UUID `json:"request_id"`
+	// FromAccountID is the payer's account the request is paid from
+	FromAccountID uuid.UUID `json:"from_account_id"`
+}
+
+// AcceptPaymentRequestTxResult is the paid request and the transfer that paid it
+type AcceptPaymentRequestTxResult struct {
+	Request PaymentRequest `json:"request"`
+	TransferTxResult
+}
+
+// AcceptPaymentRequestTx pays a pending request with a transfer from the
+// payer's account to the requester's account in the request's currency. The
+// transfer, the request being marked paid and the requester's notification
+// commit together or not at all.
+func (store *SQLStore) AcceptPaymentRequestTx(ctx context.Context, arg AcceptPaymentRequestTxParams) (AcceptPaymentRequestTxResult, error) {
+	var result AcceptPaymentRequestTxResult
+
+	err := store.execTx(ctx, nil, func(q *Queries) error {
+		request, err := pendingPaymentRequest(ctx, q, arg.RequestID)
+		if err != nil {
+			return err
+		}
+
+		fromAccount, err := q.GetAccount(ctx, arg.FromAccountID)
+		if err != nil {
+			return err
+		}
+		if fromAccount.Owner != request.Payer || fromAccount.Currency != request.Currency {
+			return fmt.Errorf("%w: account %v isn't the payer's %s account", ErrPaymentRequestAccount, fromAccount.ID, request.Currency)
+		}
+
+		recipient, err := q.ResolveRecipientAccount(ctx, ResolveRecipientAccountParams{
+			Currency: request.Currency,
+			Handle:   request.Requester,
+		})
+		if errors.Is(err, ErrNotFound) {
+			return fmt.Errorf("%w: %s has no %s account", ErrPaymentRequestAccount, request.Requester, request.Currency)
+		}
+		if err != nil {
+			return err
+		}
+
+		err = transferTx(ctx, q, TransferTxParams{
+			FromAccountID: fromAccount.ID,
+			ToAccountID:   recipient.Account.ID,
+			Amount:        request.Amount,
+		}, &result.TransferTxResult)
+		if err != nil {
+			return err
+		}
+
+		result.Request, err = q.FinishPaymentRequest(ctx, FinishPaymentRequestParams{
+			ID:         request.ID,
+			Status:     PaymentRequestStatusPaid,
+			TransferID: uuid.NullUUID{UUID: result.Transfer.ID, Valid: true},
+		})
+		if err != nil {
+			return err
+		}
+		return notify(ctx, q, request.Requester, NotificationPaymentRequestPaid, result.Request)
+	})
+	if err != nil {
+		return AcceptPaymentRequestTxResult{}, err
+	}
+	return result, nil
+}
+
+// DeclinePaymentRequestTx turns a pending request down and notifies the requester
+func (store *SQLStore) DeclinePaymentRequestTx(ctx context.Context, requestID uuid.UUID) (PaymentRequest, error) {
+	var request PaymentRequest
+
+	err := store.execTx(ctx, nil, func(q *Queries) error {
+		pending, err := pendingPaymentRequest(ctx, q, requestID)
+		if err != nil {
+			return err
+		}
+
+		request, err = q.FinishPaymentRequest(ctx, FinishPaymentRequestParams{
+			ID:     pending.ID,
+			Status: PaymentRequestStatusDeclined,
+		})
+		if err != nil {
+			return err
+		}
+		return notify(ctx, q, request.Requester, NotificationPaymentRequestDeclined, request)
+	})
+	if err != nil {
+		return PaymentRequest{}, err
+	}
+	return request, nil
+}
+
+// pendingPaymentRequest locks a request and checks it can still be answered
+func pendingPaymentRequest(ctx context.Context, q *Queries, requestID uuid.UUID) (PaymentRequest, error) {
+	request, err := q.GetPaymentRequestForUpdate(ctx, requestID)
+	if err != nil {
+		return PaymentRequest{}, err
+	}
+	if request.Status != PaymentRequestStatusPending {
+		return PaymentRequest{}, fmt.Errorf("%w: payment request %v is %s", ErrPaymentRequestNotPending, request.ID, request.Status)
+	}
+	if !time.Now().Before(request.ExpiresAt) {
+		return PaymentRequest{}, fmt.Errorf("%w: payment request %v expired at %v", ErrPaymentRequestExpired, request.ID, request.ExpiresAt)
+	}
+	return request, nil
+}
@@ -0,0 +1,244 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: payment_requests.sql
+
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+const createPaymentRequest = `-- name: CreatePaymentRequest :one
+INSERT INTO payment_requests(
+    requester,
+    payer,
+    amount,
+    currency,
+    memo,
+    expires_at
+) VALUES (
+    $1,$2,$3,$4,$5,$6
+) RETURNING id, requester, payer, amount, currency, memo, status, transfer_id, expires_at, created_at, updated_at
+`
+
+type CreatePaymentRequestParams struct {
+	Requester string          `json:"requester"`
+	Payer     string          `json:"payer"`
+	Amount    decimal.Decimal `json:"amount"`
+	Currency  string          `json:"currency"`
+	Memo      string          `json:"memo"`
+	ExpiresAt time.Time       `json:"expires_at"`
+}
+
+func (q *Queries) CreatePaymentRequest(ctx context.Context, arg CreatePaymentRequestParams) (PaymentRequest, error) {
+	row := q.db.QueryRow(ctx, createPaymentRequest,
+		arg.Requester,
+		arg.Payer,
+		arg.Amount,
+		arg.Currency,
+		arg.Memo,
+		arg.ExpiresAt,
+	)
+	var i PaymentRequest
+	err := row.Scan(
+		&i.ID,
+		&i.Requester,
+		&i.Payer,
+		&i.Amount,
+		&i.Currency,
+		&i.Memo,
+		&i.Status,
+		&i.TransferID,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const finishPaymentRequest = `-- name: FinishPaymentRequest :one
+UPDATE payment_requests
+  set status = $2,
+      transfer_id = $3,
+      updated_at = now()
+WHERE id = $1
+RETURNING id, requester, payer, amount, currency, memo, status, transfer_id, expires_at, created_at, updated_at
+`
+
+type FinishPaymentRequestParams struct {
+	ID         uuid.UUID     `json:"id"`
+	Status     string        `json:"status"`
+	TransferID uuid.NullUUID `json:"transfer_id"`
+}
+
+func (q *Queries) FinishPaymentRequest(ctx context.Context, arg FinishPaymentRequestParams) (PaymentRequest, error) {
+	row := q.db.QueryRow(ctx, finishPaymentRequest, arg.ID, arg.Status, arg.TransferID)
+	var i PaymentRequest
+	err := row.Scan(
+		&i.ID,
+		&i.Requester,
+		&i.Payer,
+		&i.Amount,
+		&i.Currency,
+		&i.Memo,
+		&i.Status,
+		&i.TransferID,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getPaymentRequest = `-- name: GetPaymentRequest :one
+SELECT id, requester, payer, amount, currency, memo, status, transfer_id, expires_at, created_at, updated_at FROM payment_requests
+WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetPaymentRequest(ctx context.Context, id uuid.UUID) (PaymentRequest, error) {
+	row := q.db.QueryRow(ctx, getPaymentRequest, id)
+	var i PaymentRequest
+	err := row.Scan(
+		&i.ID,
+		&i.Requester,
+		&i.Payer,
+		&i.Amount,
+		&i.Currency,
+		&i.Memo,
+		&i.Status,
+		&i.TransferID,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getPaymentRequestForUpdate = `-- name: GetPaymentRequestForUpdate :one
+SELECT id, requester, payer, amount, currency, memo, status, transfer_id, expires_at, created_at, updated_at FROM payment_requests
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetPaymentRequestForUpdate(ctx context.Context, id uuid.UUID) (PaymentRequest, error) {
+	row := q.db.QueryRow(ctx, getPaymentRequestForUpdate, id)
+	var i PaymentRequest
+	err := row.Scan(
+		&i.ID,
+		&i.Requester,
+		&i.Payer,
+		&i.Amount,
+		&i.Currency,
+		&i.Memo,
+		&i.Status,
+		&i.TransferID,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listIncomingPaymentRequests = `-- name: ListIncomingPaymentRequests :many
+SELECT id, requester, payer, amount, currency, memo, status, transfer_id, expires_at, created_at, updated_at FROM payment_requests
+WHERE payer = $1 AND status = 'pending' AND expires_at > $2
+ORDER BY created_at
+LIMIT $4
+OFFSET $3
+`
+
+type ListIncomingPaymentRequestsParams struct {
+	Payer  string    `json:"payer"`
+	Now    time.Time `json:"now"`
+	Offset int32     `json:"offset"`
+	Limit  int32     `json:"limit"`
+}
+
+// requests the payer can still accept or decline, oldest first
+func (q *Queries) ListIncomingPaymentRequests(ctx context.Context, arg ListIncomingPaymentRequestsParams) ([]PaymentRequest, error) {
+	rows, err := q.db.Query(ctx, listIncomingPaymentRequests,
+		arg.Payer,
+		arg.Now,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []PaymentRequest{}
+	for rows.Next() {
+		var i PaymentRequest
+		if err := rows.Scan(
+			&i.ID,
+			&i.Requester,
+			&i.Payer,
+			&i.Amount,
+			&i.Currency,
+			&i.Memo,
+			&i.Status,
+			&i.TransferID,
+			&i.ExpiresAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOutgoingPaymentRequests = `-- name: ListOutgoingPaymentRequests :many
+SELECT id, requester, payer, amount, currency, memo, status, transfer_id, expires_at, created_at, updated_at FROM payment_requests
+WHERE requester = $1
+ORDER BY created_at DESC
+LIMIT $2
+OFFSET $3
+`
+
+type ListOutgoingPaymentRequestsParams struct {
+	Requester string `json:"requester"`
+	Limit     int32  `json:"limit"`
+	Offset    int32  `json:"offset"`
+}
+
+func (q *Queries) ListOutgoingPaymentRequests(ctx context.Context, arg ListOutgoingPaymentRequestsParams) ([]PaymentRequest, error) {
+	rows, err := q.db.Query(ctx, listOutgoingPaymentRequests, arg.Requester, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []PaymentRequest{}
+	for rows.Next() {
+		var i PaymentRequest
+		if err := rows.Scan(
+			&i.ID,
+			&i.Requester,
+			&i.Payer,
+			&i.Amount,
+			&i.Currency,
+			&i.Memo,
+			&i.Status,
+			&i.TransferID,
+			&i.ExpiresAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
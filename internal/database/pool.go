@@ -0,0 +1,43 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Glenn444/banking-app/util"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// NewPool connects a pool to the database at config.DB_URL and checks that
+// it can be reached. Pool settings left at zero keep pgxpool's defaults.
+func NewPool(ctx context.Context, config util.Config) (*pgxpool.Pool, error) {
+	poolConfig, err := pgxpool.ParseConfig(config.DB_URL)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse db url: %w", err)
+	}
+	if config.DBMaxConns > 0 {
+		poolConfig.MaxConns = config.DBMaxConns
+	}
+	if config.DBMinConns > 0 {
+		poolConfig.MinConns = config.DBMinConns
+	}
+	if config.DBMaxConnLifetime > 0 {
+		poolConfig.MaxConnLifetime = config.DBMaxConnLifetime
+	}
+	if config.DBMaxConnIdleTime > 0 {
+		poolConfig.MaxConnIdleTime = config.DBMaxConnIdleTime
+	}
+	if config.DBConnectTimeout > 0 {
+		poolConfig.ConnConfig.ConnectTimeout = config.DBConnectTimeout
+	}
+
+	pool, err := pgxpool.NewWithConfig(ctx, poolConfig)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create pool: %w", err)
+	}
+	if err := pool.Ping(ctx); err != nil {
+		pool.Close()
+		return nil, fmt.Errorf("cannot ping db: %w", err)
+	}
+	return pool, nil
+}
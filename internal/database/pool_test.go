@@ -0,0 +1,27 @@
+package database
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/Glenn444/banking-app/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPool(t *testing.T) {
+	pool, err := NewPool(context.Background(), util.Config{
+		DB_URL:            os.Getenv("DB_URL"),
+		DBMaxConns:        3,
+		DBMaxConnIdleTime: time.Minute,
+	})
+	require.NoError(t, err)
+	defer pool.Close()
+
+	require.Equal(t, int32(3), pool.Config().MaxConns)
+	require.Equal(t, time.Minute, pool.Config().MaxConnIdleTime)
+
+	_, err = NewPool(context.Background(), util.Config{DB_URL: "postgres://%"})
+	require.Error(t, err)
+}
@@ -6,31 +6,204 @@ package database
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 type Querier interface {
+	AddAccountAvailableBalance(ctx context.Context, arg AddAccountAvailableBalanceParams) (Account, error)
+	AddOrganisationMember(ctx context.Context, arg AddOrganisationMemberParams) (OrganisationMember, error)
+	AddTransferApproval(ctx context.Context, id uuid.UUID) (TransferApproval, error)
+	AddTransferBatchProgress(ctx context.Context, arg AddTransferBatchProgressParams) (TransferBatch, error)
+	AdvanceScheduledTransfer(ctx context.Context, arg AdvanceScheduledTransferParams) (ScheduledTransfer, error)
+	// picks the oldest pending transfer, or one whose worker stopped processing it
+	ClaimTransfer(ctx context.Context, staleBefore time.Time) (Transfer, error)
+	// picks the oldest pending batch, or one whose worker stopped updating it
+	ClaimTransferBatch(ctx context.Context, staleBefore time.Time) (TransferBatch, error)
+	CompleteTransfer(ctx context.Context, id uuid.UUID) (Transfer, error)
+	ConfirmEscrowPayee(ctx context.Context, id uuid.UUID) (Escrow, error)
+	ConfirmEscrowPayer(ctx context.Context, id uuid.UUID) (Escrow, error)
 	CreateAccount(ctx context.Context, arg CreateAccountParams) (Account, error)
+	// fails with a unique violation when the actor already approved
+	CreateApprovalAuditEntry(ctx context.Context, arg CreateApprovalAuditEntryParams) (ApprovalAuditLog, error)
 	CreateEntries(ctx context.Context, arg CreateEntriesParams) (Entry, error)
+	CreateEscrow(ctx context.Context, arg CreateEscrowParams) (Escrow, error)
+	CreateEscrowAccount(ctx context.Context, currency string) error
+	CreateEscrowEvent(ctx context.Context, arg CreateEscrowEventParams) (EscrowEvent, error)
+	CreateFeeRule(ctx context.Context, arg CreateFeeRuleParams) (FeeRule, error)
+	// adds the currency's next fee schedule version, two admins saving at once
+	// collide on fee_schedule_version_unique
+	CreateFeeSchedule(ctx context.Context, arg CreateFeeScheduleParams) (FeeSchedule, error)
+	CreateFeesAccount(ctx context.Context, currency string) error
+	CreateFundingTransaction(ctx context.Context, arg CreateFundingTransactionParams) (FundingTransaction, error)
+	CreateFxPositionAccount(ctx context.Context, currency string) error
+	CreateFxQuote(ctx context.Context, arg CreateFxQuoteParams) (FxQuote, error)
+	CreateFxTransfer(ctx context.Context, arg CreateFxTransferParams) (Transfer, error)
+	CreateHold(ctx context.Context, arg CreateHoldParams) (Hold, error)
+	CreateInterestAccrual(ctx context.Context, arg CreateInterestAccrualParams) (InterestAccrual, error)
+	CreateInterestExpenseAccount(ctx context.Context, currency string) error
+	CreateInterestPayout(ctx context.Context, arg CreateInterestPayoutParams) (InterestPayout, error)
+	CreateInterestProduct(ctx context.Context, arg CreateInterestProductParams) (InterestProduct, error)
+	CreateInterestRun(ctx context.Context, arg CreateInterestRunParams) (InterestRun, error)
+	CreateNotification(ctx context.Context, arg CreateNotificationParams) (Notification, error)
+	CreateOrganisation(ctx context.Context, arg CreateOrganisationParams) (Organisation, error)
+	CreateOverdraftInterestAccount(ctx context.Context, currency string) error
+	CreateOverdraftInterestCharge(ctx context.Context, arg CreateOverdraftInterestChargeParams) (OverdraftInterestCharge, error)
+	CreatePaymentRequest(ctx context.Context, arg CreatePaymentRequestParams) (PaymentRequest, error)
+	// queues a transfer for a worker, its money moves when it is processed
+	CreatePendingTransfer(ctx context.Context, arg CreatePendingTransferParams) (Transfer, error)
+	CreateScheduledTransfer(ctx context.Context, arg CreateScheduledTransferParams) (ScheduledTransfer, error)
+	// an attempt that is still running was claimed by a scheduler that
+	// stopped before recording how it went, it can be claimed again
+	CreateScheduledTransferRun(ctx context.Context, arg CreateScheduledTransferRunParams) (ScheduledTransferRun, error)
+	CreateSettlementAccount(ctx context.Context, currency string) error
 	CreateTransfer(ctx context.Context, arg CreateTransferParams) (Transfer, error)
+	CreateTransferApproval(ctx context.Context, arg CreateTransferApprovalParams) (TransferApproval, error)
+	CreateTransferBatch(ctx context.Context, arg CreateTransferBatchParams) (TransferBatch, error)
+	CreateTransferBatchItem(ctx context.Context, arg CreateTransferBatchItemParams) error
 	CreateUsers(ctx context.Context, arg CreateUsersParams) (User, error)
 	DeleteAccount(ctx context.Context, id uuid.UUID) error
 	DeleteEntry(ctx context.Context, id uuid.UUID) error
 	DeleteTransfer(ctx context.Context, id uuid.UUID) error
+	DeleteTransferLimit(ctx context.Context, id uuid.UUID) (int64, error)
+	FailPendingTransferBatchItems(ctx context.Context, arg FailPendingTransferBatchItemsParams) (int64, error)
+	FailTransfer(ctx context.Context, arg FailTransferParams) (Transfer, error)
+	FinishEscrow(ctx context.Context, arg FinishEscrowParams) (Escrow, error)
+	FinishHold(ctx context.Context, arg FinishHoldParams) (Hold, error)
+	FinishPaymentRequest(ctx context.Context, arg FinishPaymentRequestParams) (PaymentRequest, error)
+	FinishScheduledTransferRun(ctx context.Context, arg FinishScheduledTransferRunParams) (ScheduledTransferRun, error)
+	FinishTransferApproval(ctx context.Context, arg FinishTransferApprovalParams) (TransferApproval, error)
+	FinishTransferBatch(ctx context.Context, arg FinishTransferBatchParams) (TransferBatch, error)
+	FinishTransferBatchItem(ctx context.Context, arg FinishTransferBatchItemParams) error
 	GetAccount(ctx context.Context, id uuid.UUID) (Account, error)
+	// the balance before any entry posted at or after the given time
+	GetAccountBalanceAt(ctx context.Context, arg GetAccountBalanceAtParams) (decimal.Decimal, error)
 	GetAccountByIdForUpdate(ctx context.Context, id uuid.UUID) (Account, error)
+	GetAccountOutgoingUsage(ctx context.Context, arg GetAccountOutgoingUsageParams) (GetAccountOutgoingUsageRow, error)
+	GetAccountTransferLimit(ctx context.Context, accountID uuid.NullUUID) (TransferLimit, error)
 	GetAllUsers(ctx context.Context) ([]GetAllUsersRow, error)
+	// the rule of the currency's current schedule whose band holds the amount
+	GetApplicableFeeRule(ctx context.Context, arg GetApplicableFeeRuleParams) (FeeRule, error)
+	GetCurrency(ctx context.Context, code string) (Currency, error)
+	GetCurrentFeeSchedule(ctx context.Context, currency string) (FeeSchedule, error)
 	GetEntry(ctx context.Context, id uuid.UUID) (Entry, error)
+	GetEscrow(ctx context.Context, id uuid.UUID) (Escrow, error)
+	GetEscrowAccount(ctx context.Context, currency string) (Account, error)
+	GetEscrowForUpdate(ctx context.Context, id uuid.UUID) (Escrow, error)
+	GetFeeScheduleByVersion(ctx context.Context, arg GetFeeScheduleByVersionParams) (FeeSchedule, error)
+	GetFeesAccount(ctx context.Context, currency string) (Account, error)
+	GetFundingTransaction(ctx context.Context, id uuid.UUID) (FundingTransaction, error)
+	GetFundingTransactionForUpdate(ctx context.Context, id uuid.UUID) (FundingTransaction, error)
+	GetFxPositionAccount(ctx context.Context, currency string) (Account, error)
+	GetFxQuote(ctx context.Context, id uuid.UUID) (FxQuote, error)
+	GetFxQuoteForUpdate(ctx context.Context, id uuid.UUID) (FxQuote, error)
+	GetHold(ctx context.Context, id uuid.UUID) (Hold, error)
+	GetHoldForUpdate(ctx context.Context, id uuid.UUID) (Hold, error)
+	GetInterestExpenseAccount(ctx context.Context, currency string) (Account, error)
+	GetInterestProduct(ctx context.Context, id uuid.UUID) (InterestProduct, error)
+	GetLastInterestRun(ctx context.Context) (InterestRun, error)
+	GetOrganisation(ctx context.Context, id uuid.UUID) (Organisation, error)
+	GetOverdraftInterestAccount(ctx context.Context, currency string) (Account, error)
+	GetPaymentRequest(ctx context.Context, id uuid.UUID) (PaymentRequest, error)
+	GetPaymentRequestForUpdate(ctx context.Context, id uuid.UUID) (PaymentRequest, error)
+	GetScheduledTransfer(ctx context.Context, id uuid.UUID) (ScheduledTransfer, error)
+	GetScheduledTransferForUpdate(ctx context.Context, id uuid.UUID) (ScheduledTransfer, error)
+	GetSettlementAccount(ctx context.Context, currency string) (Account, error)
 	GetTransfer(ctx context.Context, id uuid.UUID) (Transfer, error)
+	GetTransferApproval(ctx context.Context, id uuid.UUID) (TransferApproval, error)
+	GetTransferApprovalForUpdate(ctx context.Context, id uuid.UUID) (TransferApproval, error)
+	GetTransferBatch(ctx context.Context, id uuid.UUID) (TransferBatch, error)
+	GetTransferBatchForUpdate(ctx context.Context, id uuid.UUID) (TransferBatch, error)
+	GetTransferByExternalReference(ctx context.Context, arg GetTransferByExternalReferenceParams) (Transfer, error)
+	GetTransferForUpdate(ctx context.Context, id uuid.UUID) (Transfer, error)
 	GetUser(ctx context.Context, username string) (User, error)
+	GetUserOutgoingUsage(ctx context.Context, arg GetUserOutgoingUsageParams) (GetUserOutgoingUsageRow, error)
+	GetUserTransferLimit(ctx context.Context, arg GetUserTransferLimitParams) (TransferLimit, error)
+	HasApprovalDecision(ctx context.Context, arg HasApprovalDecisionParams) (bool, error)
+	HasOrganisationRole(ctx context.Context, arg HasOrganisationRoleParams) (bool, error)
+	IsOrganisationMember(ctx context.Context, arg IsOrganisationMemberParams) (bool, error)
 	ListAccounts(ctx context.Context, arg ListAccountsParams) ([]Account, error)
+	ListAccountsByIDs(ctx context.Context, ids []uuid.UUID) ([]Account, error)
+	// accounts on an interest product that existed at the end of the business
+	// date and haven't been accrued for it
+	ListAccountsDueForAccrual(ctx context.Context, arg ListAccountsDueForAccrualParams) ([]uuid.UUID, error)
+	// accounts with interest accrued up to period_end that hasn't been paid out,
+	// with the payout frequency of their product, monthly once they left it
+	ListAccountsWithUnpaidAccruals(ctx context.Context, periodEnd time.Time) ([]ListAccountsWithUnpaidAccrualsRow, error)
+	ListAllAccountsByOwner(ctx context.Context, owner string) ([]Account, error)
+	ListApprovalAuditLog(ctx context.Context, approvalID uuid.UUID) ([]ApprovalAuditLog, error)
+	ListCurrencies(ctx context.Context) ([]Currency, error)
+	ListDueScheduledTransfers(ctx context.Context, arg ListDueScheduledTransfersParams) ([]ScheduledTransfer, error)
 	ListEntries(ctx context.Context, arg ListEntriesParams) ([]Entry, error)
+	ListEscrowEvents(ctx context.Context, escrowID uuid.UUID) ([]EscrowEvent, error)
+	// the escrows a user pays or is paid by, newest first
+	ListEscrows(ctx context.Context, arg ListEscrowsParams) ([]Escrow, error)
+	ListExpiredEscrows(ctx context.Context, arg ListExpiredEscrowsParams) ([]uuid.UUID, error)
+	ListExpiredHolds(ctx context.Context, arg ListExpiredHoldsParams) ([]uuid.UUID, error)
+	ListExpiredTransferApprovals(ctx context.Context, arg ListExpiredTransferApprovalsParams) ([]uuid.UUID, error)
+	ListFeeRules(ctx context.Context, scheduleID uuid.UUID) ([]FeeRule, error)
+	ListFeeSchedules(ctx context.Context, arg ListFeeSchedulesParams) ([]FeeSchedule, error)
+	ListFundingTransactions(ctx context.Context, arg ListFundingTransactionsParams) ([]FundingTransaction, error)
+	ListHolds(ctx context.Context, arg ListHoldsParams) ([]Hold, error)
+	// requests the payer can still accept or decline, oldest first
+	ListIncomingPaymentRequests(ctx context.Context, arg ListIncomingPaymentRequestsParams) ([]PaymentRequest, error)
+	ListInterestPayouts(ctx context.Context, arg ListInterestPayoutsParams) ([]InterestPayout, error)
+	ListInterestProducts(ctx context.Context) ([]InterestProduct, error)
+	ListNotifications(ctx context.Context, arg ListNotificationsParams) ([]Notification, error)
+	ListOrganisationMembers(ctx context.Context, organisationID uuid.UUID) ([]OrganisationMember, error)
+	ListOutgoingPaymentRequests(ctx context.Context, arg ListOutgoingPaymentRequestsParams) ([]PaymentRequest, error)
+	ListOverdraftInterestCharges(ctx context.Context, arg ListOverdraftInterestChargesParams) ([]OverdraftInterestCharge, error)
+	// accounts that closed the business date overdrawn and haven't been charged
+	// interest for it, the closing balance leaves out entries posted since
+	ListOverdrawnAccountsDue(ctx context.Context, arg ListOverdrawnAccountsDueParams) ([]uuid.UUID, error)
+	ListPendingTransferBatchItems(ctx context.Context, arg ListPendingTransferBatchItemsParams) ([]TransferBatchItem, error)
+	ListScheduledTransferRuns(ctx context.Context, arg ListScheduledTransferRunsParams) ([]ScheduledTransferRun, error)
+	ListScheduledTransfers(ctx context.Context, arg ListScheduledTransfersParams) ([]ScheduledTransfer, error)
+	// an organisation's transfer approvals, status filters them when set
+	ListTransferApprovals(ctx context.Context, arg ListTransferApprovalsParams) ([]TransferApproval, error)
+	ListTransferBatchItems(ctx context.Context, arg ListTransferBatchItemsParams) ([]TransferBatchItem, error)
+	ListTransferBatches(ctx context.Context, arg ListTransferBatchesParams) ([]TransferBatch, error)
 	ListTransfers(ctx context.Context, arg ListTransfersParams) ([]Transfer, error)
+	ListUserOrganisations(ctx context.Context, username string) ([]Organisation, error)
+	// serialises transfers across a user's accounts while their limits are checked
+	LockUserForTransfer(ctx context.Context, username string) (string, error)
+	MarkFxQuoteUsed(ctx context.Context, id uuid.UUID) error
+	MarkInterestAccrualsPaid(ctx context.Context, arg MarkInterestAccrualsPaidParams) (int64, error)
+	MarkNotificationRead(ctx context.Context, arg MarkNotificationReadParams) (Notification, error)
+	MarkUserEmailVerified(ctx context.Context, username string) (User, error)
+	RemoveOrganisationMember(ctx context.Context, arg RemoveOrganisationMemberParams) (int64, error)
+	// finds the account in a currency of the user known by a username, alias or
+	// verified email, preferring that order and a checking account, system users
+	// can't be resolved
+	ResolveRecipientAccount(ctx context.Context, arg ResolveRecipientAccountParams) (ResolveRecipientAccountRow, error)
+	ReverseTransfer(ctx context.Context, arg ReverseTransferParams) (Transfer, error)
+	// an account's transfers either way, newest first. search matches the
+	// description or reference, metadata matches transfers containing it.
+	SearchAccountTransfers(ctx context.Context, arg SearchAccountTransfersParams) ([]Transfer, error)
+	SetAccountInterestProduct(ctx context.Context, arg SetAccountInterestProductParams) (Account, error)
+	SetAccountOrganisation(ctx context.Context, arg SetAccountOrganisationParams) (Account, error)
+	SetAccountOverdraft(ctx context.Context, arg SetAccountOverdraftParams) (Account, error)
+	SetFundingProviderReference(ctx context.Context, arg SetFundingProviderReferenceParams) (FundingTransaction, error)
+	SetTransferDetails(ctx context.Context, arg SetTransferDetailsParams) (Transfer, error)
+	SetTransferFee(ctx context.Context, arg SetTransferFeeParams) (Transfer, error)
+	SetUserAlias(ctx context.Context, arg SetUserAliasParams) (User, error)
+	// the unpaid accruals up to period_end plus the residue the account's last
+	// payout carried over
+	SumUnpaidInterestAccruals(ctx context.Context, arg SumUnpaidInterestAccrualsParams) (decimal.Decimal, error)
+	// available_balance moves by the same amount, holds are unaffected
 	UpdateAccount(ctx context.Context, arg UpdateAccountParams) error
+	UpdateCurrencyEnabled(ctx context.Context, arg UpdateCurrencyEnabledParams) (Currency, error)
 	UpdateEntry(ctx context.Context, arg UpdateEntryParams) error
+	UpdateFundingStatus(ctx context.Context, arg UpdateFundingStatusParams) (FundingTransaction, error)
+	UpdateOrganisationPolicy(ctx context.Context, arg UpdateOrganisationPolicyParams) (Organisation, error)
 	UpdateRefreshToken(ctx context.Context, arg UpdateRefreshTokenParams) error
+	UpdateScheduledTransfer(ctx context.Context, arg UpdateScheduledTransferParams) (ScheduledTransfer, error)
 	UpdateTransfer(ctx context.Context, arg UpdateTransferParams) error
+	UpdateUserTier(ctx context.Context, arg UpdateUserTierParams) (User, error)
+	UpsertAccountTransferLimit(ctx context.Context, arg UpsertAccountTransferLimitParams) (TransferLimit, error)
+	UpsertUserTransferLimit(ctx context.Context, arg UpsertUserTransferLimitParams) (TransferLimit, error)
 }
 
 var _ Querier = (*Queries)(nil)
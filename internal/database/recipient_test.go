@@ -0,0 +1,65 @@
+package database
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/Glenn444/banking-app/util"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveRecipientAccount(t *testing.T) {
+	ctx := context.Background()
+	user := CreateRandomUser(t)
+	checking := createAccountInCurrency(t, user, "USD", decimal.Zero)
+	_, err := testQueries.CreateAccount(ctx, CreateAccountParams{
+		Owner:       user.Username,
+		Balance:     decimal.Zero,
+		Currency:    "USD",
+		AccountType: AccountTypeSavings,
+	})
+	require.NoError(t, err)
+
+	resolve := func(handle, currency string) (ResolveRecipientAccountRow, error) {
+		return testQueries.ResolveRecipientAccount(ctx, ResolveRecipientAccountParams{
+			Currency: currency,
+			Handle:   handle,
+		})
+	}
+
+	// the checking account is preferred over savings
+	row, err := resolve(user.Username, "USD")
+	require.NoError(t, err)
+	require.Equal(t, checking.ID, row.Account.ID)
+	require.Equal(t, user.FullName, row.FullName)
+
+	_, err = resolve(user.Username, "EUR")
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+
+	alias := util.RandomString(12)
+	_, err = testQueries.SetUserAlias(ctx, SetUserAliasParams{
+		Username: user.Username,
+		Alias:    pgtype.Text{String: alias, Valid: true},
+	})
+	require.NoError(t, err)
+	row, err = resolve(strings.ToUpper(alias), "USD")
+	require.NoError(t, err)
+	require.Equal(t, checking.ID, row.Account.ID)
+
+	// an email only resolves once it's verified
+	_, err = resolve(user.Email, "USD")
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+	_, err = testQueries.MarkUserEmailVerified(ctx, user.Username)
+	require.NoError(t, err)
+	row, err = resolve(user.Email, "USD")
+	require.NoError(t, err)
+	require.Equal(t, checking.ID, row.Account.ID)
+
+	// system accounts can't be paid by handle
+	_, err = resolve("fees", "USD")
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+}
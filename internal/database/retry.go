@@ -0,0 +1,65 @@
+package database
+
+import (
+	"errors"
+	"expvar"
+	"math/rand/v2"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// TxRetryPolicy decides how often and how soon execTx runs a transaction
+// again after it lost a race with another one
+type TxRetryPolicy struct {
+	// MaxAttempts counts the first attempt
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+// DefaultTxRetryPolicy tries a transaction 5 times, backing off from 10ms
+// up to 500ms between attempts
+var DefaultTxRetryPolicy = TxRetryPolicy{
+	MaxAttempts: 5,
+	BaseDelay:   10 * time.Millisecond,
+	MaxDelay:    500 * time.Millisecond,
+}
+
+// delay is how long to wait after the given failed attempt, a random
+// duration up to the exponential backoff so racing transactions spread out
+func (p TxRetryPolicy) delay(attempt int) time.Duration {
+	backoff := p.BaseDelay << (attempt - 1)
+	if backoff <= 0 || backoff > p.MaxDelay {
+		backoff = p.MaxDelay
+	}
+	if backoff <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int64N(int64(backoff))) + 1
+}
+
+// retryableTxErrors are the SQLSTATEs of transactions that failed only
+// because of other transactions and can succeed when run again
+var retryableTxErrors = map[string]string{
+	"40001": "serialization_failure",
+	"40P01": "deadlock_detected",
+	"55P03": "lock_not_available",
+}
+
+// retryableTxError tells whether err is worth running the transaction
+// again for, and names the reason
+func retryableTxError(err error) (string, bool) {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return "", false
+	}
+	reason, ok := retryableTxErrors[pgErr.Code]
+	return reason, ok
+}
+
+// transaction retries by reason, published with expvar
+var (
+	txRetries          = expvar.NewMap("db_tx_retries")
+	txRetriesExhausted = expvar.NewMap("db_tx_retries_exhausted")
+)
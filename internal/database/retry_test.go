@@ -0,0 +1,180 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func retryCount(counters *expvar.Map, reason string) int64 {
+	count, ok := counters.Get(reason).(*expvar.Int)
+	if !ok {
+		return 0
+	}
+	return count.Value()
+}
+
+func TestRetryableTxError(t *testing.T) {
+	reason, ok := retryableTxError(fmt.Errorf("transfer: %w", &pgconn.PgError{Code: "40P01"}))
+	require.True(t, ok)
+	require.Equal(t, "deadlock_detected", reason)
+
+	_, ok = retryableTxError(&pgconn.PgError{Code: "23505"})
+	require.False(t, ok)
+	_, ok = retryableTxError(ErrInsufficientFunds)
+	require.False(t, ok)
+	_, ok = retryableTxError(nil)
+	require.False(t, ok)
+}
+
+func TestTxRetryDelay(t *testing.T) {
+	policy := TxRetryPolicy{MaxAttempts: 5, BaseDelay: 10 * time.Millisecond, MaxDelay: 50 * time.Millisecond}
+	for attempt := 1; attempt <= 10; attempt++ {
+		delay := policy.delay(attempt)
+		require.Greater(t, delay, time.Duration(0))
+		require.LessOrEqual(t, delay, policy.MaxDelay)
+	}
+	require.LessOrEqual(t, policy.delay(1), policy.BaseDelay)
+}
+
+func TestExecTxRetriesDeadlock(t *testing.T) {
+	store := NewStore(testDB).(*SQLStore)
+	ctx := context.Background()
+	account1, account2 := createRandomAccount(t), createRandomAccount(t)
+	before := retryCount(txRetries, "deadlock_detected")
+
+	// both transactions hold their first lock before either asks for its
+	// second, so postgres has to kill one of them
+	var locked sync.WaitGroup
+	locked.Add(2)
+	lockBoth := func(first, second uuid.UUID) error {
+		attempts := 0
+		return store.execTx(ctx, nil, func(q *Queries) error {
+			attempts++
+			if _, err := q.GetAccountByIdForUpdate(ctx, first); err != nil {
+				return err
+			}
+			if attempts == 1 {
+				locked.Done()
+				locked.Wait()
+			}
+			_, err := q.GetAccountByIdForUpdate(ctx, second)
+			return err
+		})
+	}
+
+	errs := make(chan error, 2)
+	go func() { errs <- lockBoth(account1.ID, account2.ID) }()
+	go func() { errs <- lockBoth(account2.ID, account1.ID) }()
+	require.NoError(t, <-errs)
+	require.NoError(t, <-errs)
+	require.Greater(t, retryCount(txRetries, "deadlock_detected"), before)
+}
+
+func TestExecTxRetriesSerializationFailure(t *testing.T) {
+	store := NewStore(testDB).(*SQLStore)
+	ctx := context.Background()
+	account := createRandomAccount(t)
+	before := retryCount(txRetries, "serialization_failure")
+
+	// both transactions read the balance before either writes it back
+	var read sync.WaitGroup
+	read.Add(2)
+	addOne := func() error {
+		attempts := 0
+		return store.execTx(ctx, &pgx.TxOptions{IsoLevel: pgx.Serializable}, func(q *Queries) error {
+			attempts++
+			current, err := q.GetAccount(ctx, account.ID)
+			if err != nil {
+				return err
+			}
+			if attempts == 1 {
+				read.Done()
+				read.Wait()
+			}
+			return q.UpdateAccount(ctx, UpdateAccountParams{
+				ID:      account.ID,
+				Balance: current.Balance.Add(decimal.NewFromInt(1)),
+			})
+		})
+	}
+
+	errs := make(chan error, 2)
+	go func() { errs <- addOne() }()
+	go func() { errs <- addOne() }()
+	require.NoError(t, <-errs)
+	require.NoError(t, <-errs)
+	require.Greater(t, retryCount(txRetries, "serialization_failure"), before)
+
+	updated, err := testQueries.GetAccount(ctx, account.ID)
+	require.NoError(t, err)
+	require.True(t, account.Balance.Add(decimal.NewFromInt(2)).Equal(updated.Balance))
+}
+
+func TestExecTxRetriesLockTimeout(t *testing.T) {
+	store := NewStore(testDB).(*SQLStore)
+	store.retry = TxRetryPolicy{MaxAttempts: 20, BaseDelay: 10 * time.Millisecond, MaxDelay: 50 * time.Millisecond}
+	ctx := context.Background()
+	account := createRandomAccount(t)
+	before := retryCount(txRetries, "lock_not_available")
+
+	holder, err := testDB.Begin(ctx)
+	require.NoError(t, err)
+	_, err = New(holder).GetAccountByIdForUpdate(ctx, account.ID)
+	require.NoError(t, err)
+	go func() {
+		time.Sleep(200 * time.Millisecond)
+		holder.Rollback(ctx)
+	}()
+
+	err = store.execTx(ctx, nil, func(q *Queries) error {
+		if _, err := q.db.Exec(ctx, "SET LOCAL lock_timeout = '20ms'"); err != nil {
+			return err
+		}
+		_, err := q.GetAccountByIdForUpdate(ctx, account.ID)
+		return err
+	})
+	require.NoError(t, err)
+	require.Greater(t, retryCount(txRetries, "lock_not_available"), before)
+}
+
+func TestExecTxGivesUp(t *testing.T) {
+	store := NewStore(testDB).(*SQLStore)
+	store.retry = TxRetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}
+	ctx := context.Background()
+	account := createRandomAccount(t)
+	before := retryCount(txRetriesExhausted, "lock_not_available")
+
+	holder, err := testDB.Begin(ctx)
+	require.NoError(t, err)
+	defer holder.Rollback(ctx)
+	_, err = New(holder).GetAccountByIdForUpdate(ctx, account.ID)
+	require.NoError(t, err)
+
+	attempts := 0
+	err = store.execTx(ctx, nil, func(q *Queries) error {
+		attempts++
+		if _, err := q.db.Exec(ctx, "SET LOCAL lock_timeout = '10ms'"); err != nil {
+			return err
+		}
+		_, err := q.GetAccountByIdForUpdate(ctx, account.ID)
+		return err
+	})
+
+	require.ErrorIs(t, err, ErrConcurrentUpdate)
+	var pgErr *pgconn.PgError
+	require.True(t, errors.As(err, &pgErr))
+	require.Equal(t, "55P03", pgErr.Code)
+	require.Equal(t, 2, attempts)
+	require.Equal(t, before+1, retryCount(txRetriesExhausted, "lock_not_available"))
+}
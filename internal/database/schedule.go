@@ -0,0 +1,18 @@
+package database
+
+const (
+	FrequencyOnce                   = "once"
+	FrequencyWeekly                 = "weekly"
+	FrequencyMonthly                = "monthly"
+	FrequencyMonthlyLastBusinessDay = "monthly_last_business_day"
+
+	ScheduleStatusActive    = "active"
+	ScheduleStatusPaused    = "paused"
+	ScheduleStatusCompleted = "completed"
+	ScheduleStatusFailed    = "failed"
+	ScheduleStatusCancelled = "cancelled"
+
+	RunStatusRunning   = "running"
+	RunStatusSucceeded = "succeeded"
+	RunStatusFailed    = "failed"
+)
@@ -0,0 +1,167 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// ErrScheduleNotDue is returned when the attempt of a scheduled transfer
+// was already made, or the schedule changed since it was listed as due
+var ErrScheduleNotDue = errors.New("scheduled transfer attempt isn't due")
+
+// ErrCurrencyMismatch is returned when a scheduled transfer's currency is no
+// longer the currency of one of its accounts
+var ErrCurrencyMismatch = errors.New("currency mismatch")
+
+type ExecuteScheduledTransferTxParams struct {
+	// Scheduled is the schedule as it was listed due, the attempt is only
+	// made while it still is. The amount and accounts are read from the
+	// locked schedule, so an edit made since it was listed is honoured
+	Scheduled ScheduledTransfer `json:"scheduled"`
+	// Succeeded and Failed are the schedule's state after the transfer goes
+	// through or is refused, the reason it was refused is added to Failed
+	Succeeded AdvanceScheduledTransferParams `json:"succeeded"`
+	Failed    AdvanceScheduledTransferParams `json:"failed"`
+}
+
+type ExecuteScheduledTransferTxResult struct {
+	Run       ScheduledTransferRun `json:"run"`
+	Scheduled ScheduledTransfer    `json:"scheduled"`
+}
+
+// ExecuteScheduledTransferTx makes the next attempt of a scheduled transfer.
+// The claim, the transfer, the run's outcome and the schedule's next state
+// commit together, so a scheduler stopping halfway leaves the attempt to be
+// made again. A transfer refused for a business reason is rolled back and
+// its failure recorded in a transaction of its own, any other error is
+// returned so the attempt is retried without counting against the schedule.
+func (store *SQLStore) ExecuteScheduledTransferTx(ctx context.Context, arg ExecuteScheduledTransferTxParams) (ExecuteScheduledTransferTxResult, error) {
+	var result ExecuteScheduledTransferTxResult
+	var transferErr error
+
+	err := store.execTx(ctx, nil, func(q *Queries) error {
+		transferErr = nil
+
+		current, run, err := claimScheduledTransferRun(ctx, q, arg.Scheduled)
+		if err != nil {
+			return err
+		}
+		result.Run = run
+
+		if err = checkScheduledTransferCurrency(ctx, q, current); err != nil {
+			transferErr = err
+			return err
+		}
+		var transfer TransferTxResult
+		err = transferTx(ctx, q, TransferTxParams{
+			FromAccountID: current.FromAccountID,
+			ToAccountID:   current.ToAccountID,
+			Amount:        current.Amount,
+		}, &transfer)
+		if err != nil {
+			transferErr = err
+			return err
+		}
+
+		result.Run, err = q.FinishScheduledTransferRun(ctx, FinishScheduledTransferRunParams{
+			ID:         result.Run.ID,
+			Status:     RunStatusSucceeded,
+			TransferID: uuid.NullUUID{UUID: transfer.Transfer.ID, Valid: true},
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Scheduled, err = q.AdvanceScheduledTransfer(ctx, arg.Succeeded)
+		return err
+	})
+	if transferErr != nil && isTransferRefusal(err) {
+		return store.failScheduledTransferRun(ctx, arg, err)
+	}
+	if err != nil {
+		return ExecuteScheduledTransferTxResult{}, err
+	}
+	return result, nil
+}
+
+// failScheduledTransferRun records an attempt whose transfer was refused
+func (store *SQLStore) failScheduledTransferRun(ctx context.Context, arg ExecuteScheduledTransferTxParams, reason error) (ExecuteScheduledTransferTxResult, error) {
+	var result ExecuteScheduledTransferTxResult
+
+	err := store.execTx(ctx, nil, func(q *Queries) error {
+		_, run, err := claimScheduledTransferRun(ctx, q, arg.Scheduled)
+		if err != nil {
+			return err
+		}
+
+		result.Run, err = q.FinishScheduledTransferRun(ctx, FinishScheduledTransferRunParams{
+			ID:     run.ID,
+			Status: RunStatusFailed,
+			Error:  reason.Error(),
+		})
+		if err != nil {
+			return err
+		}
+
+		failed := arg.Failed
+		failed.LastError = reason.Error()
+		result.Scheduled, err = q.AdvanceScheduledTransfer(ctx, failed)
+		return err
+	})
+	if err != nil {
+		return ExecuteScheduledTransferTxResult{}, err
+	}
+	return result, nil
+}
+
+// isTransferRefusal reports whether the transfer was refused by the bank's
+// rules rather than failing to run, only a refusal uses up an attempt
+func isTransferRefusal(err error) bool {
+	return errors.Is(err, ErrInsufficientFunds) ||
+		errors.Is(err, ErrLimitExceeded) ||
+		errors.Is(err, ErrInvalidAmountScale) ||
+		errors.Is(err, ErrCurrencyMismatch)
+}
+
+// checkScheduledTransferCurrency refuses a schedule whose accounts no longer
+// hold the currency it was set up in
+func checkScheduledTransferCurrency(ctx context.Context, q *Queries, scheduled ScheduledTransfer) error {
+	for _, id := range []uuid.UUID{scheduled.FromAccountID, scheduled.ToAccountID} {
+		account, err := q.GetAccount(ctx, id)
+		if err != nil {
+			return err
+		}
+		if account.Currency != scheduled.Currency {
+			return fmt.Errorf("%w: account %v holds %s, schedule is in %s", ErrCurrencyMismatch, id, account.Currency, scheduled.Currency)
+		}
+	}
+	return nil
+}
+
+// claimScheduledTransferRun locks the schedule and claims its next attempt,
+// as long as the schedule is still where it was when listed due. It returns
+// the locked schedule, the attempt is made with what it holds now
+func claimScheduledTransferRun(ctx context.Context, q *Queries, scheduled ScheduledTransfer) (ScheduledTransfer, ScheduledTransferRun, error) {
+	current, err := q.GetScheduledTransferForUpdate(ctx, scheduled.ID)
+	if err != nil {
+		return ScheduledTransfer{}, ScheduledTransferRun{}, err
+	}
+	if current.Status != ScheduleStatusActive ||
+		!current.NextRunAt.Equal(scheduled.NextRunAt) ||
+		current.Attempts != scheduled.Attempts {
+		return ScheduledTransfer{}, ScheduledTransferRun{}, fmt.Errorf("%w: schedule %v has moved on", ErrScheduleNotDue, scheduled.ID)
+	}
+
+	run, err := q.CreateScheduledTransferRun(ctx, CreateScheduledTransferRunParams{
+		ScheduledTransferID: scheduled.ID,
+		ScheduledFor:        scheduled.NextRunAt,
+		Attempt:             scheduled.Attempts + 1,
+	})
+	if errors.Is(err, ErrNotFound) {
+		return ScheduledTransfer{}, ScheduledTransferRun{}, fmt.Errorf("%w: attempt %d of schedule %v was already made", ErrScheduleNotDue, scheduled.Attempts+1, scheduled.ID)
+	}
+	return current, run, err
+}
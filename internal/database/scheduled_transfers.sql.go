@@ -0,0 +1,457 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: scheduled_transfers.sql
+
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+)
+
+const advanceScheduledTransfer = `-- name: AdvanceScheduledTransfer :one
+UPDATE scheduled_transfers
+  set next_run_at = $2,
+      next_attempt_at = $3,
+      attempts = $4,
+      status = $5,
+      last_error = $6,
+      updated_at = now()
+WHERE id = $1
+RETURNING id, owner, from_account_id, to_account_id, amount, currency, frequency, start_at, end_at, next_run_at, next_attempt_at, attempts, status, last_error, created_at, updated_at
+`
+
+type AdvanceScheduledTransferParams struct {
+	ID            uuid.UUID `json:"id"`
+	NextRunAt     time.Time `json:"next_run_at"`
+	NextAttemptAt time.Time `json:"next_attempt_at"`
+	Attempts      int32     `json:"attempts"`
+	Status        string    `json:"status"`
+	LastError     string    `json:"last_error"`
+}
+
+func (q *Queries) AdvanceScheduledTransfer(ctx context.Context, arg AdvanceScheduledTransferParams) (ScheduledTransfer, error) {
+	row := q.db.QueryRow(ctx, advanceScheduledTransfer,
+		arg.ID,
+		arg.NextRunAt,
+		arg.NextAttemptAt,
+		arg.Attempts,
+		arg.Status,
+		arg.LastError,
+	)
+	var i ScheduledTransfer
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.Currency,
+		&i.Frequency,
+		&i.StartAt,
+		&i.EndAt,
+		&i.NextRunAt,
+		&i.NextAttemptAt,
+		&i.Attempts,
+		&i.Status,
+		&i.LastError,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createScheduledTransfer = `-- name: CreateScheduledTransfer :one
+INSERT INTO scheduled_transfers(
+    "owner",
+    from_account_id,
+    to_account_id,
+    amount,
+    currency,
+    frequency,
+    start_at,
+    end_at,
+    next_run_at,
+    next_attempt_at
+) VALUES (
+    $1,$2,$3,$4,$5,$6,$7,$8,$9,$9
+) RETURNING id, owner, from_account_id, to_account_id, amount, currency, frequency, start_at, end_at, next_run_at, next_attempt_at, attempts, status, last_error, created_at, updated_at
+`
+
+type CreateScheduledTransferParams struct {
+	Owner         string             `json:"owner"`
+	FromAccountID uuid.UUID          `json:"from_account_id"`
+	ToAccountID   uuid.UUID          `json:"to_account_id"`
+	Amount        decimal.Decimal    `json:"amount"`
+	Currency      string             `json:"currency"`
+	Frequency     string             `json:"frequency"`
+	StartAt       time.Time          `json:"start_at"`
+	EndAt         pgtype.Timestamptz `json:"end_at"`
+	NextRunAt     time.Time          `json:"next_run_at"`
+}
+
+func (q *Queries) CreateScheduledTransfer(ctx context.Context, arg CreateScheduledTransferParams) (ScheduledTransfer, error) {
+	row := q.db.QueryRow(ctx, createScheduledTransfer,
+		arg.Owner,
+		arg.FromAccountID,
+		arg.ToAccountID,
+		arg.Amount,
+		arg.Currency,
+		arg.Frequency,
+		arg.StartAt,
+		arg.EndAt,
+		arg.NextRunAt,
+	)
+	var i ScheduledTransfer
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.Currency,
+		&i.Frequency,
+		&i.StartAt,
+		&i.EndAt,
+		&i.NextRunAt,
+		&i.NextAttemptAt,
+		&i.Attempts,
+		&i.Status,
+		&i.LastError,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createScheduledTransferRun = `-- name: CreateScheduledTransferRun :one
+INSERT INTO scheduled_transfer_runs(
+    scheduled_transfer_id,
+    scheduled_for,
+    attempt
+) VALUES (
+    $1,$2,$3
+)
+ON CONFLICT ON CONSTRAINT run_attempt_key DO UPDATE
+  set created_at = now()
+  WHERE scheduled_transfer_runs.status = 'running'
+RETURNING id, scheduled_transfer_id, scheduled_for, attempt, status, transfer_id, error, created_at, finished_at
+`
+
+type CreateScheduledTransferRunParams struct {
+	ScheduledTransferID uuid.UUID `json:"scheduled_transfer_id"`
+	ScheduledFor        time.Time `json:"scheduled_for"`
+	Attempt             int32     `json:"attempt"`
+}
+
+// an attempt that is still running was claimed by a scheduler that
+// stopped before recording how it went, it can be claimed again
+func (q *Queries) CreateScheduledTransferRun(ctx context.Context, arg CreateScheduledTransferRunParams) (ScheduledTransferRun, error) {
+	row := q.db.QueryRow(ctx, createScheduledTransferRun, arg.ScheduledTransferID, arg.ScheduledFor, arg.Attempt)
+	var i ScheduledTransferRun
+	err := row.Scan(
+		&i.ID,
+		&i.ScheduledTransferID,
+		&i.ScheduledFor,
+		&i.Attempt,
+		&i.Status,
+		&i.TransferID,
+		&i.Error,
+		&i.CreatedAt,
+		&i.FinishedAt,
+	)
+	return i, err
+}
+
+const finishScheduledTransferRun = `-- name: FinishScheduledTransferRun :one
+UPDATE scheduled_transfer_runs
+  set status = $2,
+      transfer_id = $3,
+      error = $4,
+      finished_at = now()
+WHERE id = $1
+RETURNING id, scheduled_transfer_id, scheduled_for, attempt, status, transfer_id, error, created_at, finished_at
+`
+
+type FinishScheduledTransferRunParams struct {
+	ID         uuid.UUID     `json:"id"`
+	Status     string        `json:"status"`
+	TransferID uuid.NullUUID `json:"transfer_id"`
+	Error      string        `json:"error"`
+}
+
+func (q *Queries) FinishScheduledTransferRun(ctx context.Context, arg FinishScheduledTransferRunParams) (ScheduledTransferRun, error) {
+	row := q.db.QueryRow(ctx, finishScheduledTransferRun,
+		arg.ID,
+		arg.Status,
+		arg.TransferID,
+		arg.Error,
+	)
+	var i ScheduledTransferRun
+	err := row.Scan(
+		&i.ID,
+		&i.ScheduledTransferID,
+		&i.ScheduledFor,
+		&i.Attempt,
+		&i.Status,
+		&i.TransferID,
+		&i.Error,
+		&i.CreatedAt,
+		&i.FinishedAt,
+	)
+	return i, err
+}
+
+const getScheduledTransfer = `-- name: GetScheduledTransfer :one
+SELECT id, owner, from_account_id, to_account_id, amount, currency, frequency, start_at, end_at, next_run_at, next_attempt_at, attempts, status, last_error, created_at, updated_at FROM scheduled_transfers
+WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetScheduledTransfer(ctx context.Context, id uuid.UUID) (ScheduledTransfer, error) {
+	row := q.db.QueryRow(ctx, getScheduledTransfer, id)
+	var i ScheduledTransfer
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.Currency,
+		&i.Frequency,
+		&i.StartAt,
+		&i.EndAt,
+		&i.NextRunAt,
+		&i.NextAttemptAt,
+		&i.Attempts,
+		&i.Status,
+		&i.LastError,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getScheduledTransferForUpdate = `-- name: GetScheduledTransferForUpdate :one
+SELECT id, owner, from_account_id, to_account_id, amount, currency, frequency, start_at, end_at, next_run_at, next_attempt_at, attempts, status, last_error, created_at, updated_at FROM scheduled_transfers
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetScheduledTransferForUpdate(ctx context.Context, id uuid.UUID) (ScheduledTransfer, error) {
+	row := q.db.QueryRow(ctx, getScheduledTransferForUpdate, id)
+	var i ScheduledTransfer
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.Currency,
+		&i.Frequency,
+		&i.StartAt,
+		&i.EndAt,
+		&i.NextRunAt,
+		&i.NextAttemptAt,
+		&i.Attempts,
+		&i.Status,
+		&i.LastError,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listDueScheduledTransfers = `-- name: ListDueScheduledTransfers :many
+SELECT id, owner, from_account_id, to_account_id, amount, currency, frequency, start_at, end_at, next_run_at, next_attempt_at, attempts, status, last_error, created_at, updated_at FROM scheduled_transfers
+WHERE status = 'active' AND next_attempt_at <= $1
+ORDER BY next_attempt_at
+LIMIT $2
+`
+
+type ListDueScheduledTransfersParams struct {
+	NextAttemptAt time.Time `json:"next_attempt_at"`
+	Limit         int32     `json:"limit"`
+}
+
+func (q *Queries) ListDueScheduledTransfers(ctx context.Context, arg ListDueScheduledTransfersParams) ([]ScheduledTransfer, error) {
+	rows, err := q.db.Query(ctx, listDueScheduledTransfers, arg.NextAttemptAt, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ScheduledTransfer{}
+	for rows.Next() {
+		var i ScheduledTransfer
+		if err := rows.Scan(
+			&i.ID,
+			&i.Owner,
+			&i.FromAccountID,
+			&i.ToAccountID,
+			&i.Amount,
+			&i.Currency,
+			&i.Frequency,
+			&i.StartAt,
+			&i.EndAt,
+			&i.NextRunAt,
+			&i.NextAttemptAt,
+			&i.Attempts,
+			&i.Status,
+			&i.LastError,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listScheduledTransferRuns = `-- name: ListScheduledTransferRuns :many
+SELECT id, scheduled_transfer_id, scheduled_for, attempt, status, transfer_id, error, created_at, finished_at FROM scheduled_transfer_runs
+WHERE scheduled_transfer_id = $1
+ORDER BY created_at DESC
+LIMIT $2
+OFFSET $3
+`
+
+type ListScheduledTransferRunsParams struct {
+	ScheduledTransferID uuid.UUID `json:"scheduled_transfer_id"`
+	Limit               int32     `json:"limit"`
+	Offset              int32     `json:"offset"`
+}
+
+func (q *Queries) ListScheduledTransferRuns(ctx context.Context, arg ListScheduledTransferRunsParams) ([]ScheduledTransferRun, error) {
+	rows, err := q.db.Query(ctx, listScheduledTransferRuns, arg.ScheduledTransferID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ScheduledTransferRun{}
+	for rows.Next() {
+		var i ScheduledTransferRun
+		if err := rows.Scan(
+			&i.ID,
+			&i.ScheduledTransferID,
+			&i.ScheduledFor,
+			&i.Attempt,
+			&i.Status,
+			&i.TransferID,
+			&i.Error,
+			&i.CreatedAt,
+			&i.FinishedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listScheduledTransfers = `-- name: ListScheduledTransfers :many
+SELECT id, owner, from_account_id, to_account_id, amount, currency, frequency, start_at, end_at, next_run_at, next_attempt_at, attempts, status, last_error, created_at, updated_at FROM scheduled_transfers
+WHERE "owner" = $1
+ORDER BY next_run_at
+LIMIT $2
+OFFSET $3
+`
+
+type ListScheduledTransfersParams struct {
+	Owner  string `json:"owner"`
+	Limit  int32  `json:"limit"`
+	Offset int32  `json:"offset"`
+}
+
+func (q *Queries) ListScheduledTransfers(ctx context.Context, arg ListScheduledTransfersParams) ([]ScheduledTransfer, error) {
+	rows, err := q.db.Query(ctx, listScheduledTransfers, arg.Owner, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ScheduledTransfer{}
+	for rows.Next() {
+		var i ScheduledTransfer
+		if err := rows.Scan(
+			&i.ID,
+			&i.Owner,
+			&i.FromAccountID,
+			&i.ToAccountID,
+			&i.Amount,
+			&i.Currency,
+			&i.Frequency,
+			&i.StartAt,
+			&i.EndAt,
+			&i.NextRunAt,
+			&i.NextAttemptAt,
+			&i.Attempts,
+			&i.Status,
+			&i.LastError,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateScheduledTransfer = `-- name: UpdateScheduledTransfer :one
+UPDATE scheduled_transfers
+  set amount = $2,
+      status = $3,
+      end_at = $4,
+      updated_at = now()
+WHERE id = $1
+RETURNING id, owner, from_account_id, to_account_id, amount, currency, frequency, start_at, end_at, next_run_at, next_attempt_at, attempts, status, last_error, created_at, updated_at
+`
+
+type UpdateScheduledTransferParams struct {
+	ID     uuid.UUID          `json:"id"`
+	Amount decimal.Decimal    `json:"amount"`
+	Status string             `json:"status"`
+	EndAt  pgtype.Timestamptz `json:"end_at"`
+}
+
+func (q *Queries) UpdateScheduledTransfer(ctx context.Context, arg UpdateScheduledTransferParams) (ScheduledTransfer, error) {
+	row := q.db.QueryRow(ctx, updateScheduledTransfer,
+		arg.ID,
+		arg.Amount,
+		arg.Status,
+		arg.EndAt,
+	)
+	var i ScheduledTransfer
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.Currency,
+		&i.Frequency,
+		&i.StartAt,
+		&i.EndAt,
+		&i.NextRunAt,
+		&i.NextAttemptAt,
+		&i.Attempts,
+		&i.Status,
+		&i.LastError,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,135 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomScheduledTransfer(t *testing.T, nextRunAt time.Time) ScheduledTransfer {
+	t.Helper()
+	user := CreateRandomUser(t)
+	fromAccount := createAccountInCurrency(t, user, "USD", decimal.NewFromInt(100))
+	toAccount := createAccountInCurrency(t, CreateRandomUser(t), "USD", decimal.Zero)
+
+	scheduled, err := testQueries.CreateScheduledTransfer(context.Background(), CreateScheduledTransferParams{
+		Owner:         user.Username,
+		FromAccountID: fromAccount.ID,
+		ToAccountID:   toAccount.ID,
+		Amount:        decimal.NewFromInt(10),
+		Currency:      "USD",
+		Frequency:     FrequencyWeekly,
+		StartAt:       nextRunAt,
+		NextRunAt:     nextRunAt,
+	})
+	require.NoError(t, err)
+	require.Equal(t, ScheduleStatusActive, scheduled.Status)
+	require.WithinDuration(t, nextRunAt, scheduled.NextAttemptAt, time.Second)
+	return scheduled
+}
+
+func TestListDueScheduledTransfers(t *testing.T) {
+	due := createRandomScheduledTransfer(t, time.Now().Add(-time.Minute))
+	notDue := createRandomScheduledTransfer(t, time.Now().Add(time.Hour))
+
+	scheduled, err := testQueries.ListDueScheduledTransfers(context.Background(), ListDueScheduledTransfersParams{
+		NextAttemptAt: time.Now(),
+		Limit:         1000,
+	})
+	require.NoError(t, err)
+
+	ids := make(map[string]bool)
+	for _, s := range scheduled {
+		ids[s.ID.String()] = true
+	}
+	require.True(t, ids[due.ID.String()])
+	require.False(t, ids[notDue.ID.String()])
+}
+
+func TestCreateScheduledTransferRun_ClaimsOnce(t *testing.T) {
+	scheduled := createRandomScheduledTransfer(t, time.Now())
+
+	arg := CreateScheduledTransferRunParams{
+		ScheduledTransferID: scheduled.ID,
+		ScheduledFor:        scheduled.NextRunAt,
+		Attempt:             1,
+	}
+	run, err := testQueries.CreateScheduledTransferRun(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, RunStatusRunning, run.Status)
+
+	// a claim left running by a scheduler that stopped can be taken over
+	reclaimed, err := testQueries.CreateScheduledTransferRun(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, run.ID, reclaimed.ID)
+
+	_, err = testQueries.FinishScheduledTransferRun(context.Background(), FinishScheduledTransferRunParams{
+		ID:     run.ID,
+		Status: RunStatusFailed,
+		Error:  "insufficient funds",
+	})
+	require.NoError(t, err)
+
+	// once it finished nobody gets the attempt again
+	_, err = testQueries.CreateScheduledTransferRun(context.Background(), arg)
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+}
+
+func TestExecuteScheduledTransferTx(t *testing.T) {
+	store := NewStore(testDB)
+	scheduled := createRandomScheduledTransfer(t, time.Now().Add(-time.Minute))
+	next := scheduled.NextRunAt.AddDate(0, 0, 7)
+
+	arg := ExecuteScheduledTransferTxParams{
+		Scheduled: scheduled,
+		Succeeded: AdvanceScheduledTransferParams{
+			ID:            scheduled.ID,
+			NextRunAt:     next,
+			NextAttemptAt: next,
+			Status:        ScheduleStatusActive,
+		},
+		Failed: AdvanceScheduledTransferParams{
+			ID:            scheduled.ID,
+			NextRunAt:     scheduled.NextRunAt,
+			NextAttemptAt: time.Now().Add(time.Hour),
+			Attempts:      1,
+			Status:        ScheduleStatusActive,
+		},
+	}
+	result, err := store.ExecuteScheduledTransferTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, RunStatusSucceeded, result.Run.Status)
+	require.True(t, result.Run.TransferID.Valid)
+	require.WithinDuration(t, next, result.Scheduled.NextRunAt, time.Second)
+
+	// the schedule has moved on, the same attempt isn't made twice
+	_, err = store.ExecuteScheduledTransferTx(context.Background(), arg)
+	require.ErrorIs(t, err, ErrScheduleNotDue)
+
+	// 90 is left, the schedule is raised to 100 after it was listed due, the
+	// transfer of 100 is refused and recorded as failed
+	_, err = testQueries.UpdateScheduledTransfer(context.Background(), UpdateScheduledTransferParams{
+		ID:     scheduled.ID,
+		Amount: decimal.NewFromInt(100),
+		Status: ScheduleStatusActive,
+	})
+	require.NoError(t, err)
+	arg.Scheduled = result.Scheduled
+	arg.Failed.NextRunAt = next
+
+	result, err = store.ExecuteScheduledTransferTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, RunStatusFailed, result.Run.Status)
+	require.False(t, result.Run.TransferID.Valid)
+	require.Contains(t, result.Run.Error, ErrInsufficientFunds.Error())
+	require.Equal(t, int32(1), result.Scheduled.Attempts)
+	require.Equal(t, result.Run.Error, result.Scheduled.LastError)
+
+	fromAccount, err := testQueries.GetAccount(context.Background(), scheduled.FromAccountID)
+	require.NoError(t, err)
+	require.True(t, decimal.NewFromInt(90).Equal(fromAccount.Balance))
+}
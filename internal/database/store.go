@@ -2,56 +2,162 @@ package database
 
 import (
 	"context"
-	"database/sql"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"time"
 
+	"github.com/Glenn444/banking-app/util"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/shopspring/decimal"
 )
 
+var (
+	ErrInsufficientFunds  = errors.New("insufficient funds")
+	ErrInvalidAmountScale = errors.New("amount has more decimals than the currency allows")
+)
+
 type Store interface{
 	Querier
 	TransferTx(ctx context.Context, arg TransferTxParams) (TransferTxResult, error)
+	CreateFundingTx(ctx context.Context, arg FundingTxParams) (FundingTxResult, error)
+	SettleFundingTx(ctx context.Context, id uuid.UUID) (FundingTxResult, error)
+	FailFundingTx(ctx context.Context, id uuid.UUID, reason string) (FundingTxResult, error)
+	SetCurrencyEnabledTx(ctx context.Context, code string, enabled bool) (Currency, error)
+	FxTransferTx(ctx context.Context, arg FxTransferTxParams) (FxTransferTxResult, error)
+	CreateHoldTx(ctx context.Context, arg CreateHoldTxParams) (HoldTxResult, error)
+	CaptureHoldTx(ctx context.Context, arg CaptureHoldTxParams) (CaptureHoldTxResult, error)
+	ReleaseHoldTx(ctx context.Context, holdID uuid.UUID) (HoldTxResult, error)
+	ExpireHoldsTx(ctx context.Context, now time.Time, limit int32) (int, error)
+	CreateTransferBatchTx(ctx context.Context, arg CreateTransferBatchTxParams) (TransferBatch, error)
+	ExecuteTransferBatchChunkTx(ctx context.Context, batchID uuid.UUID, chunkSize int32) (TransferBatch, error)
+	AccrueOverdraftInterestTx(ctx context.Context, businessDate time.Time, limit int32) (int, error)
+	AccrueInterestTx(ctx context.Context, businessDate time.Time) (InterestRun, error)
+	CreateFeeScheduleTx(ctx context.Context, arg CreateFeeScheduleTxParams) (FeeScheduleResult, error)
+	CreatePaymentRequestTx(ctx context.Context, arg CreatePaymentRequestParams) (PaymentRequest, error)
+	AcceptPaymentRequestTx(ctx context.Context, arg AcceptPaymentRequestTxParams) (AcceptPaymentRequestTxResult, error)
+	DeclinePaymentRequestTx(ctx context.Context, requestID uuid.UUID) (PaymentRequest, error)
+	CreateTransferApprovalTx(ctx context.Context, arg CreateTransferApprovalTxParams) (TransferApprovalTxResult, error)
+	ApproveTransferTx(ctx context.Context, arg DecideTransferApprovalTxParams) (TransferApprovalTxResult, error)
+	RejectTransferTx(ctx context.Context, arg DecideTransferApprovalTxParams) (TransferApprovalTxResult, error)
+	CancelTransferApprovalTx(ctx context.Context, arg DecideTransferApprovalTxParams) (TransferApprovalTxResult, error)
+	ExpireTransferApprovalsTx(ctx context.Context, now time.Time, limit int32) (int, error)
+	CreateEscrowTx(ctx context.Context, arg CreateEscrowTxParams) (EscrowTxResult, error)
+	ConfirmEscrowTx(ctx context.Context, arg EscrowActionTxParams) (EscrowTxResult, error)
+	DisputeEscrowTx(ctx context.Context, arg EscrowActionTxParams) (EscrowTxResult, error)
+	ExpireEscrowsTx(ctx context.Context, now time.Time, limit int32) (int, error)
+	SubmitTransferTx(ctx context.Context, arg TransferTxParams) (Transfer, error)
+	ProcessTransferTx(ctx context.Context, transferID uuid.UUID) (Transfer, error)
+	ExecuteScheduledTransferTx(ctx context.Context, arg ExecuteScheduledTransferTxParams) (ExecuteScheduledTransferTxResult, error)
+	ReverseTransferTx(ctx context.Context, transferID uuid.UUID) (ReverseTransferTxResult, error)
+	Ping(ctx context.Context) error
+	SchemaVersion(ctx context.Context) (int64, error)
+	PoolStats() PoolStats
 }
 
 type SQLStore struct {
 	*Queries
-	db *sql.DB
+	db    *pgxpool.Pool
+	retry TxRetryPolicy
 }
 
-// NewStore creates a new store
-func NewStore(db *sql.DB) Store {
+// NewStore creates a new store on a connection pool
+func NewStore(db *pgxpool.Pool) Store {
 	return &SQLStore{
 		db:      db,
-		Queries: New(db),
+		Queries: New(notFoundDB{db}),
+		retry:   DefaultTxRetryPolicy,
+	}
+}
+
+// execTx executes a function within a database transaction started with
+// opts, nil opts use the driver's defaults. A transaction that fails on a
+// deadlock, a serialization failure or a lock timeout is run again in a new
+// transaction, so fn must be safe to run more than once. Its errors are
+// sorted into the taxonomy of errors.go.
+func (store *SQLStore) execTx(ctx context.Context, opts *pgx.TxOptions, fn func(*Queries) error) error {
+	for attempt := 1; ; attempt++ {
+		err := store.runTx(ctx, opts, fn)
+		reason, retryable := retryableTxError(err)
+		if !retryable {
+			return TranslateError(err)
+		}
+		if attempt >= store.retry.MaxAttempts {
+			txRetriesExhausted.Add(reason, 1)
+			return TranslateError(err)
+		}
+		txRetries.Add(reason, 1)
+
+		select {
+		case <-ctx.Done():
+			return TranslateError(err)
+		case <-time.After(store.retry.delay(attempt)):
+		}
 	}
 }
 
-// execTx executes a function within a database transaction
-func (store *SQLStore) execTx(ctx context.Context, fn func(*Queries) error) error {
-	tx, err := store.db.BeginTx(ctx, nil)
+// runTx is a single attempt of execTx
+func (store *SQLStore) runTx(ctx context.Context, opts *pgx.TxOptions, fn func(*Queries) error) error {
+	if opts == nil {
+		opts = &pgx.TxOptions{}
+	}
+	tx, err := store.db.BeginTx(ctx, *opts)
 	if err != nil {
 		return err
 	}
 
 	//generate a queries object tied to a specific transaction tx
-	q := New(tx)
+	q := New(notFoundDB{tx})
 
 	err = fn(q)
 	if err != nil {
-		if rbErr := tx.Rollback(); rbErr != nil {
-			return fmt.Errorf("tx err: %v, rb err: %v", err, rbErr)
+		if rbErr := tx.Rollback(ctx); rbErr != nil {
+			return fmt.Errorf("tx err: %w, rb err: %v", err, rbErr)
 		}
 		return err // Return the original error, don't continue to commit
 	}
 
-	return tx.Commit()
+	return tx.Commit(ctx)
 }
 
 type TransferTxParams struct {
 	FromAccountID uuid.UUID       `json:"from_account_id"`
 	ToAccountID   uuid.UUID       `json:"to_account_id"`
 	Amount        decimal.Decimal `json:"amount"`
+	TransferDetails
+}
+
+// TransferDetails are the optional details kept on a transfer, a reference
+// can only be used once per from account
+type TransferDetails struct {
+	Description       string          `json:"description"`
+	ExternalReference pgtype.Text     `json:"external_reference"`
+	Metadata          json.RawMessage `json:"metadata"`
+}
+
+// saveTransferDetails saves the details on a transfer just created, it does
+// nothing when there are none
+func saveTransferDetails(ctx context.Context, q *Queries, transfer *Transfer, details TransferDetails) error {
+	if details.Description == "" && !details.ExternalReference.Valid && len(details.Metadata) == 0 {
+		return nil
+	}
+
+	metadata := details.Metadata
+	if len(metadata) == 0 {
+		metadata = json.RawMessage("{}")
+	}
+
+	var err error
+	*transfer, err = q.SetTransferDetails(ctx, SetTransferDetailsParams{
+		ID:                transfer.ID,
+		Description:       details.Description,
+		ExternalReference: details.ExternalReference,
+		Metadata:          metadata,
+	})
+	return err
 }
 
 // TransferTxResult is the result of the transfer
@@ -61,6 +167,10 @@ type TransferTxResult struct {
 	ToAccount   Account  `json:"to_account"`
 	FromEntry   Entry    `json:"from_entry"`
 	ToEntry     Entry    `json:"to_entry"`
+	// Fee is what the sender was charged on top of the amount, FeeEntry is
+	// the sender's fee entry and is nil when no fee was charged
+	Fee      decimal.Decimal `json:"fee"`
+	FeeEntry *Entry          `json:"fee_entry"`
 }
 
 type txKeyType struct{}
@@ -71,108 +181,148 @@ var txKey = txKeyType{}
 func (store *SQLStore) TransferTx(ctx context.Context, arg TransferTxParams) (TransferTxResult, error) {
 	var result TransferTxResult
 
-	err := store.execTx(ctx, func(q *Queries) error {
-		var err error
-		txName := ctx.Value(txKey)
+	err := store.execTx(ctx, nil, func(q *Queries) error {
+		return transferTx(ctx, q, arg, &result)
+	})
+	if err != nil {
+		return TransferTxResult{}, err
+	}
+	return result, nil
+}
 
-		fmt.Println(txName, "create transfer")
-		result.Transfer, err = q.CreateTransfer(ctx, CreateTransferParams(arg))
+// transferTx moves the money of a transfer inside an open transaction, so a
+// transfer can be made together with other changes that commit with it
+func transferTx(ctx context.Context, q *Queries, arg TransferTxParams, result *TransferTxResult) error {
+	var err error
+	txName := ctx.Value(txKey)
 
-		if err != nil {
-			return err
-		}
+	fmt.Println(txName, "create transfer")
+	result.Transfer, err = q.CreateTransfer(ctx, CreateTransferParams{
+		FromAccountID: arg.FromAccountID,
+		ToAccountID:   arg.ToAccountID,
+		Amount:        arg.Amount,
+	})
 
-		fmt.Println(txName, "create entry 1")
-		result.FromEntry, err = q.CreateEntries(ctx, CreateEntriesParams{
-			AccountID: arg.FromAccountID,
-			Amount:    arg.Amount.Neg(),
-		})
-		if err != nil {
-			return err
-		}
+	if err != nil {
+		return err
+	}
 
-		fmt.Println(txName, "create entry 2")
-		result.ToEntry, err = q.CreateEntries(ctx, CreateEntriesParams{
-			AccountID: arg.ToAccountID,
-			Amount:    arg.Amount,
-		})
-		if err != nil {
-			return err
-		}
+	if err = saveTransferDetails(ctx, q, &result.Transfer, arg.TransferDetails); err != nil {
+		return err
+	}
 
-		//TODO: update accounts' balance
-		var fromAccount, toAccount Account
-		if arg.FromAccountID.String() < arg.ToAccountID.String() {
-			fmt.Println(txName, "Get Account (lock) from -> to ")
-			fmt.Println(txName, "Get Account 1")
-			fromAccount, err = q.GetAccountByIdForUpdate(ctx, arg.FromAccountID)
-			if err != nil {
-				return err
-			}
-
-			fmt.Println(txName, "Get Account 2")
-			toAccount, err = q.GetAccountByIdForUpdate(ctx, arg.ToAccountID)
-			if err != nil {
-				return err
-			}
-		} else {
-			//fmt.Println(txName, "Get Account (lock) to -> from")
-			
-
-			//fmt.Println(txName, "Get Account 2")
-			toAccount, err = q.GetAccountByIdForUpdate(ctx, arg.ToAccountID)
-			if err != nil {
-				return err
-			}
-			//fmt.Println(txName, "Get Account 1")
-			fromAccount, err = q.GetAccountByIdForUpdate(ctx, arg.FromAccountID)
-			if err != nil {
-				return err
-			}
-		}
-		//1. Check if senders balance is able to transfer amount
-		if fromAccount.Balance.LessThan(arg.Amount) {
-			return fmt.Errorf("insufficient funds: account %v has balance %v, transfer amount %v",
-				arg.FromAccountID, fromAccount.Balance, arg.Amount)
-		}
+	return moveTransferFunds(ctx, q, arg, result)
+}
+
+// moveTransferFunds moves the money of the transfer in result, which is
+// already stored, checking what the sender can spend and its limits
+func moveTransferFunds(ctx context.Context, q *Queries, arg TransferTxParams, result *TransferTxResult) error {
+	var err error
+	txName := ctx.Value(txKey)
 
-		updateAccount1Balance := fromAccount.Balance.Sub(arg.Amount)
-		updateAccount2Balance := toAccount.Balance.Add(arg.Amount)
+	fmt.Println(txName, "create entry 1")
+	result.FromEntry, err = q.CreateEntries(ctx, CreateEntriesParams{
+		AccountID: arg.FromAccountID,
+		Amount:    arg.Amount.Neg(),
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(txName, "create entry 2")
+	result.ToEntry, err = q.CreateEntries(ctx, CreateEntriesParams{
+		AccountID: arg.ToAccountID,
+		Amount:    arg.Amount,
+	})
+	if err != nil {
+		return err
+	}
 
-		//fmt.Println(txName, "update Account 1")
-		err = q.UpdateAccount(ctx, UpdateAccountParams{
-			ID:      arg.FromAccountID,
-			Balance: updateAccount1Balance,
-		})
+	//TODO: update accounts' balance
+	var fromAccount, toAccount Account
+	if arg.FromAccountID.String() < arg.ToAccountID.String() {
+		fmt.Println(txName, "Get Account (lock) from -> to ")
+		fmt.Println(txName, "Get Account 1")
+		fromAccount, err = q.GetAccountByIdForUpdate(ctx, arg.FromAccountID)
 		if err != nil {
 			return err
 		}
 
-		//fmt.Println(txName, "update Account 2")
-		err = q.UpdateAccount(ctx, UpdateAccountParams{
-			ID:      arg.ToAccountID,
-			Balance: updateAccount2Balance,
-		})
+		fmt.Println(txName, "Get Account 2")
+		toAccount, err = q.GetAccountByIdForUpdate(ctx, arg.ToAccountID)
 		if err != nil {
 			return err
 		}
+	} else {
+		//fmt.Println(txName, "Get Account (lock) to -> from")
+		
 
-		//fmt.Println(txName, "Get Account 1")
-		result.FromAccount, err = q.GetAccount(ctx, arg.FromAccountID)
+		//fmt.Println(txName, "Get Account 2")
+		toAccount, err = q.GetAccountByIdForUpdate(ctx, arg.ToAccountID)
 		if err != nil {
 			return err
 		}
-
-		//fmt.Println(txName, "Get Account 2")
-		result.ToAccount, err = q.GetAccount(ctx, arg.ToAccountID)
+		//fmt.Println(txName, "Get Account 1")
+		fromAccount, err = q.GetAccountByIdForUpdate(ctx, arg.FromAccountID)
 		if err != nil {
 			return err
 		}
+	}
+	//1. Check the amount fits the currency and what the sender can spend, its available balance plus any overdraft, funds reserved by holds can't be transferred
+	if !util.IsValidAmount(arg.Amount, fromAccount.Currency) {
+		return fmt.Errorf("%w: %v %s", ErrInvalidAmountScale, arg.Amount, fromAccount.Currency)
+	}
+	//2. Price the transfer, the sender has to be able to pay the fee as well
+	quote, err := QuoteTransferFee(ctx, q, fromAccount, arg.Amount)
+	if err != nil {
+		return err
+	}
+	if fromAccount.SpendableBalance().LessThan(quote.Total) {
+		return fmt.Errorf("%w: account %v can spend %v, transfer amount %v, fee %v",
+			ErrInsufficientFunds, arg.FromAccountID, fromAccount.SpendableBalance(), arg.Amount, quote.Fee)
+	}
+	if err = checkTransferLimits(ctx, q, &fromAccount, arg.Amount, result.Transfer.ID, time.Now()); err != nil {
+		return err
+	}
 
-		return nil
+	updateAccount1Balance := fromAccount.Balance.Sub(arg.Amount)
+	updateAccount2Balance := toAccount.Balance.Add(arg.Amount)
+
+	//fmt.Println(txName, "update Account 1")
+	err = q.UpdateAccount(ctx, UpdateAccountParams{
+		ID:      arg.FromAccountID,
+		Balance: updateAccount1Balance,
 	})
 	if err != nil {
-		return TransferTxResult{}, err
+		return err
 	}
-	return result, nil
+
+	//fmt.Println(txName, "update Account 2")
+	err = q.UpdateAccount(ctx, UpdateAccountParams{
+		ID:      arg.ToAccountID,
+		Balance: updateAccount2Balance,
+	})
+	if err != nil {
+		return err
+	}
+
+	result.Fee = quote.Fee
+	result.FeeEntry, err = chargeTransferFee(ctx, q, &result.Transfer, quote)
+	if err != nil {
+		return err
+	}
+
+	//fmt.Println(txName, "Get Account 1")
+	result.FromAccount, err = q.GetAccount(ctx, arg.FromAccountID)
+	if err != nil {
+		return err
+	}
+
+	//fmt.Println(txName, "Get Account 2")
+	result.ToAccount, err = q.GetAccount(ctx, arg.ToAccountID)
+	if err != nil {
+		return err
+	}
+
+	return nil
 }
@@ -2,12 +2,15 @@ package database
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/Glenn444/banking-app/util"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 )
@@ -269,6 +272,30 @@ func TestTransferTx_InvalidAmounts(t *testing.T) {
 	}
 }
 
+func TestTransferTx_ExcessPrecision(t *testing.T) {
+	store := NewStore(testDB)
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+
+	currency, ok := util.LookupCurrency(account1.Currency)
+	require.True(t, ok)
+
+	// one more decimal than the currency allows
+	amount := decimal.New(1, -(currency.MinorUnits + 1))
+
+	result, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        amount,
+	})
+	require.ErrorIs(t, err, ErrInvalidAmountScale)
+	require.Empty(t, result.Transfer)
+
+	finalAccount1, err := store.GetAccount(context.Background(), account1.ID)
+	require.NoError(t, err)
+	require.Equal(t, account1.Balance, finalAccount1.Balance)
+}
+
 func TestTransferTx_LargeAmount(t *testing.T) {
 	store := NewStore(testDB)
 
@@ -515,3 +542,75 @@ func BenchmarkTransferTx_Concurrent(b *testing.B) {
 		}
 	})
 }
+
+func TestTransferTx_Details(t *testing.T) {
+	store := NewStore(testDB)
+	ctx := context.Background()
+	user := CreateRandomUser(t)
+	fromAccount := createAccountInCurrency(t, user, "USD", decimal.NewFromInt(100))
+	toAccount := createAccountInCurrency(t, CreateRandomUser(t), "USD", decimal.Zero)
+
+	reference := pgtype.Text{String: util.RandomString(16), Valid: true}
+	result, err := store.TransferTx(ctx, TransferTxParams{
+		FromAccountID: fromAccount.ID,
+		ToAccountID:   toAccount.ID,
+		Amount:        decimal.NewFromInt(10),
+		TransferDetails: TransferDetails{
+			Description:       "March rent",
+			ExternalReference: reference,
+			Metadata:          json.RawMessage(`{"invoice": "A-17", "unit": 4}`),
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "March rent", result.Transfer.Description)
+	require.Equal(t, reference, result.Transfer.ExternalReference)
+	require.JSONEq(t, `{"invoice": "A-17", "unit": 4}`, string(result.Transfer.Metadata))
+
+	// a reference is only used once per sender, the failed transfer moves nothing
+	_, err = store.TransferTx(ctx, TransferTxParams{
+		FromAccountID:   fromAccount.ID,
+		ToAccountID:     toAccount.ID,
+		Amount:          decimal.NewFromInt(10),
+		TransferDetails: TransferDetails{ExternalReference: reference},
+	})
+	require.Error(t, err)
+	account, err := testQueries.GetAccount(ctx, fromAccount.ID)
+	require.NoError(t, err)
+	require.True(t, decimal.NewFromInt(90).Equal(account.Balance))
+
+	existing, err := testQueries.GetTransferByExternalReference(ctx, GetTransferByExternalReferenceParams{
+		FromAccountID:     fromAccount.ID,
+		ExternalReference: reference,
+	})
+	require.NoError(t, err)
+	require.Equal(t, result.Transfer.ID, existing.ID)
+
+	// a transfer without details keeps the empty defaults
+	_, err = store.TransferTx(ctx, TransferTxParams{
+		FromAccountID: fromAccount.ID,
+		ToAccountID:   toAccount.ID,
+		Amount:        decimal.NewFromInt(5),
+	})
+	require.NoError(t, err)
+
+	search := func(arg SearchAccountTransfersParams) []Transfer {
+		arg.AccountID = toAccount.ID
+		arg.Limit = 10
+		if arg.Metadata == nil {
+			arg.Metadata = json.RawMessage("{}")
+		}
+		transfers, err := testQueries.SearchAccountTransfers(ctx, arg)
+		require.NoError(t, err)
+		return transfers
+	}
+
+	require.Len(t, search(SearchAccountTransfersParams{}), 2)
+	found := search(SearchAccountTransfersParams{Search: pgtype.Text{String: "RENT", Valid: true}})
+	require.Len(t, found, 1)
+	require.Equal(t, result.Transfer.ID, found[0].ID)
+	found = search(SearchAccountTransfersParams{ExternalReference: reference})
+	require.Len(t, found, 1)
+	found = search(SearchAccountTransfersParams{Metadata: json.RawMessage(`{"unit": 4}`)})
+	require.Len(t, found, 1)
+	require.Empty(t, search(SearchAccountTransfersParams{Metadata: json.RawMessage(`{"unit": 5}`)}))
+}
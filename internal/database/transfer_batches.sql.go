@@ -0,0 +1,443 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: transfer_batches.sql
+
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+const addTransferBatchProgress = `-- name: AddTransferBatchProgress :one
+UPDATE transfer_batches
+  set succeeded_items = succeeded_items + $1,
+      failed_items = failed_items + $2,
+      updated_at = now()
+WHERE id = $3
+RETURNING id, owner, from_account_id, currency, mode, status, total_items, total_amount, succeeded_items, failed_items, error, created_at, updated_at, completed_at
+`
+
+type AddTransferBatchProgressParams struct {
+	Succeeded int32     `json:"succeeded"`
+	Failed    int32     `json:"failed"`
+	ID        uuid.UUID `json:"id"`
+}
+
+func (q *Queries) AddTransferBatchProgress(ctx context.Context, arg AddTransferBatchProgressParams) (TransferBatch, error) {
+	row := q.db.QueryRow(ctx, addTransferBatchProgress, arg.Succeeded, arg.Failed, arg.ID)
+	var i TransferBatch
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.FromAccountID,
+		&i.Currency,
+		&i.Mode,
+		&i.Status,
+		&i.TotalItems,
+		&i.TotalAmount,
+		&i.SucceededItems,
+		&i.FailedItems,
+		&i.Error,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.CompletedAt,
+	)
+	return i, err
+}
+
+const claimTransferBatch = `-- name: ClaimTransferBatch :one
+UPDATE transfer_batches
+  set status = 'processing',
+      updated_at = now()
+WHERE id = (
+    SELECT b.id FROM transfer_batches b
+    WHERE b.status = 'pending'
+       OR (b.status = 'processing' AND b.updated_at < $1)
+    ORDER BY b.created_at
+    LIMIT 1
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING id, owner, from_account_id, currency, mode, status, total_items, total_amount, succeeded_items, failed_items, error, created_at, updated_at, completed_at
+`
+
+// picks the oldest pending batch, or one whose worker stopped updating it
+func (q *Queries) ClaimTransferBatch(ctx context.Context, staleBefore time.Time) (TransferBatch, error) {
+	row := q.db.QueryRow(ctx, claimTransferBatch, staleBefore)
+	var i TransferBatch
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.FromAccountID,
+		&i.Currency,
+		&i.Mode,
+		&i.Status,
+		&i.TotalItems,
+		&i.TotalAmount,
+		&i.SucceededItems,
+		&i.FailedItems,
+		&i.Error,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.CompletedAt,
+	)
+	return i, err
+}
+
+const createTransferBatch = `-- name: CreateTransferBatch :one
+INSERT INTO transfer_batches(
+    "owner",
+    from_account_id,
+    currency,
+    mode,
+    total_items,
+    total_amount
+) VALUES (
+    $1,$2,$3,$4,$5,$6
+) RETURNING id, owner, from_account_id, currency, mode, status, total_items, total_amount, succeeded_items, failed_items, error, created_at, updated_at, completed_at
+`
+
+type CreateTransferBatchParams struct {
+	Owner         string          `json:"owner"`
+	FromAccountID uuid.UUID       `json:"from_account_id"`
+	Currency      string          `json:"currency"`
+	Mode          string          `json:"mode"`
+	TotalItems    int32           `json:"total_items"`
+	TotalAmount   decimal.Decimal `json:"total_amount"`
+}
+
+func (q *Queries) CreateTransferBatch(ctx context.Context, arg CreateTransferBatchParams) (TransferBatch, error) {
+	row := q.db.QueryRow(ctx, createTransferBatch,
+		arg.Owner,
+		arg.FromAccountID,
+		arg.Currency,
+		arg.Mode,
+		arg.TotalItems,
+		arg.TotalAmount,
+	)
+	var i TransferBatch
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.FromAccountID,
+		&i.Currency,
+		&i.Mode,
+		&i.Status,
+		&i.TotalItems,
+		&i.TotalAmount,
+		&i.SucceededItems,
+		&i.FailedItems,
+		&i.Error,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.CompletedAt,
+	)
+	return i, err
+}
+
+const createTransferBatchItem = `-- name: CreateTransferBatchItem :exec
+INSERT INTO transfer_batch_items(
+    batch_id,
+    line,
+    to_account_id,
+    amount
+) VALUES (
+    $1,$2,$3,$4
+)
+`
+
+type CreateTransferBatchItemParams struct {
+	BatchID     uuid.UUID       `json:"batch_id"`
+	Line        int32           `json:"line"`
+	ToAccountID uuid.UUID       `json:"to_account_id"`
+	Amount      decimal.Decimal `json:"amount"`
+}
+
+func (q *Queries) CreateTransferBatchItem(ctx context.Context, arg CreateTransferBatchItemParams) error {
+	_, err := q.db.Exec(ctx, createTransferBatchItem,
+		arg.BatchID,
+		arg.Line,
+		arg.ToAccountID,
+		arg.Amount,
+	)
+	return err
+}
+
+const failPendingTransferBatchItems = `-- name: FailPendingTransferBatchItems :execrows
+UPDATE transfer_batch_items
+  set status = 'failed',
+      error = $2,
+      processed_at = now()
+WHERE batch_id = $1 AND status = 'pending'
+`
+
+type FailPendingTransferBatchItemsParams struct {
+	BatchID uuid.UUID `json:"batch_id"`
+	Error   string    `json:"error"`
+}
+
+func (q *Queries) FailPendingTransferBatchItems(ctx context.Context, arg FailPendingTransferBatchItemsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, failPendingTransferBatchItems, arg.BatchID, arg.Error)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const finishTransferBatch = `-- name: FinishTransferBatch :one
+UPDATE transfer_batches
+  set status = $2,
+      error = $3,
+      updated_at = now(),
+      completed_at = now()
+WHERE id = $1
+RETURNING id, owner, from_account_id, currency, mode, status, total_items, total_amount, succeeded_items, failed_items, error, created_at, updated_at, completed_at
+`
+
+type FinishTransferBatchParams struct {
+	ID     uuid.UUID `json:"id"`
+	Status string    `json:"status"`
+	Error  string    `json:"error"`
+}
+
+func (q *Queries) FinishTransferBatch(ctx context.Context, arg FinishTransferBatchParams) (TransferBatch, error) {
+	row := q.db.QueryRow(ctx, finishTransferBatch, arg.ID, arg.Status, arg.Error)
+	var i TransferBatch
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.FromAccountID,
+		&i.Currency,
+		&i.Mode,
+		&i.Status,
+		&i.TotalItems,
+		&i.TotalAmount,
+		&i.SucceededItems,
+		&i.FailedItems,
+		&i.Error,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.CompletedAt,
+	)
+	return i, err
+}
+
+const finishTransferBatchItem = `-- name: FinishTransferBatchItem :exec
+UPDATE transfer_batch_items
+  set status = $2,
+      transfer_id = $3,
+      error = $4,
+      processed_at = now()
+WHERE id = $1
+`
+
+type FinishTransferBatchItemParams struct {
+	ID         uuid.UUID     `json:"id"`
+	Status     string        `json:"status"`
+	TransferID uuid.NullUUID `json:"transfer_id"`
+	Error      string        `json:"error"`
+}
+
+func (q *Queries) FinishTransferBatchItem(ctx context.Context, arg FinishTransferBatchItemParams) error {
+	_, err := q.db.Exec(ctx, finishTransferBatchItem,
+		arg.ID,
+		arg.Status,
+		arg.TransferID,
+		arg.Error,
+	)
+	return err
+}
+
+const getTransferBatch = `-- name: GetTransferBatch :one
+SELECT id, owner, from_account_id, currency, mode, status, total_items, total_amount, succeeded_items, failed_items, error, created_at, updated_at, completed_at FROM transfer_batches
+WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetTransferBatch(ctx context.Context, id uuid.UUID) (TransferBatch, error) {
+	row := q.db.QueryRow(ctx, getTransferBatch, id)
+	var i TransferBatch
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.FromAccountID,
+		&i.Currency,
+		&i.Mode,
+		&i.Status,
+		&i.TotalItems,
+		&i.TotalAmount,
+		&i.SucceededItems,
+		&i.FailedItems,
+		&i.Error,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.CompletedAt,
+	)
+	return i, err
+}
+
+const getTransferBatchForUpdate = `-- name: GetTransferBatchForUpdate :one
+SELECT id, owner, from_account_id, currency, mode, status, total_items, total_amount, succeeded_items, failed_items, error, created_at, updated_at, completed_at FROM transfer_batches
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetTransferBatchForUpdate(ctx context.Context, id uuid.UUID) (TransferBatch, error) {
+	row := q.db.QueryRow(ctx, getTransferBatchForUpdate, id)
+	var i TransferBatch
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.FromAccountID,
+		&i.Currency,
+		&i.Mode,
+		&i.Status,
+		&i.TotalItems,
+		&i.TotalAmount,
+		&i.SucceededItems,
+		&i.FailedItems,
+		&i.Error,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.CompletedAt,
+	)
+	return i, err
+}
+
+const listPendingTransferBatchItems = `-- name: ListPendingTransferBatchItems :many
+SELECT id, batch_id, line, to_account_id, amount, status, transfer_id, error, processed_at FROM transfer_batch_items
+WHERE batch_id = $1 AND status = 'pending'
+ORDER BY line
+LIMIT $2
+`
+
+type ListPendingTransferBatchItemsParams struct {
+	BatchID uuid.UUID `json:"batch_id"`
+	Limit   int32     `json:"limit"`
+}
+
+func (q *Queries) ListPendingTransferBatchItems(ctx context.Context, arg ListPendingTransferBatchItemsParams) ([]TransferBatchItem, error) {
+	rows, err := q.db.Query(ctx, listPendingTransferBatchItems, arg.BatchID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []TransferBatchItem{}
+	for rows.Next() {
+		var i TransferBatchItem
+		if err := rows.Scan(
+			&i.ID,
+			&i.BatchID,
+			&i.Line,
+			&i.ToAccountID,
+			&i.Amount,
+			&i.Status,
+			&i.TransferID,
+			&i.Error,
+			&i.ProcessedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTransferBatchItems = `-- name: ListTransferBatchItems :many
+SELECT id, batch_id, line, to_account_id, amount, status, transfer_id, error, processed_at FROM transfer_batch_items
+WHERE batch_id = $1
+ORDER BY line
+LIMIT $2
+OFFSET $3
+`
+
+type ListTransferBatchItemsParams struct {
+	BatchID uuid.UUID `json:"batch_id"`
+	Limit   int32     `json:"limit"`
+	Offset  int32     `json:"offset"`
+}
+
+func (q *Queries) ListTransferBatchItems(ctx context.Context, arg ListTransferBatchItemsParams) ([]TransferBatchItem, error) {
+	rows, err := q.db.Query(ctx, listTransferBatchItems, arg.BatchID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []TransferBatchItem{}
+	for rows.Next() {
+		var i TransferBatchItem
+		if err := rows.Scan(
+			&i.ID,
+			&i.BatchID,
+			&i.Line,
+			&i.ToAccountID,
+			&i.Amount,
+			&i.Status,
+			&i.TransferID,
+			&i.Error,
+			&i.ProcessedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTransferBatches = `-- name: ListTransferBatches :many
+SELECT id, owner, from_account_id, currency, mode, status, total_items, total_amount, succeeded_items, failed_items, error, created_at, updated_at, completed_at FROM transfer_batches
+WHERE "owner" = $1
+ORDER BY created_at DESC
+LIMIT $2
+OFFSET $3
+`
+
+type ListTransferBatchesParams struct {
+	Owner  string `json:"owner"`
+	Limit  int32  `json:"limit"`
+	Offset int32  `json:"offset"`
+}
+
+func (q *Queries) ListTransferBatches(ctx context.Context, arg ListTransferBatchesParams) ([]TransferBatch, error) {
+	rows, err := q.db.Query(ctx, listTransferBatches, arg.Owner, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []TransferBatch{}
+	for rows.Next() {
+		var i TransferBatch
+		if err := rows.Scan(
+			&i.ID,
+			&i.Owner,
+			&i.FromAccountID,
+			&i.Currency,
+			&i.Mode,
+			&i.Status,
+			&i.TotalItems,
+			&i.TotalAmount,
+			&i.SucceededItems,
+			&i.FailedItems,
+			&i.Error,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.CompletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
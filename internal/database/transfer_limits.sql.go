@@ -0,0 +1,265 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: transfer_limits.sql
+
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+)
+
+const deleteTransferLimit = `-- name: DeleteTransferLimit :execrows
+DELETE FROM transfer_limits
+WHERE id = $1
+`
+
+func (q *Queries) DeleteTransferLimit(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteTransferLimit, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getAccountOutgoingUsage = `-- name: GetAccountOutgoingUsage :one
+SELECT
+    COALESCE(SUM(amount) FILTER (WHERE created_at >= $1), 0)::numeric AS day_total,
+    COALESCE(SUM(amount), 0)::numeric AS month_total,
+    COUNT(*) FILTER (WHERE created_at >= $2) AS hour_count
+FROM transfers
+WHERE from_account_id = $3 AND created_at >= $4
+  AND id <> $5 AND status <> 'failed'
+`
+
+type GetAccountOutgoingUsageParams struct {
+	DayStart          time.Time `json:"day_start"`
+	HourStart         time.Time `json:"hour_start"`
+	AccountID         uuid.UUID `json:"account_id"`
+	MonthStart        time.Time `json:"month_start"`
+	ExcludeTransferID uuid.UUID `json:"exclude_transfer_id"`
+}
+
+type GetAccountOutgoingUsageRow struct {
+	DayTotal   decimal.Decimal `json:"day_total"`
+	MonthTotal decimal.Decimal `json:"month_total"`
+	HourCount  int64           `json:"hour_count"`
+}
+
+func (q *Queries) GetAccountOutgoingUsage(ctx context.Context, arg GetAccountOutgoingUsageParams) (GetAccountOutgoingUsageRow, error) {
+	row := q.db.QueryRow(ctx, getAccountOutgoingUsage,
+		arg.DayStart,
+		arg.HourStart,
+		arg.AccountID,
+		arg.MonthStart,
+		arg.ExcludeTransferID,
+	)
+	var i GetAccountOutgoingUsageRow
+	err := row.Scan(&i.DayTotal, &i.MonthTotal, &i.HourCount)
+	return i, err
+}
+
+const getAccountTransferLimit = `-- name: GetAccountTransferLimit :one
+SELECT id, account_id, owner, currency, max_single_amount, daily_amount, monthly_amount, hourly_count, created_at, updated_at FROM transfer_limits
+WHERE account_id = $1 LIMIT 1
+`
+
+func (q *Queries) GetAccountTransferLimit(ctx context.Context, accountID uuid.NullUUID) (TransferLimit, error) {
+	row := q.db.QueryRow(ctx, getAccountTransferLimit, accountID)
+	var i TransferLimit
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Owner,
+		&i.Currency,
+		&i.MaxSingleAmount,
+		&i.DailyAmount,
+		&i.MonthlyAmount,
+		&i.HourlyCount,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getUserOutgoingUsage = `-- name: GetUserOutgoingUsage :one
+SELECT
+    COALESCE(SUM(t.amount) FILTER (WHERE t.created_at >= $1), 0)::numeric AS day_total,
+    COALESCE(SUM(t.amount), 0)::numeric AS month_total,
+    COUNT(*) FILTER (WHERE t.created_at >= $2) AS hour_count
+FROM transfers t
+JOIN accounts a ON a.id = t.from_account_id
+WHERE a."owner" = $3 AND a.currency = $4
+  AND t.created_at >= $5
+  AND t.id <> $6 AND t.status <> 'failed'
+`
+
+type GetUserOutgoingUsageParams struct {
+	DayStart          time.Time `json:"day_start"`
+	HourStart         time.Time `json:"hour_start"`
+	Owner             string    `json:"owner"`
+	Currency          string    `json:"currency"`
+	MonthStart        time.Time `json:"month_start"`
+	ExcludeTransferID uuid.UUID `json:"exclude_transfer_id"`
+}
+
+type GetUserOutgoingUsageRow struct {
+	DayTotal   decimal.Decimal `json:"day_total"`
+	MonthTotal decimal.Decimal `json:"month_total"`
+	HourCount  int64           `json:"hour_count"`
+}
+
+func (q *Queries) GetUserOutgoingUsage(ctx context.Context, arg GetUserOutgoingUsageParams) (GetUserOutgoingUsageRow, error) {
+	row := q.db.QueryRow(ctx, getUserOutgoingUsage,
+		arg.DayStart,
+		arg.HourStart,
+		arg.Owner,
+		arg.Currency,
+		arg.MonthStart,
+		arg.ExcludeTransferID,
+	)
+	var i GetUserOutgoingUsageRow
+	err := row.Scan(&i.DayTotal, &i.MonthTotal, &i.HourCount)
+	return i, err
+}
+
+const getUserTransferLimit = `-- name: GetUserTransferLimit :one
+SELECT id, account_id, owner, currency, max_single_amount, daily_amount, monthly_amount, hourly_count, created_at, updated_at FROM transfer_limits
+WHERE "owner" = $1 AND currency = $2 LIMIT 1
+`
+
+type GetUserTransferLimitParams struct {
+	Owner    pgtype.Text `json:"owner"`
+	Currency string      `json:"currency"`
+}
+
+func (q *Queries) GetUserTransferLimit(ctx context.Context, arg GetUserTransferLimitParams) (TransferLimit, error) {
+	row := q.db.QueryRow(ctx, getUserTransferLimit, arg.Owner, arg.Currency)
+	var i TransferLimit
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Owner,
+		&i.Currency,
+		&i.MaxSingleAmount,
+		&i.DailyAmount,
+		&i.MonthlyAmount,
+		&i.HourlyCount,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const upsertAccountTransferLimit = `-- name: UpsertAccountTransferLimit :one
+INSERT INTO transfer_limits(
+    account_id,
+    currency,
+    max_single_amount,
+    daily_amount,
+    monthly_amount,
+    hourly_count
+) VALUES (
+    $1,$2,$3,$4,$5,$6
+)
+ON CONFLICT (account_id) WHERE account_id IS NOT NULL DO UPDATE
+  set max_single_amount = EXCLUDED.max_single_amount,
+      daily_amount = EXCLUDED.daily_amount,
+      monthly_amount = EXCLUDED.monthly_amount,
+      hourly_count = EXCLUDED.hourly_count,
+      updated_at = now()
+RETURNING id, account_id, owner, currency, max_single_amount, daily_amount, monthly_amount, hourly_count, created_at, updated_at
+`
+
+type UpsertAccountTransferLimitParams struct {
+	AccountID       uuid.NullUUID       `json:"account_id"`
+	Currency        string              `json:"currency"`
+	MaxSingleAmount decimal.NullDecimal `json:"max_single_amount"`
+	DailyAmount     decimal.NullDecimal `json:"daily_amount"`
+	MonthlyAmount   decimal.NullDecimal `json:"monthly_amount"`
+	HourlyCount     pgtype.Int4         `json:"hourly_count"`
+}
+
+func (q *Queries) UpsertAccountTransferLimit(ctx context.Context, arg UpsertAccountTransferLimitParams) (TransferLimit, error) {
+	row := q.db.QueryRow(ctx, upsertAccountTransferLimit,
+		arg.AccountID,
+		arg.Currency,
+		arg.MaxSingleAmount,
+		arg.DailyAmount,
+		arg.MonthlyAmount,
+		arg.HourlyCount,
+	)
+	var i TransferLimit
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Owner,
+		&i.Currency,
+		&i.MaxSingleAmount,
+		&i.DailyAmount,
+		&i.MonthlyAmount,
+		&i.HourlyCount,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const upsertUserTransferLimit = `-- name: UpsertUserTransferLimit :one
+INSERT INTO transfer_limits(
+    "owner",
+    currency,
+    max_single_amount,
+    daily_amount,
+    monthly_amount,
+    hourly_count
+) VALUES (
+    $1,$2,$3,$4,$5,$6
+)
+ON CONFLICT ("owner", currency) WHERE "owner" IS NOT NULL DO UPDATE
+  set max_single_amount = EXCLUDED.max_single_amount,
+      daily_amount = EXCLUDED.daily_amount,
+      monthly_amount = EXCLUDED.monthly_amount,
+      hourly_count = EXCLUDED.hourly_count,
+      updated_at = now()
+RETURNING id, account_id, owner, currency, max_single_amount, daily_amount, monthly_amount, hourly_count, created_at, updated_at
+`
+
+type UpsertUserTransferLimitParams struct {
+	Owner           pgtype.Text         `json:"owner"`
+	Currency        string              `json:"currency"`
+	MaxSingleAmount decimal.NullDecimal `json:"max_single_amount"`
+	DailyAmount     decimal.NullDecimal `json:"daily_amount"`
+	MonthlyAmount   decimal.NullDecimal `json:"monthly_amount"`
+	HourlyCount     pgtype.Int4         `json:"hourly_count"`
+}
+
+func (q *Queries) UpsertUserTransferLimit(ctx context.Context, arg UpsertUserTransferLimitParams) (TransferLimit, error) {
+	row := q.db.QueryRow(ctx, upsertUserTransferLimit,
+		arg.Owner,
+		arg.Currency,
+		arg.MaxSingleAmount,
+		arg.DailyAmount,
+		arg.MonthlyAmount,
+		arg.HourlyCount,
+	)
+	var i TransferLimit
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Owner,
+		&i.Currency,
+		&i.MaxSingleAmount,
+		&i.DailyAmount,
+		&i.MonthlyAmount,
+		&i.HourlyCount,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,126 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessTransferTx(t *testing.T) {
+	store := NewStore(testDB)
+	ctx := context.Background()
+	sender, recipient := CreateRandomUser(t), CreateRandomUser(t)
+	fromAccount := createAccountInCurrency(t, sender, "USD", decimal.NewFromInt(100))
+	toAccount := createAccountInCurrency(t, recipient, "USD", decimal.Zero)
+
+	submit := func(amount int64) Transfer {
+		transfer, err := store.SubmitTransferTx(ctx, TransferTxParams{
+			FromAccountID: fromAccount.ID,
+			ToAccountID:   toAccount.ID,
+			Amount:        decimal.NewFromInt(amount),
+		})
+		require.NoError(t, err)
+		require.Equal(t, TransferStatusPending, transfer.Status)
+		require.False(t, transfer.CompletedAt.Valid)
+		return transfer
+	}
+
+	// queued transfers don't move money, so one over the balance is accepted
+	completing := submit(60)
+	failing := submit(500)
+
+	account, err := testQueries.GetAccount(ctx, fromAccount.ID)
+	require.NoError(t, err)
+	require.True(t, fromAccount.Balance.Equal(account.Balance))
+
+	completed, err := store.ProcessTransferTx(ctx, completing.ID)
+	require.NoError(t, err)
+	require.Equal(t, TransferStatusCompleted, completed.Status)
+	require.True(t, completed.CompletedAt.Valid)
+
+	failed, err := store.ProcessTransferTx(ctx, failing.ID)
+	require.NoError(t, err)
+	require.Equal(t, TransferStatusFailed, failed.Status)
+	require.True(t, failed.FailedAt.Valid)
+	require.Contains(t, failed.FailureReason, ErrInsufficientFunds.Error())
+
+	account, err = testQueries.GetAccount(ctx, toAccount.ID)
+	require.NoError(t, err)
+	require.True(t, decimal.NewFromInt(60).Equal(account.Balance))
+
+	_, err = store.ProcessTransferTx(ctx, completed.ID)
+	require.ErrorIs(t, err, ErrTransferNotQueued)
+
+	notifications, err := testQueries.ListNotifications(ctx, ListNotificationsParams{
+		Username: sender.Username,
+		Limit:    10,
+	})
+	require.NoError(t, err)
+	require.Len(t, notifications, 2)
+}
+
+func TestClaimTransfer(t *testing.T) {
+	store := NewStore(testDB)
+	ctx := context.Background()
+	sender, recipient := CreateRandomUser(t), CreateRandomUser(t)
+	fromAccount := createAccountInCurrency(t, sender, "USD", decimal.NewFromInt(100))
+	toAccount := createAccountInCurrency(t, recipient, "USD", decimal.Zero)
+
+	submitted, err := store.SubmitTransferTx(ctx, TransferTxParams{
+		FromAccountID: fromAccount.ID,
+		ToAccountID:   toAccount.ID,
+		Amount:        decimal.NewFromInt(5),
+	})
+	require.NoError(t, err)
+
+	// drain the queue, other tests may have left transfers in it
+	claimedOurs := false
+	for {
+		claimed, err := testQueries.ClaimTransfer(ctx, time.Now().Add(-time.Hour))
+		if err != nil {
+			break
+		}
+		require.Equal(t, TransferStatusProcessing, claimed.Status)
+		if claimed.ID == submitted.ID {
+			claimedOurs = true
+		}
+	}
+	require.True(t, claimedOurs)
+
+	// a processing transfer is only taken over once it is stale
+	claimed, err := testQueries.ClaimTransfer(ctx, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, TransferStatusProcessing, claimed.Status)
+}
+
+func TestReverseTransferTx(t *testing.T) {
+	store := NewStore(testDB)
+	ctx := context.Background()
+	sender, recipient := CreateRandomUser(t), CreateRandomUser(t)
+	fromAccount := createAccountInCurrency(t, sender, "USD", decimal.NewFromInt(100))
+	toAccount := createAccountInCurrency(t, recipient, "USD", decimal.Zero)
+
+	result, err := store.TransferTx(ctx, TransferTxParams{
+		FromAccountID: fromAccount.ID,
+		ToAccountID:   toAccount.ID,
+		Amount:        decimal.NewFromInt(30),
+	})
+	require.NoError(t, err)
+	require.Equal(t, TransferStatusCompleted, result.Transfer.Status)
+
+	reversed, err := store.ReverseTransferTx(ctx, result.Transfer.ID)
+	require.NoError(t, err)
+	require.Equal(t, TransferStatusReversed, reversed.Transfer.Status)
+	require.Equal(t, reversed.Reversal.ID, reversed.Transfer.ReversalTransferID.UUID)
+	require.Equal(t, toAccount.ID, reversed.Reversal.FromAccountID)
+
+	account, err := testQueries.GetAccount(ctx, toAccount.ID)
+	require.NoError(t, err)
+	require.True(t, account.Balance.IsZero())
+
+	_, err = store.ReverseTransferTx(ctx, result.Transfer.ID)
+	require.ErrorIs(t, err, ErrTransferNotReversible)
+}
@@ -0,0 +1,206 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+const (
+	TransferStatusPending    = "pending"
+	TransferStatusProcessing = "processing"
+	TransferStatusCompleted  = "completed"
+	TransferStatusFailed     = "failed"
+	TransferStatusReversed   = "reversed"
+)
+
+var (
+	ErrTransferNotQueued     = errors.New("transfer isn't waiting to be processed")
+	ErrTransferNotReversible = errors.New("transfer can't be reversed")
+)
+
+// SubmitTransferTx queues a transfer, it is stored pending and a worker
+// moves its money later through ProcessTransferTx
+func (store *SQLStore) SubmitTransferTx(ctx context.Context, arg TransferTxParams) (Transfer, error) {
+	var transfer Transfer
+
+	err := store.execTx(ctx, nil, func(q *Queries) error {
+		var err error
+		transfer, err = q.CreatePendingTransfer(ctx, CreatePendingTransferParams{
+			FromAccountID: arg.FromAccountID,
+			ToAccountID:   arg.ToAccountID,
+			Amount:        arg.Amount,
+		})
+		if err != nil {
+			return err
+		}
+		return saveTransferDetails(ctx, q, &transfer, arg.TransferDetails)
+	})
+	if err != nil {
+		return Transfer{}, err
+	}
+	return transfer, nil
+}
+
+// ProcessTransferTx moves the money of a queued transfer and completes it.
+// A transfer the sender can't make, for lack of funds or over a limit, is
+// failed with the reason, any other error leaves it to be processed again.
+// The sender is notified either way.
+func (store *SQLStore) ProcessTransferTx(ctx context.Context, transferID uuid.UUID) (Transfer, error) {
+	var result TransferTxResult
+
+	err := store.execTx(ctx, nil, func(q *Queries) error {
+		transfer, err := q.GetTransferForUpdate(ctx, transferID)
+		if err != nil {
+			return err
+		}
+		if transfer.Status != TransferStatusPending && transfer.Status != TransferStatusProcessing {
+			return fmt.Errorf("%w: transfer %v is %s", ErrTransferNotQueued, transfer.ID, transfer.Status)
+		}
+
+		result.Transfer = transfer
+		err = moveTransferFunds(ctx, q, TransferTxParams{
+			FromAccountID: transfer.FromAccountID,
+			ToAccountID:   transfer.ToAccountID,
+			Amount:        transfer.Amount,
+		}, &result)
+		if err != nil {
+			return err
+		}
+
+		result.Transfer, err = q.CompleteTransfer(ctx, transfer.ID)
+		if err != nil {
+			return err
+		}
+		return notify(ctx, q, result.FromAccount.Owner, NotificationTransferCompleted, result.Transfer)
+	})
+	if transferRejected(err) {
+		return store.failTransfer(ctx, transferID, err.Error())
+	}
+	if err != nil {
+		return Transfer{}, err
+	}
+	return result.Transfer, nil
+}
+
+// transferRejected tells whether err means the transfer can never be made
+// as it is, as opposed to a failure worth trying again
+func transferRejected(err error) bool {
+	return errors.Is(err, ErrInsufficientFunds) ||
+		errors.Is(err, ErrLimitExceeded) ||
+		errors.Is(err, ErrInvalidAmountScale)
+}
+
+// failTransfer fails a queued transfer and tells the sender why
+func (store *SQLStore) failTransfer(ctx context.Context, transferID uuid.UUID, reason string) (Transfer, error) {
+	var transfer Transfer
+
+	err := store.execTx(ctx, nil, func(q *Queries) error {
+		var err error
+		transfer, err = q.GetTransferForUpdate(ctx, transferID)
+		if err != nil {
+			return err
+		}
+		if transfer.Status != TransferStatusPending && transfer.Status != TransferStatusProcessing {
+			return fmt.Errorf("%w: transfer %v is %s", ErrTransferNotQueued, transfer.ID, transfer.Status)
+		}
+
+		transfer, err = q.FailTransfer(ctx, FailTransferParams{
+			ID:            transfer.ID,
+			FailureReason: reason,
+		})
+		if err != nil {
+			return err
+		}
+
+		fromAccount, err := q.GetAccount(ctx, transfer.FromAccountID)
+		if err != nil {
+			return err
+		}
+		return notify(ctx, q, fromAccount.Owner, NotificationTransferFailed, transfer)
+	})
+	if err != nil {
+		return Transfer{}, err
+	}
+	return transfer, nil
+}
+
+// ReverseTransferTxResult is a reversed transfer and the transfer that
+// moved its money back
+type ReverseTransferTxResult struct {
+	Transfer Transfer `json:"transfer"`
+	Reversal Transfer `json:"reversal"`
+}
+
+// ReverseTransferTx moves the amount of a completed transfer back from the
+// recipient to the sender and marks it reversed. The fee the sender paid is
+// kept and the recipient has to be able to spend the amount. Cross-currency
+// transfers can't be reversed, their rate has moved on.
+func (store *SQLStore) ReverseTransferTx(ctx context.Context, transferID uuid.UUID) (ReverseTransferTxResult, error) {
+	var result ReverseTransferTxResult
+
+	err := store.execTx(ctx, nil, func(q *Queries) error {
+		transfer, err := q.GetTransferForUpdate(ctx, transferID)
+		if err != nil {
+			return err
+		}
+		if transfer.Status != TransferStatusCompleted {
+			return fmt.Errorf("%w: transfer %v is %s", ErrTransferNotReversible, transfer.ID, transfer.Status)
+		}
+		if transfer.FxQuoteID.Valid {
+			return fmt.Errorf("%w: transfer %v is cross-currency", ErrTransferNotReversible, transfer.ID)
+		}
+
+		accounts, err := lockAccounts(ctx, q, transfer.FromAccountID, transfer.ToAccountID)
+		if err != nil {
+			return err
+		}
+		fromAccount, toAccount := accounts[transfer.FromAccountID], accounts[transfer.ToAccountID]
+		if toAccount.SpendableBalance().LessThan(transfer.Amount) {
+			return fmt.Errorf("%w: account %v can spend %v, reversal amount %v",
+				ErrInsufficientFunds, toAccount.ID, toAccount.SpendableBalance(), transfer.Amount)
+		}
+
+		result.Reversal, err = q.CreateTransfer(ctx, CreateTransferParams{
+			FromAccountID: toAccount.ID,
+			ToAccountID:   fromAccount.ID,
+			Amount:        transfer.Amount,
+		})
+		if err != nil {
+			return err
+		}
+		err = saveTransferDetails(ctx, q, &result.Reversal, TransferDetails{
+			Description: fmt.Sprintf("reversal of transfer %v", transfer.ID),
+		})
+		if err != nil {
+			return err
+		}
+		if _, err = postEntry(ctx, q, toAccount, transfer.Amount.Neg()); err != nil {
+			return err
+		}
+		if _, err = postEntry(ctx, q, fromAccount, transfer.Amount); err != nil {
+			return err
+		}
+
+		result.Transfer, err = q.ReverseTransfer(ctx, ReverseTransferParams{
+			ID:                 transfer.ID,
+			ReversalTransferID: uuid.NullUUID{UUID: result.Reversal.ID, Valid: true},
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, username := range []string{fromAccount.Owner, toAccount.Owner} {
+			if err = notify(ctx, q, username, NotificationTransferReversed, result); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return ReverseTransferTxResult{}, err
+	}
+	return result, nil
+}
@@ -7,11 +7,197 @@ package database
 
 import (
 	"context"
+	"encoding/json"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/shopspring/decimal"
 )
 
+const claimTransfer = `-- name: ClaimTransfer :one
+UPDATE transfers
+  set status = 'processing',
+      processing_at = now(),
+      updated_at = now()
+WHERE id = (
+    SELECT t.id FROM transfers t
+    WHERE t.status = 'pending'
+       OR (t.status = 'processing' AND t.processing_at < $1::timestamptz)
+    ORDER BY t.created_at
+    LIMIT 1
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING id, from_account_id, to_account_id, amount, created_at, updated_at, fx_quote_id, converted_amount, fee, fee_rule_id, description, external_reference, metadata, status, failure_reason, processing_at, completed_at, failed_at, reversed_at, reversal_transfer_id
+`
+
+// picks the oldest pending transfer, or one whose worker stopped processing it
+func (q *Queries) ClaimTransfer(ctx context.Context, staleBefore time.Time) (Transfer, error) {
+	row := q.db.QueryRow(ctx, claimTransfer, staleBefore)
+	var i Transfer
+	err := row.Scan(
+		&i.ID,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.FxQuoteID,
+		&i.ConvertedAmount,
+		&i.Fee,
+		&i.FeeRuleID,
+		&i.Description,
+		&i.ExternalReference,
+		&i.Metadata,
+		&i.Status,
+		&i.FailureReason,
+		&i.ProcessingAt,
+		&i.CompletedAt,
+		&i.FailedAt,
+		&i.ReversedAt,
+		&i.ReversalTransferID,
+	)
+	return i, err
+}
+
+const completeTransfer = `-- name: CompleteTransfer :one
+UPDATE transfers
+  set status = 'completed',
+      completed_at = now(),
+      updated_at = now()
+WHERE id = $1
+RETURNING id, from_account_id, to_account_id, amount, created_at, updated_at, fx_quote_id, converted_amount, fee, fee_rule_id, description, external_reference, metadata, status, failure_reason, processing_at, completed_at, failed_at, reversed_at, reversal_transfer_id
+`
+
+func (q *Queries) CompleteTransfer(ctx context.Context, id uuid.UUID) (Transfer, error) {
+	row := q.db.QueryRow(ctx, completeTransfer, id)
+	var i Transfer
+	err := row.Scan(
+		&i.ID,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.FxQuoteID,
+		&i.ConvertedAmount,
+		&i.Fee,
+		&i.FeeRuleID,
+		&i.Description,
+		&i.ExternalReference,
+		&i.Metadata,
+		&i.Status,
+		&i.FailureReason,
+		&i.ProcessingAt,
+		&i.CompletedAt,
+		&i.FailedAt,
+		&i.ReversedAt,
+		&i.ReversalTransferID,
+	)
+	return i, err
+}
+
+const createFxTransfer = `-- name: CreateFxTransfer :one
+INSERT INTO transfers(
+    from_account_id,
+    to_account_id,
+    amount,
+    fx_quote_id,
+    converted_amount
+) VALUES (
+    $1,$2,$3,$4,$5
+) RETURNING id, from_account_id, to_account_id, amount, created_at, updated_at, fx_quote_id, converted_amount, fee, fee_rule_id, description, external_reference, metadata, status, failure_reason, processing_at, completed_at, failed_at, reversed_at, reversal_transfer_id
+`
+
+type CreateFxTransferParams struct {
+	FromAccountID   uuid.UUID           `json:"from_account_id"`
+	ToAccountID     uuid.UUID           `json:"to_account_id"`
+	Amount          decimal.Decimal     `json:"amount"`
+	FxQuoteID       uuid.NullUUID       `json:"fx_quote_id"`
+	ConvertedAmount decimal.NullDecimal `json:"converted_amount"`
+}
+
+func (q *Queries) CreateFxTransfer(ctx context.Context, arg CreateFxTransferParams) (Transfer, error) {
+	row := q.db.QueryRow(ctx, createFxTransfer,
+		arg.FromAccountID,
+		arg.ToAccountID,
+		arg.Amount,
+		arg.FxQuoteID,
+		arg.ConvertedAmount,
+	)
+	var i Transfer
+	err := row.Scan(
+		&i.ID,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.FxQuoteID,
+		&i.ConvertedAmount,
+		&i.Fee,
+		&i.FeeRuleID,
+		&i.Description,
+		&i.ExternalReference,
+		&i.Metadata,
+		&i.Status,
+		&i.FailureReason,
+		&i.ProcessingAt,
+		&i.CompletedAt,
+		&i.FailedAt,
+		&i.ReversedAt,
+		&i.ReversalTransferID,
+	)
+	return i, err
+}
+
+const createPendingTransfer = `-- name: CreatePendingTransfer :one
+INSERT INTO transfers(
+    from_account_id,
+    to_account_id,
+    amount,
+    status,
+    completed_at
+) VALUES (
+    $1,$2,$3,'pending',NULL
+) RETURNING id, from_account_id, to_account_id, amount, created_at, updated_at, fx_quote_id, converted_amount, fee, fee_rule_id, description, external_reference, metadata, status, failure_reason, processing_at, completed_at, failed_at, reversed_at, reversal_transfer_id
+`
+
+type CreatePendingTransferParams struct {
+	FromAccountID uuid.UUID       `json:"from_account_id"`
+	ToAccountID   uuid.UUID       `json:"to_account_id"`
+	Amount        decimal.Decimal `json:"amount"`
+}
+
+// queues a transfer for a worker, its money moves when it is processed
+func (q *Queries) CreatePendingTransfer(ctx context.Context, arg CreatePendingTransferParams) (Transfer, error) {
+	row := q.db.QueryRow(ctx, createPendingTransfer, arg.FromAccountID, arg.ToAccountID, arg.Amount)
+	var i Transfer
+	err := row.Scan(
+		&i.ID,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.FxQuoteID,
+		&i.ConvertedAmount,
+		&i.Fee,
+		&i.FeeRuleID,
+		&i.Description,
+		&i.ExternalReference,
+		&i.Metadata,
+		&i.Status,
+		&i.FailureReason,
+		&i.ProcessingAt,
+		&i.CompletedAt,
+		&i.FailedAt,
+		&i.ReversedAt,
+		&i.ReversalTransferID,
+	)
+	return i, err
+}
+
 const createTransfer = `-- name: CreateTransfer :one
 INSERT INTO transfers(
     from_account_id,
@@ -19,7 +205,7 @@ INSERT INTO transfers(
     amount
 ) VALUES (
     $1,$2,$3
-) RETURNING id, from_account_id, to_account_id, amount, created_at, updated_at
+) RETURNING id, from_account_id, to_account_id, amount, created_at, updated_at, fx_quote_id, converted_amount, fee, fee_rule_id, description, external_reference, metadata, status, failure_reason, processing_at, completed_at, failed_at, reversed_at, reversal_transfer_id
 `
 
 type CreateTransferParams struct {
@@ -29,7 +215,7 @@ type CreateTransferParams struct {
 }
 
 func (q *Queries) CreateTransfer(ctx context.Context, arg CreateTransferParams) (Transfer, error) {
-	row := q.db.QueryRowContext(ctx, createTransfer, arg.FromAccountID, arg.ToAccountID, arg.Amount)
+	row := q.db.QueryRow(ctx, createTransfer, arg.FromAccountID, arg.ToAccountID, arg.Amount)
 	var i Transfer
 	err := row.Scan(
 		&i.ID,
@@ -38,6 +224,20 @@ func (q *Queries) CreateTransfer(ctx context.Context, arg CreateTransferParams)
 		&i.Amount,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.FxQuoteID,
+		&i.ConvertedAmount,
+		&i.Fee,
+		&i.FeeRuleID,
+		&i.Description,
+		&i.ExternalReference,
+		&i.Metadata,
+		&i.Status,
+		&i.FailureReason,
+		&i.ProcessingAt,
+		&i.CompletedAt,
+		&i.FailedAt,
+		&i.ReversedAt,
+		&i.ReversalTransferID,
 	)
 	return i, err
 }
@@ -48,17 +248,133 @@ WHERE id = $1
 `
 
 func (q *Queries) DeleteTransfer(ctx context.Context, id uuid.UUID) error {
-	_, err := q.db.ExecContext(ctx, deleteTransfer, id)
+	_, err := q.db.Exec(ctx, deleteTransfer, id)
 	return err
 }
 
+const failTransfer = `-- name: FailTransfer :one
+UPDATE transfers
+  set status = 'failed',
+      failure_reason = $2,
+      failed_at = now(),
+      updated_at = now()
+WHERE id = $1
+RETURNING id, from_account_id, to_account_id, amount, created_at, updated_at, fx_quote_id, converted_amount, fee, fee_rule_id, description, external_reference, metadata, status, failure_reason, processing_at, completed_at, failed_at, reversed_at, reversal_transfer_id
+`
+
+type FailTransferParams struct {
+	ID            uuid.UUID `json:"id"`
+	FailureReason string    `json:"failure_reason"`
+}
+
+func (q *Queries) FailTransfer(ctx context.Context, arg FailTransferParams) (Transfer, error) {
+	row := q.db.QueryRow(ctx, failTransfer, arg.ID, arg.FailureReason)
+	var i Transfer
+	err := row.Scan(
+		&i.ID,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.FxQuoteID,
+		&i.ConvertedAmount,
+		&i.Fee,
+		&i.FeeRuleID,
+		&i.Description,
+		&i.ExternalReference,
+		&i.Metadata,
+		&i.Status,
+		&i.FailureReason,
+		&i.ProcessingAt,
+		&i.CompletedAt,
+		&i.FailedAt,
+		&i.ReversedAt,
+		&i.ReversalTransferID,
+	)
+	return i, err
+}
+
 const getTransfer = `-- name: GetTransfer :one
-SELECT id, from_account_id, to_account_id, amount, created_at, updated_at FROM transfers
+SELECT id, from_account_id, to_account_id, amount, created_at, updated_at, fx_quote_id, converted_amount, fee, fee_rule_id, description, external_reference, metadata, status, failure_reason, processing_at, completed_at, failed_at, reversed_at, reversal_transfer_id FROM transfers
 WHERE id = $1 LIMIT 1
 `
 
 func (q *Queries) GetTransfer(ctx context.Context, id uuid.UUID) (Transfer, error) {
-	row := q.db.QueryRowContext(ctx, getTransfer, id)
+	row := q.db.QueryRow(ctx, getTransfer, id)
+	var i Transfer
+	err := row.Scan(
+		&i.ID,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.FxQuoteID,
+		&i.ConvertedAmount,
+		&i.Fee,
+		&i.FeeRuleID,
+		&i.Description,
+		&i.ExternalReference,
+		&i.Metadata,
+		&i.Status,
+		&i.FailureReason,
+		&i.ProcessingAt,
+		&i.CompletedAt,
+		&i.FailedAt,
+		&i.ReversedAt,
+		&i.ReversalTransferID,
+	)
+	return i, err
+}
+
+const getTransferByExternalReference = `-- name: GetTransferByExternalReference :one
+SELECT id, from_account_id, to_account_id, amount, created_at, updated_at, fx_quote_id, converted_amount, fee, fee_rule_id, description, external_reference, metadata, status, failure_reason, processing_at, completed_at, failed_at, reversed_at, reversal_transfer_id FROM transfers
+WHERE from_account_id = $1 AND external_reference = $2
+LIMIT 1
+`
+
+type GetTransferByExternalReferenceParams struct {
+	FromAccountID     uuid.UUID   `json:"from_account_id"`
+	ExternalReference pgtype.Text `json:"external_reference"`
+}
+
+func (q *Queries) GetTransferByExternalReference(ctx context.Context, arg GetTransferByExternalReferenceParams) (Transfer, error) {
+	row := q.db.QueryRow(ctx, getTransferByExternalReference, arg.FromAccountID, arg.ExternalReference)
+	var i Transfer
+	err := row.Scan(
+		&i.ID,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.FxQuoteID,
+		&i.ConvertedAmount,
+		&i.Fee,
+		&i.FeeRuleID,
+		&i.Description,
+		&i.ExternalReference,
+		&i.Metadata,
+		&i.Status,
+		&i.FailureReason,
+		&i.ProcessingAt,
+		&i.CompletedAt,
+		&i.FailedAt,
+		&i.ReversedAt,
+		&i.ReversalTransferID,
+	)
+	return i, err
+}
+
+const getTransferForUpdate = `-- name: GetTransferForUpdate :one
+SELECT id, from_account_id, to_account_id, amount, created_at, updated_at, fx_quote_id, converted_amount, fee, fee_rule_id, description, external_reference, metadata, status, failure_reason, processing_at, completed_at, failed_at, reversed_at, reversal_transfer_id FROM transfers
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetTransferForUpdate(ctx context.Context, id uuid.UUID) (Transfer, error) {
+	row := q.db.QueryRow(ctx, getTransferForUpdate, id)
 	var i Transfer
 	err := row.Scan(
 		&i.ID,
@@ -67,12 +383,26 @@ func (q *Queries) GetTransfer(ctx context.Context, id uuid.UUID) (Transfer, erro
 		&i.Amount,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.FxQuoteID,
+		&i.ConvertedAmount,
+		&i.Fee,
+		&i.FeeRuleID,
+		&i.Description,
+		&i.ExternalReference,
+		&i.Metadata,
+		&i.Status,
+		&i.FailureReason,
+		&i.ProcessingAt,
+		&i.CompletedAt,
+		&i.FailedAt,
+		&i.ReversedAt,
+		&i.ReversalTransferID,
 	)
 	return i, err
 }
 
 const listTransfers = `-- name: ListTransfers :many
-SELECT id, from_account_id, to_account_id, amount, created_at, updated_at FROM transfers
+SELECT id, from_account_id, to_account_id, amount, created_at, updated_at, fx_quote_id, converted_amount, fee, fee_rule_id, description, external_reference, metadata, status, failure_reason, processing_at, completed_at, failed_at, reversed_at, reversal_transfer_id FROM transfers
 ORDER BY id
 LIMIT $1
 OFFSET $2
@@ -84,7 +414,7 @@ type ListTransfersParams struct {
 }
 
 func (q *Queries) ListTransfers(ctx context.Context, arg ListTransfersParams) ([]Transfer, error) {
-	rows, err := q.db.QueryContext(ctx, listTransfers, arg.Limit, arg.Offset)
+	rows, err := q.db.Query(ctx, listTransfers, arg.Limit, arg.Offset)
 	if err != nil {
 		return nil, err
 	}
@@ -99,20 +429,197 @@ func (q *Queries) ListTransfers(ctx context.Context, arg ListTransfersParams) ([
 			&i.Amount,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.FxQuoteID,
+			&i.ConvertedAmount,
+			&i.Fee,
+			&i.FeeRuleID,
+			&i.Description,
+			&i.ExternalReference,
+			&i.Metadata,
+			&i.Status,
+			&i.FailureReason,
+			&i.ProcessingAt,
+			&i.CompletedAt,
+			&i.FailedAt,
+			&i.ReversedAt,
+			&i.ReversalTransferID,
 		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
 	}
-	if err := rows.Close(); err != nil {
+	if err := rows.Err(); err != nil {
 		return nil, err
 	}
+	return items, nil
+}
+
+const reverseTransfer = `-- name: ReverseTransfer :one
+UPDATE transfers
+  set status = 'reversed',
+      reversal_transfer_id = $2,
+      reversed_at = now(),
+      updated_at = now()
+WHERE id = $1
+RETURNING id, from_account_id, to_account_id, amount, created_at, updated_at, fx_quote_id, converted_amount, fee, fee_rule_id, description, external_reference, metadata, status, failure_reason, processing_at, completed_at, failed_at, reversed_at, reversal_transfer_id
+`
+
+type ReverseTransferParams struct {
+	ID                 uuid.UUID     `json:"id"`
+	ReversalTransferID uuid.NullUUID `json:"reversal_transfer_id"`
+}
+
+func (q *Queries) ReverseTransfer(ctx context.Context, arg ReverseTransferParams) (Transfer, error) {
+	row := q.db.QueryRow(ctx, reverseTransfer, arg.ID, arg.ReversalTransferID)
+	var i Transfer
+	err := row.Scan(
+		&i.ID,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.FxQuoteID,
+		&i.ConvertedAmount,
+		&i.Fee,
+		&i.FeeRuleID,
+		&i.Description,
+		&i.ExternalReference,
+		&i.Metadata,
+		&i.Status,
+		&i.FailureReason,
+		&i.ProcessingAt,
+		&i.CompletedAt,
+		&i.FailedAt,
+		&i.ReversedAt,
+		&i.ReversalTransferID,
+	)
+	return i, err
+}
+
+const searchAccountTransfers = `-- name: SearchAccountTransfers :many
+SELECT id, from_account_id, to_account_id, amount, created_at, updated_at, fx_quote_id, converted_amount, fee, fee_rule_id, description, external_reference, metadata, status, failure_reason, processing_at, completed_at, failed_at, reversed_at, reversal_transfer_id FROM transfers
+WHERE (from_account_id = $1 OR to_account_id = $1)
+  AND (
+    $2::text IS NULL
+    OR strpos(lower(description), lower($2)) > 0
+    OR strpos(lower(COALESCE(external_reference, '')), lower($2)) > 0
+  )
+  AND ($3::text IS NULL OR external_reference = $3)
+  AND metadata @> $4::jsonb
+ORDER BY created_at DESC, id
+LIMIT $6
+OFFSET $5
+`
+
+type SearchAccountTransfersParams struct {
+	AccountID         uuid.UUID       `json:"account_id"`
+	Search            pgtype.Text     `json:"search"`
+	ExternalReference pgtype.Text     `json:"external_reference"`
+	Metadata          json.RawMessage `json:"metadata"`
+	Offset            int32           `json:"offset"`
+	Limit             int32           `json:"limit"`
+}
+
+// an account's transfers either way, newest first. search matches the
+// description or reference, metadata matches transfers containing it.
+func (q *Queries) SearchAccountTransfers(ctx context.Context, arg SearchAccountTransfersParams) ([]Transfer, error) {
+	rows, err := q.db.Query(ctx, searchAccountTransfers,
+		arg.AccountID,
+		arg.Search,
+		arg.ExternalReference,
+		arg.Metadata,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Transfer{}
+	for rows.Next() {
+		var i Transfer
+		if err := rows.Scan(
+			&i.ID,
+			&i.FromAccountID,
+			&i.ToAccountID,
+			&i.Amount,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.FxQuoteID,
+			&i.ConvertedAmount,
+			&i.Fee,
+			&i.FeeRuleID,
+			&i.Description,
+			&i.ExternalReference,
+			&i.Metadata,
+			&i.Status,
+			&i.FailureReason,
+			&i.ProcessingAt,
+			&i.CompletedAt,
+			&i.FailedAt,
+			&i.ReversedAt,
+			&i.ReversalTransferID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
 	if err := rows.Err(); err != nil {
 		return nil, err
 	}
 	return items, nil
 }
 
+const setTransferDetails = `-- name: SetTransferDetails :one
+UPDATE transfers
+  set description = $2,
+      external_reference = $3,
+      metadata = $4
+WHERE id = $1
+RETURNING id, from_account_id, to_account_id, amount, created_at, updated_at, fx_quote_id, converted_amount, fee, fee_rule_id, description, external_reference, metadata, status, failure_reason, processing_at, completed_at, failed_at, reversed_at, reversal_transfer_id
+`
+
+type SetTransferDetailsParams struct {
+	ID                uuid.UUID       `json:"id"`
+	Description       string          `json:"description"`
+	ExternalReference pgtype.Text     `json:"external_reference"`
+	Metadata          json.RawMessage `json:"metadata"`
+}
+
+func (q *Queries) SetTransferDetails(ctx context.Context, arg SetTransferDetailsParams) (Transfer, error) {
+	row := q.db.QueryRow(ctx, setTransferDetails,
+		arg.ID,
+		arg.Description,
+		arg.ExternalReference,
+		arg.Metadata,
+	)
+	var i Transfer
+	err := row.Scan(
+		&i.ID,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.FxQuoteID,
+		&i.ConvertedAmount,
+		&i.Fee,
+		&i.FeeRuleID,
+		&i.Description,
+		&i.ExternalReference,
+		&i.Metadata,
+		&i.Status,
+		&i.FailureReason,
+		&i.ProcessingAt,
+		&i.CompletedAt,
+		&i.FailedAt,
+		&i.ReversedAt,
+		&i.ReversalTransferID,
+	)
+	return i, err
+}
+
 const updateTransfer = `-- name: UpdateTransfer :exec
 UPDATE transfers
   set amount = $2
@@ -125,6 +632,6 @@ type UpdateTransferParams struct {
 }
 
 func (q *Queries) UpdateTransfer(ctx context.Context, arg UpdateTransferParams) error {
-	_, err := q.db.ExecContext(ctx, updateTransfer, arg.ID, arg.Amount)
+	_, err := q.db.Exec(ctx, updateTransfer, arg.ID, arg.Amount)
 	return err
 }
@@ -2,11 +2,11 @@ package database
 
 import (
 	"context"
-	"database/sql"
 	"testing"
 	"time"
 
 	"github.com/Glenn444/banking-app/util"
+	"github.com/jackc/pgx/v5"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 )
@@ -117,6 +117,6 @@ func TestDeleteTransfer(t *testing.T) {
 
 	deletedTransfer, err := testQueries.GetTransfer(context.Background(), transfer.ID)
 	require.Error(t, err)
-	require.EqualError(t, err, sql.ErrNoRows.Error())
+	require.EqualError(t, err, pgx.ErrNoRows.Error())
 	require.Empty(t, deletedTransfer)
 }
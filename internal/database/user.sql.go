@@ -8,6 +8,8 @@ package database
 import (
 	"context"
 	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const createUsers = `-- name: CreateUsers :one
@@ -18,7 +20,7 @@ INSERT INTO users (
     email
 ) VALUES (
     $1,$2,$3,$4
-) RETURNING username, hashed_password, full_name, email, password_changed_at, created_at, refresh_token
+) RETURNING username, hashed_password, full_name, email, password_changed_at, created_at, refresh_token, role, tier, alias, email_verified_at
 `
 
 type CreateUsersParams struct {
@@ -29,7 +31,7 @@ type CreateUsersParams struct {
 }
 
 func (q *Queries) CreateUsers(ctx context.Context, arg CreateUsersParams) (User, error) {
-	row := q.db.QueryRowContext(ctx, createUsers,
+	row := q.db.QueryRow(ctx, createUsers,
 		arg.Username,
 		arg.HashedPassword,
 		arg.FullName,
@@ -44,30 +46,38 @@ func (q *Queries) CreateUsers(ctx context.Context, arg CreateUsersParams) (User,
 		&i.PasswordChangedAt,
 		&i.CreatedAt,
 		&i.RefreshToken,
+		&i.Role,
+		&i.Tier,
+		&i.Alias,
+		&i.EmailVerifiedAt,
 	)
 	return i, err
 }
 
 const getAllUsers = `-- name: GetAllUsers :many
-SELECT username,full_name,email,username, hashed_password, full_name, email, password_changed_at, created_at, refresh_token FROM users
+SELECT username,full_name,email,username, hashed_password, full_name, email, password_changed_at, created_at, refresh_token, role, tier, alias, email_verified_at FROM users
 ORDER BY username
 `
 
 type GetAllUsersRow struct {
-	Username          string    `json:"username"`
-	FullName          string    `json:"full_name"`
-	Email             string    `json:"email"`
-	Username_2        string    `json:"username_2"`
-	HashedPassword    string    `json:"hashed_password"`
-	FullName_2        string    `json:"full_name_2"`
-	Email_2           string    `json:"email_2"`
-	PasswordChangedAt time.Time `json:"password_changed_at"`
-	CreatedAt         time.Time `json:"created_at"`
-	RefreshToken      string    `json:"refresh_token"`
+	Username          string             `json:"username"`
+	FullName          string             `json:"full_name"`
+	Email             string             `json:"email"`
+	Username_2        string             `json:"username_2"`
+	HashedPassword    string             `json:"hashed_password"`
+	FullName_2        string             `json:"full_name_2"`
+	Email_2           string             `json:"email_2"`
+	PasswordChangedAt time.Time          `json:"password_changed_at"`
+	CreatedAt         time.Time          `json:"created_at"`
+	RefreshToken      string             `json:"refresh_token"`
+	Role              string             `json:"role"`
+	Tier              string             `json:"tier"`
+	Alias             pgtype.Text        `json:"alias"`
+	EmailVerifiedAt   pgtype.Timestamptz `json:"email_verified_at"`
 }
 
 func (q *Queries) GetAllUsers(ctx context.Context) ([]GetAllUsersRow, error) {
-	rows, err := q.db.QueryContext(ctx, getAllUsers)
+	rows, err := q.db.Query(ctx, getAllUsers)
 	if err != nil {
 		return nil, err
 	}
@@ -86,14 +96,15 @@ func (q *Queries) GetAllUsers(ctx context.Context) ([]GetAllUsersRow, error) {
 			&i.PasswordChangedAt,
 			&i.CreatedAt,
 			&i.RefreshToken,
+			&i.Role,
+			&i.Tier,
+			&i.Alias,
+			&i.EmailVerifiedAt,
 		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
 	}
-	if err := rows.Close(); err != nil {
-		return nil, err
-	}
 	if err := rows.Err(); err != nil {
 		return nil, err
 	}
@@ -101,12 +112,82 @@ func (q *Queries) GetAllUsers(ctx context.Context) ([]GetAllUsersRow, error) {
 }
 
 const getUser = `-- name: GetUser :one
-SELECT username, hashed_password, full_name, email, password_changed_at, created_at, refresh_token FROM users
+SELECT username, hashed_password, full_name, email, password_changed_at, created_at, refresh_token, role, tier, alias, email_verified_at FROM users
 WHERE username = $1 LIMIT 1
 `
 
 func (q *Queries) GetUser(ctx context.Context, username string) (User, error) {
-	row := q.db.QueryRowContext(ctx, getUser, username)
+	row := q.db.QueryRow(ctx, getUser, username)
+	var i User
+	err := row.Scan(
+		&i.Username,
+		&i.HashedPassword,
+		&i.FullName,
+		&i.Email,
+		&i.PasswordChangedAt,
+		&i.CreatedAt,
+		&i.RefreshToken,
+		&i.Role,
+		&i.Tier,
+		&i.Alias,
+		&i.EmailVerifiedAt,
+	)
+	return i, err
+}
+
+const lockUserForTransfer = `-- name: LockUserForTransfer :one
+SELECT username FROM users
+WHERE username = $1
+FOR NO KEY UPDATE
+`
+
+// serialises transfers across a user's accounts while their limits are checked
+func (q *Queries) LockUserForTransfer(ctx context.Context, username string) (string, error) {
+	row := q.db.QueryRow(ctx, lockUserForTransfer, username)
+	err := row.Scan(&username)
+	return username, err
+}
+
+const markUserEmailVerified = `-- name: MarkUserEmailVerified :one
+UPDATE users
+  set email_verified_at = COALESCE(email_verified_at, now())
+WHERE username = $1
+RETURNING username, hashed_password, full_name, email, password_changed_at, created_at, refresh_token, role, tier, alias, email_verified_at
+`
+
+func (q *Queries) MarkUserEmailVerified(ctx context.Context, username string) (User, error) {
+	row := q.db.QueryRow(ctx, markUserEmailVerified, username)
+	var i User
+	err := row.Scan(
+		&i.Username,
+		&i.HashedPassword,
+		&i.FullName,
+		&i.Email,
+		&i.PasswordChangedAt,
+		&i.CreatedAt,
+		&i.RefreshToken,
+		&i.Role,
+		&i.Tier,
+		&i.Alias,
+		&i.EmailVerifiedAt,
+	)
+	return i, err
+}
+
+const setUserAlias = `-- name: SetUserAlias :one
+UPDATE users
+  set alias = $1
+WHERE username = $2
+RETURNING username, hashed_password, full_name, email, password_changed_at, created_at, refresh_token, role, tier, alias, email_verified_at
+`
+
+type SetUserAliasParams struct {
+	Alias    pgtype.Text `json:"alias"`
+	Username string      `json:"username"`
+}
+
+func (q *Queries) SetUserAlias(ctx context.Context, arg SetUserAliasParams) (User, error) {
+	row := q.db.QueryRow(ctx, setUserAlias, arg.Alias, arg.Username)
 	var i User
 	err := row.Scan(
 		&i.Username,
@@ -116,6 +197,10 @@ func (q *Queries) GetUser(ctx context.Context, username string) (User, error) {
 		&i.PasswordChangedAt,
 		&i.CreatedAt,
 		&i.RefreshToken,
+		&i.Role,
+		&i.Tier,
+		&i.Alias,
+		&i.EmailVerifiedAt,
 	)
 	return i, err
 }
@@ -132,6 +217,6 @@ type UpdateRefreshTokenParams struct {
 }
 
 func (q *Queries) UpdateRefreshToken(ctx context.Context, arg UpdateRefreshTokenParams) error {
-	_, err := q.db.ExecContext(ctx, updateRefreshToken, arg.Username, arg.RefreshToken)
+	_, err := q.db.Exec(ctx, updateRefreshToken, arg.Username, arg.RefreshToken)
 	return err
 }
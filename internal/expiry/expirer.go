@@ -0,0 +1,40 @@
+package expiry
+
+import (
+	"context"
+	"time"
+)
+
+// batchSize caps how many rows a single pass expires
+const batchSize = 500
+
+// ExpireFunc expires up to limit rows that are past their expiry at now, in
+// one transaction, and returns how many it expired. The store's
+// ExpireHoldsTx, ExpireTransferApprovalsTx and ExpireEscrowsTx are ones.
+type ExpireFunc func(ctx context.Context, now time.Time, limit int32) (int, error)
+
+// Expirer settles whatever expire works on once it passes its expiry: holds
+// are released, transfers waiting for approval are cancelled and escrows
+// nobody settled are refunded, giving the reserved funds back without
+// anyone having to act
+type Expirer struct {
+	expire ExpireFunc
+}
+
+// NewExpirer creates an expirer running expire
+func NewExpirer(expire ExpireFunc) *Expirer {
+	return &Expirer{expire: expire}
+}
+
+// RunOnce expires everything past its expiry, a batch at a time, and
+// returns how many it expired
+func (e *Expirer) RunOnce(ctx context.Context) (int, error) {
+	total := 0
+	for {
+		expired, err := e.expire(ctx, time.Now(), batchSize)
+		total += expired
+		if err != nil || expired < batchSize {
+			return total, err
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package expiry
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	mock_database "github.com/Glenn444/banking-app/internal/database/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRunOnce(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mock_database.NewMockStore(ctrl)
+	gomock.InOrder(
+		store.EXPECT().ExpireHoldsTx(gomock.Any(), gomock.Any(), gomock.Eq(int32(batchSize))).Return(batchSize, nil),
+		store.EXPECT().ExpireHoldsTx(gomock.Any(), gomock.Any(), gomock.Eq(int32(batchSize))).Return(3, nil),
+	)
+
+	expired, err := NewExpirer(store.ExpireHoldsTx).RunOnce(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, batchSize+3, expired)
+}
+
+func TestRunOnce_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	errExpire := errors.New("connection reset")
+	store := mock_database.NewMockStore(ctrl)
+	store.EXPECT().ExpireEscrowsTx(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(2, errExpire)
+
+	expired, err := NewExpirer(store.ExpireEscrowsTx).RunOnce(context.Background())
+	require.ErrorIs(t, err, errExpire)
+	require.Equal(t, 2, expired)
+}
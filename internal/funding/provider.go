@@ -0,0 +1,68 @@
+package funding
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Glenn444/banking-app/util"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type Kind string
+type Status string
+
+const (
+	Deposit    Kind = "deposit"
+	Withdrawal Kind = "withdrawal"
+
+	StatusPending Status = "pending"
+	StatusSettled Status = "settled"
+	StatusFailed  Status = "failed"
+)
+
+var ErrUnknownReference = errors.New("unknown provider reference")
+
+// Instruction describes money moving between the bank and an external funding source
+type Instruction struct {
+	TransactionID uuid.UUID
+	AccountID     uuid.UUID
+	Kind          Kind
+	Amount        decimal.Decimal
+	Currency      string
+}
+
+// Result is the provider's view of an instruction
+type Result struct {
+	Reference     string
+	Status        Status
+	FailureReason string
+}
+
+// Provider is an interface for external funding sources (card acquirers, bank rails...)
+type Provider interface {
+	// Name identifies the provider on stored funding transactions
+	Name() string
+
+	// Initiate submits an instruction and returns its current status
+	Initiate(ctx context.Context, instruction Instruction) (Result, error)
+
+	// Status returns the latest status of a previously initiated instruction
+	Status(ctx context.Context, reference string) (Result, error)
+}
+
+// NewProvider returns the provider configured by name. The simulator moves
+// no real money, so it can only be picked in development or tests.
+func NewProvider(name string, environment string) (Provider, error) {
+	switch name {
+	case "":
+		return nil, errors.New("no funding provider configured")
+	case SimulatorName:
+		if environment != util.EnvironmentDevelopment && environment != util.EnvironmentTest {
+			return nil, fmt.Errorf("the funding simulator only runs in development or test, not in %q", environment)
+		}
+		return NewSimulator(), nil
+	}
+	return nil, errors.New("unsupported funding provider: " + name)
+}
@@ -0,0 +1,66 @@
+package funding
+
+import (
+	"testing"
+
+	"github.com/Glenn444/banking-app/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewProvider(t *testing.T) {
+	testCases := []struct {
+		name        string
+		provider    string
+		environment string
+		wantErr     bool
+	}{
+		{
+			name:        "SimulatorInDevelopment",
+			provider:    SimulatorName,
+			environment: util.EnvironmentDevelopment,
+		},
+		{
+			name:        "SimulatorInTest",
+			provider:    SimulatorName,
+			environment: util.EnvironmentTest,
+		},
+		{
+			name:        "SimulatorInProduction",
+			provider:    SimulatorName,
+			environment: util.EnvironmentProduction,
+			wantErr:     true,
+		},
+		{
+			name:        "SimulatorWithoutEnvironment",
+			provider:    SimulatorName,
+			environment: "",
+			wantErr:     true,
+		},
+		{
+			name:        "NotConfigured",
+			provider:    "",
+			environment: util.EnvironmentDevelopment,
+			wantErr:     true,
+		},
+		{
+			name:        "Unsupported",
+			provider:    "carrier-pigeon",
+			environment: util.EnvironmentProduction,
+			wantErr:     true,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			provider, err := NewProvider(tc.provider, tc.environment)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.provider, provider.Name())
+		})
+	}
+}
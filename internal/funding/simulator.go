@@ -0,0 +1,77 @@
+package funding
+
+import (
+	"context"
+	"sync"
+)
+
+const SimulatorName = "simulator"
+
+// Simulator is an in-memory Provider for local development and tests.
+// By default every instruction settles straight away; set Decide to
+// keep instructions pending or make them fail.
+type Simulator struct {
+	// Decide picks the initial outcome of an instruction
+	Decide func(instruction Instruction) Result
+
+	mu      sync.Mutex
+	results map[string]Result
+}
+
+// NewSimulator creates a simulator that settles everything immediately
+func NewSimulator() *Simulator {
+	return &Simulator{
+		results: make(map[string]Result),
+	}
+}
+
+func (s *Simulator) Name() string {
+	return SimulatorName
+}
+
+func (s *Simulator) Initiate(ctx context.Context, instruction Instruction) (Result, error) {
+	result := Result{Status: StatusSettled}
+	if s.Decide != nil {
+		result = s.Decide(instruction)
+	}
+	result.Reference = "sim_" + instruction.TransactionID.String()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.results[result.Reference] = result
+
+	return result, nil
+}
+
+func (s *Simulator) Status(ctx context.Context, reference string) (Result, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result, ok := s.results[reference]
+	if !ok {
+		return Result{}, ErrUnknownReference
+	}
+	return result, nil
+}
+
+// Settle moves a pending instruction to settled
+func (s *Simulator) Settle(reference string) error {
+	return s.resolve(reference, Result{Status: StatusSettled})
+}
+
+// Fail moves a pending instruction to failed with the given reason
+func (s *Simulator) Fail(reference string, reason string) error {
+	return s.resolve(reference, Result{Status: StatusFailed, FailureReason: reason})
+}
+
+func (s *Simulator) resolve(reference string, result Result) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.results[reference]; !ok {
+		return ErrUnknownReference
+	}
+	result.Reference = reference
+	s.results[reference] = result
+	return nil
+}
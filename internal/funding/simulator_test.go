@@ -0,0 +1,62 @@
+package funding
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimulatorSettlesByDefault(t *testing.T) {
+	simulator := NewSimulator()
+
+	result, err := simulator.Initiate(context.Background(), Instruction{
+		TransactionID: uuid.New(),
+		Kind:          Deposit,
+		Amount:        decimal.NewFromInt(10),
+		Currency:      "USD",
+	})
+	require.NoError(t, err)
+	require.Equal(t, StatusSettled, result.Status)
+	require.NotEmpty(t, result.Reference)
+
+	status, err := simulator.Status(context.Background(), result.Reference)
+	require.NoError(t, err)
+	require.Equal(t, result, status)
+}
+
+func TestSimulatorPendingLifecycle(t *testing.T) {
+	simulator := NewSimulator()
+	simulator.Decide = func(instruction Instruction) Result {
+		return Result{Status: StatusPending}
+	}
+
+	settle, err := simulator.Initiate(context.Background(), Instruction{TransactionID: uuid.New()})
+	require.NoError(t, err)
+	require.Equal(t, StatusPending, settle.Status)
+
+	fail, err := simulator.Initiate(context.Background(), Instruction{TransactionID: uuid.New()})
+	require.NoError(t, err)
+
+	require.NoError(t, simulator.Settle(settle.Reference))
+	require.NoError(t, simulator.Fail(fail.Reference, "insufficient funds at source"))
+
+	status, err := simulator.Status(context.Background(), settle.Reference)
+	require.NoError(t, err)
+	require.Equal(t, StatusSettled, status.Status)
+
+	status, err = simulator.Status(context.Background(), fail.Reference)
+	require.NoError(t, err)
+	require.Equal(t, StatusFailed, status.Status)
+	require.Equal(t, "insufficient funds at source", status.FailureReason)
+}
+
+func TestSimulatorUnknownReference(t *testing.T) {
+	simulator := NewSimulator()
+
+	_, err := simulator.Status(context.Background(), "missing")
+	require.ErrorIs(t, err, ErrUnknownReference)
+	require.ErrorIs(t, simulator.Settle("missing"), ErrUnknownReference)
+}
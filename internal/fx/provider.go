@@ -0,0 +1,52 @@
+package fx
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+var ErrRateNotFound = errors.New("fx rate not found")
+
+// Rate is the price of one unit of From in To
+type Rate struct {
+	From string          `json:"from"`
+	To   string          `json:"to"`
+	Rate decimal.Decimal `json:"rate"`
+	AsOf time.Time       `json:"as_of"`
+}
+
+// Convert returns amount expressed in the To currency
+func (r Rate) Convert(amount decimal.Decimal) decimal.Decimal {
+	return amount.Mul(r.Rate)
+}
+
+// Exceeds reports whether amount in currency is above limit in
+// limitCurrency, the amount is converted at the provider's current rate
+// when the currencies differ
+func Exceeds(ctx context.Context, p Provider, amount decimal.Decimal, currency string, limit decimal.Decimal, limitCurrency string) (bool, error) {
+	if currency != limitCurrency {
+		rate, err := p.Rate(ctx, currency, limitCurrency)
+		if err != nil {
+			return false, err
+		}
+		amount = rate.Convert(amount)
+	}
+	return amount.GreaterThan(limit), nil
+}
+
+// Provider is an interface for foreign exchange rate sources
+type Provider interface {
+	// Rate returns the current rate to convert from one currency to another
+	Rate(ctx context.Context, from string, to string) (Rate, error)
+}
+
+// NewProvider returns the rates file provider, or the built-in table when no file is configured
+func NewProvider(ratesFile string) (Provider, error) {
+	if ratesFile == "" {
+		return DefaultRates(), nil
+	}
+	return LoadFile(ratesFile)
+}
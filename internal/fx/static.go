@@ -0,0 +1,108 @@
+package fx
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// rateScale is the number of decimals kept on cross rates
+const rateScale = 10
+
+// StaticProvider serves rates from a fixed table quoted against a base
+// currency, cross rates are derived through the base. It's meant for local
+// development and tests, or a rates file refreshed by an external job.
+type StaticProvider struct {
+	base  string
+	rates map[string]decimal.Decimal
+	asOf  time.Time
+}
+
+// NewStaticProvider creates a provider where rates[c] is the price of one base unit in c
+func NewStaticProvider(base string, rates map[string]decimal.Decimal, asOf time.Time) *StaticProvider {
+	table := make(map[string]decimal.Decimal, len(rates)+1)
+	for currency, rate := range rates {
+		table[currency] = rate
+	}
+	table[base] = decimal.NewFromInt(1)
+
+	return &StaticProvider{
+		base:  base,
+		rates: table,
+		asOf:  asOf,
+	}
+}
+
+// DefaultRates is a fixed USD based table used when no rates file is configured
+func DefaultRates() *StaticProvider {
+	return NewStaticProvider("USD", map[string]decimal.Decimal{
+		"EUR": decimal.RequireFromString("0.92"),
+		"CAD": decimal.RequireFromString("1.36"),
+		"JPY": decimal.RequireFromString("151.50"),
+		"BHD": decimal.RequireFromString("0.376"),
+		"GBP": decimal.RequireFromString("0.79"),
+		"CHF": decimal.RequireFromString("0.90"),
+		"KWD": decimal.RequireFromString("0.307"),
+	}, time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC))
+}
+
+type ratesFile struct {
+	Base  string                     `json:"base"`
+	AsOf  time.Time                  `json:"as_of"`
+	Rates map[string]decimal.Decimal `json:"rates"`
+}
+
+// LoadFile reads a rates table from a JSON file shaped like
+// {"base": "USD", "as_of": "2026-01-01T00:00:00Z", "rates": {"EUR": "0.92"}}
+func LoadFile(path string) (*StaticProvider, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var file ratesFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("cannot parse rates file %s: %w", path, err)
+	}
+	if file.Base == "" {
+		return nil, fmt.Errorf("rates file %s has no base currency", path)
+	}
+	for currency, rate := range file.Rates {
+		if !rate.IsPositive() {
+			return nil, fmt.Errorf("rates file %s has a non positive rate for %s", path, currency)
+		}
+	}
+
+	asOf := file.AsOf
+	if asOf.IsZero() {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		asOf = info.ModTime()
+	}
+
+	return NewStaticProvider(file.Base, file.Rates, asOf), nil
+}
+
+func (p *StaticProvider) Rate(ctx context.Context, from string, to string) (Rate, error) {
+	fromRate, ok := p.rates[from]
+	if !ok {
+		return Rate{}, fmt.Errorf("%w: %s", ErrRateNotFound, from)
+	}
+	toRate, ok := p.rates[to]
+	if !ok {
+		return Rate{}, fmt.Errorf("%w: %s", ErrRateNotFound, to)
+	}
+
+	return Rate{
+		From: from,
+		To:   to,
+		Rate: toRate.DivRound(fromRate, rateScale),
+		AsOf: p.asOf,
+	}, nil
+}
@@ -0,0 +1,83 @@
+package fx
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStaticProviderCrossRate(t *testing.T) {
+	asOf := time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)
+	provider := NewStaticProvider("USD", map[string]decimal.Decimal{
+		"EUR": decimal.RequireFromString("0.8"),
+		"JPY": decimal.RequireFromString("160"),
+	}, asOf)
+
+	rate, err := provider.Rate(context.Background(), "USD", "EUR")
+	require.NoError(t, err)
+	require.True(t, decimal.RequireFromString("0.8").Equal(rate.Rate))
+	require.Equal(t, asOf, rate.AsOf)
+
+	rate, err = provider.Rate(context.Background(), "EUR", "JPY")
+	require.NoError(t, err)
+	require.True(t, decimal.NewFromInt(200).Equal(rate.Rate))
+	require.True(t, decimal.NewFromInt(2000).Equal(rate.Convert(decimal.NewFromInt(10))))
+
+	_, err = provider.Rate(context.Background(), "USD", "XXX")
+	require.ErrorIs(t, err, ErrRateNotFound)
+}
+
+func TestExceeds(t *testing.T) {
+	provider := NewStaticProvider("USD", map[string]decimal.Decimal{
+		"EUR": decimal.RequireFromString("0.8"),
+	}, time.Now())
+
+	testCases := []struct {
+		name     string
+		amount   string
+		currency string
+		exceeds  bool
+	}{
+		{name: "SameCurrencyBelow", amount: "500", currency: "USD", exceeds: false},
+		{name: "SameCurrencyAbove", amount: "500.01", currency: "USD", exceeds: true},
+		// 450 EUR is 562.50 USD
+		{name: "ConvertedAbove", amount: "450", currency: "EUR", exceeds: true},
+		// 400 EUR is 500 USD
+		{name: "ConvertedAtLimit", amount: "400", currency: "EUR", exceeds: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			exceeds, err := Exceeds(context.Background(), provider, decimal.RequireFromString(tc.amount), tc.currency, decimal.NewFromInt(500), "USD")
+			require.NoError(t, err)
+			require.Equal(t, tc.exceeds, exceeds)
+		})
+	}
+
+	_, err := Exceeds(context.Background(), provider, decimal.NewFromInt(1), "XXX", decimal.NewFromInt(500), "USD")
+	require.ErrorIs(t, err, ErrRateNotFound)
+}
+
+func TestLoadFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rates.json")
+	err := os.WriteFile(path, []byte(`{"base":"EUR","as_of":"2026-02-01T00:00:00Z","rates":{"USD":"1.25"}}`), 0o600)
+	require.NoError(t, err)
+
+	provider, err := LoadFile(path)
+	require.NoError(t, err)
+
+	rate, err := provider.Rate(context.Background(), "USD", "EUR")
+	require.NoError(t, err)
+	require.True(t, decimal.RequireFromString("0.8").Equal(rate.Rate))
+	require.Equal(t, time.Date(2026, time.February, 1, 0, 0, 0, 0, time.UTC), rate.AsOf)
+
+	err = os.WriteFile(path, []byte(`{"base":"EUR","rates":{"USD":"-1"}}`), 0o600)
+	require.NoError(t, err)
+	_, err = LoadFile(path)
+	require.Error(t, err)
+}
@@ -0,0 +1,151 @@
+package health
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/Glenn444/banking-app/internal/scheduler"
+)
+
+// MaxFailures is how many runs in a row a worker can fail before it counts
+// as unhealthy
+const MaxFailures = 3
+
+// minStallAfter keeps workers that run every few seconds from counting as
+// stalled while they work through a long queue
+const minStallAfter = time.Minute
+
+// Monitor runs the background workers and keeps track of how their runs
+// go, so readiness and admins can tell when one has stopped, stalled or
+// keeps failing
+type Monitor struct {
+	clock   scheduler.Clock
+	mu      sync.Mutex
+	workers []*worker
+}
+
+type worker struct {
+	name      string
+	interval  time.Duration
+	startedAt time.Time
+	lastRun   time.Time
+	lastError error
+	failures  int
+	stopped   bool
+}
+
+// WorkerStatus is how a worker is doing
+type WorkerStatus struct {
+	Name     string
+	Interval time.Duration
+	// LastRun is when the last run finished, zero until the first one does
+	LastRun time.Time
+	// LastError is the error of the last run, empty when it succeeded
+	LastError string
+	// Failures counts the runs that failed since the last one that succeeded
+	Failures int
+	Stopped  bool
+	Healthy  bool
+}
+
+// NewMonitor creates a monitor without workers
+func NewMonitor(clock scheduler.Clock) *Monitor {
+	return &Monitor{clock: clock}
+}
+
+// Run calls run every interval until ctx is cancelled, recording each run
+// under name
+func (m *Monitor) Run(ctx context.Context, name string, interval time.Duration, run func(context.Context) error) {
+	w := m.add(name, interval)
+	defer m.stop(w)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		err := run(ctx)
+		if err != nil {
+			log.Println(name+": ", err)
+		}
+		m.record(w, err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (m *Monitor) add(name string, interval time.Duration) *worker {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	w := &worker{name: name, interval: interval, startedAt: m.clock.Now()}
+	m.workers = append(m.workers, w)
+	return w
+}
+
+func (m *Monitor) record(w *worker, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	w.lastRun = m.clock.Now()
+	w.lastError = err
+	if err != nil {
+		w.failures++
+	} else {
+		w.failures = 0
+	}
+}
+
+func (m *Monitor) stop(w *worker) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	w.stopped = true
+}
+
+// Workers returns the status of every worker, in the order they started
+func (m *Monitor) Workers() []WorkerStatus {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.clock.Now()
+	statuses := make([]WorkerStatus, 0, len(m.workers))
+	for _, w := range m.workers {
+		status := WorkerStatus{
+			Name:     w.name,
+			Interval: w.interval,
+			LastRun:  w.lastRun,
+			Failures: w.failures,
+			Stopped:  w.stopped,
+		}
+		if w.lastError != nil {
+			status.LastError = w.lastError.Error()
+		}
+		status.Healthy = !w.stopped && w.failures < MaxFailures && !w.stalled(now)
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+// stalled tells whether the worker has gone three intervals without
+// finishing a run
+func (w *worker) stalled(now time.Time) bool {
+	since := w.lastRun
+	if since.IsZero() {
+		since = w.startedAt
+	}
+	return now.Sub(since) > max(3*w.interval, minStallAfter)
+}
+
+// IgnoreResult adapts a worker's RunOnce, which reports what it did, to Run
+func IgnoreResult[T any](runOnce func(context.Context) (T, error)) func(context.Context) error {
+	return func(ctx context.Context) error {
+		_, err := runOnce(ctx)
+		return err
+	}
+}
@@ -0,0 +1,135 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClock is a clock tests move forward by hand
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func TestWorkers(t *testing.T) {
+	errRun := errors.New("db is down")
+
+	testCases := []struct {
+		name        string
+		interval    time.Duration
+		runs        []error
+		elapsed     time.Duration
+		stopped     bool
+		wantHealthy bool
+	}{
+		{
+			name:        "Starting",
+			interval:    time.Second,
+			elapsed:     30 * time.Second,
+			wantHealthy: true,
+		},
+		{
+			name:        "StuckOnFirstRun",
+			interval:    time.Second,
+			elapsed:     2 * time.Minute,
+			wantHealthy: false,
+		},
+		{
+			name:        "RunningOnTime",
+			interval:    time.Hour,
+			runs:        []error{nil, nil},
+			elapsed:     2 * time.Hour,
+			wantHealthy: true,
+		},
+		{
+			name:        "Stalled",
+			interval:    time.Hour,
+			runs:        []error{nil},
+			elapsed:     4 * time.Hour,
+			wantHealthy: false,
+		},
+		{
+			name:        "RecoveredFromFailures",
+			interval:    time.Minute,
+			runs:        []error{errRun, errRun, errRun, nil},
+			wantHealthy: true,
+		},
+		{
+			name:        "FailingOverAndOver",
+			interval:    time.Minute,
+			runs:        []error{nil, errRun, errRun, errRun},
+			wantHealthy: false,
+		},
+		{
+			name:        "Stopped",
+			interval:    time.Minute,
+			runs:        []error{nil},
+			stopped:     true,
+			wantHealthy: false,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			clock := &fakeClock{now: time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)}
+			monitor := NewMonitor(clock)
+
+			w := monitor.add("worker", tc.interval)
+			for _, err := range tc.runs {
+				monitor.record(w, err)
+			}
+			if tc.stopped {
+				monitor.stop(w)
+			}
+			clock.now = clock.now.Add(tc.elapsed)
+
+			statuses := monitor.Workers()
+			require.Len(t, statuses, 1)
+			require.Equal(t, tc.wantHealthy, statuses[0].Healthy)
+			require.Equal(t, tc.stopped, statuses[0].Stopped)
+			if len(tc.runs) > 0 && tc.runs[len(tc.runs)-1] != nil {
+				require.Equal(t, errRun.Error(), statuses[0].LastError)
+			} else {
+				require.Empty(t, statuses[0].LastError)
+			}
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	monitor := NewMonitor(&fakeClock{now: time.Now()})
+	require.Empty(t, monitor.Workers())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ran := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		monitor.Run(ctx, "transfer worker", time.Hour, func(context.Context) error {
+			close(ran)
+			return errors.New("db is down")
+		})
+		close(done)
+	}()
+
+	<-ran
+	cancel()
+	<-done
+
+	statuses := monitor.Workers()
+	require.Len(t, statuses, 1)
+	require.Equal(t, "transfer worker", statuses[0].Name)
+	require.Equal(t, time.Hour, statuses[0].Interval)
+	require.Equal(t, 1, statuses[0].Failures)
+	require.Equal(t, "db is down", statuses[0].LastError)
+	require.True(t, statuses[0].Stopped)
+	require.False(t, statuses[0].Healthy)
+}
@@ -0,0 +1,71 @@
+package interest
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	db "github.com/Glenn444/banking-app/internal/database"
+	"github.com/Glenn444/banking-app/internal/scheduler"
+)
+
+// MaxBackfillDays caps how many business dates one backfill may run
+const MaxBackfillDays = 366
+
+var ErrBackfillRange = errors.New("backfill range is invalid")
+
+// Engine accrues interest for every business date that has ended, a
+// business date is a UTC calendar day. When runs were missed it catches up
+// from the day after the last recorded run.
+type Engine struct {
+	store db.Store
+	clock scheduler.Clock
+}
+
+// NewEngine creates an engine
+func NewEngine(store db.Store, clock scheduler.Clock) *Engine {
+	return &Engine{store: store, clock: clock}
+}
+
+// RunOnce runs every business date since the last recorded run up to
+// yesterday and returns the runs. The first run ever only runs yesterday.
+func (e *Engine) RunOnce(ctx context.Context) ([]db.InterestRun, error) {
+	yesterday := db.BusinessDate(e.clock.Now()).AddDate(0, 0, -1)
+
+	from := yesterday
+	last, err := e.store.GetLastInterestRun(ctx)
+	switch {
+	case err == nil:
+		from = db.BusinessDate(last.BusinessDate).AddDate(0, 0, 1)
+	case !errors.Is(err, db.ErrNotFound):
+		return nil, err
+	}
+
+	if from.After(yesterday) {
+		return nil, nil
+	}
+	return Backfill(ctx, e.store, from, yesterday)
+}
+
+// Backfill runs every business date from from to to, both included, in
+// order. Dates already run are run again, which accrues and pays only what
+// was missed.
+func Backfill(ctx context.Context, store db.Store, from, to time.Time) ([]db.InterestRun, error) {
+	from, to = db.BusinessDate(from), db.BusinessDate(to)
+	if to.Before(from) {
+		return nil, ErrBackfillRange
+	}
+	if to.Sub(from) >= MaxBackfillDays*24*time.Hour {
+		return nil, ErrBackfillRange
+	}
+
+	runs := []db.InterestRun{}
+	for date := from; !date.After(to); date = date.AddDate(0, 0, 1) {
+		run, err := store.AccrueInterestTx(ctx, date)
+		if err != nil {
+			return runs, err
+		}
+		runs = append(runs, run)
+	}
+	return runs, nil
+}
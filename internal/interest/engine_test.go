@@ -0,0 +1,95 @@
+package interest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	db "github.com/Glenn444/banking-app/internal/database"
+	mock_database "github.com/Glenn444/banking-app/internal/database/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type fixedClock time.Time
+
+func (c fixedClock) Now() time.Time {
+	return time.Time(c)
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func expectRun(store *mock_database.MockStore, businessDate time.Time) *gomock.Call {
+	return store.EXPECT().
+		AccrueInterestTx(gomock.Any(), gomock.Eq(businessDate)).
+		Times(1).
+		Return(db.InterestRun{BusinessDate: businessDate}, nil)
+}
+
+func TestRunOnce_CatchesUp(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Date(2026, 3, 4, 9, 0, 0, 0, time.UTC)
+	store := mock_database.NewMockStore(ctrl)
+	store.EXPECT().
+		GetLastInterestRun(gomock.Any()).
+		Times(1).
+		Return(db.InterestRun{BusinessDate: date(2026, 2, 28)}, nil)
+	gomock.InOrder(
+		expectRun(store, date(2026, 3, 1)),
+		expectRun(store, date(2026, 3, 2)),
+		expectRun(store, date(2026, 3, 3)),
+	)
+
+	runs, err := NewEngine(store, fixedClock(now)).RunOnce(context.Background())
+	require.NoError(t, err)
+	require.Len(t, runs, 3)
+}
+
+func TestRunOnce_FirstRun(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Date(2026, 3, 4, 0, 30, 0, 0, time.UTC)
+	store := mock_database.NewMockStore(ctrl)
+	store.EXPECT().GetLastInterestRun(gomock.Any()).Times(1).Return(db.InterestRun{}, db.ErrNotFound)
+	expectRun(store, date(2026, 3, 3))
+
+	runs, err := NewEngine(store, fixedClock(now)).RunOnce(context.Background())
+	require.NoError(t, err)
+	require.Len(t, runs, 1)
+}
+
+func TestRunOnce_UpToDate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Date(2026, 3, 4, 23, 0, 0, 0, time.UTC)
+	store := mock_database.NewMockStore(ctrl)
+	store.EXPECT().
+		GetLastInterestRun(gomock.Any()).
+		Times(1).
+		Return(db.InterestRun{BusinessDate: date(2026, 3, 3)}, nil)
+	store.EXPECT().AccrueInterestTx(gomock.Any(), gomock.Any()).Times(0)
+
+	runs, err := NewEngine(store, fixedClock(now)).RunOnce(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, runs)
+}
+
+func TestBackfill_Range(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mock_database.NewMockStore(ctrl)
+	store.EXPECT().AccrueInterestTx(gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := Backfill(context.Background(), store, date(2026, 3, 2), date(2026, 3, 1))
+	require.ErrorIs(t, err, ErrBackfillRange)
+
+	_, err = Backfill(context.Background(), store, date(2025, 1, 1), date(2026, 3, 1))
+	require.ErrorIs(t, err, ErrBackfillRange)
+}
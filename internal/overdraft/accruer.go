@@ -0,0 +1,39 @@
+package overdraft
+
+import (
+	"context"
+
+	db "github.com/Glenn444/banking-app/internal/database"
+	"github.com/Glenn444/banking-app/internal/scheduler"
+)
+
+// batchSize caps how many accounts a single pass charges
+const batchSize = 500
+
+// Accruer charges accounts that closed a business date overdrawn a day of
+// interest on their closing balance, once the date has ended. The business
+// date is the UTC calendar day.
+type Accruer struct {
+	store db.Store
+	clock scheduler.Clock
+}
+
+// NewAccruer creates an accruer
+func NewAccruer(store db.Store, clock scheduler.Clock) *Accruer {
+	return &Accruer{store: store, clock: clock}
+}
+
+// RunOnce charges every account that closed yesterday overdrawn and wasn't
+// charged for it yet, and returns how many it charged
+func (a *Accruer) RunOnce(ctx context.Context) (int, error) {
+	businessDate := db.BusinessDate(a.clock.Now()).AddDate(0, 0, -1)
+
+	total := 0
+	for {
+		charged, err := a.store.AccrueOverdraftInterestTx(ctx, businessDate, batchSize)
+		total += charged
+		if err != nil || charged < batchSize {
+			return total, err
+		}
+	}
+}
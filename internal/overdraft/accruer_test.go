@@ -0,0 +1,55 @@
+package overdraft
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mock_database "github.com/Glenn444/banking-app/internal/database/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type fixedClock time.Time
+
+func (c fixedClock) Now() time.Time {
+	return time.Time(c)
+}
+
+func TestRunOnce(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// late evening in New York is already the next UTC business date, so
+	// the date that ended is March 1st
+	now := time.Date(2026, 3, 1, 21, 30, 0, 0, time.FixedZone("EST", -5*60*60))
+	businessDate := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	store := mock_database.NewMockStore(ctrl)
+	gomock.InOrder(
+		store.EXPECT().
+			AccrueOverdraftInterestTx(gomock.Any(), gomock.Eq(businessDate), gomock.Eq(int32(batchSize))).
+			Return(batchSize, nil),
+		store.EXPECT().
+			AccrueOverdraftInterestTx(gomock.Any(), gomock.Eq(businessDate), gomock.Eq(int32(batchSize))).
+			Return(7, nil),
+	)
+
+	charged, err := NewAccruer(store, fixedClock(now)).RunOnce(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, batchSize+7, charged)
+}
+
+func TestRunOnce_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	errAccrue := errors.New("connection reset")
+	store := mock_database.NewMockStore(ctrl)
+	store.EXPECT().AccrueOverdraftInterestTx(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(1, errAccrue)
+
+	charged, err := NewAccruer(store, fixedClock(time.Now())).RunOnce(context.Background())
+	require.ErrorIs(t, err, errAccrue)
+	require.Equal(t, 1, charged)
+}
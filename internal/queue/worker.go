@@ -0,0 +1,54 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	db "github.com/Glenn444/banking-app/internal/database"
+)
+
+// DefaultStaleAfter is how long a transfer can stay processing before
+// another worker takes it over
+const DefaultStaleAfter = 5 * time.Minute
+
+// Worker processes submitted transfers. The transfers table is the queue,
+// transfers are claimed with SKIP LOCKED so several workers can run side by
+// side, and a transfer whose worker died is picked up again once it goes
+// stale.
+type Worker struct {
+	store      db.Store
+	staleAfter time.Duration
+}
+
+// NewWorker creates a worker, a zero staleAfter falls back to the default
+func NewWorker(store db.Store, staleAfter time.Duration) *Worker {
+	if staleAfter <= 0 {
+		staleAfter = DefaultStaleAfter
+	}
+	return &Worker{
+		store:      store,
+		staleAfter: staleAfter,
+	}
+}
+
+// RunOnce processes transfers until none are waiting and returns how many
+// it finished, completed or failed
+func (w *Worker) RunOnce(ctx context.Context) (int, error) {
+	finished := 0
+	for {
+		transfer, err := w.store.ClaimTransfer(ctx, time.Now().Add(-w.staleAfter))
+		if err != nil {
+			if errors.Is(err, db.ErrNotFound) {
+				return finished, nil
+			}
+			return finished, err
+		}
+
+		_, err = w.store.ProcessTransferTx(ctx, transfer.ID)
+		if err != nil && !errors.Is(err, db.ErrTransferNotQueued) {
+			return finished, err
+		}
+		finished++
+	}
+}
@@ -0,0 +1,91 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	db "github.com/Glenn444/banking-app/internal/database"
+	mock_database "github.com/Glenn444/banking-app/internal/database/mock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRunOnce(t *testing.T) {
+	transfer := db.Transfer{ID: uuid.New(), Status: db.TransferStatusProcessing}
+	errProcess := errors.New("connection reset")
+
+	testCases := []struct {
+		name       string
+		buildStubs func(store *mock_database.MockStore)
+		finished   int
+		err        error
+	}{
+		{
+			name: "ProcessesUntilEmpty",
+			buildStubs: func(store *mock_database.MockStore) {
+				gomock.InOrder(
+					store.EXPECT().ClaimTransfer(gomock.Any(), gomock.Any()).Return(transfer, nil),
+					store.EXPECT().
+						ProcessTransferTx(gomock.Any(), gomock.Eq(transfer.ID)).
+						Return(db.Transfer{ID: transfer.ID, Status: db.TransferStatusCompleted}, nil),
+					store.EXPECT().ClaimTransfer(gomock.Any(), gomock.Any()).Return(transfer, nil),
+					store.EXPECT().
+						ProcessTransferTx(gomock.Any(), gomock.Eq(transfer.ID)).
+						Return(db.Transfer{ID: transfer.ID, Status: db.TransferStatusFailed}, nil),
+					store.EXPECT().ClaimTransfer(gomock.Any(), gomock.Any()).Return(db.Transfer{}, db.ErrNotFound),
+				)
+			},
+			finished: 2,
+		},
+		{
+			name: "FinishedElsewhere",
+			buildStubs: func(store *mock_database.MockStore) {
+				gomock.InOrder(
+					store.EXPECT().ClaimTransfer(gomock.Any(), gomock.Any()).Return(transfer, nil),
+					store.EXPECT().
+						ProcessTransferTx(gomock.Any(), gomock.Any()).
+						Return(db.Transfer{}, db.ErrTransferNotQueued),
+					store.EXPECT().ClaimTransfer(gomock.Any(), gomock.Any()).Return(db.Transfer{}, db.ErrNotFound),
+				)
+			},
+			finished: 1,
+		},
+		{
+			name: "ProcessError",
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().ClaimTransfer(gomock.Any(), gomock.Any()).Times(1).Return(transfer, nil)
+				store.EXPECT().
+					ProcessTransferTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Transfer{}, errProcess)
+			},
+			err: errProcess,
+		},
+		{
+			name: "NothingWaiting",
+			buildStubs: func(store *mock_database.MockStore) {
+				store.EXPECT().ClaimTransfer(gomock.Any(), gomock.Any()).Times(1).Return(db.Transfer{}, db.ErrNotFound)
+				store.EXPECT().ProcessTransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_database.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			worker := NewWorker(store, 0)
+			finished, err := worker.RunOnce(context.Background())
+			require.ErrorIs(t, err, tc.err)
+			require.Equal(t, tc.finished, finished)
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package scheduler
+
+import "time"
+
+// Clock tells the scheduler what time it is, tests swap it for a fixed clock
+type Clock interface {
+	Now() time.Time
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+// RealClock returns a Clock backed by time.Now
+func RealClock() Clock {
+	return realClock{}
+}
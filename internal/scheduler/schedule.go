@@ -0,0 +1,71 @@
+package scheduler
+
+import (
+	"time"
+
+	db "github.com/Glenn444/banking-app/internal/database"
+)
+
+// NextOccurrence returns the occurrence that follows previous for a schedule
+// starting at start. It returns false when the schedule doesn't repeat.
+// Monthly schedules keep the start's day of month, clamped to the month's
+// length, and all schedules keep the start's time of day.
+func NextOccurrence(frequency string, start time.Time, previous time.Time) (time.Time, bool) {
+	previous = previous.In(start.Location())
+
+	switch frequency {
+	case db.FrequencyWeekly:
+		return previous.AddDate(0, 0, 7), true
+	case db.FrequencyMonthly:
+		year, month := nextMonth(previous)
+		day := min(start.Day(), daysIn(year, month))
+		return atTimeOf(start, year, month, day), true
+	case db.FrequencyMonthlyLastBusinessDay:
+		year, month := nextMonth(previous)
+		return atTimeOf(start, year, month, lastBusinessDay(year, month)), true
+	}
+	return time.Time{}, false
+}
+
+// FirstOccurrence returns the first run of a schedule starting at start
+func FirstOccurrence(frequency string, start time.Time) time.Time {
+	if frequency != db.FrequencyMonthlyLastBusinessDay {
+		return start
+	}
+
+	first := atTimeOf(start, start.Year(), start.Month(), lastBusinessDay(start.Year(), start.Month()))
+	if first.Before(start) {
+		// this month's last business day has already gone
+		first, _ = NextOccurrence(frequency, start, first)
+	}
+	return first
+}
+
+func nextMonth(t time.Time) (int, time.Month) {
+	if t.Month() == time.December {
+		return t.Year() + 1, time.January
+	}
+	return t.Year(), t.Month() + 1
+}
+
+func daysIn(year int, month time.Month) int {
+	// day 0 of the following month is the last day of this one
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// lastBusinessDay is the last Monday to Friday of the month, holidays aren't considered
+func lastBusinessDay(year int, month time.Month) int {
+	day := daysIn(year, month)
+	for {
+		switch time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Weekday() {
+		case time.Saturday, time.Sunday:
+			day--
+		default:
+			return day
+		}
+	}
+}
+
+func atTimeOf(start time.Time, year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, start.Hour(), start.Minute(), start.Second(), start.Nanosecond(), start.Location())
+}
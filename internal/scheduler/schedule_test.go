@@ -0,0 +1,112 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	db "github.com/Glenn444/banking-app/internal/database"
+	"github.com/stretchr/testify/require"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 9, 30, 0, 0, time.UTC)
+}
+
+func TestNextOccurrence(t *testing.T) {
+	testCases := []struct {
+		name      string
+		frequency string
+		start     time.Time
+		previous  time.Time
+		next      time.Time
+		repeats   bool
+	}{
+		{
+			name:      "Once",
+			frequency: db.FrequencyOnce,
+			start:     date(2026, time.March, 10),
+			previous:  date(2026, time.March, 10),
+		},
+		{
+			name:      "Weekly",
+			frequency: db.FrequencyWeekly,
+			start:     date(2026, time.March, 10),
+			previous:  date(2026, time.March, 31),
+			next:      date(2026, time.April, 7),
+			repeats:   true,
+		},
+		{
+			name:      "MonthlyClampsToShortMonth",
+			frequency: db.FrequencyMonthly,
+			start:     date(2026, time.January, 31),
+			previous:  date(2026, time.January, 31),
+			next:      date(2026, time.February, 28),
+			repeats:   true,
+		},
+		{
+			name:      "MonthlyKeepsStartDay",
+			frequency: db.FrequencyMonthly,
+			start:     date(2026, time.January, 31),
+			previous:  date(2026, time.February, 28),
+			next:      date(2026, time.March, 31),
+			repeats:   true,
+		},
+		{
+			name:      "MonthlyLeapYear",
+			frequency: db.FrequencyMonthly,
+			start:     date(2028, time.January, 30),
+			previous:  date(2028, time.January, 30),
+			next:      date(2028, time.February, 29),
+			repeats:   true,
+		},
+		{
+			name:      "MonthlyDecemberRollover",
+			frequency: db.FrequencyMonthly,
+			start:     date(2026, time.December, 15),
+			previous:  date(2026, time.December, 15),
+			next:      date(2027, time.January, 15),
+			repeats:   true,
+		},
+		{
+			// 31 Oct 2026 is a Saturday
+			name:      "LastBusinessDaySkipsWeekend",
+			frequency: db.FrequencyMonthlyLastBusinessDay,
+			start:     date(2026, time.September, 1),
+			previous:  date(2026, time.September, 30),
+			next:      date(2026, time.October, 30),
+			repeats:   true,
+		},
+		{
+			// 31 Jan 2027 is a Sunday
+			name:      "LastBusinessDayDecemberRollover",
+			frequency: db.FrequencyMonthlyLastBusinessDay,
+			start:     date(2026, time.December, 1),
+			previous:  date(2026, time.December, 31),
+			next:      date(2027, time.January, 29),
+			repeats:   true,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			next, ok := NextOccurrence(tc.frequency, tc.start, tc.previous)
+			require.Equal(t, tc.repeats, ok)
+			require.True(t, tc.next.Equal(next), "got %s", next)
+		})
+	}
+}
+
+func TestFirstOccurrence(t *testing.T) {
+	start := date(2026, time.October, 5)
+	require.Equal(t, start, FirstOccurrence(db.FrequencyMonthly, start))
+
+	// the last business day of October 2026 is Friday the 30th
+	first := FirstOccurrence(db.FrequencyMonthlyLastBusinessDay, start)
+	require.Equal(t, date(2026, time.October, 30), first)
+
+	// starting after it moves to November's, Monday the 30th
+	first = FirstOccurrence(db.FrequencyMonthlyLastBusinessDay, date(2026, time.October, 31))
+	require.Equal(t, date(2026, time.November, 30), first)
+}
@@ -0,0 +1,127 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	db "github.com/Glenn444/banking-app/internal/database"
+)
+
+// RetryPolicy decides how failed occurrences are retried
+type RetryPolicy struct {
+	// MaxAttempts is how many times an occurrence is tried before giving up
+	MaxAttempts int32
+	// Backoff is the wait after the first failure, it doubles after each one
+	Backoff time.Duration
+}
+
+// DefaultRetryPolicy tries an occurrence 3 times, 1h then 2h apart
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	Backoff:     time.Hour,
+}
+
+func (p RetryPolicy) delay(attempts int32) time.Duration {
+	return p.Backoff << (attempts - 1)
+}
+
+// batchSize caps how many due transfers a single pass picks up
+const batchSize = 100
+
+// Scheduler executes due scheduled transfers through
+// Store.ExecuteScheduledTransferTx. Every attempt is claimed with a run
+// record in the transaction that makes it, so an occurrence is never
+// executed twice even with several schedulers running.
+type Scheduler struct {
+	store  db.Store
+	clock  Clock
+	policy RetryPolicy
+}
+
+// New creates a scheduler, zero values in policy fall back to DefaultRetryPolicy
+func New(store db.Store, clock Clock, policy RetryPolicy) *Scheduler {
+	if policy.MaxAttempts <= 0 {
+		policy.MaxAttempts = DefaultRetryPolicy.MaxAttempts
+	}
+	if policy.Backoff <= 0 {
+		policy.Backoff = DefaultRetryPolicy.Backoff
+	}
+	return &Scheduler{
+		store:  store,
+		clock:  clock,
+		policy: policy,
+	}
+}
+
+// RunOnce executes every transfer that is due now and returns how many it attempted
+func (s *Scheduler) RunOnce(ctx context.Context) (int, error) {
+	due, err := s.store.ListDueScheduledTransfers(ctx, db.ListDueScheduledTransfersParams{
+		NextAttemptAt: s.clock.Now(),
+		Limit:         batchSize,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	attempted := 0
+	for _, scheduled := range due {
+		ok, err := s.execute(ctx, scheduled)
+		if err != nil {
+			return attempted, err
+		}
+		if ok {
+			attempted++
+		}
+	}
+	return attempted, nil
+}
+
+// execute runs one attempt of the current occurrence, it returns false when
+// another scheduler already made the attempt
+func (s *Scheduler) execute(ctx context.Context, scheduled db.ScheduledTransfer) (bool, error) {
+	attempt := scheduled.Attempts + 1
+	_, err := s.store.ExecuteScheduledTransferTx(ctx, db.ExecuteScheduledTransferTxParams{
+		Scheduled: scheduled,
+		Succeeded: s.advance(scheduled, attempt, false),
+		Failed:    s.advance(scheduled, attempt, true),
+	})
+	if errors.Is(err, db.ErrScheduleNotDue) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// advance works out the schedule's state after an attempt, the store adds
+// the reason of a failed one
+func (s *Scheduler) advance(scheduled db.ScheduledTransfer, attempt int32, failed bool) db.AdvanceScheduledTransferParams {
+	arg := db.AdvanceScheduledTransferParams{
+		ID:            scheduled.ID,
+		NextRunAt:     scheduled.NextRunAt,
+		NextAttemptAt: scheduled.NextAttemptAt,
+		Attempts:      attempt,
+		Status:        db.ScheduleStatusActive,
+	}
+
+	if failed {
+		if attempt < s.policy.MaxAttempts {
+			arg.NextAttemptAt = s.clock.Now().Add(s.policy.delay(attempt))
+			return arg
+		}
+	}
+
+	// the occurrence is done, either paid or given up on
+	next, ok := NextOccurrence(scheduled.Frequency, scheduled.StartAt, scheduled.NextRunAt)
+	if !ok || (scheduled.EndAt.Valid && next.After(scheduled.EndAt.Time)) {
+		arg.Status = db.ScheduleStatusCompleted
+		if failed && scheduled.Frequency == db.FrequencyOnce {
+			arg.Status = db.ScheduleStatusFailed
+		}
+		return arg
+	}
+
+	arg.NextRunAt = next
+	arg.NextAttemptAt = next
+	arg.Attempts = 0
+	return arg
+}
@@ -0,0 +1,227 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	db "github.com/Glenn444/banking-app/internal/database"
+	mock_database "github.com/Glenn444/banking-app/internal/database/mock"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type fixedClock time.Time
+
+func (c fixedClock) Now() time.Time {
+	return time.Time(c)
+}
+
+func randomSchedule(frequency string, nextRunAt time.Time) db.ScheduledTransfer {
+	return db.ScheduledTransfer{
+		ID:            uuid.New(),
+		Owner:         "owner",
+		FromAccountID: uuid.New(),
+		ToAccountID:   uuid.New(),
+		Amount:        decimal.NewFromInt(25),
+		Currency:      "USD",
+		Frequency:     frequency,
+		StartAt:       nextRunAt,
+		NextRunAt:     nextRunAt,
+		NextAttemptAt: nextRunAt,
+		Status:        db.ScheduleStatusActive,
+	}
+}
+
+func TestRunOnce(t *testing.T) {
+	now := date(2026, time.October, 19)
+	policy := RetryPolicy{MaxAttempts: 3, Backoff: time.Hour}
+
+	testCases := []struct {
+		name     string
+		schedule func() db.ScheduledTransfer
+		// succeeded and failed are the states the schedule is left in
+		succeeded func(scheduled db.ScheduledTransfer) db.AdvanceScheduledTransferParams
+		failed    func(scheduled db.ScheduledTransfer) db.AdvanceScheduledTransferParams
+		storeErr  error
+		attempted int
+		wantErr   bool
+	}{
+		{
+			name: "SuccessAdvancesToNextOccurrence",
+			schedule: func() db.ScheduledTransfer {
+				return randomSchedule(db.FrequencyWeekly, now)
+			},
+			succeeded: func(scheduled db.ScheduledTransfer) db.AdvanceScheduledTransferParams {
+				return db.AdvanceScheduledTransferParams{
+					ID:            scheduled.ID,
+					NextRunAt:     now.AddDate(0, 0, 7),
+					NextAttemptAt: now.AddDate(0, 0, 7),
+					Attempts:      0,
+					Status:        db.ScheduleStatusActive,
+				}
+			},
+			failed: func(scheduled db.ScheduledTransfer) db.AdvanceScheduledTransferParams {
+				return db.AdvanceScheduledTransferParams{
+					ID:            scheduled.ID,
+					NextRunAt:     now,
+					NextAttemptAt: now.Add(time.Hour),
+					Attempts:      1,
+					Status:        db.ScheduleStatusActive,
+				}
+			},
+			attempted: 1,
+		},
+		{
+			name: "OnceCompletes",
+			schedule: func() db.ScheduledTransfer {
+				return randomSchedule(db.FrequencyOnce, now)
+			},
+			succeeded: func(scheduled db.ScheduledTransfer) db.AdvanceScheduledTransferParams {
+				return db.AdvanceScheduledTransferParams{
+					ID:            scheduled.ID,
+					NextRunAt:     scheduled.NextRunAt,
+					NextAttemptAt: scheduled.NextAttemptAt,
+					Attempts:      1,
+					Status:        db.ScheduleStatusCompleted,
+				}
+			},
+			attempted: 1,
+		},
+		{
+			name: "FailureRetriesWithBackoff",
+			schedule: func() db.ScheduledTransfer {
+				scheduled := randomSchedule(db.FrequencyMonthly, now.Add(-time.Hour))
+				scheduled.Attempts = 1
+				return scheduled
+			},
+			failed: func(scheduled db.ScheduledTransfer) db.AdvanceScheduledTransferParams {
+				return db.AdvanceScheduledTransferParams{
+					ID:            scheduled.ID,
+					NextRunAt:     scheduled.NextRunAt,
+					NextAttemptAt: now.Add(2 * time.Hour),
+					Attempts:      2,
+					Status:        db.ScheduleStatusActive,
+				}
+			},
+			attempted: 1,
+		},
+		{
+			name: "RecurringGivesUpOnOccurrence",
+			schedule: func() db.ScheduledTransfer {
+				scheduled := randomSchedule(db.FrequencyMonthly, date(2026, time.October, 15))
+				scheduled.Attempts = 2
+				return scheduled
+			},
+			failed: func(scheduled db.ScheduledTransfer) db.AdvanceScheduledTransferParams {
+				return db.AdvanceScheduledTransferParams{
+					ID:            scheduled.ID,
+					NextRunAt:     date(2026, time.November, 15),
+					NextAttemptAt: date(2026, time.November, 15),
+					Attempts:      0,
+					Status:        db.ScheduleStatusActive,
+				}
+			},
+			attempted: 1,
+		},
+		{
+			name: "OnceFailsAfterMaxAttempts",
+			schedule: func() db.ScheduledTransfer {
+				scheduled := randomSchedule(db.FrequencyOnce, now.Add(-3*time.Hour))
+				scheduled.Attempts = 2
+				return scheduled
+			},
+			failed: func(scheduled db.ScheduledTransfer) db.AdvanceScheduledTransferParams {
+				return db.AdvanceScheduledTransferParams{
+					ID:            scheduled.ID,
+					NextRunAt:     scheduled.NextRunAt,
+					NextAttemptAt: scheduled.NextAttemptAt,
+					Attempts:      3,
+					Status:        db.ScheduleStatusFailed,
+				}
+			},
+			attempted: 1,
+		},
+		{
+			name: "PastEndAtCompletes",
+			schedule: func() db.ScheduledTransfer {
+				scheduled := randomSchedule(db.FrequencyWeekly, now)
+				scheduled.EndAt = pgtype.Timestamptz{Time: now.AddDate(0, 0, 3), Valid: true}
+				return scheduled
+			},
+			succeeded: func(scheduled db.ScheduledTransfer) db.AdvanceScheduledTransferParams {
+				return db.AdvanceScheduledTransferParams{
+					ID:            scheduled.ID,
+					NextRunAt:     scheduled.NextRunAt,
+					NextAttemptAt: scheduled.NextAttemptAt,
+					Attempts:      1,
+					Status:        db.ScheduleStatusCompleted,
+				}
+			},
+			attempted: 1,
+		},
+		{
+			name: "AlreadyClaimed",
+			schedule: func() db.ScheduledTransfer {
+				return randomSchedule(db.FrequencyWeekly, now)
+			},
+			storeErr:  fmt.Errorf("%w: attempt 1 was already made", db.ErrScheduleNotDue),
+			attempted: 0,
+		},
+		{
+			name: "StoreError",
+			schedule: func() db.ScheduledTransfer {
+				return randomSchedule(db.FrequencyWeekly, now)
+			},
+			storeErr:  errors.New("connection refused"),
+			attempted: 0,
+			wantErr:   true,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_database.NewMockStore(ctrl)
+			scheduled := tc.schedule()
+			store.EXPECT().
+				ListDueScheduledTransfers(gomock.Any(), gomock.Eq(db.ListDueScheduledTransfersParams{
+					NextAttemptAt: now,
+					Limit:         batchSize,
+				})).
+				Times(1).
+				Return([]db.ScheduledTransfer{scheduled}, nil)
+			store.EXPECT().
+				ExecuteScheduledTransferTx(gomock.Any(), gomock.Any()).
+				Times(1).
+				DoAndReturn(func(_ context.Context, arg db.ExecuteScheduledTransferTxParams) (db.ExecuteScheduledTransferTxResult, error) {
+					require.Equal(t, scheduled, arg.Scheduled)
+					if tc.succeeded != nil {
+						require.Equal(t, tc.succeeded(scheduled), arg.Succeeded)
+					}
+					if tc.failed != nil {
+						require.Equal(t, tc.failed(scheduled), arg.Failed)
+					}
+					return db.ExecuteScheduledTransferTxResult{}, tc.storeErr
+				})
+
+			s := New(store, fixedClock(now), policy)
+			attempted, err := s.RunOnce(context.Background())
+			if tc.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.attempted, attempted)
+		})
+	}
+}
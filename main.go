@@ -1,13 +1,31 @@
 package main
 
 import (
-	"database/sql"
+	"context"
+	"crypto/tls"
+	"errors"
 	"log"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
 
 	"github.com/Glenn444/banking-app/api"
+	"github.com/Glenn444/banking-app/gapi"
+	"github.com/Glenn444/banking-app/internal/batch"
 	db "github.com/Glenn444/banking-app/internal/database"
+	"github.com/Glenn444/banking-app/internal/expiry"
+	"github.com/Glenn444/banking-app/internal/health"
+	"github.com/Glenn444/banking-app/internal/interest"
+	"github.com/Glenn444/banking-app/internal/overdraft"
+	"github.com/Glenn444/banking-app/internal/queue"
+	"github.com/Glenn444/banking-app/internal/scheduler"
 	"github.com/Glenn444/banking-app/util"
-	_ "github.com/lib/pq"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
 
@@ -18,23 +36,239 @@ func main()  {
 		log.Fatal("error loading the config, ",err)
 	}
 	
-	var dbSource = config.DB_URL //os.Getenv("DB_URL")
-	var dbDriver = config.DBDriver
 	var Address = config.ServerAddress
 
-	conn, err := sql.Open(dbDriver,dbSource)
+	pool, err := db.NewPool(context.Background(), config)
 
 	if err != nil{
 		log.Fatal("cannot connect to db: ",err)
 	}
 
-	defer conn.Close()
+	defer pool.Close()
 
-	store := db.NewStore(conn)
+	store := db.NewStore(pool)
 	server,err := api.NewServer(config,store)
+	if err != nil {
+		log.Fatal("cannot create server: ", err)
+	}
 
-	err = server.Start(Address)
+	err = server.RefreshCurrencies(context.Background())
 	if err != nil{
-		log.Fatal("cannot start server: ",err)
+		log.Fatal("cannot load currencies: ",err)
+	}
+
+	// the workers stop once the servers have drained, so the transfers they
+	// accepted can still be processed
+	workerCtx, stopWorkers := context.WithCancel(context.Background())
+	var workers sync.WaitGroup
+	// the monitor runs the workers and tells readiness how they are doing
+	monitor := health.NewMonitor(scheduler.RealClock())
+	server.MonitorWorkers(monitor)
+
+	// the registry is reloaded on every instance, not just the one that
+	// served the change to a currency
+	currencyInterval := config.CurrencyInterval
+	if currencyInterval <= 0 {
+		currencyInterval = 30 * time.Second
+	}
+	workers.Go(func() {
+		monitor.Run(workerCtx, "currency refresher", currencyInterval, server.RefreshCurrencies)
+	})
+
+	schedulerInterval := config.SchedulerInterval
+	if schedulerInterval <= 0 {
+		schedulerInterval = time.Minute
+	}
+	transferScheduler := scheduler.New(store, scheduler.RealClock(), scheduler.RetryPolicy{
+		MaxAttempts: config.SchedulerMaxAttempts,
+		Backoff:     config.SchedulerRetryBackoff,
+	})
+	workers.Go(func() {
+		monitor.Run(workerCtx, "scheduler", schedulerInterval, health.IgnoreResult(transferScheduler.RunOnce))
+	})
+
+	batchInterval := config.BatchWorkerInterval
+	if batchInterval <= 0 {
+		batchInterval = 5 * time.Second
+	}
+	batchWorker := batch.NewWorker(store, config.BatchChunkSize, batch.DefaultStaleAfter)
+	workers.Go(func() {
+		monitor.Run(workerCtx, "batch worker", batchInterval, health.IgnoreResult(batchWorker.RunOnce))
+	})
+
+	transferInterval := config.TransferWorkerInterval
+	if transferInterval <= 0 {
+		transferInterval = time.Second
+	}
+	transferWorker := queue.NewWorker(store, queue.DefaultStaleAfter)
+	workers.Go(func() {
+		monitor.Run(workerCtx, "transfer worker", transferInterval, health.IgnoreResult(transferWorker.RunOnce))
+	})
+
+	holdInterval := config.HoldExpiryInterval
+	if holdInterval <= 0 {
+		holdInterval = time.Minute
+	}
+	holdExpirer := expiry.NewExpirer(store.ExpireHoldsTx)
+	workers.Go(func() {
+		monitor.Run(workerCtx, "hold expirer", holdInterval, health.IgnoreResult(holdExpirer.RunOnce))
+	})
+
+	approvalInterval := config.ApprovalExpiryInterval
+	if approvalInterval <= 0 {
+		approvalInterval = time.Minute
+	}
+	approvalExpirer := expiry.NewExpirer(store.ExpireTransferApprovalsTx)
+	workers.Go(func() {
+		monitor.Run(workerCtx, "approval expirer", approvalInterval, health.IgnoreResult(approvalExpirer.RunOnce))
+	})
+
+	escrowInterval := config.EscrowExpiryInterval
+	if escrowInterval <= 0 {
+		escrowInterval = time.Minute
+	}
+	escrowExpirer := expiry.NewExpirer(store.ExpireEscrowsTx)
+	workers.Go(func() {
+		monitor.Run(workerCtx, "escrow expirer", escrowInterval, health.IgnoreResult(escrowExpirer.RunOnce))
+	})
+
+	overdraftInterval := config.OverdraftInterval
+	if overdraftInterval <= 0 {
+		overdraftInterval = time.Hour
+	}
+	overdraftAccruer := overdraft.NewAccruer(store, scheduler.RealClock())
+	workers.Go(func() {
+		monitor.Run(workerCtx, "overdraft accruer", overdraftInterval, health.IgnoreResult(overdraftAccruer.RunOnce))
+	})
+
+	interestInterval := config.InterestInterval
+	if interestInterval <= 0 {
+		interestInterval = time.Hour
+	}
+	interestEngine := interest.NewEngine(store, scheduler.RealClock())
+	workers.Go(func() {
+		monitor.Run(workerCtx, "interest engine", interestInterval, health.IgnoreResult(interestEngine.RunOnce))
+	})
+
+	grpcAPI, err := gapi.NewServer(config, store)
+	if err != nil {
+		log.Fatal("cannot create gRPC server: ", err)
+	}
+	grpcServer := runGRPCServer(config, grpcAPI, server.TLSConfig())
+	gateway, gatewayServer := runGatewayServer(config, grpcAPI, server.TLSConfig())
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	serverErr := make(chan error, 1)
+	go func() {
+		serverErr <- server.Start(Address)
+	}()
+
+	select {
+	case err = <-serverErr:
+		if err != nil {
+			log.Print("cannot start server: ", err)
+		}
+	case <-ctx.Done():
+		log.Print("shutting down")
+	}
+	stop()
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), config.ShutdownTimeout)
+	defer cancel()
+
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		log.Print("http server didn't drain: ", err)
+	}
+	if err := gatewayServer.Shutdown(shutdownCtx); err != nil {
+		log.Print("gateway server didn't drain: ", err)
+	}
+	gateway.Stop()
+	stopGRPCServer(shutdownCtx, grpcServer)
+
+	stopWorkers()
+	workersDone := make(chan struct{})
+	go func() {
+		workers.Wait()
+		close(workersDone)
+	}()
+	select {
+	case <-workersDone:
+	case <-shutdownCtx.Done():
+		log.Print("workers didn't stop in time")
+	}
+	log.Print("server stopped")
+}
+
+// runGRPCServer serves the gRPC API on its own port, over TLS whenever the
+// HTTP server uses it
+func runGRPCServer(config util.Config, server *gapi.Server, tlsConfig *tls.Config) *grpc.Server {
+	listener, err := net.Listen("tcp", config.GRPCServerAddress)
+	if err != nil {
+		log.Fatal("cannot listen for gRPC: ", err)
+	}
+
+	var opts []grpc.ServerOption
+	if tlsConfig != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+	grpcServer := server.NewGRPCServer(opts...)
+	go func() {
+		log.Printf("serving gRPC at %s", listener.Addr())
+		if err := grpcServer.Serve(listener); err != nil {
+			log.Print("gRPC server stopped: ", err)
+		}
+	}()
+	return grpcServer
+}
+
+// stopGRPCServer waits for the calls in flight until ctx is done, then
+// cancels the ones left
+func stopGRPCServer(ctx context.Context, grpcServer *grpc.Server) {
+	stopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		log.Print("gRPC server didn't drain: ", ctx.Err())
+		grpcServer.Stop()
+	}
+}
+
+// runGatewayServer serves the gRPC API over HTTP/JSON, over TLS whenever
+// the HTTP server uses it
+func runGatewayServer(config util.Config, server *gapi.Server, tlsConfig *tls.Config) (*gapi.Gateway, *http.Server) {
+	gateway, err := server.NewGateway(context.Background())
+	if err != nil {
+		log.Fatal("cannot create gateway: ", err)
+	}
+
+	gatewayServer := &http.Server{
+		Addr:              config.GatewayServerAddress,
+		Handler:           gateway,
+		ReadTimeout:       config.HTTPReadTimeout,
+		ReadHeaderTimeout: config.HTTPReadHeaderTimeout,
+		WriteTimeout:      config.HTTPWriteTimeout,
+		IdleTimeout:       config.HTTPIdleTimeout,
+		MaxHeaderBytes:    config.HTTPMaxHeaderBytes,
+		TLSConfig:         tlsConfig,
 	}
-}
\ No newline at end of file
+	go func() {
+		log.Printf("serving gRPC gateway at %s", config.GatewayServerAddress)
+		var err error
+		if tlsConfig != nil {
+			err = gatewayServer.ListenAndServeTLS("", "")
+		} else {
+			err = gatewayServer.ListenAndServe()
+		}
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Print("gateway server stopped: ", err)
+		}
+	}()
+	return gateway, gatewayServer
+}
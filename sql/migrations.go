@@ -0,0 +1,38 @@
+// Package sql holds the goose migrations the database schema is built from
+// and the queries sqlc generates the database package from
+package sql
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"strconv"
+	"strings"
+)
+
+//go:embed schema/*.sql
+var migrations embed.FS
+
+// LatestVersion is the version of the newest migration, the schema version
+// a database is at once it has been migrated all the way up
+func LatestVersion() (int64, error) {
+	files, err := fs.Glob(migrations, "schema/*.sql")
+	if err != nil {
+		return 0, err
+	}
+
+	var latest int64
+	for _, file := range files {
+		name := strings.TrimPrefix(file, "schema/")
+		prefix, _, found := strings.Cut(name, "_")
+		if !found {
+			return 0, fmt.Errorf("migration %s has no version prefix", name)
+		}
+		version, err := strconv.ParseInt(prefix, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("migration %s has no version prefix: %w", name, err)
+		}
+		latest = max(latest, version)
+	}
+	return latest, nil
+}
@@ -0,0 +1,24 @@
+package sql
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLatestVersion(t *testing.T) {
+	version, err := LatestVersion()
+	require.NoError(t, err)
+
+	// the migrations are named after their timestamp, so the newest sorts last
+	files, err := filepath.Glob("schema/*.sql")
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+	sort.Strings(files)
+
+	newest := filepath.Base(files[len(files)-1])
+	require.Regexp(t, fmt.Sprintf("^%d_", version), newest)
+}
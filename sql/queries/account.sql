@@ -2,9 +2,11 @@
 INSERT INTO accounts(
     "owner",
     balance,
-    currency
+    available_balance,
+    currency,
+    account_type
 ) VALUES (
-    $1,$2,$3
+    $1,$2,$2,$3,$4
 ) RETURNING *;
 
 
@@ -26,11 +28,52 @@ LIMIT $2
 OFFSET $3;
 
 -- name: UpdateAccount :exec
+-- available_balance moves by the same amount, holds are unaffected
 UPDATE accounts
-  set balance = $2
+  set available_balance = available_balance + ($2 - balance),
+      balance = $2
 WHERE id = $1;
 
+-- name: AddAccountAvailableBalance :one
+UPDATE accounts
+  set available_balance = available_balance + sqlc.arg(amount)
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
 
 -- name: DeleteAccount :exec
 DELETE FROM accounts
 WHERE id = $1;
+
+
+-- name: ListAllAccountsByOwner :many
+SELECT * FROM accounts
+WHERE owner = $1
+ORDER BY currency;
+
+-- name: ListAccountsByIDs :many
+SELECT * FROM accounts
+WHERE id = ANY(sqlc.arg(ids)::uuid[]);
+
+-- name: ResolveRecipientAccount :one
+-- finds the account in a currency of the user known by a username, alias or
+-- verified email, preferring that order and a checking account, system users
+-- can't be resolved
+SELECT sqlc.embed(a), u.full_name FROM accounts a
+JOIN users u ON u.username = a."owner"
+WHERE a.currency = sqlc.arg(currency)
+  AND u."role" <> 'system'
+  AND (
+    u.username = sqlc.arg(handle)
+    OR lower(u.alias) = lower(sqlc.arg(handle))
+    OR (lower(u.email) = lower(sqlc.arg(handle)) AND u.email_verified_at IS NOT NULL)
+  )
+ORDER BY
+  CASE
+    WHEN u.username = sqlc.arg(handle) THEN 0
+    WHEN lower(u.alias) = lower(sqlc.arg(handle)) THEN 1
+    ELSE 2
+  END,
+  a.account_type = 'checking' DESC,
+  a.created_at
+LIMIT 1;
@@ -0,0 +1,23 @@
+-- name: ListCurrencies :many
+SELECT * FROM currencies
+ORDER BY code;
+
+-- name: GetCurrency :one
+SELECT * FROM currencies
+WHERE code = $1 LIMIT 1;
+
+-- name: UpdateCurrencyEnabled :one
+UPDATE currencies
+  set enabled = $2,
+      updated_at = now()
+WHERE code = $1
+RETURNING *;
+
+-- name: CreateSettlementAccount :exec
+INSERT INTO accounts(
+    "owner",
+    balance,
+    currency
+) VALUES (
+    'settlement', 0, $1
+) ON CONFLICT ("owner", currency, account_type) DO NOTHING;
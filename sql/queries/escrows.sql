@@ -0,0 +1,91 @@
+-- name: GetEscrowAccount :one
+SELECT * FROM accounts
+WHERE "owner" = 'escrow' AND currency = $1
+LIMIT 1;
+
+-- name: CreateEscrowAccount :exec
+INSERT INTO accounts(
+    "owner",
+    balance,
+    currency
+) VALUES (
+    'escrow', 0, $1
+) ON CONFLICT ("owner", currency, account_type) DO NOTHING;
+
+-- name: CreateEscrow :one
+INSERT INTO escrows(
+    payer,
+    payee,
+    from_account_id,
+    to_account_id,
+    escrow_account_id,
+    amount,
+    currency,
+    description,
+    funding_transfer_id,
+    expires_at
+) VALUES (
+    $1,$2,$3,$4,$5,$6,$7,$8,$9,$10
+) RETURNING *;
+
+-- name: GetEscrow :one
+SELECT * FROM escrows
+WHERE id = $1 LIMIT 1;
+
+-- name: GetEscrowForUpdate :one
+SELECT * FROM escrows
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: ListEscrows :many
+-- the escrows a user pays or is paid by, newest first
+SELECT * FROM escrows
+WHERE payer = sqlc.arg(username) OR payee = sqlc.arg(username)
+ORDER BY created_at DESC
+LIMIT sqlc.arg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: ListExpiredEscrows :many
+SELECT id FROM escrows
+WHERE status = 'funded' AND expires_at <= $1
+ORDER BY expires_at
+LIMIT $2;
+
+-- name: ConfirmEscrowPayer :one
+UPDATE escrows
+  set payer_confirmed_at = COALESCE(payer_confirmed_at, now()),
+      updated_at = now()
+WHERE id = $1
+RETURNING *;
+
+-- name: ConfirmEscrowPayee :one
+UPDATE escrows
+  set payee_confirmed_at = COALESCE(payee_confirmed_at, now()),
+      updated_at = now()
+WHERE id = $1
+RETURNING *;
+
+-- name: FinishEscrow :one
+UPDATE escrows
+  set status = $2,
+      settlement_transfer_id = $3,
+      updated_at = now()
+WHERE id = $1
+RETURNING *;
+
+-- name: CreateEscrowEvent :one
+INSERT INTO escrow_events(
+    escrow_id,
+    event,
+    from_status,
+    to_status,
+    actor,
+    reason
+) VALUES (
+    $1,$2,$3,$4,$5,$6
+) RETURNING *;
+
+-- name: ListEscrowEvents :many
+SELECT * FROM escrow_events
+WHERE escrow_id = $1
+ORDER BY id;
@@ -0,0 +1,90 @@
+-- name: CreateFeeSchedule :one
+-- adds the currency's next fee schedule version, two admins saving at once
+-- collide on fee_schedule_version_unique
+INSERT INTO fee_schedules(
+    currency,
+    version,
+    created_by
+) VALUES (
+    sqlc.arg(currency),
+    (SELECT COALESCE(MAX(version), 0) + 1 FROM fee_schedules WHERE currency = sqlc.arg(currency)),
+    sqlc.arg(created_by)
+) RETURNING *;
+
+-- name: GetCurrentFeeSchedule :one
+SELECT * FROM fee_schedules
+WHERE currency = $1
+ORDER BY version DESC
+LIMIT 1;
+
+-- name: GetFeeScheduleByVersion :one
+SELECT * FROM fee_schedules
+WHERE currency = $1 AND version = $2
+LIMIT 1;
+
+-- name: ListFeeSchedules :many
+SELECT * FROM fee_schedules
+WHERE currency = $1
+ORDER BY version DESC
+LIMIT $2
+OFFSET $3;
+
+-- name: CreateFeeRule :one
+INSERT INTO fee_rules(
+    schedule_id,
+    tier,
+    min_amount,
+    max_amount,
+    flat_fee,
+    percentage,
+    min_fee,
+    max_fee
+) VALUES (
+    $1,$2,$3,$4,$5,$6,$7,$8
+) RETURNING *;
+
+-- name: ListFeeRules :many
+SELECT * FROM fee_rules
+WHERE schedule_id = $1
+ORDER BY tier, min_amount;
+
+-- name: GetApplicableFeeRule :one
+-- the rule of the currency's current schedule whose band holds the amount
+SELECT r.* FROM fee_rules r
+WHERE r.schedule_id = (
+    SELECT s.id FROM fee_schedules s
+    WHERE s.currency = sqlc.arg(currency)
+    ORDER BY s.version DESC
+    LIMIT 1
+  )
+  AND r.tier = sqlc.arg(tier)
+  AND r.min_amount <= sqlc.arg(amount)
+  AND (r.max_amount IS NULL OR sqlc.arg(amount) < r.max_amount)
+LIMIT 1;
+
+-- name: SetTransferFee :one
+UPDATE transfers
+  set fee = $2,
+      fee_rule_id = $3
+WHERE id = $1
+RETURNING *;
+
+-- name: GetFeesAccount :one
+SELECT * FROM accounts
+WHERE "owner" = 'fees' AND currency = $1
+LIMIT 1;
+
+-- name: CreateFeesAccount :exec
+INSERT INTO accounts(
+    "owner",
+    balance,
+    currency
+) VALUES (
+    'fees', 0, $1
+) ON CONFLICT ("owner", currency, account_type) DO NOTHING;
+
+-- name: UpdateUserTier :one
+UPDATE users
+  set tier = $2
+WHERE username = $1
+RETURNING *;
@@ -0,0 +1,51 @@
+-- name: CreateFundingTransaction :one
+INSERT INTO funding_transactions(
+    account_id,
+    settlement_account_id,
+    kind,
+    amount,
+    currency,
+    provider
+) VALUES (
+    $1,$2,$3,$4,$5,$6
+) RETURNING *;
+
+
+-- name: GetFundingTransaction :one
+SELECT * FROM funding_transactions
+WHERE id = $1 LIMIT 1;
+
+
+-- name: GetFundingTransactionForUpdate :one
+SELECT * FROM funding_transactions
+WHERE id = $1 LIMIT 1
+FOR UPDATE;
+
+-- name: ListFundingTransactions :many
+SELECT * FROM funding_transactions
+WHERE account_id = $1
+ORDER BY created_at DESC
+LIMIT $2
+OFFSET $3;
+
+-- name: SetFundingProviderReference :one
+UPDATE funding_transactions
+  set provider_reference = $2,
+      updated_at = now()
+WHERE id = $1
+RETURNING *;
+
+-- name: UpdateFundingStatus :one
+UPDATE funding_transactions
+  set status = $2,
+      failure_reason = $3,
+      settled_at = sqlc.narg(settled_at),
+      updated_at = now()
+WHERE id = $1
+RETURNING *;
+
+
+-- name: GetSettlementAccount :one
+SELECT * FROM accounts
+WHERE "owner" = 'settlement' AND currency = $1
+LIMIT 1;
@@ -0,0 +1,40 @@
+-- name: CreateFxQuote :one
+INSERT INTO fx_quotes(
+    "owner",
+    from_currency,
+    to_currency,
+    rate,
+    from_amount,
+    to_amount,
+    expires_at
+) VALUES (
+    $1,$2,$3,$4,$5,$6,$7
+) RETURNING *;
+
+-- name: GetFxQuote :one
+SELECT * FROM fx_quotes
+WHERE id = $1 LIMIT 1;
+
+-- name: GetFxQuoteForUpdate :one
+SELECT * FROM fx_quotes
+WHERE id = $1 LIMIT 1
+FOR UPDATE;
+
+-- name: MarkFxQuoteUsed :exec
+UPDATE fx_quotes
+  set used_at = now()
+WHERE id = $1;
+
+-- name: GetFxPositionAccount :one
+SELECT * FROM accounts
+WHERE "owner" = 'fx_position' AND currency = $1
+LIMIT 1;
+
+-- name: CreateFxPositionAccount :exec
+INSERT INTO accounts(
+    "owner",
+    balance,
+    currency
+) VALUES (
+    'fx_position', 0, $1
+) ON CONFLICT ("owner", currency, account_type) DO NOTHING;
@@ -0,0 +1,42 @@
+-- name: CreateHold :one
+INSERT INTO holds(
+    account_id,
+    to_account_id,
+    amount,
+    currency,
+    description,
+    expires_at
+) VALUES (
+    $1,$2,$3,$4,$5,$6
+) RETURNING *;
+
+-- name: GetHold :one
+SELECT * FROM holds
+WHERE id = $1 LIMIT 1;
+
+-- name: GetHoldForUpdate :one
+SELECT * FROM holds
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: ListHolds :many
+SELECT * FROM holds
+WHERE account_id = $1
+ORDER BY created_at DESC
+LIMIT $2
+OFFSET $3;
+
+-- name: ListExpiredHolds :many
+SELECT id FROM holds
+WHERE status = 'active' AND expires_at <= $1
+ORDER BY expires_at
+LIMIT $2;
+
+-- name: FinishHold :one
+UPDATE holds
+  set status = $2,
+      captured_amount = $3,
+      transfer_id = $4,
+      updated_at = now()
+WHERE id = $1
+RETURNING *;
@@ -0,0 +1,146 @@
+-- name: CreateInterestProduct :one
+INSERT INTO interest_products(
+    name,
+    currency,
+    annual_rate,
+    day_count,
+    payout_frequency
+) VALUES (
+    $1,$2,$3,$4,$5
+) RETURNING *;
+
+-- name: GetInterestProduct :one
+SELECT * FROM interest_products
+WHERE id = $1 LIMIT 1;
+
+-- name: ListInterestProducts :many
+SELECT * FROM interest_products
+ORDER BY name;
+
+-- name: SetAccountInterestProduct :one
+UPDATE accounts
+  set interest_product_id = sqlc.narg(interest_product_id),
+      updated_at = now()
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: GetInterestExpenseAccount :one
+SELECT * FROM accounts
+WHERE "owner" = 'interest_expense' AND currency = $1
+LIMIT 1;
+
+-- name: CreateInterestExpenseAccount :exec
+INSERT INTO accounts(
+    "owner",
+    balance,
+    currency
+) VALUES (
+    'interest_expense', 0, $1
+) ON CONFLICT ("owner", currency, account_type) DO NOTHING;
+
+-- name: ListAccountsDueForAccrual :many
+-- accounts on an interest product that existed at the end of the business
+-- date and haven't been accrued for it
+SELECT a.id FROM accounts a
+WHERE a.interest_product_id IS NOT NULL
+  AND a.created_at < sqlc.arg(day_end)
+  AND NOT EXISTS (
+    SELECT 1 FROM interest_accruals ia
+    WHERE ia.account_id = a.id AND ia.business_date = sqlc.arg(business_date)
+  )
+ORDER BY a.id
+LIMIT sqlc.arg('limit');
+
+-- name: GetAccountBalanceAt :one
+-- the balance before any entry posted at or after the given time
+SELECT (a.balance - COALESCE((
+    SELECT SUM(e.amount) FROM entries e
+    WHERE e.account_id = a.id AND e.created_at >= sqlc.arg(at)::timestamptz
+), 0))::numeric AS balance
+FROM accounts a
+WHERE a.id = sqlc.arg(account_id);
+
+-- name: CreateInterestAccrual :one
+INSERT INTO interest_accruals(
+    account_id,
+    interest_product_id,
+    business_date,
+    balance,
+    annual_rate,
+    day_count,
+    amount
+) VALUES (
+    $1,$2,$3,$4,$5,$6,$7
+)
+ON CONFLICT (account_id, business_date) DO NOTHING
+RETURNING *;
+
+-- name: ListAccountsWithUnpaidAccruals :many
+-- accounts with interest accrued up to period_end that hasn't been paid out,
+-- with the payout frequency of their product, monthly once they left it
+SELECT DISTINCT ia.account_id,
+    COALESCE(p.payout_frequency, 'monthly')::varchar AS payout_frequency
+FROM interest_accruals ia
+JOIN accounts a ON a.id = ia.account_id
+LEFT JOIN interest_products p ON p.id = a.interest_product_id
+WHERE ia.payout_id IS NULL AND ia.business_date <= sqlc.arg(period_end)
+ORDER BY ia.account_id;
+
+-- name: SumUnpaidInterestAccruals :one
+-- the unpaid accruals up to period_end plus the residue the account's last
+-- payout carried over
+SELECT (COALESCE((
+    SELECT SUM(ia.amount) FROM interest_accruals ia
+    WHERE ia.account_id = sqlc.arg(account_id) AND ia.payout_id IS NULL
+      AND ia.business_date <= sqlc.arg(period_end)
+), 0) + COALESCE((
+    SELECT ip.residue FROM interest_payouts ip
+    WHERE ip.account_id = sqlc.arg(account_id) AND ip.period_end < sqlc.arg(period_end)
+    ORDER BY ip.period_end DESC
+    LIMIT 1
+), 0))::numeric AS accrued;
+
+-- name: CreateInterestPayout :one
+INSERT INTO interest_payouts(
+    account_id,
+    period_end,
+    accrued_amount,
+    amount,
+    residue
+) VALUES (
+    $1,$2,$3,$4,$5
+)
+ON CONFLICT (account_id, period_end) DO NOTHING
+RETURNING *;
+
+-- name: MarkInterestAccrualsPaid :execrows
+UPDATE interest_accruals
+  set payout_id = sqlc.arg(payout_id)
+WHERE account_id = sqlc.arg(account_id) AND payout_id IS NULL
+  AND business_date <= sqlc.arg(period_end);
+
+-- name: ListInterestPayouts :many
+SELECT * FROM interest_payouts
+WHERE account_id = $1
+ORDER BY period_end DESC
+LIMIT $2
+OFFSET $3;
+
+-- name: GetLastInterestRun :one
+SELECT * FROM interest_runs
+ORDER BY business_date DESC
+LIMIT 1;
+
+-- name: CreateInterestRun :one
+INSERT INTO interest_runs(
+    business_date,
+    accrued,
+    paid_out
+) VALUES (
+    $1,$2,$3
+)
+ON CONFLICT (business_date) DO UPDATE
+  set accrued = interest_runs.accrued + EXCLUDED.accrued,
+      paid_out = interest_runs.paid_out + EXCLUDED.paid_out,
+      completed_at = now()
+RETURNING *;
@@ -0,0 +1,22 @@
+-- name: CreateNotification :one
+INSERT INTO notifications(
+    username,
+    kind,
+    payload
+) VALUES (
+    $1,$2,$3
+) RETURNING *;
+
+-- name: ListNotifications :many
+SELECT * FROM notifications
+WHERE username = sqlc.arg(username)
+  AND (NOT sqlc.arg(unread_only)::boolean OR read_at IS NULL)
+ORDER BY created_at DESC
+LIMIT sqlc.arg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: MarkNotificationRead :one
+UPDATE notifications
+  set read_at = COALESCE(read_at, now())
+WHERE id = $1 AND username = $2
+RETURNING *;
@@ -0,0 +1,150 @@
+-- name: CreateOrganisation :one
+INSERT INTO organisations(
+    "name",
+    approval_threshold,
+    approval_threshold_currency,
+    required_approvals,
+    approval_ttl_seconds,
+    created_by
+) VALUES (
+    $1,$2,$3,$4,$5,$6
+) RETURNING *;
+
+-- name: GetOrganisation :one
+SELECT * FROM organisations
+WHERE id = $1 LIMIT 1;
+
+-- name: UpdateOrganisationPolicy :one
+UPDATE organisations
+  set approval_threshold = $2,
+      approval_threshold_currency = $3,
+      required_approvals = $4,
+      approval_ttl_seconds = $5,
+      updated_at = now()
+WHERE id = $1
+RETURNING *;
+
+-- name: ListUserOrganisations :many
+SELECT DISTINCT o.* FROM organisations o
+JOIN organisation_members m ON m.organisation_id = o.id
+WHERE m.username = $1
+ORDER BY o.created_at;
+
+-- name: AddOrganisationMember :one
+INSERT INTO organisation_members(
+    organisation_id,
+    username,
+    "role"
+) VALUES (
+    $1,$2,$3
+) ON CONFLICT (organisation_id, username, "role") DO UPDATE
+  set "role" = EXCLUDED."role"
+RETURNING *;
+
+-- name: RemoveOrganisationMember :execrows
+DELETE FROM organisation_members
+WHERE organisation_id = $1 AND username = $2 AND "role" = $3;
+
+-- name: ListOrganisationMembers :many
+SELECT * FROM organisation_members
+WHERE organisation_id = $1
+ORDER BY username, "role";
+
+-- name: HasOrganisationRole :one
+SELECT EXISTS (
+    SELECT 1 FROM organisation_members
+    WHERE organisation_id = $1 AND username = $2 AND "role" = $3
+);
+
+-- name: SetAccountOrganisation :one
+UPDATE accounts
+  set organisation_id = $2,
+      updated_at = now()
+WHERE id = $1
+RETURNING *;
+
+-- name: CreateTransferApproval :one
+INSERT INTO transfer_approvals(
+    organisation_id,
+    from_account_id,
+    to_account_id,
+    amount,
+    currency,
+    description,
+    external_reference,
+    metadata,
+    initiated_by,
+    required_approvals,
+    expires_at,
+    reserved_amount
+) VALUES (
+    $1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12
+) RETURNING *;
+
+-- name: GetTransferApproval :one
+SELECT * FROM transfer_approvals
+WHERE id = $1 LIMIT 1;
+
+-- name: GetTransferApprovalForUpdate :one
+SELECT * FROM transfer_approvals
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: ListTransferApprovals :many
+-- an organisation's transfer approvals, status filters them when set
+SELECT * FROM transfer_approvals
+WHERE organisation_id = sqlc.arg(organisation_id)
+  AND (sqlc.narg(status)::varchar IS NULL OR status = sqlc.narg(status))
+ORDER BY created_at DESC
+LIMIT sqlc.arg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: ListExpiredTransferApprovals :many
+SELECT id FROM transfer_approvals
+WHERE status = 'pending_approval' AND expires_at <= $1
+ORDER BY expires_at
+LIMIT $2;
+
+-- name: AddTransferApproval :one
+UPDATE transfer_approvals
+  set approvals = approvals + 1,
+      updated_at = now()
+WHERE id = $1
+RETURNING *;
+
+-- name: FinishTransferApproval :one
+UPDATE transfer_approvals
+  set status = $2,
+      transfer_id = $3,
+      updated_at = now()
+WHERE id = $1
+RETURNING *;
+
+-- name: CreateApprovalAuditEntry :one
+-- fails with a unique violation when the actor already approved
+INSERT INTO approval_audit_log(
+    approval_id,
+    organisation_id,
+    actor,
+    decision,
+    reason
+) VALUES (
+    $1,$2,$3,$4,$5
+) RETURNING *;
+
+-- name: ListApprovalAuditLog :many
+SELECT * FROM approval_audit_log
+WHERE approval_id = $1
+ORDER BY id;
+
+-- name: HasApprovalDecision :one
+SELECT EXISTS (
+    SELECT 1 FROM approval_audit_log
+    WHERE approval_id = $1 AND actor = $2 AND decision = $3
+);
+
+-- name: IsOrganisationMember :one
+SELECT EXISTS (
+    SELECT 1 FROM organisation_members
+    WHERE organisation_id = $1 AND username = $2
+);
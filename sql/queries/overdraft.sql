@@ -0,0 +1,58 @@
+-- name: SetAccountOverdraft :one
+UPDATE accounts
+  set overdraft_limit = sqlc.arg(overdraft_limit),
+      overdraft_interest_rate = sqlc.arg(overdraft_interest_rate),
+      updated_at = now()
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: GetOverdraftInterestAccount :one
+SELECT * FROM accounts
+WHERE "owner" = 'overdraft_interest' AND currency = $1
+LIMIT 1;
+
+-- name: CreateOverdraftInterestAccount :exec
+INSERT INTO accounts(
+    "owner",
+    balance,
+    currency
+) VALUES (
+    'overdraft_interest', 0, $1
+) ON CONFLICT ("owner", currency, account_type) DO NOTHING;
+
+-- name: ListOverdrawnAccountsDue :many
+-- accounts that closed the business date overdrawn and haven't been charged
+-- interest for it, the closing balance leaves out entries posted since
+SELECT a.id FROM accounts a
+WHERE a.overdraft_interest_rate > 0
+  AND a.created_at < sqlc.arg(day_end)
+  AND a.balance - COALESCE((
+    SELECT SUM(e.amount) FROM entries e
+    WHERE e.account_id = a.id AND e.created_at >= sqlc.arg(day_end)
+  ), 0) < 0
+  AND NOT EXISTS (
+    SELECT 1 FROM overdraft_interest_charges c
+    WHERE c.account_id = a.id AND c.business_date = sqlc.arg(business_date)
+  )
+ORDER BY a.id
+LIMIT sqlc.arg('limit');
+
+-- name: CreateOverdraftInterestCharge :one
+INSERT INTO overdraft_interest_charges(
+    account_id,
+    business_date,
+    balance,
+    interest_rate,
+    amount
+) VALUES (
+    $1,$2,$3,$4,$5
+)
+ON CONFLICT (account_id, business_date) DO NOTHING
+RETURNING *;
+
+-- name: ListOverdraftInterestCharges :many
+SELECT * FROM overdraft_interest_charges
+WHERE account_id = $1
+ORDER BY business_date DESC
+LIMIT $2
+OFFSET $3;
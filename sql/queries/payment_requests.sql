@@ -0,0 +1,43 @@
+-- name: CreatePaymentRequest :one
+INSERT INTO payment_requests(
+    requester,
+    payer,
+    amount,
+    currency,
+    memo,
+    expires_at
+) VALUES (
+    $1,$2,$3,$4,$5,$6
+) RETURNING *;
+
+-- name: GetPaymentRequest :one
+SELECT * FROM payment_requests
+WHERE id = $1 LIMIT 1;
+
+-- name: GetPaymentRequestForUpdate :one
+SELECT * FROM payment_requests
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: ListIncomingPaymentRequests :many
+-- requests the payer can still accept or decline, oldest first
+SELECT * FROM payment_requests
+WHERE payer = sqlc.arg(payer) AND status = 'pending' AND expires_at > sqlc.arg(now)
+ORDER BY created_at
+LIMIT sqlc.arg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: ListOutgoingPaymentRequests :many
+SELECT * FROM payment_requests
+WHERE requester = $1
+ORDER BY created_at DESC
+LIMIT $2
+OFFSET $3;
+
+-- name: FinishPaymentRequest :one
+UPDATE payment_requests
+  set status = $2,
+      transfer_id = $3,
+      updated_at = now()
+WHERE id = $1
+RETURNING *;
@@ -0,0 +1,88 @@
+-- name: CreateScheduledTransfer :one
+INSERT INTO scheduled_transfers(
+    "owner",
+    from_account_id,
+    to_account_id,
+    amount,
+    currency,
+    frequency,
+    start_at,
+    end_at,
+    next_run_at,
+    next_attempt_at
+) VALUES (
+    $1,$2,$3,$4,$5,$6,$7,$8,$9,$9
+) RETURNING *;
+
+-- name: GetScheduledTransfer :one
+SELECT * FROM scheduled_transfers
+WHERE id = $1 LIMIT 1;
+
+-- name: GetScheduledTransferForUpdate :one
+SELECT * FROM scheduled_transfers
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: ListScheduledTransfers :many
+SELECT * FROM scheduled_transfers
+WHERE "owner" = $1
+ORDER BY next_run_at
+LIMIT $2
+OFFSET $3;
+
+-- name: ListDueScheduledTransfers :many
+SELECT * FROM scheduled_transfers
+WHERE status = 'active' AND next_attempt_at <= $1
+ORDER BY next_attempt_at
+LIMIT $2;
+
+-- name: UpdateScheduledTransfer :one
+UPDATE scheduled_transfers
+  set amount = $2,
+      status = $3,
+      end_at = $4,
+      updated_at = now()
+WHERE id = $1
+RETURNING *;
+
+-- name: AdvanceScheduledTransfer :one
+UPDATE scheduled_transfers
+  set next_run_at = $2,
+      next_attempt_at = $3,
+      attempts = $4,
+      status = $5,
+      last_error = $6,
+      updated_at = now()
+WHERE id = $1
+RETURNING *;
+
+-- name: CreateScheduledTransferRun :one
+INSERT INTO scheduled_transfer_runs(
+    scheduled_transfer_id,
+    scheduled_for,
+    attempt
+) VALUES (
+    $1,$2,$3
+)
+-- an attempt that is still running was claimed by a scheduler that
+-- stopped before recording how it went, it can be claimed again
+ON CONFLICT ON CONSTRAINT run_attempt_key DO UPDATE
+  set created_at = now()
+  WHERE scheduled_transfer_runs.status = 'running'
+RETURNING *;
+
+-- name: FinishScheduledTransferRun :one
+UPDATE scheduled_transfer_runs
+  set status = $2,
+      transfer_id = $3,
+      error = $4,
+      finished_at = now()
+WHERE id = $1
+RETURNING *;
+
+-- name: ListScheduledTransferRuns :many
+SELECT * FROM scheduled_transfer_runs
+WHERE scheduled_transfer_id = $1
+ORDER BY created_at DESC
+LIMIT $2
+OFFSET $3;
@@ -0,0 +1,97 @@
+-- name: CreateTransferBatch :one
+INSERT INTO transfer_batches(
+    "owner",
+    from_account_id,
+    currency,
+    mode,
+    total_items,
+    total_amount
+) VALUES (
+    $1,$2,$3,$4,$5,$6
+) RETURNING *;
+
+-- name: CreateTransferBatchItem :exec
+INSERT INTO transfer_batch_items(
+    batch_id,
+    line,
+    to_account_id,
+    amount
+) VALUES (
+    $1,$2,$3,$4
+);
+
+-- name: GetTransferBatch :one
+SELECT * FROM transfer_batches
+WHERE id = $1 LIMIT 1;
+
+-- name: GetTransferBatchForUpdate :one
+SELECT * FROM transfer_batches
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: ListTransferBatches :many
+SELECT * FROM transfer_batches
+WHERE "owner" = $1
+ORDER BY created_at DESC
+LIMIT $2
+OFFSET $3;
+
+-- name: ClaimTransferBatch :one
+-- picks the oldest pending batch, or one whose worker stopped updating it
+UPDATE transfer_batches
+  set status = 'processing',
+      updated_at = now()
+WHERE id = (
+    SELECT b.id FROM transfer_batches b
+    WHERE b.status = 'pending'
+       OR (b.status = 'processing' AND b.updated_at < sqlc.arg(stale_before))
+    ORDER BY b.created_at
+    LIMIT 1
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: AddTransferBatchProgress :one
+UPDATE transfer_batches
+  set succeeded_items = succeeded_items + sqlc.arg(succeeded),
+      failed_items = failed_items + sqlc.arg(failed),
+      updated_at = now()
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: FinishTransferBatch :one
+UPDATE transfer_batches
+  set status = $2,
+      error = $3,
+      updated_at = now(),
+      completed_at = now()
+WHERE id = $1
+RETURNING *;
+
+-- name: ListTransferBatchItems :many
+SELECT * FROM transfer_batch_items
+WHERE batch_id = $1
+ORDER BY line
+LIMIT $2
+OFFSET $3;
+
+-- name: ListPendingTransferBatchItems :many
+SELECT * FROM transfer_batch_items
+WHERE batch_id = $1 AND status = 'pending'
+ORDER BY line
+LIMIT $2;
+
+-- name: FinishTransferBatchItem :exec
+UPDATE transfer_batch_items
+  set status = $2,
+      transfer_id = $3,
+      error = $4,
+      processed_at = now()
+WHERE id = $1;
+
+-- name: FailPendingTransferBatchItems :execrows
+UPDATE transfer_batch_items
+  set status = 'failed',
+      error = $2,
+      processed_at = now()
+WHERE batch_id = $1 AND status = 'pending';
@@ -0,0 +1,69 @@
+-- name: GetAccountTransferLimit :one
+SELECT * FROM transfer_limits
+WHERE account_id = $1 LIMIT 1;
+
+-- name: GetUserTransferLimit :one
+SELECT * FROM transfer_limits
+WHERE "owner" = $1 AND currency = $2 LIMIT 1;
+
+-- name: UpsertAccountTransferLimit :one
+INSERT INTO transfer_limits(
+    account_id,
+    currency,
+    max_single_amount,
+    daily_amount,
+    monthly_amount,
+    hourly_count
+) VALUES (
+    $1,$2,$3,$4,$5,$6
+)
+ON CONFLICT (account_id) WHERE account_id IS NOT NULL DO UPDATE
+  set max_single_amount = EXCLUDED.max_single_amount,
+      daily_amount = EXCLUDED.daily_amount,
+      monthly_amount = EXCLUDED.monthly_amount,
+      hourly_count = EXCLUDED.hourly_count,
+      updated_at = now()
+RETURNING *;
+
+-- name: UpsertUserTransferLimit :one
+INSERT INTO transfer_limits(
+    "owner",
+    currency,
+    max_single_amount,
+    daily_amount,
+    monthly_amount,
+    hourly_count
+) VALUES (
+    $1,$2,$3,$4,$5,$6
+)
+ON CONFLICT ("owner", currency) WHERE "owner" IS NOT NULL DO UPDATE
+  set max_single_amount = EXCLUDED.max_single_amount,
+      daily_amount = EXCLUDED.daily_amount,
+      monthly_amount = EXCLUDED.monthly_amount,
+      hourly_count = EXCLUDED.hourly_count,
+      updated_at = now()
+RETURNING *;
+
+-- name: DeleteTransferLimit :execrows
+DELETE FROM transfer_limits
+WHERE id = $1;
+
+-- name: GetAccountOutgoingUsage :one
+SELECT
+    COALESCE(SUM(amount) FILTER (WHERE created_at >= sqlc.arg(day_start)), 0)::numeric AS day_total,
+    COALESCE(SUM(amount), 0)::numeric AS month_total,
+    COUNT(*) FILTER (WHERE created_at >= sqlc.arg(hour_start)) AS hour_count
+FROM transfers
+WHERE from_account_id = sqlc.arg(account_id) AND created_at >= sqlc.arg(month_start)
+  AND id <> sqlc.arg(exclude_transfer_id) AND status <> 'failed';
+
+-- name: GetUserOutgoingUsage :one
+SELECT
+    COALESCE(SUM(t.amount) FILTER (WHERE t.created_at >= sqlc.arg(day_start)), 0)::numeric AS day_total,
+    COALESCE(SUM(t.amount), 0)::numeric AS month_total,
+    COUNT(*) FILTER (WHERE t.created_at >= sqlc.arg(hour_start)) AS hour_count
+FROM transfers t
+JOIN accounts a ON a.id = t.from_account_id
+WHERE a."owner" = sqlc.arg(owner) AND a.currency = sqlc.arg(currency)
+  AND t.created_at >= sqlc.arg(month_start)
+  AND t.id <> sqlc.arg(exclude_transfer_id) AND t.status <> 'failed';
@@ -26,4 +26,103 @@ WHERE id = $1;
 
 -- name: DeleteTransfer :exec
 DELETE FROM transfers
-WHERE id = $1;
\ No newline at end of file
+WHERE id = $1;
+
+-- name: CreateFxTransfer :one
+INSERT INTO transfers(
+    from_account_id,
+    to_account_id,
+    amount,
+    fx_quote_id,
+    converted_amount
+) VALUES (
+    $1,$2,$3,$4,$5
+) RETURNING *;
+
+-- name: SetTransferDetails :one
+UPDATE transfers
+  set description = $2,
+      external_reference = $3,
+      metadata = $4
+WHERE id = $1
+RETURNING *;
+
+-- name: GetTransferByExternalReference :one
+SELECT * FROM transfers
+WHERE from_account_id = $1 AND external_reference = $2
+LIMIT 1;
+
+-- name: SearchAccountTransfers :many
+-- an account's transfers either way, newest first. search matches the
+-- description or reference, metadata matches transfers containing it.
+SELECT * FROM transfers
+WHERE (from_account_id = sqlc.arg(account_id) OR to_account_id = sqlc.arg(account_id))
+  AND (
+    sqlc.narg(search)::text IS NULL
+    OR strpos(lower(description), lower(sqlc.narg(search))) > 0
+    OR strpos(lower(COALESCE(external_reference, '')), lower(sqlc.narg(search))) > 0
+  )
+  AND (sqlc.narg(external_reference)::text IS NULL OR external_reference = sqlc.narg(external_reference))
+  AND metadata @> sqlc.arg(metadata)::jsonb
+ORDER BY created_at DESC, id
+LIMIT sqlc.arg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: CreatePendingTransfer :one
+-- queues a transfer for a worker, its money moves when it is processed
+INSERT INTO transfers(
+    from_account_id,
+    to_account_id,
+    amount,
+    status,
+    completed_at
+) VALUES (
+    $1,$2,$3,'pending',NULL
+) RETURNING *;
+
+-- name: GetTransferForUpdate :one
+SELECT * FROM transfers
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: ClaimTransfer :one
+-- picks the oldest pending transfer, or one whose worker stopped processing it
+UPDATE transfers
+  set status = 'processing',
+      processing_at = now(),
+      updated_at = now()
+WHERE id = (
+    SELECT t.id FROM transfers t
+    WHERE t.status = 'pending'
+       OR (t.status = 'processing' AND t.processing_at < sqlc.arg(stale_before)::timestamptz)
+    ORDER BY t.created_at
+    LIMIT 1
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: CompleteTransfer :one
+UPDATE transfers
+  set status = 'completed',
+      completed_at = now(),
+      updated_at = now()
+WHERE id = $1
+RETURNING *;
+
+-- name: FailTransfer :one
+UPDATE transfers
+  set status = 'failed',
+      failure_reason = $2,
+      failed_at = now(),
+      updated_at = now()
+WHERE id = $1
+RETURNING *;
+
+-- name: ReverseTransfer :one
+UPDATE transfers
+  set status = 'reversed',
+      reversal_transfer_id = $2,
+      reversed_at = now(),
+      updated_at = now()
+WHERE id = $1
+RETURNING *;
@@ -19,4 +19,21 @@ ORDER BY username;
 -- name: UpdateRefreshToken :exec
 UPDATE users
 SET refresh_token = $2
-WHERE username = $1;
\ No newline at end of file
+WHERE username = $1;
+-- name: LockUserForTransfer :one
+-- serialises transfers across a user's accounts while their limits are checked
+SELECT username FROM users
+WHERE username = $1
+FOR NO KEY UPDATE;
+
+-- name: SetUserAlias :one
+UPDATE users
+  set alias = sqlc.narg(alias)
+WHERE username = sqlc.arg(username)
+RETURNING *;
+
+-- name: MarkUserEmailVerified :one
+UPDATE users
+  set email_verified_at = COALESCE(email_verified_at, now())
+WHERE username = $1
+RETURNING *;
@@ -0,0 +1,46 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- system user that owns the per-currency settlement accounts.
+-- it has no usable password so it can never log in.
+INSERT INTO "users" ("username", "hashed_password", "full_name", "email")
+VALUES ('settlement', '', 'Settlement', 'settlement@system.local');
+
+INSERT INTO accounts ("owner", balance, currency)
+VALUES ('settlement', 0, 'USD'),
+       ('settlement', 0, 'EUR'),
+       ('settlement', 0, 'CAD');
+
+CREATE TABLE funding_transactions(
+    id uuid PRIMARY KEY default gen_random_uuid(),
+    account_id uuid NOT NULL,
+    settlement_account_id uuid NOT NULL,
+    kind varchar(20) NOT NULL,
+    amount numeric(9,2) NOT NULL,
+    currency varchar(100) NOT NULL,
+    status varchar(20) NOT NULL default 'pending',
+    provider varchar(100) NOT NULL,
+    provider_reference varchar(256) NOT NULL default '',
+    failure_reason text NOT NULL default '',
+    settled_at timestamptz,
+    created_at timestamptz NOT NULL default now(),
+    updated_at timestamptz NOT NULL default now(),
+
+    CONSTRAINT funding_amount_positive CHECK (amount > 0),
+    CONSTRAINT funding_kind_valid CHECK (kind IN ('deposit', 'withdrawal')),
+    CONSTRAINT funding_status_valid CHECK (status IN ('pending', 'settled', 'failed')),
+    CONSTRAINT fk_funding_account FOREIGN KEY (account_id) REFERENCES accounts(id) ON DELETE cascade,
+    CONSTRAINT fk_funding_settlement_account FOREIGN KEY (settlement_account_id) REFERENCES accounts(id)
+);
+
+CREATE INDEX idx_funding_transactions_account_id ON funding_transactions(account_id);
+CREATE INDEX idx_funding_transactions_status ON funding_transactions(status);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS funding_transactions;
+DELETE FROM accounts WHERE "owner" = 'settlement';
+DELETE FROM "users" WHERE "username" = 'settlement';
+-- +goose StatementEnd
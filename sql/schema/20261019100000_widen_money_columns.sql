@@ -0,0 +1,65 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- numeric(24,4) holds every ISO 4217 minor unit (max 4 decimals),
+-- the per-currency scale is enforced by the application
+ALTER TABLE accounts ALTER COLUMN balance TYPE numeric(24,4);
+ALTER TABLE transfers ALTER COLUMN amount TYPE numeric(24,4);
+ALTER TABLE entries ALTER COLUMN amount TYPE numeric(24,4);
+ALTER TABLE funding_transactions ALTER COLUMN amount TYPE numeric(24,4);
+
+CREATE OR REPLACE FUNCTION validate_transfer_balance()
+RETURNS TRIGGER AS $$
+DECLARE
+    current_balance numeric(24,4);
+BEGIN
+    -- Get the current balance of the from_account
+    SELECT balance INTO current_balance
+    FROM accounts
+    WHERE id = NEW.from_account_id;
+
+    -- Check if sufficient balance exists
+    IF current_balance < NEW.amount THEN
+        RAISE EXCEPTION 'Insufficient balance. Available: %, Required: %',
+            current_balance, NEW.amount;
+    END IF;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+INSERT INTO accounts ("owner", balance, currency)
+VALUES ('settlement', 0, 'JPY'),
+       ('settlement', 0, 'BHD');
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM accounts WHERE "owner" = 'settlement' AND currency IN ('JPY', 'BHD');
+
+CREATE OR REPLACE FUNCTION validate_transfer_balance()
+RETURNS TRIGGER AS $$
+DECLARE
+    current_balance numeric(9,2);
+BEGIN
+    -- Get the current balance of the from_account
+    SELECT balance INTO current_balance
+    FROM accounts
+    WHERE id = NEW.from_account_id;
+
+    -- Check if sufficient balance exists
+    IF current_balance < NEW.amount THEN
+        RAISE EXCEPTION 'Insufficient balance. Available: %, Required: %',
+            current_balance, NEW.amount;
+    END IF;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+ALTER TABLE funding_transactions ALTER COLUMN amount TYPE numeric(9,2);
+ALTER TABLE entries ALTER COLUMN amount TYPE numeric(9,2);
+ALTER TABLE transfers ALTER COLUMN amount TYPE numeric(9,2);
+ALTER TABLE accounts ALTER COLUMN balance TYPE numeric(9,2);
+-- +goose StatementEnd
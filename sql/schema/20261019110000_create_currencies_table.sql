@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE currencies(
+    code varchar(3) PRIMARY KEY,
+    numeric_code integer NOT NULL UNIQUE,
+    minor_units integer NOT NULL,
+    enabled boolean NOT NULL default false,
+    symbol varchar(10) NOT NULL default '',
+    created_at timestamptz NOT NULL default now(),
+    updated_at timestamptz NOT NULL default now(),
+
+    CONSTRAINT minor_units_range CHECK (minor_units BETWEEN 0 AND 4)
+);
+
+INSERT INTO currencies (code, numeric_code, minor_units, enabled, symbol)
+VALUES ('USD', 840, 2, true, '$'),
+       ('EUR', 978, 2, true, '€'),
+       ('CAD', 124, 2, true, 'CA$'),
+       ('JPY', 392, 0, true, '¥'),
+       ('BHD', 48, 3, true, 'BD'),
+       ('GBP', 826, 2, false, '£'),
+       ('CHF', 756, 2, false, 'CHF'),
+       ('KWD', 414, 3, false, 'KD');
+
+ALTER TABLE accounts ADD CONSTRAINT fk_account_currency FOREIGN KEY (currency) REFERENCES currencies(code);
+
+ALTER TABLE users ADD "role" varchar(20) NOT NULL DEFAULT 'depositor';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users DROP COLUMN IF EXISTS "role";
+ALTER TABLE accounts DROP CONSTRAINT IF EXISTS fk_account_currency;
+DROP TABLE IF EXISTS currencies;
+-- +goose StatementEnd
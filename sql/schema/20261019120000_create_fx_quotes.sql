@@ -0,0 +1,47 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- system user that owns the per-currency FX position accounts,
+-- cross-currency transfers are booked through them
+INSERT INTO "users" ("username", "hashed_password", "full_name", "email")
+VALUES ('fx_position', '', 'FX Position', 'fx_position@system.local');
+
+INSERT INTO accounts ("owner", balance, currency)
+SELECT 'fx_position', 0, code FROM currencies;
+
+CREATE TABLE fx_quotes(
+    id uuid PRIMARY KEY default gen_random_uuid(),
+    "owner" varchar NOT NULL,
+    from_currency varchar(3) NOT NULL,
+    to_currency varchar(3) NOT NULL,
+    rate numeric(24,10) NOT NULL,
+    from_amount numeric(24,4) NOT NULL,
+    to_amount numeric(24,4) NOT NULL,
+    expires_at timestamptz NOT NULL,
+    used_at timestamptz,
+    created_at timestamptz NOT NULL default now(),
+
+    CONSTRAINT fx_rate_positive CHECK (rate > 0),
+    CONSTRAINT fx_amounts_positive CHECK (from_amount > 0 AND to_amount > 0),
+    CONSTRAINT fx_different_currencies CHECK (from_currency != to_currency),
+    CONSTRAINT fk_fx_quote_owner FOREIGN KEY ("owner") REFERENCES users(username) ON DELETE cascade,
+    CONSTRAINT fk_fx_quote_from_currency FOREIGN KEY (from_currency) REFERENCES currencies(code),
+    CONSTRAINT fk_fx_quote_to_currency FOREIGN KEY (to_currency) REFERENCES currencies(code)
+);
+
+CREATE INDEX idx_fx_quotes_owner ON fx_quotes("owner");
+
+ALTER TABLE transfers ADD fx_quote_id uuid REFERENCES fx_quotes(id);
+ALTER TABLE transfers ADD converted_amount numeric(24,4);
+CREATE UNIQUE INDEX idx_transfers_fx_quote_id ON transfers(fx_quote_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_transfers_fx_quote_id;
+ALTER TABLE transfers DROP COLUMN IF EXISTS converted_amount;
+ALTER TABLE transfers DROP COLUMN IF EXISTS fx_quote_id;
+DROP TABLE IF EXISTS fx_quotes;
+DELETE FROM accounts WHERE "owner" = 'fx_position';
+DELETE FROM "users" WHERE "username" = 'fx_position';
+-- +goose StatementEnd
@@ -0,0 +1,63 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE scheduled_transfers(
+    id uuid PRIMARY KEY default gen_random_uuid(),
+    "owner" varchar NOT NULL,
+    from_account_id uuid NOT NULL,
+    to_account_id uuid NOT NULL,
+    amount numeric(24,4) NOT NULL,
+    currency varchar(3) NOT NULL,
+    frequency varchar(30) NOT NULL,
+    start_at timestamptz NOT NULL,
+    end_at timestamptz,
+    -- next_run_at is the occurrence being executed, next_attempt_at is
+    -- when to (re)try it, attempts counts failures of that occurrence
+    next_run_at timestamptz NOT NULL,
+    next_attempt_at timestamptz NOT NULL,
+    attempts integer NOT NULL default 0,
+    status varchar(20) NOT NULL default 'active',
+    last_error text NOT NULL default '',
+    created_at timestamptz NOT NULL default now(),
+    updated_at timestamptz NOT NULL default now(),
+
+    CONSTRAINT scheduled_amount_positive CHECK (amount > 0),
+    CONSTRAINT scheduled_different_accounts CHECK (from_account_id != to_account_id),
+    CONSTRAINT scheduled_frequency_valid CHECK (frequency IN ('once', 'weekly', 'monthly', 'monthly_last_business_day')),
+    CONSTRAINT scheduled_status_valid CHECK (status IN ('active', 'paused', 'completed', 'failed', 'cancelled')),
+    CONSTRAINT fk_scheduled_owner FOREIGN KEY ("owner") REFERENCES users(username) ON DELETE cascade,
+    CONSTRAINT fk_scheduled_from_account FOREIGN KEY (from_account_id) REFERENCES accounts(id) ON DELETE cascade,
+    CONSTRAINT fk_scheduled_to_account FOREIGN KEY (to_account_id) REFERENCES accounts(id) ON DELETE cascade
+);
+
+CREATE INDEX idx_scheduled_transfers_owner ON scheduled_transfers("owner");
+CREATE INDEX idx_scheduled_transfers_due ON scheduled_transfers(next_attempt_at) WHERE status = 'active';
+
+CREATE TABLE scheduled_transfer_runs(
+    id uuid PRIMARY KEY default gen_random_uuid(),
+    scheduled_transfer_id uuid NOT NULL,
+    scheduled_for timestamptz NOT NULL,
+    attempt integer NOT NULL,
+    status varchar(20) NOT NULL default 'running',
+    transfer_id uuid,
+    error text NOT NULL default '',
+    created_at timestamptz NOT NULL default now(),
+    finished_at timestamptz,
+
+    CONSTRAINT run_status_valid CHECK (status IN ('running', 'succeeded', 'failed')),
+    CONSTRAINT fk_run_scheduled_transfer FOREIGN KEY (scheduled_transfer_id) REFERENCES scheduled_transfers(id) ON DELETE cascade,
+    CONSTRAINT fk_run_transfer FOREIGN KEY (transfer_id) REFERENCES transfers(id) ON DELETE SET NULL,
+    -- each attempt of an occurrence is claimed exactly once
+    CONSTRAINT run_attempt_key UNIQUE (scheduled_transfer_id, scheduled_for, attempt)
+);
+
+-- an occurrence can succeed at most once
+CREATE UNIQUE INDEX idx_scheduled_transfer_runs_succeeded
+    ON scheduled_transfer_runs(scheduled_transfer_id, scheduled_for)
+    WHERE status = 'succeeded';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS scheduled_transfer_runs;
+DROP TABLE IF EXISTS scheduled_transfers;
+-- +goose StatementEnd
@@ -0,0 +1,56 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE transfer_batches(
+    id uuid PRIMARY KEY default gen_random_uuid(),
+    "owner" varchar NOT NULL,
+    from_account_id uuid NOT NULL,
+    currency varchar(3) NOT NULL,
+    mode varchar(20) NOT NULL,
+    status varchar(30) NOT NULL default 'pending',
+    total_items integer NOT NULL,
+    total_amount numeric(24,4) NOT NULL,
+    succeeded_items integer NOT NULL default 0,
+    failed_items integer NOT NULL default 0,
+    error text NOT NULL default '',
+    created_at timestamptz NOT NULL default now(),
+    updated_at timestamptz NOT NULL default now(),
+    completed_at timestamptz,
+
+    CONSTRAINT batch_mode_valid CHECK (mode IN ('all_or_nothing', 'best_effort')),
+    CONSTRAINT batch_status_valid CHECK (status IN ('pending', 'processing', 'completed', 'partially_completed', 'failed')),
+    CONSTRAINT batch_items_positive CHECK (total_items > 0),
+    CONSTRAINT fk_batch_owner FOREIGN KEY ("owner") REFERENCES users(username) ON DELETE cascade,
+    CONSTRAINT fk_batch_from_account FOREIGN KEY (from_account_id) REFERENCES accounts(id) ON DELETE cascade
+);
+
+CREATE INDEX idx_transfer_batches_owner ON transfer_batches("owner");
+CREATE INDEX idx_transfer_batches_open ON transfer_batches(created_at) WHERE status IN ('pending', 'processing');
+
+-- to_account_id has no foreign key, an account closed after the batch was
+-- accepted fails its item when the batch runs instead of deleting it
+CREATE TABLE transfer_batch_items(
+    id uuid PRIMARY KEY default gen_random_uuid(),
+    batch_id uuid NOT NULL,
+    line integer NOT NULL,
+    to_account_id uuid NOT NULL,
+    amount numeric(24,4) NOT NULL,
+    status varchar(20) NOT NULL default 'pending',
+    transfer_id uuid,
+    error text NOT NULL default '',
+    processed_at timestamptz,
+
+    CONSTRAINT batch_item_amount_positive CHECK (amount > 0),
+    CONSTRAINT batch_item_status_valid CHECK (status IN ('pending', 'succeeded', 'failed')),
+    CONSTRAINT fk_batch_item_batch FOREIGN KEY (batch_id) REFERENCES transfer_batches(id) ON DELETE cascade,
+    CONSTRAINT fk_batch_item_transfer FOREIGN KEY (transfer_id) REFERENCES transfers(id) ON DELETE SET NULL,
+    CONSTRAINT batch_item_line_key UNIQUE (batch_id, line)
+);
+
+CREATE INDEX idx_transfer_batch_items_pending ON transfer_batch_items(batch_id, line) WHERE status = 'pending';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS transfer_batch_items;
+DROP TABLE IF EXISTS transfer_batches;
+-- +goose StatementEnd
@@ -0,0 +1,81 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- available_balance is balance minus the funds reserved by active holds,
+-- it is what transfers and withdrawals may spend
+ALTER TABLE accounts ADD COLUMN available_balance numeric(24,4) NOT NULL default 0;
+UPDATE accounts SET available_balance = balance;
+
+CREATE TABLE holds(
+    id uuid PRIMARY KEY default gen_random_uuid(),
+    account_id uuid NOT NULL,
+    to_account_id uuid NOT NULL,
+    amount numeric(24,4) NOT NULL,
+    currency varchar(3) NOT NULL,
+    captured_amount numeric(24,4) NOT NULL default 0,
+    description varchar NOT NULL default '',
+    status varchar(20) NOT NULL default 'active',
+    transfer_id uuid,
+    expires_at timestamptz NOT NULL,
+    created_at timestamptz NOT NULL default now(),
+    updated_at timestamptz NOT NULL default now(),
+
+    CONSTRAINT hold_amount_positive CHECK (amount > 0),
+    CONSTRAINT hold_capture_within_amount CHECK (captured_amount >= 0 AND captured_amount <= amount),
+    CONSTRAINT hold_different_accounts CHECK (account_id != to_account_id),
+    CONSTRAINT hold_status_valid CHECK (status IN ('active', 'captured', 'released', 'expired')),
+    CONSTRAINT fk_hold_account FOREIGN KEY (account_id) REFERENCES accounts(id) ON DELETE cascade,
+    CONSTRAINT fk_hold_to_account FOREIGN KEY (to_account_id) REFERENCES accounts(id) ON DELETE cascade,
+    CONSTRAINT fk_hold_transfer FOREIGN KEY (transfer_id) REFERENCES transfers(id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_holds_account_id ON holds(account_id);
+CREATE INDEX idx_holds_to_account_id ON holds(to_account_id);
+CREATE INDEX idx_holds_expiry ON holds(expires_at) WHERE status = 'active';
+
+CREATE OR REPLACE FUNCTION validate_transfer_balance()
+RETURNS TRIGGER AS $$
+DECLARE
+    current_balance numeric(24,4);
+BEGIN
+    -- Get the balance of the from_account that isn't reserved by holds
+    SELECT available_balance INTO current_balance
+    FROM accounts
+    WHERE id = NEW.from_account_id;
+
+    -- Check if sufficient balance exists
+    IF current_balance < NEW.amount THEN
+        RAISE EXCEPTION 'Insufficient balance. Available: %, Required: %',
+            current_balance, NEW.amount;
+    END IF;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION validate_transfer_balance()
+RETURNS TRIGGER AS $$
+DECLARE
+    current_balance numeric(24,4);
+BEGIN
+    -- Get the current balance of the from_account
+    SELECT balance INTO current_balance
+    FROM accounts
+    WHERE id = NEW.from_account_id;
+
+    -- Check if sufficient balance exists
+    IF current_balance < NEW.amount THEN
+        RAISE EXCEPTION 'Insufficient balance. Available: %, Required: %',
+            current_balance, NEW.amount;
+    END IF;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TABLE IF EXISTS holds;
+ALTER TABLE accounts DROP COLUMN IF EXISTS available_balance;
+-- +goose StatementEnd
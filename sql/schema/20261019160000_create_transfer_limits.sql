@@ -0,0 +1,41 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- a limit row applies either to one account or to all of a user's accounts
+-- in a currency, a NULL limit isn't enforced
+CREATE TABLE transfer_limits(
+    id uuid PRIMARY KEY default gen_random_uuid(),
+    account_id uuid,
+    "owner" varchar,
+    currency varchar(3) NOT NULL,
+    max_single_amount numeric(24,4),
+    daily_amount numeric(24,4),
+    monthly_amount numeric(24,4),
+    hourly_count integer,
+    created_at timestamptz NOT NULL default now(),
+    updated_at timestamptz NOT NULL default now(),
+
+    CONSTRAINT limit_one_scope CHECK ((account_id IS NULL) != ("owner" IS NULL)),
+    CONSTRAINT limit_amounts_positive CHECK (
+        (max_single_amount IS NULL OR max_single_amount > 0) AND
+        (daily_amount IS NULL OR daily_amount > 0) AND
+        (monthly_amount IS NULL OR monthly_amount > 0) AND
+        (hourly_count IS NULL OR hourly_count > 0)
+    ),
+    CONSTRAINT fk_limit_account FOREIGN KEY (account_id) REFERENCES accounts(id) ON DELETE cascade,
+    CONSTRAINT fk_limit_owner FOREIGN KEY ("owner") REFERENCES users(username) ON DELETE cascade,
+    CONSTRAINT fk_limit_currency FOREIGN KEY (currency) REFERENCES currencies(code)
+);
+
+CREATE UNIQUE INDEX idx_transfer_limits_account ON transfer_limits(account_id) WHERE account_id IS NOT NULL;
+CREATE UNIQUE INDEX idx_transfer_limits_owner ON transfer_limits("owner", currency) WHERE "owner" IS NOT NULL;
+
+-- outgoing totals are summed per account over the current month
+CREATE INDEX idx_transfers_from_account_created_at ON transfers(from_account_id, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_transfers_from_account_created_at;
+DROP TABLE IF EXISTS transfer_limits;
+-- +goose StatementEnd
@@ -0,0 +1,87 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- overdraft_limit is how far below zero the account may go,
+-- overdraft_interest_rate is the yearly rate charged on a negative balance
+ALTER TABLE accounts ADD COLUMN overdraft_limit numeric(24,4) NOT NULL default 0;
+ALTER TABLE accounts ADD COLUMN overdraft_interest_rate numeric(7,6) NOT NULL default 0;
+ALTER TABLE accounts ADD CONSTRAINT overdraft_limit_non_negative CHECK (overdraft_limit >= 0);
+ALTER TABLE accounts ADD CONSTRAINT overdraft_interest_rate_non_negative CHECK (overdraft_interest_rate >= 0);
+
+CREATE INDEX idx_accounts_overdrawn ON accounts(id) WHERE balance < 0;
+
+-- system user that owns the per-currency accounts overdraft interest is paid into,
+-- it has no usable password so it can never log in.
+INSERT INTO "users" ("username", "hashed_password", "full_name", "email")
+VALUES ('overdraft_interest', '', 'Overdraft Interest', 'overdraft_interest@system.local');
+
+INSERT INTO accounts ("owner", balance, currency)
+SELECT 'overdraft_interest', 0, code FROM currencies;
+
+-- one row per account and business date, so interest is charged once a day
+CREATE TABLE overdraft_interest_charges(
+    id uuid PRIMARY KEY default gen_random_uuid(),
+    account_id uuid NOT NULL,
+    business_date date NOT NULL,
+    balance numeric(24,4) NOT NULL,
+    interest_rate numeric(7,6) NOT NULL,
+    amount numeric(24,4) NOT NULL,
+    created_at timestamptz NOT NULL default now(),
+
+    CONSTRAINT overdraft_charge_amount_non_negative CHECK (amount >= 0),
+    CONSTRAINT fk_overdraft_charge_account FOREIGN KEY (account_id) REFERENCES accounts(id) ON DELETE cascade,
+    CONSTRAINT overdraft_charge_once_a_day UNIQUE (account_id, business_date)
+);
+
+CREATE OR REPLACE FUNCTION validate_transfer_balance()
+RETURNS TRIGGER AS $$
+DECLARE
+    current_balance numeric(24,4);
+BEGIN
+    -- Get what the from_account may spend, its available balance plus its overdraft
+    SELECT available_balance + overdraft_limit INTO current_balance
+    FROM accounts
+    WHERE id = NEW.from_account_id;
+
+    -- Check if sufficient balance exists
+    IF current_balance < NEW.amount THEN
+        RAISE EXCEPTION 'Insufficient balance. Available: %, Required: %',
+            current_balance, NEW.amount;
+    END IF;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION validate_transfer_balance()
+RETURNS TRIGGER AS $$
+DECLARE
+    current_balance numeric(24,4);
+BEGIN
+    -- Get the balance of the from_account that isn't reserved by holds
+    SELECT available_balance INTO current_balance
+    FROM accounts
+    WHERE id = NEW.from_account_id;
+
+    -- Check if sufficient balance exists
+    IF current_balance < NEW.amount THEN
+        RAISE EXCEPTION 'Insufficient balance. Available: %, Required: %',
+            current_balance, NEW.amount;
+    END IF;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TABLE IF EXISTS overdraft_interest_charges;
+DELETE FROM accounts WHERE "owner" = 'overdraft_interest';
+DELETE FROM "users" WHERE "username" = 'overdraft_interest';
+DROP INDEX IF EXISTS idx_accounts_overdrawn;
+ALTER TABLE accounts DROP CONSTRAINT IF EXISTS overdraft_interest_rate_non_negative;
+ALTER TABLE accounts DROP CONSTRAINT IF EXISTS overdraft_limit_non_negative;
+ALTER TABLE accounts DROP COLUMN IF EXISTS overdraft_interest_rate;
+ALTER TABLE accounts DROP COLUMN IF EXISTS overdraft_limit;
+-- +goose StatementEnd
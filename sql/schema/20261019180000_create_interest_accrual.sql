@@ -0,0 +1,103 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- an interest product pays annual_rate on end-of-day balances, each day is
+-- worth a fraction of a year given by day_count and the accrued interest is
+-- paid out at the end of every payout_frequency period
+CREATE TABLE interest_products(
+    id uuid PRIMARY KEY default gen_random_uuid(),
+    name varchar(100) NOT NULL,
+    currency varchar(3) NOT NULL,
+    annual_rate numeric(7,6) NOT NULL,
+    day_count varchar(20) NOT NULL default 'actual_365',
+    payout_frequency varchar(20) NOT NULL default 'monthly',
+    created_at timestamptz NOT NULL default now(),
+    updated_at timestamptz NOT NULL default now(),
+
+    CONSTRAINT interest_product_name_key UNIQUE (name),
+    CONSTRAINT interest_product_rate_non_negative CHECK (annual_rate >= 0),
+    CONSTRAINT interest_product_day_count_valid CHECK (day_count IN ('actual_365', 'actual_360', 'actual_actual')),
+    CONSTRAINT interest_product_payout_frequency_valid CHECK (payout_frequency IN ('monthly', 'quarterly', 'annually')),
+    CONSTRAINT fk_interest_product_currency FOREIGN KEY (currency) REFERENCES currencies(code)
+);
+
+-- a user may hold a checking and a savings account in the same currency
+ALTER TABLE accounts ADD COLUMN account_type varchar(20) NOT NULL default 'checking';
+ALTER TABLE accounts ADD COLUMN interest_product_id uuid;
+ALTER TABLE accounts ADD CONSTRAINT account_type_valid CHECK (account_type IN ('checking', 'savings'));
+ALTER TABLE accounts ADD CONSTRAINT interest_product_savings_only CHECK (interest_product_id IS NULL OR account_type = 'savings');
+ALTER TABLE accounts ADD CONSTRAINT fk_account_interest_product FOREIGN KEY (interest_product_id) REFERENCES interest_products(id);
+ALTER TABLE accounts DROP CONSTRAINT "owner_currency_key";
+ALTER TABLE accounts ADD CONSTRAINT "owner_currency_key" UNIQUE ("owner", currency, account_type);
+
+-- system user that owns the per-currency accounts interest is paid from,
+-- it has no usable password so it can never log in.
+INSERT INTO "users" ("username", "hashed_password", "full_name", "email")
+VALUES ('interest_expense', '', 'Interest Expense', 'interest_expense@system.local');
+
+INSERT INTO accounts ("owner", balance, currency)
+SELECT 'interest_expense', 0, code FROM currencies;
+
+-- interest payouts are rounded to the currency, the daily accruals aren't
+CREATE TABLE interest_payouts(
+    id uuid PRIMARY KEY default gen_random_uuid(),
+    account_id uuid NOT NULL,
+    period_end date NOT NULL,
+    accrued_amount numeric(30,10) NOT NULL,
+    amount numeric(24,4) NOT NULL,
+    created_at timestamptz NOT NULL default now(),
+
+    CONSTRAINT interest_payout_amount_non_negative CHECK (amount >= 0),
+    CONSTRAINT fk_interest_payout_account FOREIGN KEY (account_id) REFERENCES accounts(id) ON DELETE cascade,
+    CONSTRAINT interest_payout_once_a_period UNIQUE (account_id, period_end)
+);
+
+-- one accrual per account and business date keeps a rerun from accruing twice
+CREATE TABLE interest_accruals(
+    id uuid PRIMARY KEY default gen_random_uuid(),
+    account_id uuid NOT NULL,
+    interest_product_id uuid NOT NULL,
+    business_date date NOT NULL,
+    balance numeric(24,4) NOT NULL,
+    annual_rate numeric(7,6) NOT NULL,
+    day_count varchar(20) NOT NULL,
+    amount numeric(30,10) NOT NULL,
+    payout_id uuid,
+    created_at timestamptz NOT NULL default now(),
+
+    CONSTRAINT interest_accrual_amount_non_negative CHECK (amount >= 0),
+    CONSTRAINT fk_interest_accrual_account FOREIGN KEY (account_id) REFERENCES accounts(id) ON DELETE cascade,
+    CONSTRAINT fk_interest_accrual_product FOREIGN KEY (interest_product_id) REFERENCES interest_products(id),
+    CONSTRAINT fk_interest_accrual_payout FOREIGN KEY (payout_id) REFERENCES interest_payouts(id),
+    CONSTRAINT interest_accrual_once_a_day UNIQUE (account_id, business_date)
+);
+
+CREATE INDEX idx_interest_accruals_unpaid ON interest_accruals(account_id) WHERE payout_id IS NULL;
+
+-- a business date is recorded once every account was accrued for it,
+-- the job backfills from the last recorded date
+CREATE TABLE interest_runs(
+    business_date date PRIMARY KEY,
+    accrued int NOT NULL default 0,
+    paid_out int NOT NULL default 0,
+    completed_at timestamptz NOT NULL default now()
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS interest_runs;
+DROP TABLE IF EXISTS interest_accruals;
+DROP TABLE IF EXISTS interest_payouts;
+DELETE FROM accounts WHERE "owner" = 'interest_expense';
+DELETE FROM "users" WHERE "username" = 'interest_expense';
+DELETE FROM accounts WHERE account_type = 'savings';
+ALTER TABLE accounts DROP CONSTRAINT IF EXISTS "owner_currency_key";
+ALTER TABLE accounts ADD CONSTRAINT "owner_currency_key" UNIQUE ("owner", currency);
+ALTER TABLE accounts DROP CONSTRAINT IF EXISTS fk_account_interest_product;
+ALTER TABLE accounts DROP CONSTRAINT IF EXISTS interest_product_savings_only;
+ALTER TABLE accounts DROP CONSTRAINT IF EXISTS account_type_valid;
+ALTER TABLE accounts DROP COLUMN IF EXISTS interest_product_id;
+ALTER TABLE accounts DROP COLUMN IF EXISTS account_type;
+DROP TABLE IF EXISTS interest_products;
+-- +goose StatementEnd
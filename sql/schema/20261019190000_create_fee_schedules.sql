@@ -0,0 +1,70 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- the tier picks which fee rules price a user's transfers
+ALTER TABLE users ADD COLUMN tier varchar(20) NOT NULL default 'standard';
+ALTER TABLE users ADD CONSTRAINT user_tier_valid CHECK (tier IN ('standard', 'premium', 'business'));
+
+-- a fee schedule is one version of a currency's fees, changing the fees adds
+-- a new version and leaves the old one and its rules as they were
+CREATE TABLE fee_schedules(
+    id uuid PRIMARY KEY default gen_random_uuid(),
+    currency varchar(3) NOT NULL,
+    version integer NOT NULL,
+    created_by varchar NOT NULL,
+    created_at timestamptz NOT NULL default now(),
+
+    CONSTRAINT fk_fee_schedule_currency FOREIGN KEY (currency) REFERENCES currencies(code),
+    CONSTRAINT fk_fee_schedule_created_by FOREIGN KEY (created_by) REFERENCES users(username),
+    CONSTRAINT fee_schedule_version_unique UNIQUE (currency, version)
+);
+
+-- a rule prices the transfers of one tier from min_amount up to, but not
+-- including, max_amount: flat_fee plus percentage of the amount, kept
+-- between min_fee and max_fee. A NULL max_amount or max_fee has no bound.
+CREATE TABLE fee_rules(
+    id uuid PRIMARY KEY default gen_random_uuid(),
+    schedule_id uuid NOT NULL,
+    tier varchar(20) NOT NULL,
+    min_amount numeric(24,4) NOT NULL default 0,
+    max_amount numeric(24,4),
+    flat_fee numeric(24,4) NOT NULL default 0,
+    percentage numeric(7,6) NOT NULL default 0,
+    min_fee numeric(24,4) NOT NULL default 0,
+    max_fee numeric(24,4),
+
+    CONSTRAINT fee_rule_tier_valid CHECK (tier IN ('standard', 'premium', 'business')),
+    CONSTRAINT fee_rule_band_valid CHECK (min_amount >= 0 AND (max_amount IS NULL OR max_amount > min_amount)),
+    CONSTRAINT fee_rule_fees_non_negative CHECK (flat_fee >= 0 AND percentage >= 0 AND min_fee >= 0),
+    CONSTRAINT fee_rule_max_fee_valid CHECK (max_fee IS NULL OR max_fee >= min_fee),
+    CONSTRAINT fk_fee_rule_schedule FOREIGN KEY (schedule_id) REFERENCES fee_schedules(id) ON DELETE cascade
+);
+
+CREATE INDEX idx_fee_rules_schedule_tier ON fee_rules(schedule_id, tier, min_amount);
+
+-- a transfer keeps the fee it was charged and the rule that priced it
+ALTER TABLE transfers ADD COLUMN fee numeric(24,4) NOT NULL default 0;
+ALTER TABLE transfers ADD COLUMN fee_rule_id uuid;
+ALTER TABLE transfers ADD CONSTRAINT fk_transfer_fee_rule FOREIGN KEY (fee_rule_id) REFERENCES fee_rules(id);
+
+-- system user that owns the per-currency accounts transfer fees are paid into,
+-- it has no usable password so it can never log in.
+INSERT INTO "users" ("username", "hashed_password", "full_name", "email")
+VALUES ('fees', '', 'Fees', 'fees@system.local');
+
+INSERT INTO accounts ("owner", balance, currency)
+SELECT 'fees', 0, code FROM currencies;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM accounts WHERE "owner" = 'fees';
+DELETE FROM "users" WHERE "username" = 'fees';
+ALTER TABLE transfers DROP CONSTRAINT IF EXISTS fk_transfer_fee_rule;
+ALTER TABLE transfers DROP COLUMN IF EXISTS fee_rule_id;
+ALTER TABLE transfers DROP COLUMN IF EXISTS fee;
+DROP TABLE IF EXISTS fee_rules;
+DROP TABLE IF EXISTS fee_schedules;
+ALTER TABLE users DROP CONSTRAINT IF EXISTS user_tier_valid;
+ALTER TABLE users DROP COLUMN IF EXISTS tier;
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- an alias is a handle users choose so others can pay them without knowing
+-- their account ids, it is unique regardless of case
+ALTER TABLE users ADD COLUMN alias varchar(30);
+CREATE UNIQUE INDEX idx_users_alias ON users (lower(alias));
+
+-- only a verified email can be used to pay a user
+ALTER TABLE users ADD COLUMN email_verified_at timestamptz;
+
+-- system users own the bank's own accounts and can't be paid by handle
+UPDATE users SET "role" = 'system'
+WHERE username IN ('settlement', 'fx_position', 'overdraft_interest', 'interest_expense', 'fees');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+UPDATE users SET "role" = 'depositor' WHERE "role" = 'system';
+ALTER TABLE users DROP COLUMN IF EXISTS email_verified_at;
+DROP INDEX IF EXISTS idx_users_alias;
+ALTER TABLE users DROP COLUMN IF EXISTS alias;
+-- +goose StatementEnd
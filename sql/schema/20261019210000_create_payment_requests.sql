@@ -0,0 +1,51 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- a payment request asks payer to send requester money, accepting it pays
+-- it with a transfer from one of the payer's accounts
+CREATE TABLE payment_requests(
+    id uuid PRIMARY KEY default gen_random_uuid(),
+    requester varchar NOT NULL,
+    payer varchar NOT NULL,
+    amount numeric(24,4) NOT NULL,
+    currency varchar(3) NOT NULL,
+    memo varchar(140) NOT NULL default '',
+    status varchar(20) NOT NULL default 'pending',
+    transfer_id uuid,
+    expires_at timestamptz NOT NULL,
+    created_at timestamptz NOT NULL default now(),
+    updated_at timestamptz NOT NULL default now(),
+
+    CONSTRAINT payment_request_amount_positive CHECK (amount > 0),
+    CONSTRAINT payment_request_status_valid CHECK (status IN ('pending', 'paid', 'declined', 'cancelled')),
+    CONSTRAINT payment_request_not_self CHECK (requester <> payer),
+    CONSTRAINT fk_payment_request_requester FOREIGN KEY (requester) REFERENCES users(username) ON DELETE cascade,
+    CONSTRAINT fk_payment_request_payer FOREIGN KEY (payer) REFERENCES users(username) ON DELETE cascade,
+    CONSTRAINT fk_payment_request_currency FOREIGN KEY (currency) REFERENCES currencies(code),
+    CONSTRAINT fk_payment_request_transfer FOREIGN KEY (transfer_id) REFERENCES transfers(id)
+);
+
+CREATE INDEX idx_payment_requests_payer ON payment_requests(payer, created_at);
+CREATE INDEX idx_payment_requests_requester ON payment_requests(requester, created_at);
+
+-- notifications are a user's inbox of things that happened to them, they
+-- are written in the transaction that caused them
+CREATE TABLE notifications(
+    id uuid PRIMARY KEY default gen_random_uuid(),
+    username varchar NOT NULL,
+    kind varchar(50) NOT NULL,
+    payload jsonb NOT NULL default '{}',
+    read_at timestamptz,
+    created_at timestamptz NOT NULL default now(),
+
+    CONSTRAINT fk_notification_user FOREIGN KEY (username) REFERENCES users(username) ON DELETE cascade
+);
+
+CREATE INDEX idx_notifications_username ON notifications(username, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS notifications;
+DROP TABLE IF EXISTS payment_requests;
+-- +goose StatementEnd
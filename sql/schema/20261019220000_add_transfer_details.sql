@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- description is a free text memo, external_reference is the sender's own id
+-- for the transfer and metadata a small bag of client data
+ALTER TABLE transfers ADD COLUMN description varchar(255) NOT NULL default '';
+ALTER TABLE transfers ADD COLUMN external_reference varchar(64);
+ALTER TABLE transfers ADD COLUMN metadata jsonb NOT NULL default '{}';
+ALTER TABLE transfers ADD CONSTRAINT transfer_metadata_valid
+    CHECK (jsonb_typeof(metadata) = 'object' AND octet_length(metadata::text) <= 8192);
+
+-- a sending account can't reuse a reference, so a retried request is caught
+CREATE UNIQUE INDEX idx_transfers_external_reference ON transfers(from_account_id, external_reference)
+    WHERE external_reference IS NOT NULL;
+
+-- transfer history is searched from either side of the transfer
+CREATE INDEX idx_transfers_to_account_created_at ON transfers(to_account_id, created_at);
+CREATE INDEX idx_transfers_metadata ON transfers USING gin (metadata jsonb_path_ops);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_transfers_metadata;
+DROP INDEX IF EXISTS idx_transfers_to_account_created_at;
+DROP INDEX IF EXISTS idx_transfers_external_reference;
+ALTER TABLE transfers DROP CONSTRAINT IF EXISTS transfer_metadata_valid;
+ALTER TABLE transfers DROP COLUMN IF EXISTS metadata;
+ALTER TABLE transfers DROP COLUMN IF EXISTS external_reference;
+ALTER TABLE transfers DROP COLUMN IF EXISTS description;
+-- +goose StatementEnd
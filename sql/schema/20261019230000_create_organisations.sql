@@ -0,0 +1,106 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- an organisation lets several users move money out of the accounts linked
+-- to it, transfers above approval_threshold wait for required_approvals
+-- distinct approvers before they are made
+CREATE TABLE organisations(
+    id uuid PRIMARY KEY default gen_random_uuid(),
+    "name" varchar(100) NOT NULL,
+    approval_threshold numeric(24,4) NOT NULL,
+    required_approvals integer NOT NULL default 1,
+    approval_ttl_seconds integer NOT NULL default 86400,
+    created_by varchar NOT NULL,
+    created_at timestamptz NOT NULL default now(),
+    updated_at timestamptz NOT NULL default now(),
+
+    CONSTRAINT organisation_threshold_not_negative CHECK (approval_threshold >= 0),
+    CONSTRAINT organisation_required_approvals_positive CHECK (required_approvals > 0),
+    CONSTRAINT organisation_approval_ttl_positive CHECK (approval_ttl_seconds > 0),
+    CONSTRAINT fk_organisation_created_by FOREIGN KEY (created_by) REFERENCES users(username)
+);
+
+CREATE UNIQUE INDEX idx_organisations_name ON organisations(lower("name"));
+
+-- a member holds one row per role, so a user can both initiate and approve
+CREATE TABLE organisation_members(
+    organisation_id uuid NOT NULL,
+    username varchar NOT NULL,
+    "role" varchar(20) NOT NULL,
+    created_at timestamptz NOT NULL default now(),
+
+    PRIMARY KEY (organisation_id, username, "role"),
+    CONSTRAINT organisation_member_role_valid CHECK ("role" IN ('initiator', 'approver')),
+    CONSTRAINT fk_organisation_member_organisation FOREIGN KEY (organisation_id) REFERENCES organisations(id) ON DELETE cascade,
+    CONSTRAINT fk_organisation_member_user FOREIGN KEY (username) REFERENCES users(username) ON DELETE cascade
+);
+
+CREATE INDEX idx_organisation_members_username ON organisation_members(username);
+
+ALTER TABLE accounts ADD COLUMN organisation_id uuid;
+ALTER TABLE accounts ADD CONSTRAINT fk_account_organisation
+    FOREIGN KEY (organisation_id) REFERENCES organisations(id) ON DELETE SET NULL;
+
+-- a transfer waiting for approval reserves its amount on the from account
+-- like a hold, it is made through TransferTx once approved
+CREATE TABLE transfer_approvals(
+    id uuid PRIMARY KEY default gen_random_uuid(),
+    organisation_id uuid NOT NULL,
+    from_account_id uuid NOT NULL,
+    to_account_id uuid NOT NULL,
+    amount numeric(24,4) NOT NULL,
+    currency varchar(3) NOT NULL,
+    description varchar(255) NOT NULL default '',
+    external_reference varchar(64),
+    metadata jsonb NOT NULL default '{}',
+    initiated_by varchar NOT NULL,
+    required_approvals integer NOT NULL,
+    approvals integer NOT NULL default 0,
+    status varchar(20) NOT NULL default 'pending_approval',
+    transfer_id uuid,
+    expires_at timestamptz NOT NULL,
+    created_at timestamptz NOT NULL default now(),
+    updated_at timestamptz NOT NULL default now(),
+
+    CONSTRAINT transfer_approval_amount_positive CHECK (amount > 0),
+    CONSTRAINT transfer_approval_different_accounts CHECK (from_account_id <> to_account_id),
+    CONSTRAINT transfer_approval_status_valid CHECK (status IN ('pending_approval', 'executed', 'rejected', 'cancelled', 'expired')),
+    CONSTRAINT fk_transfer_approval_organisation FOREIGN KEY (organisation_id) REFERENCES organisations(id) ON DELETE cascade,
+    CONSTRAINT fk_transfer_approval_from_account FOREIGN KEY (from_account_id) REFERENCES accounts(id) ON DELETE cascade,
+    CONSTRAINT fk_transfer_approval_to_account FOREIGN KEY (to_account_id) REFERENCES accounts(id) ON DELETE cascade,
+    CONSTRAINT fk_transfer_approval_initiated_by FOREIGN KEY (initiated_by) REFERENCES users(username),
+    CONSTRAINT fk_transfer_approval_transfer FOREIGN KEY (transfer_id) REFERENCES transfers(id)
+);
+
+CREATE INDEX idx_transfer_approvals_organisation ON transfer_approvals(organisation_id, created_at);
+CREATE INDEX idx_transfer_approvals_expiry ON transfer_approvals(expires_at) WHERE status = 'pending_approval';
+
+-- every decision taken on a transfer approval, actor is empty for the
+-- ones the system takes
+CREATE TABLE approval_audit_log(
+    id bigserial PRIMARY KEY,
+    approval_id uuid NOT NULL,
+    organisation_id uuid NOT NULL,
+    actor varchar NOT NULL default '',
+    decision varchar(20) NOT NULL,
+    reason varchar(255) NOT NULL default '',
+    created_at timestamptz NOT NULL default now(),
+
+    CONSTRAINT approval_audit_decision_valid CHECK (decision IN ('initiated', 'approved', 'rejected', 'cancelled', 'expired', 'executed')),
+    CONSTRAINT fk_approval_audit_approval FOREIGN KEY (approval_id) REFERENCES transfer_approvals(id) ON DELETE cascade,
+    CONSTRAINT fk_approval_audit_organisation FOREIGN KEY (organisation_id) REFERENCES organisations(id) ON DELETE cascade
+);
+
+CREATE INDEX idx_approval_audit_log_approval ON approval_audit_log(approval_id, id);
+-- an approver signs off a transfer once
+CREATE UNIQUE INDEX idx_approval_audit_log_approver ON approval_audit_log(approval_id, actor) WHERE decision = 'approved';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS approval_audit_log;
+DROP TABLE IF EXISTS transfer_approvals;
+ALTER TABLE accounts DROP COLUMN IF EXISTS organisation_id;
+DROP TABLE IF EXISTS organisation_members;
+DROP TABLE IF EXISTS organisations;
+-- +goose StatementEnd
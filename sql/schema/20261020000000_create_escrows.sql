@@ -0,0 +1,74 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- system user that owns the per-currency accounts escrowed funds wait in
+INSERT INTO "users" ("username", "hashed_password", "full_name", "email", "role")
+VALUES ('escrow', '', 'Escrow', 'escrow@system.local', 'system');
+
+INSERT INTO accounts ("owner", balance, currency)
+SELECT 'escrow', 0, code FROM currencies;
+
+-- an escrow holds a payer's funds in the escrow account until both parties
+-- confirm, which releases them to the payee, or until a dispute or the
+-- expiry refunds them to the payer
+CREATE TABLE escrows(
+    id uuid PRIMARY KEY default gen_random_uuid(),
+    payer varchar NOT NULL,
+    payee varchar NOT NULL,
+    from_account_id uuid NOT NULL,
+    to_account_id uuid NOT NULL,
+    escrow_account_id uuid NOT NULL,
+    amount numeric(24,4) NOT NULL,
+    currency varchar(3) NOT NULL,
+    description varchar(255) NOT NULL default '',
+    status varchar(20) NOT NULL default 'funded',
+    payer_confirmed_at timestamptz,
+    payee_confirmed_at timestamptz,
+    funding_transfer_id uuid NOT NULL,
+    settlement_transfer_id uuid,
+    expires_at timestamptz NOT NULL,
+    created_at timestamptz NOT NULL default now(),
+    updated_at timestamptz NOT NULL default now(),
+
+    CONSTRAINT escrow_amount_positive CHECK (amount > 0),
+    CONSTRAINT escrow_status_valid CHECK (status IN ('funded', 'released', 'refunded')),
+    CONSTRAINT escrow_different_accounts CHECK (from_account_id <> to_account_id),
+    CONSTRAINT fk_escrow_payer FOREIGN KEY (payer) REFERENCES users(username) ON DELETE cascade,
+    CONSTRAINT fk_escrow_payee FOREIGN KEY (payee) REFERENCES users(username) ON DELETE cascade,
+    CONSTRAINT fk_escrow_from_account FOREIGN KEY (from_account_id) REFERENCES accounts(id) ON DELETE cascade,
+    CONSTRAINT fk_escrow_to_account FOREIGN KEY (to_account_id) REFERENCES accounts(id) ON DELETE cascade,
+    CONSTRAINT fk_escrow_escrow_account FOREIGN KEY (escrow_account_id) REFERENCES accounts(id),
+    CONSTRAINT fk_escrow_funding_transfer FOREIGN KEY (funding_transfer_id) REFERENCES transfers(id),
+    CONSTRAINT fk_escrow_settlement_transfer FOREIGN KEY (settlement_transfer_id) REFERENCES transfers(id)
+);
+
+CREATE INDEX idx_escrows_payer ON escrows(payer, created_at);
+CREATE INDEX idx_escrows_payee ON escrows(payee, created_at);
+CREATE INDEX idx_escrows_expiry ON escrows(expires_at) WHERE status = 'funded';
+
+-- every event of an escrow's state machine, actor is empty for the ones
+-- the system causes
+CREATE TABLE escrow_events(
+    id bigserial PRIMARY KEY,
+    escrow_id uuid NOT NULL,
+    event varchar(20) NOT NULL,
+    from_status varchar(20) NOT NULL,
+    to_status varchar(20) NOT NULL,
+    actor varchar NOT NULL default '',
+    reason varchar(255) NOT NULL default '',
+    created_at timestamptz NOT NULL default now(),
+
+    CONSTRAINT escrow_event_valid CHECK (event IN ('funded', 'confirmed', 'released', 'disputed', 'expired')),
+    CONSTRAINT fk_escrow_event_escrow FOREIGN KEY (escrow_id) REFERENCES escrows(id) ON DELETE cascade
+);
+
+CREATE INDEX idx_escrow_events_escrow ON escrow_events(escrow_id, id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS escrow_events;
+DROP TABLE IF EXISTS escrows;
+DELETE FROM accounts WHERE "owner" = 'escrow';
+DELETE FROM "users" WHERE "username" = 'escrow';
+-- +goose StatementEnd
@@ -0,0 +1,84 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- a transfer is completed when its money moves. A submitted transfer waits
+-- pending in the table, which doubles as the work queue, until a worker
+-- claims it for processing and completes or fails it. A completed transfer
+-- can later be reversed by a transfer the other way.
+ALTER TABLE transfers ADD COLUMN status varchar(20) NOT NULL default 'completed';
+ALTER TABLE transfers ADD COLUMN failure_reason text NOT NULL default '';
+ALTER TABLE transfers ADD COLUMN processing_at timestamptz;
+ALTER TABLE transfers ADD COLUMN completed_at timestamptz default now();
+ALTER TABLE transfers ADD COLUMN failed_at timestamptz;
+ALTER TABLE transfers ADD COLUMN reversed_at timestamptz;
+ALTER TABLE transfers ADD COLUMN reversal_transfer_id uuid;
+ALTER TABLE transfers ADD CONSTRAINT transfer_status_valid
+    CHECK (status IN ('pending', 'processing', 'completed', 'failed', 'reversed'));
+ALTER TABLE transfers ADD CONSTRAINT fk_transfer_reversal
+    FOREIGN KEY (reversal_transfer_id) REFERENCES transfers(id);
+
+UPDATE transfers SET completed_at = created_at;
+
+CREATE INDEX idx_transfers_queue ON transfers(created_at) WHERE status IN ('pending', 'processing');
+
+-- the money of a queued transfer moves when it is processed, so only
+-- completed transfers are checked against the balance when inserted
+CREATE OR REPLACE FUNCTION validate_transfer_balance()
+RETURNS TRIGGER AS $$
+DECLARE
+    current_balance numeric(24,4);
+BEGIN
+    IF NEW.status <> 'completed' THEN
+        RETURN NEW;
+    END IF;
+
+    -- Get what the from_account may spend, its available balance plus its overdraft
+    SELECT available_balance + overdraft_limit INTO current_balance
+    FROM accounts
+    WHERE id = NEW.from_account_id;
+
+    -- Check if sufficient balance exists
+    IF current_balance < NEW.amount THEN
+        RAISE EXCEPTION 'Insufficient balance. Available: %, Required: %',
+            current_balance, NEW.amount;
+    END IF;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION validate_transfer_balance()
+RETURNS TRIGGER AS $$
+DECLARE
+    current_balance numeric(24,4);
+BEGIN
+    -- Get what the from_account may spend, its available balance plus its overdraft
+    SELECT available_balance + overdraft_limit INTO current_balance
+    FROM accounts
+    WHERE id = NEW.from_account_id;
+
+    -- Check if sufficient balance exists
+    IF current_balance < NEW.amount THEN
+        RAISE EXCEPTION 'Insufficient balance. Available: %, Required: %',
+            current_balance, NEW.amount;
+    END IF;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DELETE FROM transfers WHERE status IN ('pending', 'processing', 'failed');
+DROP INDEX IF EXISTS idx_transfers_queue;
+ALTER TABLE transfers DROP CONSTRAINT IF EXISTS fk_transfer_reversal;
+ALTER TABLE transfers DROP CONSTRAINT IF EXISTS transfer_status_valid;
+ALTER TABLE transfers DROP COLUMN IF EXISTS reversal_transfer_id;
+ALTER TABLE transfers DROP COLUMN IF EXISTS reversed_at;
+ALTER TABLE transfers DROP COLUMN IF EXISTS failed_at;
+ALTER TABLE transfers DROP COLUMN IF EXISTS completed_at;
+ALTER TABLE transfers DROP COLUMN IF EXISTS processing_at;
+ALTER TABLE transfers DROP COLUMN IF EXISTS failure_reason;
+ALTER TABLE transfers DROP COLUMN IF EXISTS status;
+-- +goose StatementEnd
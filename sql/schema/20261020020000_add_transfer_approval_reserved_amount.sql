@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- a transfer waiting for approval reserves its fee as well as its amount,
+-- reserved_amount is what it took off the available balance, and gives
+-- back, whatever the fee schedule says by the time it is decided
+ALTER TABLE transfer_approvals ADD COLUMN reserved_amount numeric(24,4);
+UPDATE transfer_approvals SET reserved_amount = amount;
+ALTER TABLE transfer_approvals ALTER COLUMN reserved_amount SET NOT NULL;
+ALTER TABLE transfer_approvals ADD CONSTRAINT transfer_approval_reserved_covers_amount
+    CHECK (reserved_amount >= amount);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE transfer_approvals DROP COLUMN IF EXISTS reserved_amount;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- a payout only pays whole minor units, residue is the fraction of
+-- accrued_amount it left out and is carried into the account's next payout
+ALTER TABLE interest_payouts ADD COLUMN residue numeric(30,10) NOT NULL default 0;
+ALTER TABLE interest_payouts ADD CONSTRAINT interest_payout_residue_non_negative
+    CHECK (residue >= 0);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE interest_payouts DROP COLUMN IF EXISTS residue;
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- the approval threshold is an amount in approval_threshold_currency,
+-- transfers out of accounts in other currencies are converted before they
+-- are compared to it. Organisations made before it count in USD
+ALTER TABLE organisations ADD COLUMN approval_threshold_currency varchar(3) NOT NULL default 'USD';
+ALTER TABLE organisations ALTER COLUMN approval_threshold_currency DROP DEFAULT;
+ALTER TABLE organisations ADD CONSTRAINT fk_organisation_threshold_currency
+    FOREIGN KEY (approval_threshold_currency) REFERENCES currencies(code);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE organisations DROP COLUMN IF EXISTS approval_threshold_currency;
+-- +goose StatementEnd
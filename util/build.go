@@ -0,0 +1,29 @@
+package util
+
+import "runtime/debug"
+
+// Version and Commit describe the build, release builds set them with
+//
+//	go build -ldflags "-X github.com/Glenn444/banking-app/util.Version=v1.2.0 -X github.com/Glenn444/banking-app/util.Commit=$(git rev-parse HEAD)"
+var (
+	Version = "dev"
+	Commit  = ""
+)
+
+// BuildCommit returns Commit, falling back to the revision go build stamps
+// into binaries built inside a git checkout
+func BuildCommit() string {
+	if Commit != "" {
+		return Commit
+	}
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return ""
+	}
+	for _, setting := range info.Settings {
+		if setting.Key == "vcs.revision" {
+			return setting.Value
+		}
+	}
+	return ""
+}
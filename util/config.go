@@ -6,12 +6,51 @@ import (
 	"github.com/spf13/viper"
 )
 
+// Environment values, anything else counts as production
+const (
+	EnvironmentDevelopment = "development"
+	EnvironmentTest        = "test"
+	EnvironmentProduction  = "production"
+)
+
 type Config struct {
-	DBDriver           string        `mapstructure:"DB_DRIVER"`
-	DB_URL             string        `mapstructure:"DB_URL"`
-	ServerAddress      string        `mapstructure:"SERVER_ADDRESS"`
-	AcessTokenDuration time.Duration `mapstructure:"ACCESS_TOKEN_DURATION"`
-	TokenSymmetricKey  string        `mapstructure:"TokenSymmetricKey"`
+	Environment            string        `mapstructure:"ENVIRONMENT"`
+	DB_URL                 string        `mapstructure:"DB_URL"`
+	DBMaxConns             int32         `mapstructure:"DB_MAX_CONNS"`
+	DBMinConns             int32         `mapstructure:"DB_MIN_CONNS"`
+	DBMaxConnLifetime      time.Duration `mapstructure:"DB_MAX_CONN_LIFETIME"`
+	DBMaxConnIdleTime      time.Duration `mapstructure:"DB_MAX_CONN_IDLE_TIME"`
+	DBConnectTimeout       time.Duration `mapstructure:"DB_CONNECT_TIMEOUT"`
+	ServerAddress          string        `mapstructure:"SERVER_ADDRESS"`
+	HTTPReadTimeout        time.Duration `mapstructure:"HTTP_READ_TIMEOUT"`
+	HTTPReadHeaderTimeout  time.Duration `mapstructure:"HTTP_READ_HEADER_TIMEOUT"`
+	HTTPWriteTimeout       time.Duration `mapstructure:"HTTP_WRITE_TIMEOUT"`
+	HTTPIdleTimeout        time.Duration `mapstructure:"HTTP_IDLE_TIMEOUT"`
+	HTTPMaxHeaderBytes     int           `mapstructure:"HTTP_MAX_HEADER_BYTES"`
+	ShutdownTimeout        time.Duration `mapstructure:"SHUTDOWN_TIMEOUT"`
+	ShutdownDrainDelay     time.Duration `mapstructure:"SHUTDOWN_DRAIN_DELAY"`
+	TLSCertFile            string        `mapstructure:"TLS_CERT_FILE"`
+	TLSKeyFile             string        `mapstructure:"TLS_KEY_FILE"`
+	TLSClientCAFile        string        `mapstructure:"TLS_CLIENT_CA_FILE"`
+	GRPCServerAddress      string        `mapstructure:"GRPC_SERVER_ADDRESS"`
+	GatewayServerAddress   string        `mapstructure:"GATEWAY_SERVER_ADDRESS"`
+	AcessTokenDuration     time.Duration `mapstructure:"ACCESS_TOKEN_DURATION"`
+	TokenSymmetricKey      string        `mapstructure:"TokenSymmetricKey"`
+	CurrencyInterval       time.Duration `mapstructure:"CURRENCY_REFRESH_INTERVAL"`
+	FundingProvider        string        `mapstructure:"FUNDING_PROVIDER"`
+	FXRatesFile            string        `mapstructure:"FX_RATES_FILE"`
+	FXQuoteTTL             time.Duration `mapstructure:"FX_QUOTE_TTL"`
+	SchedulerInterval      time.Duration `mapstructure:"SCHEDULER_INTERVAL"`
+	SchedulerMaxAttempts   int32         `mapstructure:"SCHEDULER_MAX_ATTEMPTS"`
+	SchedulerRetryBackoff  time.Duration `mapstructure:"SCHEDULER_RETRY_BACKOFF"`
+	BatchWorkerInterval    time.Duration `mapstructure:"BATCH_WORKER_INTERVAL"`
+	BatchChunkSize         int32         `mapstructure:"BATCH_CHUNK_SIZE"`
+	TransferWorkerInterval time.Duration `mapstructure:"TRANSFER_WORKER_INTERVAL"`
+	HoldExpiryInterval     time.Duration `mapstructure:"HOLD_EXPIRY_INTERVAL"`
+	OverdraftInterval      time.Duration `mapstructure:"OVERDRAFT_INTEREST_INTERVAL"`
+	InterestInterval       time.Duration `mapstructure:"INTEREST_ACCRUAL_INTERVAL"`
+	ApprovalExpiryInterval time.Duration `mapstructure:"APPROVAL_EXPIRY_INTERVAL"`
+	EscrowExpiryInterval   time.Duration `mapstructure:"ESCROW_EXPIRY_INTERVAL"`
 }
 
 func LoadConfig(path string) (config Config, err error) {
@@ -21,6 +60,17 @@ func LoadConfig(path string) (config Config, err error) {
 
 	viper.AutomaticEnv()
 
+	// the HTTP servers never run without timeouts, whatever app.env leaves out
+	viper.SetDefault("HTTP_READ_TIMEOUT", 15*time.Second)
+	viper.SetDefault("HTTP_READ_HEADER_TIMEOUT", 5*time.Second)
+	viper.SetDefault("HTTP_WRITE_TIMEOUT", 30*time.Second)
+	viper.SetDefault("HTTP_IDLE_TIMEOUT", 2*time.Minute)
+	viper.SetDefault("HTTP_MAX_HEADER_BYTES", 1<<20)
+	viper.SetDefault("SHUTDOWN_TIMEOUT", 30*time.Second)
+	viper.SetDefault("SHUTDOWN_DRAIN_DELAY", 5*time.Second)
+	// development settings have to be asked for
+	viper.SetDefault("ENVIRONMENT", EnvironmentProduction)
+
 	err = viper.ReadInConfig()
 
 	if err != nil {
@@ -39,7 +39,7 @@ func RandomMoney()decimal.Decimal{
 }
 
 func RandomCurrency()string{
-	currencies := SupportedCurrencies
+	currencies := SupportedCurrencies()
 	n := len(currencies)
 	return currencies[rand.Intn(n)]
 }
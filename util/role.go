@@ -0,0 +1,8 @@
+package util
+
+const (
+	DepositorRole = "depositor"
+	AdminRole     = "admin"
+	// SystemRole owns the bank's own accounts, it can't log in or be paid by handle
+	SystemRole = "system"
+)
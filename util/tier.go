@@ -0,0 +1,7 @@
+package util
+
+const (
+	StandardTier = "standard"
+	PremiumTier  = "premium"
+	BusinessTier = "business"
+)
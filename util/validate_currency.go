@@ -1,23 +1,98 @@
 package util
 
-var SupportedCurrencies = []string{
-	"USD",
-	"EUR",
-	"CAD",
+import (
+	"sort"
+	"sync"
+
+	"github.com/shopspring/decimal"
+)
+
+// Currency holds the ISO 4217 details we need to handle amounts
+type Currency struct {
+	Code        string `json:"code"`
+	NumericCode int32  `json:"numeric_code"`
+	// MinorUnits is the number of decimals, e.g. 2 for USD, 0 for JPY
+	MinorUnits int32  `json:"minor_units"`
+	Enabled    bool   `json:"enabled"`
+	Symbol     string `json:"symbol"`
 }
 
-var currencySet map[string]bool
+// the registry is empty until SetCurrencies loads it, the server fills it
+// from the currencies table at startup and refuses to start if it can't
+var (
+	currencyMu  sync.RWMutex
+	currencySet map[string]Currency
+)
+
+// SetCurrencies replaces the in-memory currency registry
+func SetCurrencies(currencies []Currency) {
+	set := make(map[string]Currency, len(currencies))
+	for _, c := range currencies {
+		set[c.Code] = c
+	}
+
+	currencyMu.Lock()
+	defer currencyMu.Unlock()
+	currencySet = set
+}
 
-func init(){
-	currencySet = make(map[string]bool, len(SupportedCurrencies))
+// Currencies returns every currency in the registry
+func Currencies() []Currency {
+	currencyMu.RLock()
+	defer currencyMu.RUnlock()
 
-	for _, c := range SupportedCurrencies{
-		currencySet[c] = true
+	currencies := make([]Currency, 0, len(currencySet))
+	for _, c := range currencySet {
+		currencies = append(currencies, c)
 	}
+	return currencies
 }
 
+// SupportedCurrencies returns the codes of every currency in the registry,
+// sorted
+func SupportedCurrencies() []string {
+	currencyMu.RLock()
+	defer currencyMu.RUnlock()
 
+	codes := make([]string, 0, len(currencySet))
+	for code := range currencySet {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
 
+// IsSupportedCurrency reports whether the currency is known, enabled or not
 func IsSupportedCurrency(currency string) bool {
-	return currencySet[currency]
-}
\ No newline at end of file
+	_, ok := LookupCurrency(currency)
+	return ok
+}
+
+// IsEnabledCurrency reports whether new accounts may be opened in the currency
+func IsEnabledCurrency(currency string) bool {
+	c, ok := LookupCurrency(currency)
+	return ok && c.Enabled
+}
+
+// LookupCurrency returns the registry entry for a currency code
+func LookupCurrency(currency string) (Currency, bool) {
+	currencyMu.RLock()
+	defer currencyMu.RUnlock()
+
+	c, ok := currencySet[currency]
+	return c, ok
+}
+
+// IsValidAmount reports whether amount fits the currency's minor units
+func IsValidAmount(amount decimal.Decimal, currency string) bool {
+	c, ok := LookupCurrency(currency)
+	if !ok {
+		return false
+	}
+	return c.ValidScale(amount)
+}
+
+// ValidScale reports whether amount has no more decimals than the currency allows
+func (c Currency) ValidScale(amount decimal.Decimal) bool {
+	return amount.Equal(amount.Truncate(c.MinorUnits))
+}
@@ -0,0 +1,60 @@
+package util
+
+import (
+	"os"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+// testCurrencies seeds the registry, it starts empty outside the server
+var testCurrencies = []Currency{
+	{Code: "USD", NumericCode: 840, MinorUnits: 2, Enabled: true, Symbol: "$"},
+	{Code: "EUR", NumericCode: 978, MinorUnits: 2, Enabled: true, Symbol: "€"},
+	{Code: "CAD", NumericCode: 124, MinorUnits: 2, Enabled: true, Symbol: "CA$"},
+	{Code: "JPY", NumericCode: 392, MinorUnits: 0, Enabled: true, Symbol: "¥"},
+	{Code: "BHD", NumericCode: 48, MinorUnits: 3, Enabled: true, Symbol: "BD"},
+}
+
+func TestMain(m *testing.M) {
+	SetCurrencies(testCurrencies)
+	os.Exit(m.Run())
+}
+
+func TestIsValidAmount(t *testing.T) {
+	testCases := []struct {
+		amount   string
+		currency string
+		valid    bool
+	}{
+		{"10.25", "USD", true},
+		{"10.255", "USD", false},
+		{"10.2500", "USD", true},
+		{"1000", "JPY", true},
+		{"1000.5", "JPY", false},
+		{"1.125", "BHD", true},
+		{"1.1255", "BHD", false},
+		{"99999999999.99", "EUR", true},
+		{"10", "XXX", false},
+	}
+
+	for _, tc := range testCases {
+		amount := decimal.RequireFromString(tc.amount)
+		require.Equal(t, tc.valid, IsValidAmount(amount, tc.currency), "%s %s", tc.amount, tc.currency)
+	}
+}
+
+func TestSupportedCurrencies(t *testing.T) {
+	defer SetCurrencies(testCurrencies)
+
+	require.Equal(t, []string{"BHD", "CAD", "EUR", "JPY", "USD"}, SupportedCurrencies())
+
+	// the codes follow the registry, disabled currencies included
+	SetCurrencies([]Currency{
+		{Code: "USD", MinorUnits: 2, Enabled: true},
+		{Code: "GBP", MinorUnits: 2, Enabled: false},
+	})
+	require.Equal(t, []string{"GBP", "USD"}, SupportedCurrencies())
+	require.False(t, IsSupportedCurrency("EUR"))
+}